@@ -431,6 +431,11 @@ func New(
 		authtypes.FeeCollectorName,
 	)
 
+	// register the provider hooks here, e.g., of reward or monitoring modules
+	app.ProviderKeeper.SetHooks(
+		providertypes.NewMultiProviderHooks(),
+	)
+
 	govConfig := govtypes.DefaultConfig()
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -492,6 +497,9 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// set the TransferKeeper in the ProviderKeeper
+	app.ProviderKeeper.SetTransferKeeper(app.TransferKeeper)
+
 	// Add an IBC middleware callback to track the consumer rewards
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
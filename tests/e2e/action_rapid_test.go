@@ -61,6 +61,7 @@ func GetActionGen() *rapid.Generator[any] {
 		GetSubmitTextProposalActionGen().AsAny(),
 		GetSubmitConsumerAdditionProposalActionGen().AsAny(),
 		GetSubmitConsumerRemovalProposalActionGen().AsAny(),
+		GetUpdateConsumerPowerShapingActionGen().AsAny(),
 		GetSubmitParamChangeProposalActionGen().AsAny(),
 		GetVoteGovProposalActionGen().AsAny(),
 		GetStartConsumerChainActionGen().AsAny(),
@@ -81,6 +82,7 @@ func GetActionGen() *rapid.Generator[any] {
 		GetAssignConsumerPubKeyActionGen().AsAny(),
 		GetCreateIbcClientsActionGen().AsAny(),
 		GetSlashMeterReplenishmentAction().AsAny(),
+		GetWaitForSlashMeterReplenishAction().AsAny(),
 		GetWaitTimeAction().AsAny(),
 		CreateCancelUnbondTokensActionGen().AsAny(),
 		CreateLightClientEquivocationAttackActionGen().AsAny(),
@@ -224,6 +226,20 @@ func GetSubmitConsumerRemovalProposalActionGen() *rapid.Generator[SubmitConsumer
 	})
 }
 
+func GetUpdateConsumerPowerShapingActionGen() *rapid.Generator[UpdateConsumerPowerShapingAction] {
+	return rapid.Custom(func(t *rapid.T) UpdateConsumerPowerShapingAction {
+		return UpdateConsumerPowerShapingAction{
+			Chain:              GetChainIDGen().Draw(t, "Chain"),
+			From:               GetValidatorIDGen().Draw(t, "From"),
+			Deposit:            rapid.Uint().Draw(t, "Deposit"),
+			ConsumerChain:      GetChainIDGen().Draw(t, "ConsumerChain"),
+			TopN:               rapid.Uint32().Draw(t, "TopN"),
+			ValidatorsPowerCap: rapid.Uint32().Draw(t, "ValidatorsPowerCap"),
+			ValidatorSetCap:    rapid.Uint32().Draw(t, "ValidatorSetCap"),
+		}
+	})
+}
+
 func GetSubmitParamChangeProposalActionGen() *rapid.Generator[SubmitEnableTransfersProposalAction] {
 	return rapid.Custom(func(t *rapid.T) SubmitEnableTransfersProposalAction {
 		return SubmitEnableTransfersProposalAction{
@@ -440,6 +456,15 @@ func GetSlashMeterReplenishmentAction() *rapid.Generator[SlashMeterReplenishment
 	})
 }
 
+func GetWaitForSlashMeterReplenishAction() *rapid.Generator[WaitForSlashMeterReplenishAction] {
+	return rapid.Custom(func(t *rapid.T) WaitForSlashMeterReplenishAction {
+		return WaitForSlashMeterReplenishAction{
+			Replenishments: rapid.Uint().Draw(t, "Replenishments"),
+			Timeout:        time.Duration(rapid.Int().Draw(t, "Timeout")) * time.Millisecond,
+		}
+	})
+}
+
 func GetWaitTimeAction() *rapid.Generator[WaitTimeAction] {
 	return rapid.Custom(func(t *rapid.T) WaitTimeAction {
 		return WaitTimeAction{
@@ -46,8 +46,10 @@ type (
 	StartConsumerChainAction             = e2e.StartConsumerChainAction
 	SubmitConsumerAdditionProposalAction = e2e.SubmitConsumerAdditionProposalAction
 	SubmitConsumerRemovalProposalAction  = e2e.SubmitConsumerRemovalProposalAction
+	UpdateConsumerPowerShapingAction     = e2e.UpdateConsumerPowerShapingAction
 	DelegateTokensAction                 = e2e.DelegateTokensAction
 	UnbondTokensAction                   = e2e.UnbondTokensAction
+	WaitForSlashMeterReplenishAction     = e2e.WaitForSlashMeterReplenishAction
 )
 
 type SendTokensAction struct {
@@ -646,6 +648,62 @@ func (tr Chain) SubmitConsumerRemovalProposal(
 	tr.waitForTx(ChainID("provi"), bz, 30*time.Second)
 }
 
+// UpdateConsumerPowerShaping updates the power shaping parameters of a running consumer chain,
+// either directly by its owner or via a governance proposal (see UpdateConsumerPowerShapingAction)
+func (tr Chain) UpdateConsumerPowerShaping(
+	action UpdateConsumerPowerShapingAction,
+	verbose bool,
+) {
+	consumerId := string(tr.testConfig.ChainConfigs[action.ConsumerChain].ConsumerId)
+	providerChainCfg := tr.testConfig.ChainConfigs[action.Chain]
+
+	msg := types.MsgUpdateConsumer{
+		ConsumerId: consumerId,
+		PowerShapingParameters: &types.PowerShapingParameters{
+			Top_N:              action.TopN,
+			ValidatorsPowerCap: action.ValidatorsPowerCap,
+			ValidatorSetCap:    action.ValidatorSetCap,
+			Allowlist:          action.Allowlist,
+			Denylist:           action.Denylist,
+			Prioritylist:       action.Prioritylist,
+		},
+	}
+
+	if action.Deposit == 0 {
+		bz, err := tr.target.UpdateConsumer(action.Chain, action.From, msg, verbose)
+		if err != nil {
+			log.Fatalf("error on update consumer consumer-id=%s, err=%s, out=%s", consumerId, err.Error(), string(bz))
+		}
+		tr.waitForTx(action.Chain, bz, 10*time.Second)
+		return
+	}
+
+	// the consumer chain is owned by governance
+	msg.Owner = authority
+	title := "Propose the update of the power shaping parameters of a chain"
+	description := "description of the power shaping update proposal"
+	summary := "summary of a power shaping update proposal"
+	expedited := false
+	deposit := fmt.Sprintf("%dstake", action.Deposit)
+	jsonStr := e2e.GenerateGovProposalContent(title, summary, metadata, deposit, description, expedited, &msg)
+	bz, err := tr.target.SubmitGovProposal(providerChainCfg.ChainId, action.From, "", jsonStr, verbose)
+	if err != nil {
+		log.Fatalf("gov submit power shaping update command failed with error: '%s', out:'%s'",
+			err.Error(), string(bz))
+	}
+	txResponse := e2e.GetTxResponse(bz)
+	if txResponse.Code != 0 {
+		log.Fatalf("gov submit power shaping update transaction failed with error code %d, Log:'%s'", txResponse.Code, txResponse.RawLog)
+	}
+
+	if verbose {
+		fmt.Println("UpdateConsumerPowerShaping output:", string(bz))
+	}
+
+	// wait for inclusion in a block -> '--broadcast-mode block' is deprecated
+	tr.waitForTx(providerChainCfg.ChainId, bz, 20*time.Second)
+}
+
 type SubmitConsumerModificationProposalAction struct {
 	Chain              ChainID
 	From               ValidatorID
@@ -2423,6 +2481,41 @@ func (tr Chain) waitForSlashMeterReplenishment(
 	}
 }
 
+// WaitForSlashMeterReplenish polls the slash meter replenish candidate on the provider
+// and counts the replenishments that increase the slash meter until the expected number is reached
+func (tr Chain) WaitForSlashMeterReplenish(
+	action WaitForSlashMeterReplenishAction,
+	verbose bool,
+) {
+	timeout := time.Now().Add(action.Timeout)
+	candidate := tr.target.GetSlashMeterReplenishCandidate()
+	slashMeter := tr.target.GetSlashMeter()
+
+	replenishments := uint(0)
+	for replenishments < action.Replenishments {
+		if time.Now().After(timeout) {
+			panic(fmt.Sprintf("\n\nWaitForSlashMeterReplenish has timed out after: %s\n\n", action.Timeout))
+		}
+
+		tr.WaitTime(5 * time.Second)
+
+		newCandidate := tr.target.GetSlashMeterReplenishCandidate()
+		if !newCandidate.After(candidate) {
+			continue
+		}
+		newSlashMeter := tr.target.GetSlashMeter()
+		if newSlashMeter <= slashMeter {
+			panic(fmt.Sprintf("slash meter is full after %d replenishments, current value: %d", replenishments, newSlashMeter))
+		}
+		replenishments++
+		if verbose {
+			fmt.Printf("slash meter replenished (%d/%d), next candidate: %s, current value: %d\n",
+				replenishments, action.Replenishments, newCandidate, newSlashMeter)
+		}
+		candidate, slashMeter = newCandidate, newSlashMeter
+	}
+}
+
 type WaitTimeAction struct {
 	WaitTime time.Duration
 }
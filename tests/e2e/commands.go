@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/kylelemons/godebug/pretty"
@@ -542,6 +543,26 @@ func (tr Commands) GetSlashMeter() int64 {
 	return slashMeter.Int()
 }
 
+func (tr Commands) GetSlashMeterReplenishCandidate() time.Time {
+	binaryName := tr.ChainConfigs[ChainID("provi")].BinaryName
+	cmd := tr.Target.ExecCommand(binaryName,
+		"query", "provider", "throttle-state",
+		`--node`, tr.GetQueryNode(ChainID("provi")),
+		`-o`, `json`,
+	)
+	bz, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Fatal(err, "\n", string(bz))
+	}
+
+	candidate := gjson.Get(string(bz), "next_replenish_candidate").String()
+	replenishCandidate, err := time.Parse(time.RFC3339Nano, candidate)
+	if err != nil {
+		log.Fatal(err, "\n", string(bz))
+	}
+	return replenishCandidate
+}
+
 func (tr Commands) GetRegisteredConsumerRewardDenoms(chain ChainID) []string {
 	binaryName := tr.ChainConfigs[chain].BinaryName
 	cmd := tr.Target.ExecCommand(binaryName,
@@ -585,6 +606,34 @@ func (tr Commands) GetPendingPacketQueueSize(chain ChainID) uint {
 	return uint(len(packetData))
 }
 
+// GetConsumerPruningQueueSize returns the number of consumer addresses on the given consumer chain
+// that are scheduled for pruning on the provider
+func (tr Commands) GetConsumerPruningQueueSize(consumerChain ChainID) uint {
+	binaryName := tr.ChainConfigs[ChainID("provi")].BinaryName
+	consumerId := tr.ChainConfigs[consumerChain].ConsumerId
+	cmd := tr.Target.ExecCommand(binaryName,
+
+		"query", "provider", "consumer-addrs-to-prune",
+		string(consumerId),
+		`--node`, tr.GetQueryNode(ChainID("provi")),
+		`-o`, `json`,
+	)
+	bz, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Fatal(err, "\n", string(bz))
+	}
+
+	if !gjson.ValidBytes(bz) {
+		panic("invalid json response from query provider consumer-addrs-to-prune: " + string(bz))
+	}
+
+	size := uint(0)
+	for _, addrs := range gjson.Get(string(bz), "consumer_addrs_to_prune.#.consumer_addrs.addresses").Array() {
+		size += uint(len(addrs.Array()))
+	}
+	return size
+}
+
 // GetClientFrozenHeight returns the frozen height for a client with the given client ID
 // by querying the hosting chain with the given chainID
 func (tr Commands) GetClientFrozenHeight(chain ChainID, clientID string) (uint64, uint64) {
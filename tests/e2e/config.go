@@ -51,6 +51,7 @@ const (
 	MintTestCfg                  TestConfigType = "mint"
 	InactiveValsExtraValsTestCfg TestConfigType = "inactive-vals-extra-vals"
 	PermissionlessTestCfg        TestConfigType = "permissionless-ics"
+	KeyAssignmentPruningTestCfg  TestConfigType = "key-assignment-pruning"
 )
 
 // getIcsVersion returns earliest ICS version (relevant to config) a git reference is part of
@@ -134,6 +135,8 @@ func GetTestConfig(cfgType TestConfigType, providerVersion, consumerVersion stri
 		testCfg = InactiveValsExtraValsTestConfig()
 	case PermissionlessTestCfg:
 		testCfg = PermissionlessTestConfig()
+	case KeyAssignmentPruningTestCfg:
+		testCfg = KeyAssignmentPruningTestConfig()
 	default:
 		panic(fmt.Sprintf("Invalid test config: %s", cfgType))
 	}
@@ -633,6 +636,24 @@ func SmallMaxValidatorsTestConfig() TestConfig {
 	return cfg
 }
 
+// KeyAssignmentPruningUnbondingPeriod is the unbonding period of the provider chain in KeyAssignmentPruningTestConfig,
+// which is also the key pruning period of the consumer chains that do not set a KeyPruningPeriod
+const KeyAssignmentPruningUnbondingPeriod = 300 * time.Second
+
+// KeyAssignmentPruningTestConfig shortens the unbonding period of the provider chain,
+// so that the consumer addresses of replaced consumer keys are pruned while the test runs
+func KeyAssignmentPruningTestConfig() TestConfig {
+	cfg := DefaultTestConfig()
+	cfg.Name = string(KeyAssignmentPruningTestCfg)
+
+	proviConfig := cfg.ChainConfigs[ChainID("provi")]
+	proviConfig.GenesisChanges += fmt.Sprintf("| .app_state.staking.params.unbonding_time = \"%ds\"",
+		int(KeyAssignmentPruningUnbondingPeriod.Seconds()))
+	cfg.ChainConfigs[ChainID("provi")] = proviConfig
+
+	return cfg
+}
+
 func GovTestConfig() TestConfig {
 	cfg := DefaultTestConfig()
 
@@ -78,6 +78,12 @@ func UnmarshalMapToActionType(rawAction json.RawMessage, actionTypeString string
 		if err == nil {
 			return a, nil
 		}
+	case "main.UpdateConsumerPowerShapingAction":
+		var a UpdateConsumerPowerShapingAction
+		err := json.Unmarshal(rawAction, &a)
+		if err == nil {
+			return a, nil
+		}
 	case "main.SubmitEnableTransfersProposalAction":
 		var a SubmitEnableTransfersProposalAction
 		err := json.Unmarshal(rawAction, &a)
@@ -204,6 +210,12 @@ func UnmarshalMapToActionType(rawAction json.RawMessage, actionTypeString string
 		if err == nil {
 			return a, nil
 		}
+	case "main.WaitForSlashMeterReplenishAction":
+		var a WaitForSlashMeterReplenishAction
+		err := json.Unmarshal(rawAction, &a)
+		if err == nil {
+			return a, nil
+		}
 	case "main.WaitTimeAction":
 		var a WaitTimeAction
 		err := json.Unmarshal(rawAction, &a)
@@ -75,6 +75,7 @@ var (
 		"democracy":                DemocracyTestCfg,
 		"democracy-reward":         DemocracyRewardTestCfg,
 		"slash-throttle":           SlashThrottleTestCfg,
+		"slash-throttle-replenish": SlashThrottleTestCfg,
 		"multiconsumer":            MulticonsumerTestCfg,
 		"consumer-misbehaviour":    ConsumerMisbehaviourTestCfg,
 		"consumer-double-sign":     DefaultTestCfg,
@@ -121,6 +122,12 @@ var stepChoices = map[string]StepChoice{
 		description: "slash throttle tests",
 		testConfig:  SlashThrottleTestCfg,
 	},
+	"slash-throttle-replenish": {
+		name:        "slash-throttle-replenish",
+		steps:       slashThrottleReplenishSteps,
+		description: "slash throttle tests waiting for slash meter replenishments",
+		testConfig:  SlashThrottleTestCfg,
+	},
 	"multiconsumer": {
 		name:        "multiconsumer",
 		steps:       multipleConsumers,
@@ -265,6 +272,12 @@ var stepChoices = map[string]StepChoice{
 		description: "tests the behaviour of inactive validators with a top N = 100 chain and when max_validators is smaller than the total number of validators",
 		testConfig:  InactiveValsExtraValsTestCfg,
 	},
+	"key-assignment-pruning": {
+		name:        "key-assignment-pruning",
+		steps:       keyAssignmentPruningSteps,
+		description: "tests that the consumer addresses of replaced consumer keys are pruned once the key pruning period elapses",
+		testConfig:  KeyAssignmentPruningTestCfg,
+	},
 }
 
 func getTestCaseUsageString() string {
@@ -352,6 +365,7 @@ func getTestCases(selectedPredefinedTests, selectedTestFiles TestSet, providerVe
 			"democracy-reward",
 			"democracy",
 			"slash-throttle",
+			"slash-throttle-replenish",
 			"consumer-double-sign",
 			"consumer-misbehaviour",
 			"consumer-double-downtime",
@@ -47,6 +47,7 @@ func GetChainStateGen() *rapid.Generator[ChainState] {
 			providerKeys := GetProviderKeysGen().Draw(t, "ProviderKeys")
 			consumerPacketQueueSize := GetConsumerChainQueueSizesGen().Draw(t, "ConsumerChainQueueSizes")
 			registeredConsumerRewardDenoms := GetRegisteredConsumerRewardDenomsGen().Draw(t, "RegisteredConsumerRewardDenoms")
+			consumerPruningQueueSize := GetConsumerPruningQueueSizeGen().Draw(t, "ConsumerPruningQueueSize")
 
 			return ChainState{
 				ValBalances:                    &valBalances,
@@ -60,6 +61,7 @@ func GetChainStateGen() *rapid.Generator[ChainState] {
 				ProviderKeys:                   &providerKeys,
 				ConsumerPendingPacketQueueSize: &consumerPacketQueueSize,
 				RegisteredConsumerRewardDenoms: &registeredConsumerRewardDenoms,
+				ConsumerPruningQueueSize:       &consumerPruningQueueSize,
 			}
 		})
 }
@@ -76,6 +78,12 @@ func GetConsumerChainQueueSizesGen() *rapid.Generator[uint] {
 	})
 }
 
+func GetConsumerPruningQueueSizeGen() *rapid.Generator[map[ChainID]uint] {
+	return rapid.Custom(func(t *rapid.T) map[ChainID]uint {
+		return rapid.MapOf(GetChainIDGen(), rapid.Uint()).Draw(t, "ConsumerPruningQueueSize")
+	})
+}
+
 func GetProviderKeysGen() *rapid.Generator[map[ValidatorID]string] {
 	return rapid.Custom(func(t *rapid.T) map[ValidatorID]string {
 		return rapid.MapOf(GetValidatorIDGen(), rapid.String()).Draw(t, "ProviderKeys")
@@ -46,6 +46,13 @@ var happyPathSteps = concatSteps(
 	stepsStopChain("consu", 3),                     // stop chain
 )
 
+var keyAssignmentPruningSteps = concatSteps(
+	stepsStartChains([]string{"consu"}, false),
+	stepsDelegate("consu"),
+	stepsAssignConsumerKeyOnStartedChain("consu", "bob"),
+	stepsPruneConsumerAddrs("consu", KeyAssignmentPruningUnbondingPeriod),
+)
+
 var shortHappyPathSteps = concatSteps(
 	stepsStartChains([]string{"consu"}, false),
 	stepsDelegate("consu"),
@@ -77,6 +84,13 @@ var slashThrottleSteps = concatSteps(
 	stepsStopChain("consu", 2),
 )
 
+var slashThrottleReplenishSteps = concatSteps(
+	stepsStartChains([]string{"consu"}, false),
+	stepsDelegate("consu"),
+	stepsThrottledDowntimeReplenish("consu"),
+	stepsStopChain("consu", 2),
+)
+
 // these tests start with transfer SendEnabled set to false
 // one of the steps will set SendEnabled to true
 var democracyUnregisteredDenomSteps = concatSteps(
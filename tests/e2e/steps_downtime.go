@@ -413,6 +413,23 @@ func stepsDoubleDowntime(consumerName string) []Step {
 	}
 }
 
+// stepsThrottledDowntimeReplenish runs the throttled downtime steps but, instead of polling the
+// slash meter value, waits for the slash meter to be replenished three times before carol is jailed
+func stepsThrottledDowntimeReplenish(consumerName string) []Step {
+	steps := stepsThrottledDowntime(consumerName)
+	for i := range steps {
+		if _, ok := steps[i].Action.(SlashMeterReplenishmentAction); ok {
+			steps[i].Action = WaitForSlashMeterReplenishAction{
+				// Meter is decremented to -23% from bob being jailed and replenished by 10% every 20 seconds,
+				// after three replenishments it becomes positive again. 3*20 = 60 seconds + buffer = 100 seconds
+				Replenishments: 3,
+				Timeout:        100 * time.Second,
+			}
+		}
+	}
+	return steps
+}
+
 // stepsThrottledDowntime creates two consumer initiated downtime slash events and relays packets
 // No slashing should occur since the downtime slash was initiated from the consumer chain
 // Validators will simply be jailed
@@ -1010,6 +1010,72 @@ func stepsTopNChain() []Step {
 				},
 			},
 		},
+		{
+			// tighten the Top N to N = 50% and hence only "carol" has to validate
+			Action: UpdateConsumerPowerShapingAction{
+				Chain:         ChainID("provi"),
+				From:          ValidatorID("bob"),
+				Deposit:       10000001,
+				ConsumerChain: ChainID("consu"),
+				TopN:          50,
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					Proposals: &map[uint]Proposal{
+						2: ConsumerAdditionProposal{
+							Deposit: 10000001,
+							Chain:   ChainID("consu"),
+							Status:  gov.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD.String(),
+						},
+					},
+				},
+			},
+		},
+		{
+			Action: VoteGovProposalAction{
+				Chain:      ChainID("provi"),
+				From:       []ValidatorID{ValidatorID("bob"), ValidatorID("carol")},
+				Vote:       []string{"yes", "yes"},
+				PropNumber: 2,
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					Proposals: &map[uint]Proposal{
+						2: ConsumerAdditionProposal{
+							Deposit: 10000001,
+							Chain:   ChainID("consu"),
+							Status:  gov.ProposalStatus_PROPOSAL_STATUS_PASSED.String(),
+						},
+					},
+				},
+			},
+		},
+		{
+			// "bob" does not belong to the Top N validators anymore and can opt out
+			Action: OptOutAction{
+				Chain:     ChainID("consu"),
+				Validator: ValidatorID("bob"),
+			},
+			State: State{},
+		},
+		{
+			Action: RelayPacketsAction{
+				ChainA:  ChainID("provi"),
+				ChainB:  ChainID("consu"),
+				Port:    "provider",
+				Channel: 0,
+			},
+			State: State{
+				ChainID("consu"): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 0,
+						// "bob" has now opted out
+						ValidatorID("bob"):   0,
+						ValidatorID("carol"): 500,
+					},
+				},
+			},
+		},
 	}
 
 	return s
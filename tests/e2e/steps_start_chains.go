@@ -374,8 +374,38 @@ func stepsStartChains(consumerNames []string, setupTransferChans bool) []Step {
 	return s
 }
 
+// a consumer key that bob assigns and immediately replaces,
+// so that its consumer address is scheduled for pruning on the provider
+const (
+	throwawayConsumerPubKey                = `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"EW+ciudIsGbrM/s9nSTcF2WwgoZqa/QyCgNHbnmjzCE="}`
+	throwawayConsumerValconsAddrOnProvider = "cosmosvalcons1f75mqkeuw9gkwd00wfu7vswmaqukqw4tq9vnt0"
+)
+
 func stepsAssignConsumerKeyOnStartedChain(consumerName, validator string) []Step {
 	return []Step{
+		{
+			// bob assigns a consumer key that his node does not use; as the key is replaced
+			// by the next step before the VSC packets are relayed, the consumer chain never uses it
+			Action: AssignConsumerPubKeyAction{
+				Chain:           ChainID(consumerName),
+				Validator:       ValidatorID("bob"),
+				ConsumerPubkey:  throwawayConsumerPubKey,
+				ReconfigureNode: false,
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					ConsumerPruningQueueSize: &map[ChainID]uint{
+						// bob was using his provider key, so no old consumer address is scheduled for pruning
+						ChainID(consumerName): 0,
+					},
+				},
+				ChainID(consumerName): ChainState{
+					AssignedKeys: &map[ValidatorID]string{
+						ValidatorID("bob"): throwawayConsumerValconsAddrOnProvider,
+					},
+				},
+			},
+		},
 		{
 			Action: AssignConsumerPubKeyAction{
 				Chain:     ChainID(consumerName),
@@ -394,6 +424,10 @@ func stepsAssignConsumerKeyOnStartedChain(consumerName, validator string) []Step
 						ValidatorID("bob"):   500,
 						ValidatorID("carol"): 500,
 					},
+					ConsumerPruningQueueSize: &map[ChainID]uint{
+						// the consumer address of the replaced key is scheduled for pruning
+						ChainID(consumerName): 1,
+					},
 				},
 				ChainID(consumerName): ChainState{
 					ValPowers: &map[ValidatorID]uint{
@@ -452,3 +486,28 @@ func stepsAssignConsumerKeyOnStartedChain(consumerName, validator string) []Step
 		},
 	}
 }
+
+// stepsPruneConsumerAddrs waits for the given key pruning period of the consumer chain to elapse,
+// i.e., for the consumer addresses scheduled for pruning by stepsAssignConsumerKeyOnStartedChain to be pruned
+func stepsPruneConsumerAddrs(consumerName string, keyPruningPeriod time.Duration) []Step {
+	return []Step{
+		{
+			Action: WaitTimeAction{
+				// also wait for a few blocks to be produced after the key pruning period elapsed
+				WaitTime: keyPruningPeriod + 10*time.Second,
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					ConsumerPruningQueueSize: &map[ChainID]uint{
+						ChainID(consumerName): 0,
+					},
+				},
+				ChainID(consumerName): ChainState{
+					AssignedKeys: &map[ValidatorID]string{
+						ValidatorID("bob"): getDefaultValidators()[ValidatorID("bob")].ConsumerValconsAddressOnProvider,
+					},
+				},
+			},
+		},
+	}
+}
@@ -284,6 +284,14 @@ func (td *DefaultDriver) GetChainState(chain ChainID, modelState ChainState) e2e
 		chainState.ConsumerPendingPacketQueueSize = &pendingPacketQueueSize
 	}
 
+	if modelState.ConsumerPruningQueueSize != nil {
+		pruningQueueSizes := map[ChainID]uint{}
+		for consumerChain := range *modelState.ConsumerPruningQueueSize {
+			pruningQueueSizes[consumerChain] = providerDriver.target.GetConsumerPruningQueueSize(consumerChain)
+		}
+		chainState.ConsumerPruningQueueSize = &pruningQueueSizes
+	}
+
 	if *verbose {
 		log.Printf("Chain state for '%s':\n%s\n", chain, pretty.Sprint(chainState))
 	}
@@ -309,6 +317,9 @@ func (td *DefaultDriver) runAction(action interface{}) error {
 	case SubmitConsumerRemovalProposalAction:
 		target := td.getChainDriver(action.Chain)
 		target.SubmitConsumerRemovalProposal(action, td.verbose)
+	case UpdateConsumerPowerShapingAction:
+		target := td.getChainDriver(action.Chain)
+		target.UpdateConsumerPowerShaping(action, td.verbose)
 	case SubmitEnableTransfersProposalAction:
 		target := td.getTargetDriver(action.Chain)
 		target.submitEnableTransfersProposalAction(action, td.verbose)
@@ -396,6 +407,9 @@ func (td *DefaultDriver) runAction(action interface{}) error {
 	case SlashMeterReplenishmentAction:
 		target := td.getTargetDriver(ChainID("provi"))
 		target.waitForSlashMeterReplenishment(action, td.verbose)
+	case WaitForSlashMeterReplenishAction:
+		target := td.getChainDriver(ChainID("provi"))
+		target.WaitForSlashMeterReplenish(action, td.verbose)
 	case WaitTimeAction:
 		target := td.getTargetDriver("")
 		target.waitForTime(action, td.verbose)
@@ -56,6 +56,23 @@ type SubmitConsumerRemovalProposalAction struct {
 	StopTimeOffset time.Duration // offset from time.Now()
 }
 
+// UpdateConsumerPowerShapingAction updates the power shaping parameters of a consumer chain.
+// If Deposit is not zero, the update is submitted via a governance proposal,
+// as required for consumer chains owned by governance (e.g., Top N chains).
+// Otherwise, the update is sent by From, who has to be the owner of the consumer chain.
+type UpdateConsumerPowerShapingAction struct {
+	Chain              ChainID
+	From               ValidatorID
+	Deposit            uint
+	ConsumerChain      ChainID
+	TopN               uint32
+	ValidatorsPowerCap uint32
+	ValidatorSetCap    uint32
+	Allowlist          []string
+	Denylist           []string
+	Prioritylist       []string
+}
+
 type StartChainAction struct {
 	Chain      ChainID
 	Validators []StartChainValidator
@@ -91,6 +108,14 @@ type UnbondTokensAction struct {
 	Amount     uint
 }
 
+// WaitForSlashMeterReplenishAction waits until the slash meter on the provider
+// has been replenished the given number of times
+type WaitForSlashMeterReplenishAction struct {
+	Replenishments uint
+	// panic if timeout is exceeded
+	Timeout time.Duration
+}
+
 type ChainCommands interface {
 	// State commands - functions use by test driver to get state information
 	GetBlockHeight(chain ChainID) uint
@@ -106,7 +131,9 @@ type ChainCommands interface {
 	GetReward(chain ChainID, validator ValidatorID, blockHeight uint, denom string) float64
 	GetRegisteredConsumerRewardDenoms(chain ChainID) []string
 	GetSlashMeter() int64
+	GetSlashMeterReplenishCandidate() time.Time
 	GetPendingPacketQueueSize(chain ChainID) uint
+	GetConsumerPruningQueueSize(consumerChain ChainID) uint
 	GetProposedConsumerChains(chain ChainID) []string
 	GetQueryNode(chain ChainID) string
 	GetQueryNodeRPCAddress(chain ChainID) string
@@ -130,8 +157,10 @@ type ActionCommands interface {
 	StartChain(action StartChainAction, verbose bool)
 	StartConsumerChain(action StartConsumerChainAction, verbose bool)
 	SubmitConsumerRemovalProposal(action SubmitConsumerRemovalProposalAction, verbose bool)
+	UpdateConsumerPowerShaping(action UpdateConsumerPowerShapingAction, verbose bool)
 	DelegateTokens(action DelegateTokensAction, verbose bool)
 	UnbondTokens(action UnbondTokensAction, verbose bool)
+	WaitForSlashMeterReplenish(action WaitForSlashMeterReplenishAction, verbose bool)
 }
 type ChainIF interface {
 	ActionCommands
@@ -272,6 +301,7 @@ type ChainState struct {
 	HasToValidate                  *map[ValidatorID][]ChainID // only relevant to provider chain
 	InflationRateChange            *int                       // whether the inflation rate between two blocks changes negatively (any negative number), is equal (0), or changes positively (any positive number)
 	ConsumerCommissionRates        *map[ValidatorID]float64
+	ConsumerPruningQueueSize       *map[ChainID]uint // only relevant to provider chain
 }
 
 // custom marshal and unmarshal functions for the chainstate that convert proposals to/from the auxiliary type with type info
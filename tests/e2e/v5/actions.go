@@ -43,8 +43,10 @@ type (
 	StartChainValidator                 = e2e.StartChainValidator
 	StartConsumerChainAction            = e2e.StartConsumerChainAction
 	SubmitConsumerRemovalProposalAction = e2e.SubmitConsumerRemovalProposalAction
+	UpdateConsumerPowerShapingAction    = e2e.UpdateConsumerPowerShapingAction
 	DelegateTokensAction                = e2e.DelegateTokensAction
 	UnbondTokensAction                  = e2e.UnbondTokensAction
+	WaitForSlashMeterReplenishAction    = e2e.WaitForSlashMeterReplenishAction
 )
 
 type Chain struct {
@@ -296,6 +298,13 @@ func (tr Chain) SubmitConsumerRemovalProposal(
 	tr.waitForTx(ChainID("provi"), bz, 20*time.Second)
 }
 
+func (tr Chain) UpdateConsumerPowerShaping(
+	action UpdateConsumerPowerShapingAction,
+	verbose bool,
+) {
+	panic("'UpdateConsumerPowerShaping' is not implemented in this version")
+}
+
 func (tr *Chain) StartConsumerChain(
 	action StartConsumerChainAction,
 	verbose bool,
@@ -674,6 +683,41 @@ func (tr Chain) DelegateTokens(
 	tr.waitBlocks(action.Chain, 2, 10*time.Second)
 }
 
+// WaitForSlashMeterReplenish polls the slash meter replenish candidate on the provider
+// and counts the replenishments that increase the slash meter until the expected number is reached
+func (tr Chain) WaitForSlashMeterReplenish(
+	action WaitForSlashMeterReplenishAction,
+	verbose bool,
+) {
+	timeout := time.Now().Add(action.Timeout)
+	candidate := tr.Target.GetSlashMeterReplenishCandidate()
+	slashMeter := tr.Target.GetSlashMeter()
+
+	replenishments := uint(0)
+	for replenishments < action.Replenishments {
+		if time.Now().After(timeout) {
+			panic(fmt.Sprintf("\n\nWaitForSlashMeterReplenish has timed out after: %s\n\n", action.Timeout))
+		}
+
+		tr.WaitTime(5 * time.Second)
+
+		newCandidate := tr.Target.GetSlashMeterReplenishCandidate()
+		if !newCandidate.After(candidate) {
+			continue
+		}
+		newSlashMeter := tr.Target.GetSlashMeter()
+		if newSlashMeter <= slashMeter {
+			panic(fmt.Sprintf("slash meter is full after %d replenishments, current value: %d", replenishments, newSlashMeter))
+		}
+		replenishments++
+		if verbose {
+			fmt.Printf("slash meter replenished (%d/%d), next candidate: %s, current value: %d\n",
+				replenishments, action.Replenishments, newCandidate, newSlashMeter)
+		}
+		candidate, slashMeter = newCandidate, newSlashMeter
+	}
+}
+
 func (tr Chain) UnbondTokens(
 	action UnbondTokensAction,
 	verbose bool,
@@ -485,6 +485,26 @@ func (tr Commands) GetSlashMeter() int64 {
 	return slashMeter.Int()
 }
 
+func (tr Commands) GetSlashMeterReplenishCandidate() time.Time {
+	binaryName := tr.ChainConfigs[ChainID("provi")].BinaryName
+	cmd := tr.Target.ExecCommand(binaryName,
+		"query", "provider", "throttle-state",
+		`--node`, tr.GetQueryNode(ChainID("provi")),
+		`-o`, `json`,
+	)
+	bz, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Fatal(err, "\n", string(bz))
+	}
+
+	candidate := gjson.Get(string(bz), "next_replenish_candidate").String()
+	replenishCandidate, err := time.Parse(time.RFC3339Nano, candidate)
+	if err != nil {
+		log.Fatal(err, "\n", string(bz))
+	}
+	return replenishCandidate
+}
+
 func (tr Commands) GetRegisteredConsumerRewardDenoms(chain ChainID) []string {
 	binaryName := tr.ChainConfigs[chain].BinaryName
 	cmd := tr.Target.ExecCommand(binaryName,
@@ -767,6 +787,10 @@ func (tr Commands) GetInflationRate(
 	panic("'GetInflationRate' is not implemented in this version")
 }
 
+func (tr Commands) GetConsumerPruningQueueSize(consumerChain ChainID) uint {
+	panic("'GetConsumerPruningQueueSize' is not implemented in this version")
+}
+
 func (tr Commands) CreateConsumer(providerChain, consumerChain ChainID, validator ValidatorID,
 	metadata providertypes.ConsumerMetadata, initParams *providertypes.ConsumerInitializationParameters,
 	powerShapingParams *providertypes.PowerShapingParameters,
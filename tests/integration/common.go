@@ -352,21 +352,14 @@ func (s *CCVTestSuite) constructSlashPacketFromConsumerWithData(bundle icstestin
 	valsetUpdateId := bundle.GetKeeper().GetHeightValsetUpdateID(
 		bundle.GetCtx(), uint64(bundle.GetCtx().BlockHeight()))
 
-	spdData := ccv.SlashPacketData{
-		Validator: abci.Validator{
+	cpdData := ccv.NewSlashConsumerPacketData(
+		abci.Validator{
 			Address: tmVal.Address,
 			Power:   tmVal.VotingPower,
 		},
-		ValsetUpdateId: valsetUpdateId,
-		Infraction:     infractionType,
-	}
-
-	cpdData := ccv.ConsumerPacketData{
-		Type: ccv.SlashPacket,
-		Data: &ccv.ConsumerPacketData_SlashPacketData{
-			SlashPacketData: &spdData,
-		},
-	}
+		valsetUpdateId,
+		infractionType,
+	)
 
 	return channeltypes.NewPacket(cpdData.GetBytes(),
 		ibcSeqNum,
@@ -376,7 +369,7 @@ func (s *CCVTestSuite) constructSlashPacketFromConsumerWithData(bundle icstestin
 		bundle.Path.EndpointB.ChannelID, // Dst channel
 		clienttypes.Height{},
 		uint64(bundle.GetCtx().BlockTime().Add(ccv.DefaultCCVTimeoutPeriod).UnixNano()),
-	), spdData
+	), *cpdData.GetSlashPacketData()
 }
 
 // incrementTime increments the overall time by jumpPeriod
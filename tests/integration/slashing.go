@@ -341,6 +341,15 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	_, err = providerKeeper.OnRecvSlashPacket(ctx, packet, *slashPacketData)
 	suite.Require().NoError(err)
 
+	// Expect an error if validator does not exist and such slash packets are rejected
+	params := providerKeeper.GetParams(ctx)
+	params.RejectUnknownSlashValidators = true
+	providerKeeper.SetParams(ctx, params)
+	_, err = providerKeeper.OnRecvSlashPacket(ctx, packet, *slashPacketData)
+	suite.Require().ErrorIs(err, providertypes.ErrUnknownSlashPacketValidator)
+	params.RejectUnknownSlashValidators = false
+	providerKeeper.SetParams(ctx, params)
+
 	// Check expected behavior for handling SlashPackets for double signing infractions
 	slashPacketData.Infraction = stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
 	ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packet, *slashPacketData)
@@ -350,6 +359,10 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	// Check expected behavior for handling SlashPackets for downtime infractions
 	slashPacketData.Infraction = stakingtypes.Infraction_INFRACTION_DOWNTIME
 
+	// Use the address of an existing validator from now on
+	validAddress = suite.providerChain.Vals.Validators[0].Address
+	slashPacketData.Validator.Address = validAddress
+
 	// Expect the packet to bounce if the slash meter is negative
 	providerKeeper.SetSlashMeter(ctx, math.NewInt(-1))
 	// Only reaches the bouncing code if it fails in the check that chain is not launched and in the check that
@@ -603,6 +603,18 @@ func (s *CCVTestSuite) TestSlashMeterAllowanceChanges() {
 	params.SlashMeterReplenishFraction = "0.3"
 	providerKeeper.SetParams(s.providerCtx(), params)
 	s.Require().Equal(int64(1200), providerKeeper.GetSlashMeterAllowance(s.providerCtx()).Int64())
+
+	// Now we set a min absolute allowance and a tiny replenish fraction,
+	// such that the floor kicks in: 0.001 * 4000 = 4 < 500
+	params.SlashMeterReplenishFraction = "0.001"
+	params.SlashMeterMinAbsoluteAllowance = 500
+	providerKeeper.SetParams(s.providerCtx(), params)
+	s.Require().Equal(int64(500), providerKeeper.GetSlashMeterAllowance(s.providerCtx()).Int64())
+
+	// The floor has no effect when the fraction yields a larger allowance
+	params.SlashMeterReplenishFraction = "0.3"
+	providerKeeper.SetParams(s.providerCtx(), params)
+	s.Require().Equal(int64(1200), providerKeeper.GetSlashMeterAllowance(s.providerCtx()).Int64())
 }
 
 // TestSlashAllValidators is similar to TestSlashSameValidator, but 100% of validators' power is jailed in a single block.
@@ -95,6 +95,13 @@ func GetMocksForHandleSlashPacket(ctx sdk.Context, mocks MockedKeepers,
 	}
 
 	if expectJailing {
+		// the power of the validator is obtained before it is jailed
+		calls = append(calls, mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(
+			ctx, expectedProviderValConsAddr.ToSdkConsAddr()).Return(
+			valToReturn, nil,
+		).Times(1))
+		calls = append(calls, mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(
+			ctx, gomock.Any()).Return(valToReturn.ConsensusPower(sdk.DefaultPowerReduction), nil).AnyTimes())
 		// slash
 		calls = append(calls, mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(ctx, expectedProviderValConsAddr.ToSdkConsAddr(), gomock.Any(),
 			gomock.Any(), gomock.Any(), gomock.Any()).Return(math.NewInt(0), nil).Times(1))
@@ -202,10 +209,20 @@ func GetMocksForSlashValidator(
 // SetupMocksForLastBondedValidatorsExpectation sets up the expectation for the `GetBondedValidatorsByPower` and `MaxValidators` methods of the `mockStakingKeeper` object.
 // These are needed in particular when calling `GetLastBondedValidators` from the provider keeper.
 // Times is the number of times the expectation should be called. Provide -1 for `AnyTimes“.
+// It also sets up the `GetLastTotalPower` method to return the total power of `vals`,
+// which is needed when computing the validator set of a consumer chain.
 func SetupMocksForLastBondedValidatorsExpectation(mockStakingKeeper *MockStakingKeeper, maxValidators uint32, vals []stakingtypes.Validator, times int) {
 	validatorsCall := mockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(vals, nil)
 	maxValidatorsCall := mockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(maxValidators, nil)
 
+	totalPower := math.ZeroInt()
+	for _, val := range vals {
+		if !val.Tokens.IsNil() {
+			totalPower = totalPower.AddRaw(val.ConsensusPower(sdk.DefaultPowerReduction))
+		}
+	}
+	mockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(totalPower, nil).AnyTimes()
+
 	if times == -1 {
 		validatorsCall.AnyTimes()
 		maxValidatorsCall.AnyTimes()
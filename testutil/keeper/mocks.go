@@ -783,6 +783,20 @@ func (mr *MockChannelKeeperMockRecorder) ChanCloseInit(ctx, portID, channelID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChanCloseInit", reflect.TypeOf((*MockChannelKeeper)(nil).ChanCloseInit), ctx, portID, channelID)
 }
 
+// GetAllChannelsWithPortPrefix mocks base method.
+func (m *MockChannelKeeper) GetAllChannelsWithPortPrefix(ctx types1.Context, portPrefix string) []types7.IdentifiedChannel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllChannelsWithPortPrefix", ctx, portPrefix)
+	ret0, _ := ret[0].([]types7.IdentifiedChannel)
+	return ret0
+}
+
+// GetAllChannelsWithPortPrefix indicates an expected call of GetAllChannelsWithPortPrefix.
+func (mr *MockChannelKeeperMockRecorder) GetAllChannelsWithPortPrefix(ctx, portPrefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllChannelsWithPortPrefix", reflect.TypeOf((*MockChannelKeeper)(nil).GetAllChannelsWithPortPrefix), ctx, portPrefix)
+}
+
 // GetChannel mocks base method.
 func (m *MockChannelKeeper) GetChannel(ctx types1.Context, srcPort, srcChan string) (types7.Channel, bool) {
 	m.ctrl.T.Helper()
@@ -1261,6 +1275,20 @@ func (m *MockIBCTransferKeeper) EXPECT() *MockIBCTransferKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetParams mocks base method.
+func (m *MockIBCTransferKeeper) GetParams(ctx types1.Context) types4.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types4.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockIBCTransferKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockIBCTransferKeeper)(nil).GetParams), ctx)
+}
+
 // Transfer mocks base method.
 func (m *MockIBCTransferKeeper) Transfer(arg0 context.Context, arg1 *types4.MsgTransfer) (*types4.MsgTransferResponse, error) {
 	m.ctrl.T.Helper()
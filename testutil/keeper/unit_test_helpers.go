@@ -113,7 +113,7 @@ func NewMockedKeepers(ctrl *gomock.Controller) MockedKeepers {
 
 // NewInMemProviderKeeper instantiates an in-mem provider keeper from params and mocked keepers
 func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) providerkeeper.Keeper {
-	return providerkeeper.NewKeeper(
+	k := providerkeeper.NewKeeper(
 		params.Cdc,
 		params.StoreKey,
 		*params.ParamsSubspace,
@@ -132,6 +132,8 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		address.NewBech32Codec("cosmosvalcons"),
 		authtypes.FeeCollectorName,
 	)
+	k.SetTransferKeeper(mocks.MockIBCTransferKeeper)
+	return k
 }
 
 // NewInMemConsumerKeeper instantiates an in-mem consumer keeper from params and mocked keepers
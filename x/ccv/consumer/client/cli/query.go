@@ -24,6 +24,7 @@ func NewQueryCmd() *cobra.Command {
 		CmdProviderInfo(),
 		CmdThrottleState(),
 		CmdParams(),
+		CmdPendingPackets(),
 	)
 
 	return cmd
@@ -136,3 +137,30 @@ func CmdParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdPendingPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packets",
+		Short: "Query the pending packets queue, grouped by packet type",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingPacketsRequest{}
+			res, err := queryClient.QueryPendingPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -74,3 +74,29 @@ func (k Keeper) QueryThrottleState(c context.Context,
 	}
 	return &resp, nil
 }
+
+func (k Keeper) QueryPendingPackets(c context.Context,
+	req *types.QueryPendingPacketsRequest,
+) (*types.QueryPendingPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	resp := types.QueryPendingPacketsResponse{
+		SlashPackets:      []types.PendingPacket{},
+		VscMaturedPackets: []types.PendingPacket{},
+	}
+
+	// the pending packets are returned in the order of the queue, so the order within each type is preserved
+	for _, packet := range k.GetAllPendingPacketsWithIdx(ctx) {
+		pendingPacket := types.PendingPacket{Idx: packet.Idx, Data: packet.ConsumerPacketData}
+		switch packet.Type {
+		case ccvtypes.SlashPacket:
+			resp.SlashPackets = append(resp.SlashPackets, pendingPacket)
+		case ccvtypes.VscMaturedPacket:
+			resp.VscMaturedPackets = append(resp.VscMaturedPackets, pendingPacket)
+		}
+	}
+	return &resp, nil
+}
@@ -555,3 +555,45 @@ func TestDeleteHeadOfPendingPackets(t *testing.T) {
 	require.Len(t, pp, 1)
 	require.Equal(t, pp[0].Type, ccv.VscMaturedPacket)
 }
+
+func TestQueryPendingPackets(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := consumerKeeper.QueryPendingPackets(ctx, nil)
+	require.Error(t, err)
+
+	// empty queue
+	resp, err := consumerKeeper.QueryPendingPackets(ctx, &types.QueryPendingPacketsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.SlashPackets)
+	require.Empty(t, resp.VscMaturedPackets)
+
+	// append a mix of pending packets
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: &ccv.VSCMaturedPacketData{ValsetUpdateId: 1},
+	})
+	consumerKeeper.AppendPendingPacket(ctx, ccv.SlashPacket, &ccv.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: &ccv.SlashPacketData{ValsetUpdateId: 2},
+	})
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: &ccv.VSCMaturedPacketData{ValsetUpdateId: 3},
+	})
+	consumerKeeper.AppendPendingPacket(ctx, ccv.SlashPacket, &ccv.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: &ccv.SlashPacketData{ValsetUpdateId: 4},
+	})
+
+	// packets are grouped by type and keep their queue order
+	resp, err = consumerKeeper.QueryPendingPackets(ctx, &types.QueryPendingPacketsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.SlashPackets, 2)
+	require.Len(t, resp.VscMaturedPackets, 2)
+	require.Equal(t, uint64(1), resp.SlashPackets[0].Idx)
+	require.Equal(t, uint64(2), resp.SlashPackets[0].Data.GetSlashPacketData().ValsetUpdateId)
+	require.Equal(t, uint64(3), resp.SlashPackets[1].Idx)
+	require.Equal(t, uint64(4), resp.SlashPackets[1].Data.GetSlashPacketData().ValsetUpdateId)
+	require.Equal(t, uint64(0), resp.VscMaturedPackets[0].Idx)
+	require.Equal(t, uint64(1), resp.VscMaturedPackets[0].Data.GetVscMaturedPacketData().ValsetUpdateId)
+	require.Equal(t, uint64(2), resp.VscMaturedPackets[1].Idx)
+	require.Equal(t, uint64(3), resp.VscMaturedPackets[1].Data.GetVscMaturedPacketData().ValsetUpdateId)
+}
@@ -540,6 +540,150 @@ func (m *ChainInfo) GetChannelID() string {
 	return ""
 }
 
+type QueryPendingPacketsRequest struct {
+}
+
+func (m *QueryPendingPacketsRequest) Reset()         { *m = QueryPendingPacketsRequest{} }
+func (m *QueryPendingPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{10}
+}
+func (m *QueryPendingPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsRequest proto.InternalMessageInfo
+
+type QueryPendingPacketsResponse struct {
+	// the pending slash packets, in the order in which they are sent
+	SlashPackets []PendingPacket `protobuf:"bytes,1,rep,name=slash_packets,json=slashPackets,proto3" json:"slash_packets"`
+	// the pending VSCMatured packets, in the order in which they are sent
+	VscMaturedPackets []PendingPacket `protobuf:"bytes,2,rep,name=vsc_matured_packets,json=vscMaturedPackets,proto3" json:"vsc_matured_packets"`
+}
+
+func (m *QueryPendingPacketsResponse) Reset()         { *m = QueryPendingPacketsResponse{} }
+func (m *QueryPendingPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{11}
+}
+func (m *QueryPendingPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsResponse) GetSlashPackets() []PendingPacket {
+	if m != nil {
+		return m.SlashPackets
+	}
+	return nil
+}
+
+func (m *QueryPendingPacketsResponse) GetVscMaturedPackets() []PendingPacket {
+	if m != nil {
+		return m.VscMaturedPackets
+	}
+	return nil
+}
+
+// PendingPacket is a packet in the pending packets queue
+type PendingPacket struct {
+	// the index of the packet in the pending packets queue
+	Idx  uint64                   `protobuf:"varint,1,opt,name=idx,proto3" json:"idx,omitempty"`
+	Data types.ConsumerPacketData `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+}
+
+func (m *PendingPacket) Reset()         { *m = PendingPacket{} }
+func (m *PendingPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingPacket) ProtoMessage()    {}
+func (*PendingPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{12}
+}
+func (m *PendingPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPacket.Merge(m, src)
+}
+func (m *PendingPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPacket proto.InternalMessageInfo
+
+func (m *PendingPacket) GetIdx() uint64 {
+	if m != nil {
+		return m.Idx
+	}
+	return 0
+}
+
+func (m *PendingPacket) GetData() types.ConsumerPacketData {
+	if m != nil {
+		return m.Data
+	}
+	return types.ConsumerPacketData{}
+}
+
 func init() {
 	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
 	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
@@ -551,6 +695,9 @@ func init() {
 	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateRequest")
 	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateResponse")
 	proto.RegisterType((*ChainInfo)(nil), "interchain_security.ccv.consumer.v1.ChainInfo")
+	proto.RegisterType((*QueryPendingPacketsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryPendingPacketsRequest")
+	proto.RegisterType((*QueryPendingPacketsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryPendingPacketsResponse")
+	proto.RegisterType((*PendingPacket)(nil), "interchain_security.ccv.consumer.v1.PendingPacket")
 }
 
 func init() {
@@ -558,59 +705,67 @@ func init() {
 }
 
 var fileDescriptor_f627751d3cc10225 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xd3, 0x36, 0xbb, 0x99, 0x2e, 0x42, 0x3b, 0x04, 0xc9, 0x78, 0x57, 0xa1, 0x32, 0x20,
-	0xc2, 0x4a, 0xb1, 0x93, 0xec, 0x21, 0xcb, 0x61, 0x59, 0xb4, 0x1b, 0xaa, 0x46, 0x02, 0xd4, 0xba,
-	0x95, 0x10, 0x5c, 0xcc, 0x74, 0x32, 0x4d, 0x2c, 0x12, 0x8f, 0x3b, 0x33, 0x36, 0xed, 0x0d, 0xc1,
-	0x1d, 0x21, 0xf1, 0x4d, 0xf8, 0x02, 0x5c, 0x2b, 0x71, 0xa0, 0x12, 0x07, 0xe0, 0x82, 0x50, 0xcb,
-	0x87, 0xe0, 0x88, 0x66, 0x3c, 0x4e, 0x9d, 0x36, 0x4d, 0xdc, 0xb2, 0x37, 0xcf, 0xfb, 0xf3, 0x7b,
-	0xbf, 0xdf, 0x9b, 0x79, 0x2f, 0x01, 0x6e, 0x10, 0x0a, 0xc2, 0xf0, 0x08, 0x05, 0xa1, 0xcf, 0x09,
-	0x8e, 0x59, 0x20, 0x8e, 0x5d, 0x8c, 0x13, 0x17, 0xd3, 0x90, 0xc7, 0x13, 0xc2, 0xdc, 0xa4, 0xed,
-	0x1e, 0xc6, 0x84, 0x1d, 0x3b, 0x11, 0xa3, 0x82, 0xc2, 0xb7, 0xe6, 0x24, 0x38, 0x18, 0x27, 0x4e,
-	0x96, 0xe0, 0x24, 0x6d, 0xab, 0x75, 0x1d, 0x6a, 0xd2, 0x76, 0xf9, 0x08, 0x31, 0x32, 0xf0, 0xa7,
-	0xe1, 0x0a, 0xd6, 0xaa, 0x0d, 0xe9, 0x90, 0xaa, 0x4f, 0x57, 0x7e, 0x69, 0xeb, 0xc3, 0x21, 0xa5,
-	0xc3, 0x31, 0x71, 0x51, 0x14, 0xb8, 0x28, 0x0c, 0xa9, 0x40, 0x22, 0xa0, 0x21, 0xd7, 0xde, 0x4e,
-	0x11, 0xee, 0x97, 0xea, 0xbc, 0xb3, 0x80, 0xd9, 0xd7, 0x01, 0x23, 0x69, 0x98, 0xfd, 0x7d, 0x19,
-	0x3c, 0xf8, 0x94, 0x1c, 0x89, 0x4d, 0x42, 0x7a, 0x01, 0x17, 0x2c, 0xd8, 0x8f, 0x65, 0xe5, 0x8f,
-	0xb8, 0x08, 0x26, 0x48, 0x10, 0xf8, 0x36, 0x78, 0x05, 0xc7, 0x8c, 0x91, 0x50, 0x6c, 0x91, 0x60,
-	0x38, 0x12, 0xa6, 0xb1, 0x61, 0x34, 0x56, 0xbc, 0x59, 0x23, 0xac, 0x03, 0x30, 0x46, 0x3c, 0x0b,
-	0x29, 0xab, 0x90, 0x9c, 0x45, 0xfa, 0x43, 0x72, 0x94, 0xf9, 0x57, 0x52, 0xff, 0x85, 0x05, 0x3e,
-	0x06, 0xaf, 0x0f, 0x72, 0xd5, 0xfd, 0x03, 0x86, 0xb0, 0xfc, 0x30, 0x57, 0x37, 0x8c, 0x46, 0xd5,
-	0xab, 0xe5, 0x9d, 0x9b, 0xda, 0x07, 0x6b, 0x60, 0x4d, 0x50, 0x81, 0xc6, 0xe6, 0x9a, 0x0a, 0x4a,
-	0x0f, 0xb2, 0x94, 0xa0, 0xdb, 0x8c, 0x26, 0xc1, 0x80, 0x30, 0xb3, 0xa2, 0x5c, 0x39, 0x4b, 0xea,
-	0x7f, 0xa1, 0x7b, 0x65, 0xde, 0xc9, 0xfc, 0x99, 0xc5, 0x7e, 0x0f, 0xbc, 0xbb, 0x23, 0x5f, 0xc1,
-	0x82, 0xa6, 0x78, 0xe4, 0x30, 0x26, 0x5c, 0xd8, 0xdf, 0x18, 0xa0, 0xb1, 0x3c, 0x96, 0x47, 0x34,
-	0xe4, 0x04, 0xee, 0x81, 0xd5, 0x01, 0x12, 0x48, 0xf5, 0x6f, 0xbd, 0xf3, 0xa1, 0x53, 0xe0, 0x75,
-	0x39, 0x8b, 0x70, 0x15, 0x9a, 0x5d, 0x03, 0x50, 0x31, 0xd8, 0x46, 0x0c, 0x4d, 0x78, 0x46, 0xcc,
-	0x07, 0xaf, 0xcd, 0x58, 0x35, 0x85, 0x2d, 0x50, 0x89, 0x94, 0x45, 0x93, 0x78, 0x74, 0x2d, 0x89,
-	0xa4, 0xed, 0x64, 0x0d, 0x49, 0x31, 0x9e, 0xaf, 0x9e, 0xfc, 0xf5, 0x66, 0xc9, 0xd3, 0xf9, 0xb6,
-	0x05, 0xcc, 0xb4, 0x80, 0xee, 0x6a, 0x3f, 0x3c, 0xa0, 0x59, 0xf1, 0x9f, 0x0d, 0xf0, 0xc6, 0x1c,
-	0xa7, 0xe6, 0xb0, 0x0d, 0xee, 0x66, 0x0a, 0x35, 0x0b, 0xa7, 0x50, 0x2b, 0x5e, 0x48, 0xb7, 0x44,
-	0xd2, 0x4c, 0xa6, 0x28, 0x12, 0x31, 0xca, 0xae, 0xbb, 0xfc, 0x7f, 0x10, 0x33, 0x14, 0xfb, 0x81,
-	0x16, 0xb0, 0x37, 0x62, 0x54, 0x88, 0x31, 0xd9, 0x15, 0xb9, 0x4b, 0xff, 0xd3, 0x00, 0xd6, 0x3c,
-	0xaf, 0xd6, 0xf7, 0x39, 0xb8, 0xc7, 0xc7, 0x88, 0x8f, 0x7c, 0x46, 0x30, 0x65, 0x03, 0xad, 0xb1,
-	0x55, 0x88, 0xd1, 0xae, 0x4c, 0xf4, 0x54, 0x9e, 0xe2, 0x64, 0x78, 0xeb, 0xfc, 0xc2, 0x04, 0xbf,
-	0x04, 0xf7, 0x23, 0x84, 0xbf, 0x22, 0xc2, 0x97, 0x57, 0xef, 0x1f, 0xc6, 0x24, 0x26, 0x66, 0x79,
-	0x63, 0x65, 0xa1, 0xe2, 0x99, 0x9b, 0x94, 0xc9, 0x3d, 0x24, 0x90, 0x56, 0xfc, 0x6a, 0x34, 0xb5,
-	0xec, 0x48, 0x30, 0xfb, 0x3b, 0x03, 0x54, 0xa7, 0x6d, 0x81, 0x26, 0xb8, 0xa3, 0x00, 0xfb, 0x3d,
-	0xa5, 0xa2, 0xea, 0x65, 0x47, 0x68, 0x81, 0xbb, 0x78, 0x1c, 0x90, 0x50, 0xf4, 0x7b, 0xaa, 0xe5,
-	0x55, 0x6f, 0x7a, 0x86, 0x36, 0xb8, 0x87, 0x69, 0x18, 0x12, 0x35, 0xa3, 0xfd, 0x9e, 0x1a, 0xf6,
-	0xaa, 0x37, 0x63, 0x83, 0x0f, 0x41, 0x15, 0x8f, 0x50, 0x18, 0x92, 0x71, 0xbf, 0xa7, 0x47, 0xfc,
-	0xc2, 0xd0, 0xf9, 0xbd, 0x02, 0xd6, 0x54, 0x87, 0xe1, 0xbf, 0x86, 0x7e, 0x67, 0x73, 0x06, 0x01,
-	0x7e, 0x5c, 0xa8, 0xa7, 0x05, 0x67, 0xd9, 0xfa, 0xe4, 0x25, 0xa1, 0xa5, 0xcf, 0xc0, 0x7e, 0xf6,
-	0xed, 0x6f, 0xff, 0xfc, 0x58, 0x7e, 0x1f, 0x76, 0x97, 0xff, 0xec, 0xc8, 0x35, 0xd8, 0x3c, 0x20,
-	0xa4, 0x99, 0x5f, 0x72, 0xf0, 0x27, 0x03, 0xac, 0xe7, 0x66, 0x18, 0x76, 0x8b, 0xf3, 0x9b, 0xd9,
-	0x05, 0xd6, 0x93, 0x9b, 0x27, 0x6a, 0x0d, 0x2d, 0xa5, 0xe1, 0x11, 0x6c, 0x2c, 0xd7, 0x90, 0xae,
-	0x05, 0xf8, 0x8b, 0x01, 0xee, 0x5f, 0x19, 0x7d, 0xf8, 0xf4, 0x06, 0x0c, 0xae, 0xee, 0x13, 0xeb,
-	0x83, 0xdb, 0xa6, 0x6b, 0x19, 0x5d, 0x25, 0xa3, 0x0d, 0xdd, 0x02, 0x32, 0x74, 0x7e, 0x33, 0x90,
-	0xbc, 0x7f, 0x35, 0xf4, 0x72, 0x9d, 0x99, 0x74, 0x78, 0x03, 0x3e, 0xf3, 0x16, 0x88, 0xf5, 0xec,
-	0xd6, 0xf9, 0x5a, 0xd0, 0x13, 0x25, 0xa8, 0x03, 0x5b, 0xcb, 0x05, 0x09, 0x0d, 0xe0, 0x73, 0x89,
-	0xf0, 0xfc, 0xb3, 0x93, 0xb3, 0xba, 0x71, 0x7a, 0x56, 0x37, 0xfe, 0x3e, 0xab, 0x1b, 0x3f, 0x9c,
-	0xd7, 0x4b, 0xa7, 0xe7, 0xf5, 0xd2, 0x1f, 0xe7, 0xf5, 0xd2, 0x17, 0x4f, 0x87, 0x81, 0x18, 0xc5,
-	0xfb, 0x0e, 0xa6, 0x13, 0x17, 0x53, 0x3e, 0xa1, 0x3c, 0x07, 0xde, 0x9c, 0x82, 0x27, 0x5d, 0xf7,
-	0xe8, 0x52, 0x85, 0xe3, 0x88, 0xf0, 0xfd, 0x8a, 0xfa, 0x33, 0xf1, 0xf8, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2e, 0x98, 0xd9, 0x58, 0x65, 0x09, 0x00, 0x00,
+	// 954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x38, 0x7f, 0x1a, 0x4f, 0x1a, 0x41, 0xa6, 0x41, 0x32, 0x9b, 0xc8, 0x44, 0x0b, 0x88,
+	0x50, 0x29, 0xbb, 0xb1, 0x73, 0x48, 0x7b, 0x28, 0xad, 0x5a, 0x53, 0xc5, 0x12, 0x45, 0xe9, 0xb6,
+	0x12, 0x02, 0x09, 0x2d, 0x93, 0xf1, 0xc4, 0x5e, 0xd5, 0x9e, 0x71, 0x66, 0x66, 0x97, 0xe4, 0x86,
+	0xe0, 0x8e, 0x90, 0xf8, 0x1c, 0x5c, 0xf8, 0x02, 0x5c, 0x2b, 0x71, 0xa0, 0x52, 0x2f, 0x70, 0x41,
+	0x28, 0x41, 0x7c, 0x06, 0x8e, 0x68, 0x66, 0x67, 0x1d, 0x6f, 0xe3, 0xda, 0xeb, 0x84, 0xdb, 0xec,
+	0x7b, 0xf3, 0x7e, 0xef, 0xf7, 0x7b, 0x6f, 0xe6, 0xcd, 0x42, 0x3f, 0x62, 0x8a, 0x0a, 0xd2, 0xc1,
+	0x11, 0x0b, 0x25, 0x25, 0xb1, 0x88, 0xd4, 0x89, 0x4f, 0x48, 0xe2, 0x13, 0xce, 0x64, 0xdc, 0xa3,
+	0xc2, 0x4f, 0x6a, 0xfe, 0x51, 0x4c, 0xc5, 0x89, 0xd7, 0x17, 0x5c, 0x71, 0xf4, 0xee, 0x88, 0x00,
+	0x8f, 0x90, 0xc4, 0xcb, 0x02, 0xbc, 0xa4, 0xe6, 0x6c, 0xbf, 0x0e, 0x35, 0xa9, 0xf9, 0xb2, 0x83,
+	0x05, 0x6d, 0x85, 0x83, 0xed, 0x06, 0xd6, 0x59, 0x6d, 0xf3, 0x36, 0x37, 0x4b, 0x5f, 0xaf, 0xac,
+	0x75, 0xbd, 0xcd, 0x79, 0xbb, 0x4b, 0x7d, 0xdc, 0x8f, 0x7c, 0xcc, 0x18, 0x57, 0x58, 0x45, 0x9c,
+	0x49, 0xeb, 0xad, 0x17, 0xe1, 0xfe, 0x4a, 0x9e, 0xf7, 0xc7, 0x30, 0xfb, 0x3a, 0x12, 0x34, 0xdd,
+	0xe6, 0x7e, 0x5f, 0x82, 0x6b, 0x9f, 0xd2, 0x63, 0xf5, 0x90, 0xd2, 0x46, 0x24, 0x95, 0x88, 0x0e,
+	0x62, 0x9d, 0xf9, 0x63, 0xa9, 0xa2, 0x1e, 0x56, 0x14, 0xbd, 0x07, 0x97, 0x49, 0x2c, 0x04, 0x65,
+	0x6a, 0x8f, 0x46, 0xed, 0x8e, 0xaa, 0x80, 0x0d, 0xb0, 0x39, 0x1b, 0xe4, 0x8d, 0xa8, 0x0a, 0x61,
+	0x17, 0xcb, 0x6c, 0x4b, 0xc9, 0x6c, 0x19, 0xb2, 0x68, 0x3f, 0xa3, 0xc7, 0x99, 0x7f, 0x36, 0xf5,
+	0x9f, 0x5b, 0xd0, 0x0e, 0x7c, 0xab, 0x35, 0x94, 0x3d, 0x3c, 0x14, 0x98, 0xe8, 0x45, 0x65, 0x6e,
+	0x03, 0x6c, 0x96, 0x83, 0xd5, 0x61, 0xe7, 0x43, 0xeb, 0x43, 0xab, 0x70, 0x5e, 0x71, 0x85, 0xbb,
+	0x95, 0x79, 0xb3, 0x29, 0xfd, 0xd0, 0xa9, 0x14, 0xdf, 0x17, 0x3c, 0x89, 0x5a, 0x54, 0x54, 0x16,
+	0x8c, 0x6b, 0xc8, 0x92, 0xfa, 0x1f, 0xd8, 0x5a, 0x55, 0xae, 0x65, 0xfe, 0xcc, 0xe2, 0x7e, 0x08,
+	0x3f, 0x78, 0xac, 0x4f, 0xc1, 0x98, 0xa2, 0x04, 0xf4, 0x28, 0xa6, 0x52, 0xb9, 0xdf, 0x00, 0xb8,
+	0x39, 0x79, 0xaf, 0xec, 0x73, 0x26, 0x29, 0x7a, 0x0a, 0xe7, 0x5a, 0x58, 0x61, 0x53, 0xbf, 0xa5,
+	0xfa, 0x3d, 0xaf, 0xc0, 0xe9, 0xf2, 0xc6, 0xe1, 0x1a, 0x34, 0x77, 0x15, 0x22, 0xc3, 0x60, 0x1f,
+	0x0b, 0xdc, 0x93, 0x19, 0xb1, 0x10, 0xde, 0xc8, 0x59, 0x2d, 0x85, 0x3d, 0xb8, 0xd0, 0x37, 0x16,
+	0x4b, 0xe2, 0xe6, 0x6b, 0x49, 0x24, 0x35, 0x2f, 0x2b, 0x48, 0x8a, 0x71, 0x7f, 0xee, 0xf9, 0x9f,
+	0xef, 0xcc, 0x04, 0x36, 0xde, 0x75, 0x60, 0x25, 0x4d, 0x60, 0xab, 0xda, 0x64, 0x87, 0x3c, 0x4b,
+	0xfe, 0x0b, 0x80, 0x6f, 0x8f, 0x70, 0x5a, 0x0e, 0xfb, 0x70, 0x31, 0x53, 0x68, 0x59, 0x78, 0x85,
+	0x4a, 0xf1, 0x40, 0xbb, 0x35, 0x92, 0x65, 0x32, 0x40, 0xd1, 0x88, 0xfd, 0xac, 0xdd, 0xa5, 0xab,
+	0x20, 0x66, 0x28, 0xee, 0x9a, 0x15, 0xf0, 0xb4, 0x23, 0xb8, 0x52, 0x5d, 0xfa, 0x44, 0x0d, 0x35,
+	0xfd, 0x0f, 0x00, 0x9d, 0x51, 0x5e, 0xab, 0xef, 0x73, 0x78, 0x5d, 0x76, 0xb1, 0xec, 0x84, 0x82,
+	0x12, 0x2e, 0x5a, 0x56, 0xe3, 0x76, 0x21, 0x46, 0x4f, 0x74, 0x60, 0x60, 0xe2, 0x0c, 0x27, 0x10,
+	0x2c, 0xc9, 0x73, 0x13, 0xfa, 0x0a, 0xae, 0xf4, 0x31, 0x79, 0x46, 0x55, 0xa8, 0x5b, 0x1f, 0x1e,
+	0xc5, 0x34, 0xa6, 0x95, 0xd2, 0xc6, 0xec, 0x58, 0xc5, 0xb9, 0x4e, 0xea, 0xe0, 0x06, 0x56, 0xd8,
+	0x2a, 0x7e, 0xa3, 0x3f, 0xb0, 0x3c, 0xd6, 0x60, 0xee, 0x77, 0x00, 0x96, 0x07, 0x65, 0x41, 0x15,
+	0x78, 0xcd, 0x00, 0x36, 0x1b, 0x46, 0x45, 0x39, 0xc8, 0x3e, 0x91, 0x03, 0x17, 0x49, 0x37, 0xa2,
+	0x4c, 0x35, 0x1b, 0xa6, 0xe4, 0xe5, 0x60, 0xf0, 0x8d, 0x5c, 0x78, 0x9d, 0x70, 0xc6, 0xa8, 0xb9,
+	0xa3, 0xcd, 0x86, 0xb9, 0xec, 0xe5, 0x20, 0x67, 0x43, 0xeb, 0xb0, 0x4c, 0x3a, 0x98, 0x31, 0xda,
+	0x6d, 0x36, 0xec, 0x15, 0x3f, 0x37, 0xb8, 0xeb, 0xb6, 0xc0, 0xfb, 0x94, 0xb5, 0x22, 0xd6, 0x4e,
+	0x69, 0x0f, 0xce, 0xf6, 0x3f, 0x00, 0xae, 0x8d, 0x74, 0xdb, 0x06, 0x7c, 0x09, 0x97, 0xd3, 0x06,
+	0xa4, 0xe2, 0xf4, 0x59, 0xd7, 0x15, 0xaa, 0x17, 0xea, 0x40, 0x0e, 0xd3, 0x56, 0x29, 0xed, 0xa7,
+	0x4d, 0x83, 0x3a, 0xf0, 0x46, 0x22, 0x49, 0xd8, 0xc3, 0x2a, 0xd6, 0xc3, 0x3d, 0x4b, 0x52, 0xba,
+	0x62, 0x92, 0x95, 0x44, 0x92, 0x47, 0x29, 0xa6, 0xcd, 0xe4, 0x3e, 0x83, 0xcb, 0xb9, 0x9d, 0xe8,
+	0x4d, 0x38, 0x1b, 0xb5, 0x8e, 0x4d, 0x2f, 0xe6, 0x02, 0xbd, 0x44, 0x7b, 0x76, 0xa6, 0x4c, 0x3a,
+	0xf6, 0xe3, 0x0e, 0x81, 0x41, 0xa8, 0xff, 0xb4, 0x08, 0xe7, 0x4d, 0x55, 0xd1, 0xbf, 0xc0, 0xde,
+	0xed, 0x11, 0xc3, 0x07, 0x7d, 0x52, 0x48, 0x60, 0xc1, 0xf9, 0xe9, 0x3c, 0xfa, 0x9f, 0xd0, 0xd2,
+	0xce, 0xbb, 0x77, 0xbf, 0x7d, 0xf9, 0xf7, 0x8f, 0xa5, 0xdb, 0x68, 0x77, 0xf2, 0x53, 0xaf, 0x9f,
+	0x9e, 0xad, 0x43, 0x4a, 0xb7, 0x86, 0x1f, 0x16, 0xf4, 0x33, 0x80, 0x4b, 0x43, 0x73, 0x13, 0xed,
+	0x16, 0xe7, 0x97, 0x9b, 0xbf, 0xce, 0xad, 0xe9, 0x03, 0xad, 0x86, 0x6d, 0xa3, 0xe1, 0x26, 0xda,
+	0x9c, 0xac, 0x21, 0x1d, 0xc5, 0xe8, 0x57, 0x00, 0x57, 0x2e, 0x8c, 0x5b, 0x74, 0x67, 0x0a, 0x06,
+	0x17, 0x67, 0xb8, 0xf3, 0xd1, 0x65, 0xc3, 0xad, 0x8c, 0x5d, 0x23, 0xa3, 0x86, 0xfc, 0x02, 0x32,
+	0x6c, 0xfc, 0x56, 0xa4, 0x79, 0xff, 0x06, 0x20, 0xba, 0x38, 0x5d, 0xd1, 0x14, 0x7c, 0x46, 0x0d,
+	0x6d, 0xe7, 0xee, 0xa5, 0xe3, 0xad, 0xa0, 0x5b, 0x46, 0x50, 0x1d, 0x6d, 0x4f, 0x16, 0xa4, 0x2c,
+	0x40, 0x28, 0x0d, 0xf5, 0x97, 0x20, 0x7b, 0x8c, 0x73, 0xf3, 0x0a, 0x4d, 0x41, 0x69, 0xe4, 0x20,
+	0x74, 0xee, 0x5d, 0x1e, 0xc0, 0x8a, 0xba, 0x6d, 0x44, 0xed, 0xa0, 0x5a, 0x81, 0x2e, 0xa5, 0x08,
+	0xd9, 0xbc, 0xbb, 0xff, 0xd9, 0xf3, 0xd3, 0x2a, 0x78, 0x71, 0x5a, 0x05, 0x7f, 0x9d, 0x56, 0xc1,
+	0x0f, 0x67, 0xd5, 0x99, 0x17, 0x67, 0xd5, 0x99, 0xdf, 0xcf, 0xaa, 0x33, 0x5f, 0xdc, 0x69, 0x47,
+	0xaa, 0x13, 0x1f, 0x78, 0x84, 0xf7, 0x7c, 0xc2, 0x65, 0x8f, 0xcb, 0x21, 0xf4, 0xad, 0x01, 0x7a,
+	0xb2, 0xeb, 0x1f, 0xbf, 0x52, 0xb7, 0x93, 0x3e, 0x95, 0x07, 0x0b, 0xe6, 0xb7, 0x74, 0xe7, 0xbf,
+	0x01, 0x00, 0xe4, 0xef, 0xd7, 0xd7, 0xaf, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -633,6 +788,8 @@ type QueryClient interface {
 	QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error)
 	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
 	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+	// QueryPendingPackets returns the packets in the pending packets queue, grouped by type
+	QueryPendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error)
 }
 
 type queryClient struct {
@@ -679,6 +836,15 @@ func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleS
 	return out, nil
 }
 
+func (c *queryClient) QueryPendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error) {
+	out := new(QueryPendingPacketsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryPendingPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ConsumerGenesis queries the genesis state needed to start a consumer chain
@@ -689,6 +855,8 @@ type QueryServer interface {
 	QueryProviderInfo(context.Context, *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error)
 	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
 	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+	// QueryPendingPackets returns the packets in the pending packets queue, grouped by type
+	QueryPendingPackets(context.Context, *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -707,6 +875,9 @@ func (*UnimplementedQueryServer) QueryProviderInfo(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
 }
+func (*UnimplementedQueryServer) QueryPendingPackets(ctx context.Context, req *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingPackets not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -784,6 +955,24 @@ func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryPendingPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryPendingPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingPackets(ctx, req.(*QueryPendingPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "interchain_security.ccv.consumer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -804,6 +993,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryThrottleState",
 			Handler:    _Query_QueryThrottleState_Handler,
 		},
+		{
+			MethodName: "QueryPendingPackets",
+			Handler:    _Query_QueryPendingPackets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "interchain_security/ccv/consumer/v1/query.proto",
@@ -1178,6 +1371,118 @@ func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VscMaturedPackets) > 0 {
+		for iNdEx := len(m.VscMaturedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VscMaturedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SlashPackets) > 0 {
+		for iNdEx := len(m.SlashPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Idx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Idx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1340,6 +1645,50 @@ func (m *ChainInfo) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashPackets) > 0 {
+		for _, e := range m.SlashPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VscMaturedPackets) > 0 {
+		for _, e := range m.VscMaturedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Idx != 0 {
+		n += 1 + sovQuery(uint64(m.Idx))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2364,6 +2713,276 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashPackets = append(m.SlashPackets, PendingPacket{})
+			if err := m.SlashPackets[len(m.SlashPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscMaturedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VscMaturedPackets = append(m.VscMaturedPackets, PendingPacket{})
+			if err := m.VscMaturedPackets[len(m.VscMaturedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
+			}
+			m.Idx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Idx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -9,12 +10,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
 
+// FlagPhase is the flag used to filter consumer chains by phase
+const FlagPhase = "phase"
+
 // NewQueryCmd returns a root CLI command handler for all x/ccv/provider query commands.
 func NewQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -41,6 +46,45 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdConsumerIdFromClientId())
 	cmd.AddCommand(CmdConsumerChain())
 	cmd.AddCommand(CmdConsumerGenesisTime())
+	cmd.AddCommand(CmdVSCMaturationSchedule())
+	cmd.AddCommand(CmdSimulateConsumerUpdate())
+	cmd.AddCommand(CmdConsumerValidatorSetHash())
+	cmd.AddCommand(CmdValsetUpdateIdToHeight())
+	cmd.AddCommand(CmdRecentValsetUpdateIds())
+	cmd.AddCommand(CmdValidatorsUsingDefaultKey())
+	cmd.AddCommand(CmdConsumerRewardsAddress())
+	cmd.AddCommand(CmdTopNThreshold())
+	cmd.AddCommand(CmdConsumerPhaseHistory())
+	cmd.AddCommand(CmdConsumerAddrsToPrune())
+	cmd.AddCommand(CmdRecentKeyAssignments())
+	cmd.AddCommand(CmdJailingReason())
+	cmd.AddCommand(CmdConsumerSlashPacketRate())
+	cmd.AddCommand(CmdEffectiveConsumerKey())
+	cmd.AddCommand(CmdConsumerLaunchFailure())
+	cmd.AddCommand(CmdSlashPacketBySeq())
+	cmd.AddCommand(CmdConsumersWithThrottledSlashing())
+	cmd.AddCommand(CmdValidatorTopNObligations())
+	cmd.AddCommand(CmdConsumerGenesisValsetHash())
+	cmd.AddCommand(CmdConsumerDistribution())
+	cmd.AddCommand(CmdConsumerValidatorSets())
+	cmd.AddCommand(CmdValidatorKeyConflicts())
+	cmd.AddCommand(CmdConsumerRemovalETA())
+	cmd.AddCommand(CmdConsumerJailedPower())
+	cmd.AddCommand(CmdValidatorDenylistedConsumers())
+	cmd.AddCommand(CmdHasToValidate())
+	cmd.AddCommand(CmdConsumerCCVTimeout())
+	cmd.AddCommand(CmdKeyAssignmentStats())
+	cmd.AddCommand(CmdSlashMeterHistory())
+	cmd.AddCommand(CmdValidatorAllConsumerKeys())
+	cmd.AddCommand(CmdMaxProviderConsensusValidators())
+	cmd.AddCommand(CmdConsumersByOwner())
+	cmd.AddCommand(CmdConsumerChainIdAvailable())
+	cmd.AddCommand(CmdNextConsumerId())
+	cmd.AddCommand(CmdConsumerVSCLatency())
+	cmd.AddCommand(CmdConsumersByClientId())
+	cmd.AddCommand(CmdPruningInvariant())
+	cmd.AddCommand(CmdValidatorsConsumerObligations())
+	cmd.AddCommand(CmdAllDefaultKeyUsers())
 	return cmd
 }
 
@@ -77,9 +121,16 @@ func CmdConsumerChains() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-consumer-chains [phase]",
 		Short: "Query consumer chains for provider chain.",
-		Long: `Query consumer chains for provider chain. An optional
-		integer parameter can be passed for phase filtering of consumer chains,
-		(Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5).`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query consumer chains for provider chain. An optional
+phase can be passed, either as an argument or with the --%s flag, for phase filtering
+of consumer chains, either by name (%s) or as integer
+(Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5).
+
+Example:
+$ %s query provider list-consumer-chains --%s=LAUNCHED
+`, FlagPhase, strings.Join(types.ValidConsumerPhases(), "|"), version.AppName, FlagPhase),
+		),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -90,12 +141,23 @@ func CmdConsumerChains() *cobra.Command {
 
 			req := &types.QueryConsumerChainsRequest{}
 
+			phase, err := cmd.Flags().GetString(FlagPhase)
+			if err != nil {
+				return err
+			}
 			if len(args) >= 1 && args[0] != "" {
-				phase, err := strconv.ParseInt(args[0], 10, 32)
-				if err != nil {
-					return err
+				phase = args[0]
+			}
+			if phase != "" {
+				if phaseInt, err := strconv.ParseInt(phase, 10, 32); err == nil {
+					req.Phase = types.ConsumerPhase(phaseInt)
+				} else {
+					// fail early on unknown phases
+					req.Phase, err = types.ParseConsumerPhase(phase)
+					if err != nil {
+						return err
+					}
 				}
-				req.Phase = types.ConsumerPhase(phase)
 			}
 
 			fs, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
@@ -117,6 +179,7 @@ func CmdConsumerChains() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagPhase, "", "phase of the consumer chains to return")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "consumer chains")
 
@@ -612,3 +675,1468 @@ func CmdConsumerGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+func CmdVSCMaturationSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vsc-maturation-schedule [consumer-id]",
+		Short: "Query the maturity times of the pending VSCs sent to the consumer chain associated with the consumer id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVSCMaturationScheduleRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryVSCMaturationSchedule(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSimulateConsumerUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-consumer-update [consumer-id] [power-shaping-params-file]",
+		Short: "Query the validator set of a consumer chain with the given power-shaping parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator set a consumer chain would have if its power-shaping
+parameters were updated to the ones provided in a JSON file, as well as the validator
+updates with respect to its current validator set. The state is not updated.
+
+Example:
+$ %s query provider simulate-consumer-update 0 power-shaping-params.json
+
+where power-shaping-params.json contains:
+{
+    "top_N": 50,
+    "validators_power_cap": 10,
+    "validator_set_cap": 0,
+    "allowlist": [],
+    "denylist": [],
+    "min_stake": "0",
+    "allow_inactive_vals": false,
+    "prioritylist": []
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			paramsJson, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			powerShapingParams := types.PowerShapingParameters{}
+			if err := cdc.UnmarshalJSON(paramsJson, &powerShapingParams); err != nil {
+				return fmt.Errorf("power-shaping parameters unmarshalling failed: %s", err)
+			}
+
+			req := &types.QuerySimulateConsumerUpdateRequest{
+				ConsumerId:         args[0],
+				PowerShapingParams: &powerShapingParams,
+			}
+			res, err := queryClient.QuerySimulateConsumerUpdate(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerValidatorSetHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-valset-hash [consumer-id]",
+		Short: "Query the validator set hash the provider expects a consumer chain to use",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the CometBFT validator set hash of the last set consumer-validator set for a given launched consumer chain.
+The hash can be compared against the validator set hash reported by the consumer chain, e.g., in its block headers.
+Note that the hashes may differ temporarily because a VSCPacket could be delayed, etc.
+
+Example:
+$ %s query provider consumer-valset-hash 3
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerValidatorSetHash(cmd.Context(),
+				&types.QueryConsumerValidatorSetHashRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValsetUpdateIdToHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-update-id-to-height [vsc-id]",
+		Short: "Query the provider block height mapped to a valset update id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider block height mapped to the given valset update id.
+
+Example:
+$ %s query provider valset-update-id-to-height 42
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vscId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryValsetUpdateIdToHeight(cmd.Context(), &types.QueryValsetUpdateIdToHeightRequest{VscId: vscId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRecentValsetUpdateIds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent-valset-update-ids",
+		Short: "Query the most recent valset update ids and their provider block heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the most recent valset update ids, up to the max_valset_update_block_heights param, together with the provider block heights they are mapped to.
+
+Example:
+$ %s query provider recent-valset-update-ids
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryRecentValsetUpdateIds(cmd.Context(), &types.QueryRecentValsetUpdateIdsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorsUsingDefaultKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-using-default-key [consumer-id]",
+		Short: "Query the validators of a consumer chain that have not assigned a consumer key",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consensus addresses of the validators in the validator set of a given launched consumer chain
+that have not assigned a consumer key, i.e., that use their provider key on the consumer chain.
+
+Example:
+$ %s query provider validators-using-default-key 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryValidatorsUsingDefaultKey(cmd.Context(),
+				&types.QueryValidatorsUsingDefaultKeyRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRewardsAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-rewards-address [consumer-id]",
+		Short: "Query the address that collects the rewards of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the address of the module account that collects the rewards sent by a given consumer chain,
+i.e., the provider fee pool address sent to the consumer chain during the channel handshake.
+
+Example:
+$ %s query provider consumer-rewards-address 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerRewardsAddress(cmd.Context(),
+				&types.QueryConsumerRewardsAddressRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTopNThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-n-threshold [top-n]",
+		Short: "Query the minimum power needed to belong to the top N% of the active validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the minimum power needed for a validator to belong to the top N%% of the current active validators,
+i.e., the power threshold of a Top N consumer chain with the given N.
+
+Example:
+$ %s query provider top-n-threshold 67
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			topN, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryTopNThreshold(cmd.Context(),
+				&types.QueryTopNThresholdRequest{TopN: uint32(topN)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerPhaseHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-phase-history [consumer-id]",
+		Short: "Query the most recent phase transitions of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the most recent phase transitions of a given consumer chain,
+together with the provider block heights at which they took place.
+
+Example:
+$ %s query provider consumer-phase-history 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerPhaseHistory(cmd.Context(),
+				&types.QueryConsumerPhaseHistoryRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerAddrsToPrune() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-addrs-to-prune [consumer-id]",
+		Short: "Query the consumer addresses of a consumer chain that are scheduled for pruning",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consensus addresses previously used by validators on a given consumer chain
+that are scheduled for pruning, grouped by their prune timestamps.
+
+Example:
+$ %s query provider consumer-addrs-to-prune 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerAddrsToPrune(cmd.Context(),
+				&types.QueryConsumerAddrsToPruneRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRecentKeyAssignments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent-key-assignments [consumer-id] [since-vsc-id]",
+		Short: "Query the consumer key rotations that became effective after a given VSC packet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer key rotations of the validators on a given consumer chain
+that became effective after the VSC packet with the given id was sent,
+i.e., the provider addresses together with the old and new consumer addresses.
+
+Example:
+$ %s query provider recent-key-assignments 3 42
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sinceVscId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryRecentKeyAssignments(cmd.Context(),
+				&types.QueryRecentKeyAssignmentsRequest{ConsumerId: args[0], SinceVscId: sinceVscId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdJailingReason() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "jailing-reason [provider-address]",
+		Short: "Query why a jailed validator was jailed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the id of the consumer chain that caused the given validator to be jailed,
+together with the reported infraction, or "provider" if the validator was jailed on the provider chain.
+
+Example:
+$ %s query provider jailing-reason %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryJailingReason(cmd.Context(),
+				&types.QueryJailingReasonRequest{ProviderAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerSlashPacketRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-slash-packet-rate [consumer-id]",
+		Short: "Query the number of slash packets recently received from a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the number of slash packets received from a given consumer chain
+in the most recent blocks, together with the number of blocks in the window.
+
+Example:
+$ %s query provider consumer-slash-packet-rate 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerSlashPacketRate(cmd.Context(),
+				&types.QueryConsumerSlashPacketRateRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEffectiveConsumerKey() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "effective-consumer-key [consumer-id] [provider-validator-address]",
+		Short: "Query the consensus public key a validator uses on a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consensus public key a validator uses on a given consumer chain,
+i.e., the assigned consumer key, or the validator's provider consensus key if no key was assigned.
+The is_default field indicates whether the provider consensus key is used.
+
+Example:
+$ %s query provider effective-consumer-key 3 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryEffectiveConsumerKey(cmd.Context(),
+				&types.QueryEffectiveConsumerKeyRequest{
+					ConsumerId:      args[0],
+					ProviderAddress: addr.String(),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerLaunchFailure() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-launch-failure [consumer-id]",
+		Short: "Query the failed attempts to launch a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the number of failed attempts to launch a given consumer chain,
+the error of the last failed attempt and the phase of the consumer chain.
+
+Example:
+$ %s query provider consumer-launch-failure 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerLaunchFailure(cmd.Context(),
+				&types.QueryConsumerLaunchFailureRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSlashPacketBySeq() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-packet-by-seq [consumer-id] [ibc-seq]",
+		Short: "Query how a slash packet received from a consumer chain was processed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the processing status (handled, throttled or unknown) of the slash packet
+received from a given consumer chain with a given IBC sequence number, and whether the validator was jailed.
+The slash packets received more than an unbonding period ago are unknown.
+
+Example:
+$ %s query provider slash-packet-by-seq 3 12
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ibcSeq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuerySlashPacketBySeq(cmd.Context(),
+				&types.QuerySlashPacketBySeqRequest{ConsumerId: args[0], IbcSeq: ibcSeq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConsumersWithThrottledSlashing returns the consumer chains that currently have slash packets throttled
+func CmdConsumersWithThrottledSlashing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumers-with-throttled-slashing",
+		Short: "Query the consumer chains that currently have slash packets throttled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer chains that currently have slash packets throttled,
+together with the number of throttled slash packets per consumer chain.
+Example:
+$ %s query provider consumers-with-throttled-slashing
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumersWithThrottledSlashing(cmd.Context(),
+				&types.QueryConsumersWithThrottledSlashingRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdValidatorTopNObligations returns the launched Top N consumer chains that a given validator is forced to validate
+func CmdValidatorTopNObligations() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-top-n-obligations [provider-validator-address]",
+		Short: "Query the Top N consumer chains a given validator is forced to validate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the launched Top N consumer chains for which the power of the given validator
+is at or above the Top N threshold, i.e., the chains the validator is forced to validate.
+Example:
+$ %s query provider validator-top-n-obligations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryValidatorTopNObligations(cmd.Context(),
+				&types.QueryValidatorTopNObligationsRequest{
+					ProviderAddress: addr.String(),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConsumerGenesisValsetHash returns the hash of the initial validator set of a given consumer chain
+func CmdConsumerGenesisValsetHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-genesis-valset-hash [consumer-id]",
+		Short: "Query the hash of the initial validator set of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the CometBFT validator set hash of the initial consumer-validator set of a given consumer chain,
+computed when the consumer chain launched. The hash can be used to verify that the consumer chain booted with the expected validator set.
+
+Example:
+$ %s query provider consumer-genesis-valset-hash 3
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerGenesisValsetHash(cmd.Context(),
+				&types.QueryConsumerGenesisValsetHashRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-distribution [consumer-id]",
+		Short: "Query the distribution transmission channel of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the distribution transmission channel of a given consumer chain, its counterparty channel on the provider chain,
+whether the channel is open, and whether IBC transfers are enabled on the provider chain.
+
+Example:
+$ %s query provider consumer-distribution 3
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerDistribution(cmd.Context(),
+				&types.QueryConsumerDistributionRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerValidatorSets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-validator-sets [consumer-id]...",
+		Short: "Query the last set consumer-validator sets of multiple consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the last set consumer-validator sets of the given consumer chains in a single call.
+At most %d consumer chains can be queried at once.
+
+Example:
+$ %s query provider consumer-validator-sets 0 3
+		`, types.MaxConsumerIdsPerValidatorSetsQuery, version.AppName),
+		),
+		Args: cobra.RangeArgs(1, types.MaxConsumerIdsPerValidatorSetsQuery),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerValidatorSets(cmd.Context(),
+				&types.QueryConsumerValidatorSetsRequest{ConsumerIds: args})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorKeyConflicts() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-key-conflicts [provider-validator-address]",
+		Short: "Query the consumer keys a validator assigned on more than one consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer keys that a given validator assigned on more than one consumer chain,
+together with the consumer chains using each of these keys. Reusing a consumer key is allowed, so this is only informational.
+
+Example:
+$ %s query provider validator-key-conflicts %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryValidatorKeyConflicts(cmd.Context(),
+				&types.QueryValidatorKeyConflictsRequest{ProviderAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRemovalETA() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-removal-eta [consumer-id]",
+		Short: "Query the time remaining until the scheduled removal of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time at which a given stopped consumer chain is scheduled to be removed
+and the time remaining until then, relative to the current block time.
+
+Example:
+$ %s query provider consumer-removal-eta 3
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerRemovalETA(cmd.Context(),
+				&types.QueryConsumerRemovalETARequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerJailedPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-jailed-power [consumer-id]",
+		Short: "Query the cumulative power jailed because of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative power of the validators jailed because of the slash packets
+received from a given consumer chain since it launched.
+
+Example:
+$ %s query provider consumer-jailed-power 3
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerJailedPower(cmd.Context(),
+				&types.QueryConsumerJailedPowerRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorDenylistedConsumers() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-denylisted-consumers [provider-validator-address]",
+		Short: "Query the consumer chains on which a validator is denylisted",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ids of the active consumer chains on which a given validator is denylisted
+and hence cannot validate, even if it opted in or belongs to the Top N validators.
+
+Example:
+$ %s query provider validator-denylisted-consumers %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryValidatorDenylistedConsumers(cmd.Context(),
+				&types.QueryValidatorDenylistedConsumersRequest{ProviderAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query whether a validator has to validate a consumer chain
+func CmdHasToValidate() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "has-to-validate [provider-validator-address] [consumer-id]",
+		Short: "Query whether a validator has to validate a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a given validator has to validate a given consumer chain, along with the reason why:
+the validator belongs to the Top N validators, opted in, is allowlisted or belongs to the current consumer validator set.
+
+Example:
+$ %s query provider has-to-validate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 0
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryValidatorHasToValidate(cmd.Context(),
+				&types.QueryValidatorHasToValidateRequest{ProviderAddress: args[0], ConsumerId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the effective timeout period of the CCV packets sent to a consumer chain
+func CmdConsumerCCVTimeout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-ccv-timeout [consumer-id]",
+		Short: "Query the effective timeout period of the CCV packets sent to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the effective timeout period of the CCV packets sent to a given consumer chain,
+i.e., the consumer-specific timeout period if one is set, or the ccv_timeout_period provider param otherwise.
+
+Example:
+$ %s query provider consumer-ccv-timeout 0
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerCCVTimeout(cmd.Context(),
+				&types.QueryConsumerCCVTimeoutRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the number of assigned consumer keys and of consumer addresses pending pruning
+func CmdKeyAssignmentStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-assignment-stats",
+		Short: "Query the number of assigned consumer keys and of consumer addresses pending pruning",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of consumer keys assigned by validators and of consumer addresses pending pruning,
+in total and for every consumer chain.
+
+Example:
+$ %s query provider key-assignment-stats
+		`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryKeyAssignmentStats(cmd.Context(), &types.QueryKeyAssignmentStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the values of the slash meter at the end of the most recent blocks
+func CmdSlashMeterHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-meter-history",
+		Short: "Query the values of the slash meter at the end of the most recent blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the values of the slash meter at the end of the most recent blocks, in ascending order of block height.
+The number of recorded blocks is set by the slash_meter_history_length param.
+
+Example:
+$ %s query provider slash-meter-history
+		`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuerySlashMeterHistory(cmd.Context(), &types.QuerySlashMeterHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the consumer keys a validator assigned on all the consumer chains
+func CmdValidatorAllConsumerKeys() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-all-consumer-keys [provider-validator-address]",
+		Short: "Query the consumer keys a validator assigned on all the consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer keys that a given validator assigned on all the consumer chains,
+together with the corresponding consumer addresses. Consumer chains on which the validator uses its provider key are not listed.
+
+Example:
+$ %s query provider validator-all-consumer-keys %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryValidatorAllConsumerKeys(cmd.Context(),
+				&types.QueryValidatorAllConsumerKeysRequest{ProviderAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMaxProviderConsensusValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "max-provider-consensus-validators",
+		Short: "Query the maximum number of provider consensus validators and the number of active ones",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the max_provider_consensus_validators param and the number of validators
+in the last validator set sent to the consensus engine of the provider chain.
+
+Example:
+$ %s query provider max-provider-consensus-validators
+		`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryMaxProviderConsensusValidators(cmd.Context(), &types.QueryMaxProviderConsensusValidatorsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumersByOwner() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "consumers-by-owner [owner-address]",
+		Short: "Query the consumer chains owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer chains owned by the given address, together with their phases.
+The owner of a consumer chain is the submitter of the message that created it, unless the ownership was transferred.
+
+Example:
+$ %s query provider consumers-by-owner %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixAccAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumersByOwner(cmd.Context(), &types.QueryConsumersByOwnerRequest{OwnerAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerChainIdAvailable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-chain-id-available [chain-id]",
+		Short: "Query whether a chain id is not used by any active consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a chain id is not used by any registered, initialized, or launched consumer chain.
+If the chain id is in use, the consumer id of the consumer chain using it is returned.
+
+Example:
+$ %s query provider consumer-chain-id-available consumer-1
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerChainIdAvailable(cmd.Context(), &types.QueryConsumerChainIdAvailableRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdNextConsumerId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-consumer-id",
+		Short: "Query the consumer id assigned to the next created consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer id assigned to the next created consumer chain.
+Consumer ids are allocated in increasing order and the ids of removed consumer chains are not reused.
+
+Example:
+$ %s query provider next-consumer-id
+		`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryNextConsumerId(cmd.Context(), &types.QueryNextConsumerIdRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerVSCLatency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-vsc-latency [consumer-id]",
+		Short: "Query the round-trip latency of the VSC packets of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the average and maximum round-trip latency, i.e., the time between sending
+a VSC packet and receiving its acknowledgement, of the most recently acknowledged
+VSC packets of a given consumer chain.
+
+Example:
+$ %s query provider consumer-vsc-latency 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerVSCLatency(cmd.Context(),
+				&types.QueryConsumerVSCLatencyRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumersByClientId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumers-by-client-id [client-id]",
+		Short: "Query the consumer chains whose IBC client is a given client",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the ids of all the consumer chains that use a given IBC client.
+
+Example:
+$ %s query provider consumers-by-client-id 07-tendermint-0
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumersByClientId(cmd.Context(),
+				&types.QueryConsumersByClientIdRequest{ClientId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPruningInvariant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pruning-invariant [consumer-id]",
+		Short: "Check that the consumer addresses of a consumer chain are assigned or scheduled for pruning",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Checks, on the current state, that every consumer address of a given consumer chain is either
+currently assigned to a validator or scheduled for pruning. Returns whether this holds together
+with the consumer addresses violating it, which would indicate store corruption.
+
+Example:
+$ %s query provider pruning-invariant 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryPruningInvariant(cmd.Context(),
+				&types.QueryPruningInvariantRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorsConsumerObligations() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validators-consumer-obligations [provider-validator-address]...",
+		Short: "Query the consumer chains multiple validators have to validate and the consumer keys they assigned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query, for each of the given validators, the consumer chains it has to validate
+and the consumer keys it assigned, in a single call.
+At most %d validators can be queried at once.
+
+Example:
+$ %s query provider validators-consumer-obligations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39
+		`, types.MaxProviderAddrsPerConsumerObligationsQuery, version.AppName, bech32PrefixConsAddr, bech32PrefixConsAddr),
+		),
+		Args: cobra.RangeArgs(1, types.MaxProviderAddrsPerConsumerObligationsQuery),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryValidatorsConsumerObligations(cmd.Context(),
+				&types.QueryValidatorsConsumerObligationsRequest{ProviderAddrs: args})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAllDefaultKeyUsers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "default-key-users",
+		Short: "Query the validators using their provider key on every launched consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query, for every launched consumer chain, the validators in the consumer validator set
+that use their provider key on the consumer chain, i.e., whose public key in the consumer validator set
+is their provider consensus key.
+
+Example:
+$ %s query provider default-key-users
+		`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryAllDefaultKeyUsers(cmd.Context(), &types.QueryAllDefaultKeyUsersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
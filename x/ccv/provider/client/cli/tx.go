@@ -18,6 +18,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -39,6 +43,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(NewCreateConsumerCmd())
 	cmd.AddCommand(NewUpdateConsumerCmd())
 	cmd.AddCommand(NewRemoveConsumerCmd())
+	cmd.AddCommand(NewResumeConsumerCmd())
+	cmd.AddCommand(NewTransferConsumerOwnershipCmd())
 	cmd.AddCommand(NewOptInCmd())
 	cmd.AddCommand(NewOptOutCmd())
 	cmd.AddCommand(NewSetConsumerCommissionRateCmd())
@@ -46,11 +52,26 @@ func GetTxCmd() *cobra.Command {
 	return cmd
 }
 
+// FlagFromPrivValidatorKey is the flag used to read the consumer key from a priv_validator_key.json file
+const FlagFromPrivValidatorKey = "from-priv-validator-key"
+
+// FlagNonce is the flag used to set the nonce of a key assignment
+const FlagNonce = "nonce"
+
 func NewAssignConsumerKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "assign-consensus-key [consumer-id] [consumer-pubkey]",
 		Short: "assign a consensus public key to use for a consumer chain",
-		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Assign a consensus public key to use for a consumer chain.
+The consumer public key is either given as a JSON string or, using the --%s flag,
+read from the priv_validator_key.json file of the node that validates the consumer chain.
+
+Example:
+%s tx provider assign-consensus-key [consumer-id] '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"<base64>"}' --from=<key-name>
+%s tx provider assign-consensus-key [consumer-id] --%s=<path/to/priv_validator_key.json> --from=<key-name>
+`, FlagFromPrivValidatorKey, version.AppName, version.AppName, FlagFromPrivValidatorKey)),
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -64,9 +85,33 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 			}
 			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
+			privValidatorKeyPath, err := cmd.Flags().GetString(FlagFromPrivValidatorKey)
+			if err != nil {
+				return err
+			}
+
+			var consumerPubKey string
+			switch {
+			case len(args) == 2 && privValidatorKeyPath != "":
+				return fmt.Errorf("the consumer public key and the --%s flag cannot be both provided", FlagFromPrivValidatorKey)
+			case len(args) == 2:
+				consumerPubKey = args[1]
+			case privValidatorKeyPath != "":
+				consumerPubKey, err = ConsumerKeyFromPrivValidatorKeyFile(privValidatorKeyPath)
+				if err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("either the consumer public key or the --%s flag must be provided", FlagFromPrivValidatorKey)
+			}
+
 			providerValAddr := clientCtx.GetFromAddress()
 
-			msg, err := types.NewMsgAssignConsumerKey(args[0], sdk.ValAddress(providerValAddr), args[1], submitter)
+			msg, err := types.NewMsgAssignConsumerKey(args[0], sdk.ValAddress(providerValAddr), consumerPubKey, submitter)
+			if err != nil {
+				return err
+			}
+			msg.Nonce, err = cmd.Flags().GetUint64(FlagNonce)
 			if err != nil {
 				return err
 			}
@@ -79,12 +124,55 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagFromPrivValidatorKey, "", "path to the priv_validator_key.json file to read the consumer public key from")
+	cmd.Flags().Uint64(FlagNonce, 0, "(optional) nonce that needs to be greater than the last nonce used by the validator, protecting against replays")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
 	return cmd
 }
 
+// ConsumerKeyFromPrivValidatorKeyFile reads the public key from the priv_validator_key.json file
+// at the given path and returns it in the JSON format expected by MsgAssignConsumerKey, e.g.,
+// {"@type":"/cosmos.crypto.ed25519.PubKey","key":"<base64>"}
+func ConsumerKeyFromPrivValidatorKeyFile(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read priv validator key file: %w", err)
+	}
+
+	var pvKey privval.FilePVKey
+	if err := cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return "", fmt.Errorf("invalid priv validator key file %s: %w", path, err)
+	}
+	if pvKey.PubKey == nil {
+		return "", fmt.Errorf("invalid priv validator key file %s: missing pub_key", path)
+	}
+
+	consumerKey, err := cryptoenc.PubKeyToProto(pvKey.PubKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid priv validator key file %s: %w", path, err)
+	}
+	// only ed25519 consumer keys are supported, see ParseConsumerKey
+	ed25519Key := consumerKey.GetEd25519()
+	if ed25519Key == nil {
+		return "", fmt.Errorf("unsupported consumer key type %s in %s: only %s is supported",
+			pvKey.PubKey.Type(), path, ed25519.KeyType)
+	}
+
+	consumerKeyJson, err := json.Marshal(struct {
+		Type string `json:"@type"`
+		Key  []byte `json:"key"`
+	}{
+		Type: "/cosmos.crypto.ed25519.PubKey",
+		Key:  ed25519Key,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(consumerKeyJson), nil
+}
+
 func NewSubmitConsumerMisbehaviourCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-consumer-misbehaviour [consumer-id] [misbehaviour]",
@@ -272,11 +360,14 @@ where create_consumer.json has the following structure:
   },
   "allowlisted_reward_denoms": {
     "denoms": ["ibc/...", "ibc/..."]
-  }
+  },
+  "inherit_from_consumer_id": ""
 }
 
 Note that both 'chain_id' and 'metadata' are mandatory;
 and 'initialization_parameters', 'power_shaping_parameters' and 'allowlisted_reward_denoms' are optional. 
+If 'inherit_from_consumer_id' is set, the consumer chain inherits at launch the key assignments 
+of the given stopped consumer chain, which must be owned by the submitter. 
 The parameters not provided are set to their zero value. 
 `, version.AppName)),
 		Args: cobra.ExactArgs(1),
@@ -308,6 +399,7 @@ The parameters not provided are set to their zero value.
 			if err != nil {
 				return err
 			}
+			msg.InheritFromConsumerId = consCreate.InheritFromConsumerId
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -487,6 +579,97 @@ Example:
 	return cmd
 }
 
+func NewResumeConsumerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-consumer [consumer-id]",
+		Short: "resume a stopped consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resumes a stopped consumer chain whose state has not been removed yet and whose CCV channel is still open.
+Note that only the owner of the chain can resume it.
+Example:
+%s tx provider resume-consumer [consumer-id]
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			consumerId := args[0]
+
+			msg, err := types.NewMsgResumeConsumer(owner, consumerId)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewTransferConsumerOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-consumer-ownership [consumer-id] [new-owner]",
+		Short: "transfer the ownership of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfers the ownership of a consumer chain to a new address, e.g., a multisig.
+Note that only the owner of the chain can transfer its ownership, and that a Top N chain must be owned by the gov module.
+Example:
+%s tx provider transfer-consumer-ownership [consumer-id] [new-owner]
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			consumerId := args[0]
+			newOwner := args[1]
+
+			msg, err := types.NewMsgTransferConsumerOwnership(owner, consumerId, newOwner)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 func NewOptInCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "opt-in [consumer-id] [consumer-pubkey]",
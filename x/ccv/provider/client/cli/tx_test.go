@@ -0,0 +1,71 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/client/cli"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestConsumerKeyFromPrivValidatorKeyFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(tmpDir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	testCases := []struct {
+		name        string
+		path        string
+		expectedKey string
+		expError    string
+	}{
+		{
+			name:        "valid ed25519 key",
+			path:        filepath.Join("testdata", "priv_validator_key.json"),
+			expectedKey: `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"Hs7tJlC/MhcOXaqamcWkZ8hOAObKugLui+qLcnd4me8="}`,
+		},
+		{
+			name:     "unsupported secp256k1 key",
+			path:     filepath.Join("testdata", "priv_validator_key_secp256k1.json"),
+			expError: "unsupported consumer key type",
+		},
+		{
+			name:     "missing file",
+			path:     filepath.Join(tmpDir, "missing.json"),
+			expError: "failed to read priv validator key file",
+		},
+		{
+			name:     "invalid json",
+			path:     writeFile("invalid.json", "not json"),
+			expError: "invalid priv validator key file",
+		},
+		{
+			name:     "missing pub key",
+			path:     writeFile("no_pub_key.json", "{}"),
+			expError: "missing pub_key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consumerKey, err := cli.ConsumerKeyFromPrivValidatorKeyFile(tc.path)
+			if tc.expError != "" {
+				require.ErrorContains(t, err, tc.expError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedKey, consumerKey)
+
+			// the consumer key has the format expected by MsgAssignConsumerKey
+			pkType, _, err := types.ParseConsumerKeyFromJson(consumerKey)
+			require.NoError(t, err)
+			require.Equal(t, "/cosmos.crypto.ed25519.PubKey", pkType)
+		})
+	}
+}
@@ -68,9 +68,17 @@ func (am AppModule) OnChanOpenTry(
 			counterpartyVersion, ccv.Version)
 	}
 
-	if err := am.keeper.VerifyConsumerChain(
+	consumerId, err := am.keeper.VerifyConsumerChain(
 		ctx, channelID, connectionHops,
-	); err != nil {
+	)
+	if err != nil {
+		return "", err
+	}
+
+	// advertise the validators power cap so that the consumer chain knows
+	// whether the received validator powers are capped
+	powerShapingParameters, err := am.keeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil && !errors.Is(err, ccv.ErrStoreKeyNotFound) {
 		return "", err
 	}
 
@@ -81,6 +89,7 @@ func (am AppModule) OnChanOpenTry(
 		// provider chain will fail
 		ProviderFeePoolAddr: am.keeper.GetConsumerRewardsPoolAddressStr(ctx),
 		Version:             ccv.Version,
+		ValidatorsPowerCap:  powerShapingParameters.ValidatorsPowerCap,
 	}
 	mdBz, err := (&md).Marshal()
 	if err != nil {
@@ -12,6 +12,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider"
@@ -68,6 +71,13 @@ func TestOnChanOpenTry(t *testing.T) {
 		{
 			"success", func(*params, *providerkeeper.Keeper) {}, true,
 		},
+		{
+			"success with validators power cap", func(params *params, keeper *providerkeeper.Keeper) {
+				err := keeper.SetConsumerPowerShapingParameters(params.ctx, "consumerId",
+					providertypes.PowerShapingParameters{ValidatorsPowerCap: 32})
+				require.NoError(t, err)
+			}, true,
+		},
 		{
 			"invalid order", func(params *params, keeper *providerkeeper.Keeper) {
 				params.order = channeltypes.UNORDERED
@@ -166,6 +176,11 @@ func TestOnChanOpenTry(t *testing.T) {
 			require.Equal(t, moduleAcct.BaseAccount.Address, md.ProviderFeePoolAddr,
 				"returned dist account metadata must match expected")
 			require.Equal(t, ccv.Version, md.Version, "returned ccv version metadata must match expected")
+			expPowerCap := uint32(0)
+			if powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, "consumerId"); err == nil {
+				expPowerCap = powerShapingParameters.ValidatorsPowerCap
+			}
+			require.Equal(t, expPowerCap, md.ValidatorsPowerCap, "returned validators power cap metadata must match expected")
 			ctrl.Finish()
 		} else {
 			require.Error(t, err, tc.name)
@@ -173,6 +188,54 @@ func TestOnChanOpenTry(t *testing.T) {
 	}
 }
 
+// TestOnChanOpenTryConsumerRewardsAddress tests that the consumer rewards address returned by
+// the QueryConsumerRewardsAddress query matches the provider fee pool address sent during the handshake
+func TestOnChanOpenTryConsumerRewardsAddress(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerModule := provider.NewAppModule(&providerKeeper, *keeperParams.ParamsSubspace, keeperParams.StoreKey)
+
+	consumerId := "0"
+	providerKeeper.SetPort(ctx, ccv.ProviderPortID)
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientIdToConsumer")
+
+	moduleAcct := authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{}}
+	moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPool).String()
+
+	mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
+		conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
+	).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIdToConsumer").Return(
+		&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
+	).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes()
+
+	// the consumer chain is unknown
+	_, err := providerKeeper.QueryConsumerRewardsAddress(ctx, &providertypes.QueryConsumerRewardsAddressRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	metadata, err := providerModule.OnChanOpenTry(
+		ctx,
+		channeltypes.ORDERED,
+		[]string{"connectionIDToConsumer"},
+		ccv.ProviderPortID,
+		"providerChannelID",
+		channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
+		ccv.Version,
+	)
+	require.NoError(t, err)
+	md := &ccv.HandshakeMetadata{}
+	err = md.Unmarshal([]byte(metadata))
+	require.NoError(t, err)
+
+	res, err := providerKeeper.QueryConsumerRewardsAddress(ctx, &providertypes.QueryConsumerRewardsAddressRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, md.ProviderFeePoolAddr, res.RewardsAddress)
+	require.Equal(t, moduleAcct.BaseAccount.Address, res.RewardsAddress)
+}
+
 // TestOnChanOpenAck tests the provider's OnChanOpenAck method against spec.
 //
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-coack1
@@ -391,3 +454,31 @@ func TestUnmarshalConsumerPacket(t *testing.T) {
 		require.Equal(t, tc.expectedPacketData, actualConsumerPacketData)
 	}
 }
+
+// TestUnmarshalSlashConsumerPacketData tests that the slash packet data created
+// with NewSlashConsumerPacketData round-trips through UnmarshalConsumerPacketData
+func TestUnmarshalSlashConsumerPacketData(t *testing.T) {
+	valConsAddr := sdk.ConsAddress([]byte("validator-address"))
+	for _, infraction := range []stakingtypes.Infraction{
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	} {
+		packetData := ccv.NewSlashConsumerPacketData(
+			abci.Validator{Address: valConsAddr, Power: 5},
+			12,
+			infraction,
+		)
+		require.NoError(t, packetData.Validate())
+
+		actualPacketData, err := provider.UnmarshalConsumerPacketData(packetData.GetBytes())
+		require.NoError(t, err)
+		require.Equal(t, packetData, actualPacketData)
+
+		slashPacketData := actualPacketData.GetSlashPacketData()
+		require.NotNil(t, slashPacketData)
+		require.Equal(t, valConsAddr.Bytes(), slashPacketData.Validator.Address)
+		require.Equal(t, int64(5), slashPacketData.Validator.Power)
+		require.Equal(t, uint64(12), slashPacketData.ValsetUpdateId)
+		require.Equal(t, infraction, slashPacketData.Infraction)
+	}
+}
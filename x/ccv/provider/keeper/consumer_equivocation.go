@@ -415,7 +415,8 @@ func verifyLightBlockCommitSig(lightBlock tmtypes.LightBlock, sigIdx int) error
 // Punish Validator section
 //
 
-// JailAndTombstoneValidator jails and tombstones the validator with the given provider consensus address
+// JailAndTombstoneValidator jails and tombstones the validator with the given provider consensus address.
+// If the validator is tombstoned, its consumer keys are unassigned on all the consumer chains.
 func (k Keeper) JailAndTombstoneValidator(ctx sdk.Context, providerAddr types.ProviderConsAddress, jailingParams *types.SlashJailParameters) error {
 	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
 	if err != nil && errors.Is(err, stakingtypes.ErrNoValidatorFound) {
@@ -454,6 +455,19 @@ func (k Keeper) JailAndTombstoneValidator(ctx sdk.Context, providerAddr types.Pr
 		if err = k.slashingKeeper.Tombstone(ctx, providerAddr.ToSdkConsAddr()); err != nil {
 			return fmt.Errorf("fail to tombstone validator: %s: %s", providerAddr.String(), err)
 		}
+
+		// Unassign the consumer keys of the tombstoned validator, so that it uses its provider key
+		// on all the consumer chains; the old consumer addresses are kept until they are pruned,
+		// so that they can still be referenced in slash requests
+		for _, validatorConsumerPubKey := range k.GetAllValidatorConsumerPubKeys(ctx, nil) {
+			if !providerAddr.ToSdkConsAddr().Equals(sdk.ConsAddress(validatorConsumerPubKey.ProviderAddr)) {
+				continue
+			}
+			if err = k.UnassignConsumerKey(ctx, validatorConsumerPubKey.ChainId, providerAddr); err != nil {
+				return fmt.Errorf("fail to unassign consumer key of validator: %s on consumer chain %s: %s",
+					providerAddr.String(), validatorConsumerPubKey.ChainId, err)
+			}
+		}
 	}
 
 	return nil
@@ -445,6 +445,78 @@ func TestJailAndTombstoneValidator(t *testing.T) {
 	}
 }
 
+// TestJailAndTombstoneValidatorUnassignsConsumerKeys tests that the consumer keys of a tombstoned
+// validator are unassigned on all the consumer chains
+func TestJailAndTombstoneValidatorUnassignsConsumerKeys(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334)
+	providerAddr := providerIdentity.ProviderConsAddress()
+	otherProviderAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842335).ProviderConsAddress()
+	launchedConsumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	registeredConsumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	otherConsumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+
+	// the validator assigned a consumer key on a launched and on a registered consumer chain
+	providerKeeper.SetConsumerPhase(ctx, "0", types.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerPhase(ctx, "1", types.CONSUMER_PHASE_REGISTERED)
+	providerKeeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr, launchedConsumerKey.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, "0", launchedConsumerKey.ConsumerConsAddress(), providerAddr)
+	providerKeeper.SetValidatorConsumerPubKey(ctx, "1", providerAddr, registeredConsumerKey.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, "1", registeredConsumerKey.ConsumerConsAddress(), providerAddr)
+	// another validator assigned a consumer key on the launched consumer chain
+	providerKeeper.SetValidatorConsumerPubKey(ctx, "0", otherProviderAddr, otherConsumerKey.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, "0", otherConsumerKey.ConsumerConsAddress(), otherProviderAddr)
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	jailEndTime := ctx.BlockTime().Add(getTestInfractionParameters().DoubleSign.JailDuration)
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(
+			ctx, providerAddr.ToSdkConsAddr()).Return(
+			stakingtypes.Validator{Status: stakingtypes.Bonded}, nil,
+		).Times(1),
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(
+			ctx, providerAddr.ToSdkConsAddr()).Return(
+			false,
+		).Times(1),
+		mocks.MockStakingKeeper.EXPECT().Jail(
+			ctx, providerAddr.ToSdkConsAddr()).
+			Times(1),
+		mocks.MockSlashingKeeper.EXPECT().JailUntil(
+			ctx, providerAddr.ToSdkConsAddr(), jailEndTime).
+			Times(1),
+		mocks.MockSlashingKeeper.EXPECT().Tombstone(
+			ctx, providerAddr.ToSdkConsAddr()).
+			Times(1),
+	)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod, nil).AnyTimes()
+
+	err := providerKeeper.JailAndTombstoneValidator(ctx, providerAddr, getTestInfractionParameters().DoubleSign)
+	require.NoError(t, err)
+
+	// the consumer keys of the tombstoned validator are unassigned
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, "0", providerAddr)
+	require.False(t, found)
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, "1", providerAddr)
+	require.False(t, found)
+
+	// on the launched consumer chain, the old consumer address is kept until it is pruned
+	_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, "0", launchedConsumerKey.ConsumerConsAddress())
+	require.True(t, found)
+	require.Equal(t,
+		[][]byte{launchedConsumerKey.SDKValConsAddress()},
+		providerKeeper.GetConsumerAddrsToPrune(ctx, "0", ctx.BlockTime().Add(unbondingPeriod)).Addresses,
+	)
+	// on the registered consumer chain, the old consumer address is deleted directly
+	_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, "1", registeredConsumerKey.ConsumerConsAddress())
+	require.False(t, found)
+
+	// the consumer key of the other validator is not affected
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, "0", otherProviderAddr)
+	require.True(t, found)
+}
+
 // createUndelegation creates an undelegation with `len(initialBalances)` entries
 func createUndelegation(initialBalances []int64, completionTimes []time.Time) stakingtypes.UnbondingDelegation {
 	var entries []stakingtypes.UnbondingDelegationEntry
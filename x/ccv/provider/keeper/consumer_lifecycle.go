@@ -63,6 +63,27 @@ func (k Keeper) InitializeConsumer(ctx sdk.Context, consumerId string) (time.Tim
 	return initializationParameters.SpawnTime, true
 }
 
+// ValidateSpawnTime returns an error if the non-zero `spawnTime` of the consumer chain with `consumerId` is
+// more than `SpawnTimePastTolerance` in the past, or if the consumer chain has a scheduled removal time
+// and `spawnTime` is not before it, as the consumer chain would be removed before it launches
+func (k Keeper) ValidateSpawnTime(ctx sdk.Context, consumerId string, spawnTime time.Time) error {
+	if spawnTime.IsZero() {
+		return nil
+	}
+
+	if spawnTime.Before(ctx.BlockTime().Add(-types.SpawnTimePastTolerance)) {
+		return fmt.Errorf("spawn time (%s) is more than %s before the current block time (%s)",
+			spawnTime, types.SpawnTimePastTolerance, ctx.BlockTime())
+	}
+
+	if removalTime, err := k.GetConsumerRemovalTime(ctx, consumerId); err == nil && !spawnTime.Before(removalTime) {
+		return fmt.Errorf("spawn time (%s) is not before the removal time (%s) of the consumer chain",
+			spawnTime, removalTime)
+	}
+
+	return nil
+}
+
 // BeginBlockLaunchConsumers launches initialized consumers chains for which the spawn time has passed
 func (k Keeper) BeginBlockLaunchConsumers(ctx sdk.Context) error {
 	bondedValidators := []stakingtypes.Validator{}
@@ -93,35 +114,143 @@ func (k Keeper) BeginBlockLaunchConsumers(ctx sdk.Context) error {
 	}
 
 	for _, consumerId := range consumerIds {
-		cachedCtx, writeFn := ctx.CacheContext()
-		err = k.LaunchConsumer(cachedCtx, bondedValidators, activeValidators, consumerId)
+		initialHeight, err := k.ValidateConsumerInitialHeight(ctx, consumerId)
 		if err != nil {
-			ctx.Logger().Error("could not launch chain",
+			ctx.Logger().Error("rejected consumer chain launch",
 				"consumerId", consumerId,
 				"error", err)
 
-			// reset spawn time to zero so that owner can try again later
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeConsumerLaunchRejected,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeInitialHeight, initialHeight.String()),
+					sdk.NewAttribute(types.AttributeRejectionReason, err.Error()),
+				),
+			)
+
+			// retrying cannot fix an inconsistent initial height, so the consumer chain is moved
+			// back to the registered phase with its spawn time reset to zero until its owner
+			// updates the initialization parameters
 			initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
-			if err != nil {
-				return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
-					"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
-			}
-			initializationRecord.SpawnTime = time.Time{}
-			err = k.SetConsumerInitializationParameters(ctx, consumerId, initializationRecord)
-			if err != nil {
-				return fmt.Errorf("setting consumer initialization parameters, consumerId(%s): %w", consumerId, err)
+			if err == nil {
+				initializationRecord.SpawnTime = time.Time{}
+				// the initial height is not validated as it is known to be inconsistent
+				if err := k.setConsumerInitializationParameters(ctx, consumerId, initializationRecord); err != nil {
+					return fmt.Errorf("setting consumer initialization parameters, consumerId(%s): %w", consumerId, err)
+				}
 			}
-			// also set the phase to registered
 			k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+			continue
+		}
+
+		cachedCtx, writeFn := ctx.CacheContext()
+		err = k.LaunchConsumer(cachedCtx, bondedValidators, activeValidators, consumerId)
+		if err != nil {
+			ctx.Logger().Error("could not launch chain",
+				"consumerId", consumerId,
+				"error", err)
 
+			if err := k.HandleFailedConsumerLaunch(ctx, consumerId, err); err != nil {
+				return err
+			}
 			continue
 		}
 
 		writeFn()
+		k.DeleteConsumerLaunchFailure(ctx, consumerId)
+
+		clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConsumerLaunched,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientId),
+				sdk.NewAttribute(types.AttributeInitialHeight, initialHeight.String()),
+			),
+		)
 	}
 	return nil
 }
 
+// ValidateConsumerInitialHeight checks that the initial height of the consumer chain with `consumerId`
+// is still consistent with its chain id, as the chain id can be updated after the initialization parameters are set
+func (k Keeper) ValidateConsumerInitialHeight(ctx sdk.Context, consumerId string) (clienttypes.Height, error) {
+	initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return clienttypes.Height{}, err
+	}
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return initializationRecord.InitialHeight, err
+	}
+	if err := types.ValidateInitialHeight(initializationRecord.InitialHeight, chainId); err != nil {
+		return initializationRecord.InitialHeight, errorsmod.Wrap(types.ErrInvalidConsumerInitialHeight, err.Error())
+	}
+	return initializationRecord.InitialHeight, nil
+}
+
+// HandleFailedConsumerLaunch records the error of a failed attempt to launch a consumer chain
+// and moves the consumer chain back to the registered phase, i.e., the spawn time is reset to zero
+// so that the owner can try again later by updating the initialization parameters
+func (k Keeper) HandleFailedConsumerLaunch(ctx sdk.Context, consumerId string, launchErr error) error {
+	failure, _ := k.GetConsumerLaunchFailure(ctx, consumerId)
+	failure.Attempts++
+	failure.Error = launchErr.Error()
+	k.SetConsumerLaunchFailure(ctx, consumerId, failure)
+
+	// reset spawn time to zero so that owner can try again later
+	initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+	}
+	initializationRecord.SpawnTime = time.Time{}
+	err = k.SetConsumerInitializationParameters(ctx, consumerId, initializationRecord)
+	if err != nil {
+		return fmt.Errorf("setting consumer initialization parameters, consumerId(%s): %w", consumerId, err)
+	}
+	// also set the phase to registered
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+
+	return nil
+}
+
+// GetConsumerLaunchFailure returns the failed attempts to launch the consumer chain with `consumerId`
+func (k Keeper) GetConsumerLaunchFailure(ctx sdk.Context, consumerId string) (failure types.ConsumerLaunchFailure, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerLaunchFailureKey(consumerId))
+	if bz == nil {
+		return failure, false
+	}
+	if err := failure.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the failure is assumed to be correctly serialized in SetConsumerLaunchFailure.
+		panic(fmt.Errorf("failed to unmarshal ConsumerLaunchFailure: %w", err))
+	}
+	return failure, true
+}
+
+// SetConsumerLaunchFailure sets the failed attempts to launch the consumer chain with `consumerId`
+func (k Keeper) SetConsumerLaunchFailure(ctx sdk.Context, consumerId string, failure types.ConsumerLaunchFailure) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := failure.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the failure is assumed to be correctly serialized in SetConsumerLaunchFailure.
+		panic(fmt.Errorf("failed to marshal ConsumerLaunchFailure: %w", err))
+	}
+	store.Set(types.ConsumerLaunchFailureKey(consumerId), bz)
+}
+
+// DeleteConsumerLaunchFailure deletes the failed attempts to launch the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerLaunchFailure(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerLaunchFailureKey(consumerId))
+}
+
 // ConsumeIdsFromTimeQueue returns from a time queue the consumer ids for which the associated time passed.
 // The number of ids return is limited to 'limit'. The ids returned are removed from the time queue.
 func (k Keeper) ConsumeIdsFromTimeQueue(
@@ -229,8 +358,21 @@ func (k Keeper) LaunchConsumer(
 	activeValidators []stakingtypes.Validator,
 	consumerId string,
 ) error {
+	// inherit the key assignments of a stopped consumer chain, if requested
+	if inheritedConsumerId, found := k.GetInheritedConsumerId(ctx, consumerId); found {
+		if err := k.InheritConsumerChain(ctx, consumerId, inheritedConsumerId); err != nil {
+			return fmt.Errorf("inheriting from consumer chain, consumerId(%s), inheritedConsumerId(%s): %w",
+				consumerId, inheritedConsumerId, err)
+		}
+	}
+
+	forcedConsumers, err := k.ComputeForcedConsumers(ctx, bondedValidators)
+	if err != nil {
+		return fmt.Errorf("computing forced consumers: %w", err)
+	}
+
 	// compute consumer initial validator set
-	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{})
+	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{}, forcedConsumers)
 	if err != nil {
 		return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
 	}
@@ -270,6 +412,18 @@ func (k Keeper) LaunchConsumer(
 		return fmt.Errorf("crating consumer client, consumerId(%s): %w", consumerId, err)
 	}
 
+	// store the hash of the initial validator set, so that anyone can later verify
+	// that the consumer chain booted with the expected validator set
+	initialValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return fmt.Errorf("getting initial validator set, consumerId(%s): %w", consumerId, err)
+	}
+	genesisValsetHash, err := ConsumerValSetHash(initialValSet)
+	if err != nil {
+		return fmt.Errorf("computing initial validator set hash, consumerId(%s): %w", consumerId, err)
+	}
+	k.SetConsumerGenesisValsetHash(ctx, consumerId, genesisValsetHash)
+
 	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
 
 	k.Logger(ctx).Info("consumer successfully launched",
@@ -281,6 +435,39 @@ func (k Keeper) LaunchConsumer(
 	return nil
 }
 
+// InheritConsumerChain copies the key assignments of the stopped consumer chain with `inheritedConsumerId`
+// to the consumer chain with `consumerId`, so that validators opting in to the new consumer chain reuse
+// the keys of the stopped one. Note that validators are not opted in, i.e., every validator has to opt in itself.
+// Validators that already assigned a key on the new consumer chain keep their own assignment.
+func (k Keeper) InheritConsumerChain(ctx sdk.Context, consumerId, inheritedConsumerId string) error {
+	if phase := k.GetConsumerPhase(ctx, inheritedConsumerId); phase != types.CONSUMER_PHASE_STOPPED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot inherit from consumer chain (%s) in phase %s", inheritedConsumerId, phase)
+	}
+
+	for _, assignment := range k.GetAllValidatorConsumerPubKeys(ctx, &inheritedConsumerId) {
+		providerAddr := types.NewProviderConsAddress(assignment.ProviderAddr)
+		if _, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); found {
+			continue
+		}
+
+		consumerAddrTmp, err := ccv.TMCryptoPublicKeyToConsAddr(*assignment.ConsumerKey)
+		if err != nil {
+			return err
+		}
+		consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+		if _, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr); found {
+			// the consumer key is already used by another validator on the new consumer chain
+			continue
+		}
+
+		k.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, *assignment.ConsumerKey)
+		k.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, providerAddr)
+	}
+
+	return nil
+}
+
 // CreateConsumerClient will create the CCV client for the given consumer chain. The CCV channel must be built
 // on top of the CCV client to ensure connection with the right consumer chain.
 func (k Keeper) CreateConsumerClient(
@@ -372,6 +559,40 @@ func (k Keeper) CreateConsumerClient(
 	return nil
 }
 
+// ComputeConsumerGenesis returns the genesis state the consumer chain `consumerId` would have
+// if it were launched in the current block, i.e., using the current initialization parameters
+// and the current initial validator set. The computation is done on a cached context that is
+// never written, i.e., this method does not change the state.
+func (k Keeper) ComputeConsumerGenesis(ctx sdk.Context, consumerId string) (ccv.ConsumerGenesisState, error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	bondedValidators, err := k.GetLastBondedValidators(cacheCtx)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("getting last bonded validators: %w", err)
+	}
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(cacheCtx)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("getting last provider active validators: %w", err)
+	}
+
+	forcedConsumers, err := k.ComputeForcedConsumers(cacheCtx, bondedValidators)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("computing forced consumers: %w", err)
+	}
+
+	initialValUpdates, err := k.ComputeConsumerNextValSet(cacheCtx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{}, forcedConsumers)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
+	}
+
+	genesisState, err := k.MakeConsumerGenesis(cacheCtx, consumerId, initialValUpdates)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("creating consumer genesis state, consumerId(%s): %w", consumerId, err)
+	}
+
+	return genesisState, nil
+}
+
 // MakeConsumerGenesis returns the created consumer genesis state for consumer chain `consumerId`,
 // as well as the validator hash of the initial validator set of the consumer chain
 func (k Keeper) MakeConsumerGenesis(
@@ -527,16 +748,20 @@ func (k Keeper) getSelfConsensusState(ctx sdk.Context, height clienttypes.Height
 // StopAndPrepareForConsumerRemoval sets the phase of the chain to stopped and prepares to get the state of the
 // chain removed after unbonding period elapses
 func (k Keeper) StopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId string) error {
-	// The phase of the chain is immediately set to stopped, albeit its state is removed later (see below).
-	// Setting the phase here helps in not considering this chain when we look at launched chains (e.g., in `QueueVSCPackets)
-	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_STOPPED)
-
 	// state of this chain is removed once UnbondingPeriod elapses
 	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
 	if err != nil {
 		return err
 	}
-	removalTime := ctx.BlockTime().Add(unbondingPeriod)
+	return k.stopAndPrepareForConsumerRemoval(ctx, consumerId, ctx.BlockTime().Add(unbondingPeriod))
+}
+
+// stopAndPrepareForConsumerRemoval sets the phase of the chain to stopped and schedules
+// the removal of its state at removalTime
+func (k Keeper) stopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId string, removalTime time.Time) error {
+	// The phase of the chain is immediately set to stopped, albeit its state is removed later (see below).
+	// Setting the phase here helps in not considering this chain when we look at launched chains (e.g., in `QueueVSCPackets)
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_STOPPED)
 
 	if err := k.SetConsumerRemovalTime(ctx, consumerId, removalTime); err != nil {
 		return fmt.Errorf("cannot set removal time (%s): %s", removalTime.String(), err.Error())
@@ -548,6 +773,87 @@ func (k Keeper) StopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId str
 	return nil
 }
 
+// StopAndPrepareForConsumersRemoval stops the launched consumer chains with the given consumer ids
+// and prepares them for removal, as in StopAndPrepareForConsumerRemoval. The state of the consumer chains
+// is removed at stopTime if set, and once UnbondingPeriod elapses otherwise. Note that all the consumer chains
+// are checked before any of them is stopped, i.e., if any of them cannot be stopped, none of them is.
+func (k Keeper) StopAndPrepareForConsumersRemoval(ctx sdk.Context, consumerIds []string, stopTime time.Time) error {
+	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+	removalTime := ctx.BlockTime().Add(unbondingPeriod)
+	if !stopTime.IsZero() {
+		// the state of a consumer chain cannot be removed before UnbondingPeriod elapses,
+		// as its validators can still be slashed for infractions committed on the consumer chain
+		if stopTime.Before(removalTime) {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+				"stop time (%s) cannot be before the unbonding period elapses (%s)", stopTime, removalTime)
+		}
+		removalTime = stopTime
+	}
+
+	for _, consumerId := range consumerIds {
+		if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "cannot get chain id of consumer %s: %s", consumerId, err.Error())
+		}
+		// a consumer chain that is already stopped is already scheduled to be removed
+		if phase := k.GetConsumerPhase(ctx, consumerId); phase != types.CONSUMER_PHASE_LAUNCHED {
+			return errorsmod.Wrapf(types.ErrInvalidPhase,
+				"chain with consumer id: %s has to be in its launched phase, got %s", consumerId, phase)
+		}
+	}
+
+	for _, consumerId := range consumerIds {
+		if err := k.stopAndPrepareForConsumerRemoval(ctx, consumerId, removalTime); err != nil {
+			return errorsmod.Wrapf(err, "cannot stop consumer %s", consumerId)
+		}
+	}
+
+	return nil
+}
+
+// ResumeConsumer transitions the stopped consumer chain with `consumerId` back to its launched phase,
+// given that its state has not been removed yet, no consumer chain inherits from it and its CCV channel
+// is still open. The consumer chain is no longer scheduled to be removed and a VSC packet with its entire
+// validator set is enqueued, so that the validator set updates resume from the provider's view of the
+// consumer validator set.
+func (k Keeper) ResumeConsumer(ctx sdk.Context, consumerId string) error {
+	if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_STOPPED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase, "consumer chain %s is not stopped", consumerId)
+	}
+
+	// the removal time is deleted together with the rest of the consumer state
+	removalTime, err := k.GetConsumerRemovalTime(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "state of consumer chain %s has been removed: %s", consumerId, err.Error())
+	}
+	// the consumer chains that inherit from the stopped chain rely on it staying stopped
+	if inheritingConsumerIds := k.GetInheritingConsumerIds(ctx, consumerId); len(inheritingConsumerIds) > 0 {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "consumer chain %s is inherited by consumer chains %v",
+			consumerId, inheritingConsumerIds)
+	}
+	if _, found := k.GetConsumerClientId(ctx, consumerId); !found {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "consumer chain %s has no client", consumerId)
+	}
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "consumer chain %s has no established CCV channel", consumerId)
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelId)
+	if !found || channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "CCV channel %s of consumer chain %s is not open", channelId, consumerId)
+	}
+
+	if err := k.RemoveConsumerToBeRemoved(ctx, consumerId, removalTime); err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "cannot remove consumer from the removal queue: %s", err.Error())
+	}
+	k.DeleteConsumerRemovalTime(ctx, consumerId)
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+
+	return k.QueueFullVSCPacket(ctx, consumerId)
+}
+
 // BeginBlockRemoveConsumers removes stopped consumer chain for which the removal time has passed
 func (k Keeper) BeginBlockRemoveConsumers(ctx sdk.Context) error {
 	consumerIds, err := k.ConsumeIdsFromTimeQueue(
@@ -587,6 +893,7 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	// clean up states
 	k.DeleteConsumerClientId(ctx, consumerId)
 	k.DeleteConsumerGenesis(ctx, consumerId)
+	k.DeleteConsumerGenesisValsetHash(ctx, consumerId)
 	// Note: this call panics if the key assignment state is invalid
 	k.DeleteKeyAssignments(ctx, consumerId)
 	k.DeleteMinimumPowerInTopN(ctx, consumerId)
@@ -619,15 +926,29 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 
 	k.DeleteInitChainHeight(ctx, consumerId)
 	k.DeleteSlashAcks(ctx, consumerId)
+	k.SetSlashPacketsPaused(ctx, consumerId, false)
+	k.SetConsumerSlashMode(ctx, consumerId, types.SLASH_MODE_JAIL)
+	k.SetVSCSendingPaused(ctx, consumerId, false)
+	k.DeleteConsumerCCVTimeoutPeriod(ctx, consumerId)
+	k.DeleteConsumerSlashMeter(ctx, consumerId)
+	k.DeleteAllPendingDowntimeSlashes(ctx, consumerId)
 	k.DeletePendingVSCPackets(ctx, consumerId)
+	k.DeleteVscSendTimestampsForConsumer(ctx, consumerId)
+	k.DeleteVSCLatenciesForConsumer(ctx, consumerId)
+	k.DeleteSlashPacketCountsForConsumer(ctx, consumerId)
+	k.DeleteConsumerJailedPower(ctx, consumerId)
+	k.DeleteSlashPacketRecordsForConsumer(ctx, consumerId)
 
 	k.DeleteAllowlist(ctx, consumerId)
 	k.DeleteDenylist(ctx, consumerId)
 	k.DeleteAllOptedIn(ctx, consumerId)
 	k.DeleteConsumerValSet(ctx, consumerId)
+	k.DeleteConsumerValSetProviderPower(ctx, consumerId)
 	k.DeletePrioritylist(ctx, consumerId)
 
 	k.DeleteConsumerRemovalTime(ctx, consumerId)
+	k.DeleteInheritedConsumerId(ctx, consumerId)
+	k.DeleteConsumerLaunchFailure(ctx, consumerId)
 
 	k.RemoveConsumerInfractionQueuedData(ctx, consumerId)
 
@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -334,6 +336,349 @@ func TestBeginBlockLaunchConsumers(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, phase)
 	_, found = providerKeeper.GetConsumerGenesis(ctx, "4")
 	require.False(t, found)
+	failure, found := providerKeeper.GetConsumerLaunchFailure(ctx, "4")
+	require.True(t, found)
+	require.Equal(t, uint32(1), failure.Attempts)
+
+	// the launched chains have no failed launch attempts
+	_, found = providerKeeper.GetConsumerLaunchFailure(ctx, "0")
+	require.False(t, found)
+}
+
+// TestBeginBlockLaunchConsumersFailedClientCreation tests that a consumer chain for which the client
+// creation fails is moved back to the registered phase with a zero spawn time and that the failure is recorded
+func TestBeginBlockLaunchConsumersFailedClientCreation(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	initializationParams := testkeeper.GetTestInitializationParameters()
+	initializationParams.SpawnTime = ctx.BlockTime()
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain0")
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParams)
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, initializationParams.SpawnTime)
+	require.NoError(t, err)
+
+	// opt in a validator so that the consumer chain could launch
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+	consAddr, _ := validator.GetConsAddr()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{validator}, -1)
+	valAddr, _ := sdk.ValAddressFromBech32(validator.GetOperator())
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
+	providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr))
+
+	// the client creation fails
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", fmt.Errorf("invalid initial height")).Times(1)
+
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	// the consumer chain is moved back to the registered phase and its spawn time is reset
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	params, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, time.Time{}, params.SpawnTime)
+	_, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+	require.False(t, found)
+
+	// the consumer chain is not launched again
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	res, err := providerKeeper.QueryConsumerLaunchFailure(ctx, &providertypes.QueryConsumerLaunchFailureRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Attempts)
+	require.Contains(t, res.Error, "invalid initial height")
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, res.Phase)
+}
+
+// TestBeginBlockLaunchConsumersInconsistentInitialHeight tests that the launch of a consumer chain with an
+// initial height inconsistent with its chain id is rejected and that the consumer chain is moved back to the
+// registered phase with a zero spawn time
+func TestBeginBlockLaunchConsumersInconsistentInitialHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	initializationParams := testkeeper.GetTestInitializationParameters()
+	initializationParams.SpawnTime = ctx.BlockTime()
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain0")
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParams)
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, initializationParams.SpawnTime)
+	require.NoError(t, err)
+
+	// the chain id is changed such that the initial height is no longer consistent with it
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-5")
+
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{}, -1)
+
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	// the consumer chain is moved back to the registered phase and its spawn time is reset
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	params, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, time.Time{}, params.SpawnTime)
+	require.Equal(t, initializationParams.InitialHeight, params.InitialHeight)
+	_, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+	require.False(t, found)
+
+	// a rejected launch is not recorded as a failed launch attempt
+	_, found = providerKeeper.GetConsumerLaunchFailure(ctx, consumerId)
+	require.False(t, found)
+}
+
+// TestLaunchConsumerInheritingFromStoppedConsumer tests that a consumer chain created with
+// `InheritFromConsumerId` by the owner of the stopped consumer chain (or by governance) launches with the
+// key assignments of the stopped consumer chain, while opting in is left to the validators
+func TestLaunchConsumerInheritingFromStoppedConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	// validator A assigned a consumer key on the stopped consumer chain and validated it, validator B did not
+	cryptoIdA := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	cryptoIdB := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	valA := cryptoIdA.SDKStakingValidator()
+	valB := cryptoIdB.SDKStakingValidator()
+	providerAddrA := cryptoIdA.ProviderConsAddress()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), cryptoIdA.SDKValOpAddress()).Return(int64(1), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), cryptoIdB.SDKValOpAddress()).Return(int64(2), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(3), nil).AnyTimes()
+
+	consumerCryptoId := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+	consumerKey := consumerCryptoId.TMProtoCryptoPublicKey()
+
+	stoppedConsumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, stoppedConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerOwnerAddress(ctx, stoppedConsumerId, "submitter")
+	providerKeeper.SetValidatorConsumerPubKey(ctx, stoppedConsumerId, providerAddrA, consumerKey)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, stoppedConsumerId, consumerCryptoId.ConsumerConsAddress(), providerAddrA)
+	err := providerKeeper.SetConsumerValSet(ctx, stoppedConsumerId, []providertypes.ConsensusValidator{
+		{ProviderConsAddr: providerAddrA.ToSdkConsAddr(), Power: 1, PublicKey: &consumerKey},
+	})
+	require.NoError(t, err)
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	msg := providertypes.MsgCreateConsumer{
+		Submitter:                "submitter",
+		ChainId:                  "consumer",
+		Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+		InitializationParameters: &initializationParameters,
+		InheritFromConsumerId:    stoppedConsumerId,
+	}
+
+	// cannot inherit from a consumer chain that is not stopped
+	_, err = msgServer.CreateConsumer(ctx, &msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	providerKeeper.SetConsumerPhase(ctx, stoppedConsumerId, providertypes.CONSUMER_PHASE_STOPPED)
+	err = providerKeeper.SetConsumerRemovalTime(ctx, stoppedConsumerId, initializationParameters.SpawnTime.Add(time.Hour))
+	require.NoError(t, err)
+
+	// only the owner of the stopped consumer chain or governance can inherit from it
+	otherMsg := msg
+	otherMsg.Submitter = "other"
+	_, err = msgServer.CreateConsumer(ctx, &otherMsg)
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	govMsg := msg
+	govMsg.Submitter = providerKeeper.GetAuthority()
+	_, err = msgServer.CreateConsumer(ctx, &govMsg)
+	require.NoError(t, err)
+
+	response, err := msgServer.CreateConsumer(ctx, &msg)
+	require.NoError(t, err)
+	consumerId := response.ConsumerId
+	inheritedConsumerId, found := providerKeeper.GetInheritedConsumerId(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, stoppedConsumerId, inheritedConsumerId)
+
+	// validators of the stopped consumer chain are not opted in automatically
+	require.False(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddrA))
+	providerKeeper.SetOptedIn(ctx, consumerId, providerAddrA)
+
+	gomock.InOrder(append(
+		testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour, 0),
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "consumer", clienttypes.NewHeight(0, 5))...,
+	)...)
+	err = providerKeeper.LaunchConsumer(ctx, []stakingtypes.Validator{valA, valB}, []stakingtypes.Validator{valA, valB}, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	// the key assignments of the stopped consumer chain are inherited
+	inheritedKeys := providerKeeper.GetAllValidatorConsumerPubKeys(ctx, &consumerId)
+	stoppedKeys := providerKeeper.GetAllValidatorConsumerPubKeys(ctx, &stoppedConsumerId)
+	require.Len(t, inheritedKeys, len(stoppedKeys))
+	for i := range stoppedKeys {
+		require.Equal(t, stoppedKeys[i].ProviderAddr, inheritedKeys[i].ProviderAddr)
+		require.Equal(t, stoppedKeys[i].ConsumerKey, inheritedKeys[i].ConsumerKey)
+	}
+	providerAddr, found := providerKeeper.GetValidatorByConsumerAddr(ctx, consumerId, consumerCryptoId.ConsumerConsAddress())
+	require.True(t, found)
+	require.Equal(t, providerAddrA, providerAddr)
+
+	// the opted-in validator A uses the consumer key inherited from the stopped consumer chain
+	valSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, valSet, 1)
+	require.Equal(t, providerAddrA.ToSdkConsAddr(), sdk.ConsAddress(valSet[0].ProviderConsAddr))
+	require.Equal(t, consumerKey, *valSet[0].PublicKey)
+}
+
+// TestLaunchConsumerStoresGenesisValsetHash tests that the hash of the initial validator set
+// of a consumer chain is stored when it launches and can be queried afterwards
+func TestLaunchConsumerStoresGenesisValsetHash(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	cryptoIdA := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	cryptoIdB := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	valA := cryptoIdA.SDKStakingValidator()
+	valB := cryptoIdB.SDKStakingValidator()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), cryptoIdA.SDKValOpAddress()).Return(int64(1), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), cryptoIdB.SDKValOpAddress()).Return(int64(2), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(3), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	response, err := msgServer.CreateConsumer(ctx, &providertypes.MsgCreateConsumer{
+		Submitter:                "submitter",
+		ChainId:                  "consumer",
+		Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+		InitializationParameters: &initializationParameters,
+	})
+	require.NoError(t, err)
+	consumerId := response.ConsumerId
+	providerKeeper.SetOptedIn(ctx, consumerId, cryptoIdA.ProviderConsAddress())
+	providerKeeper.SetOptedIn(ctx, consumerId, cryptoIdB.ProviderConsAddress())
+
+	// the hash is not available before the consumer chain launches
+	_, found := providerKeeper.GetConsumerGenesisValsetHash(ctx, consumerId)
+	require.False(t, found)
+	_, err = providerKeeper.QueryConsumerGenesisValsetHash(ctx, &providertypes.QueryConsumerGenesisValsetHashRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+
+	gomock.InOrder(append(
+		testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour, 0),
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "consumer", clienttypes.NewHeight(0, 5))...,
+	)...)
+	err = providerKeeper.LaunchConsumer(ctx, []stakingtypes.Validator{valA, valB}, []stakingtypes.Validator{valA, valB}, consumerId)
+	require.NoError(t, err)
+
+	// the stored hash matches a recomputation over the initial validator set
+	valSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, valSet, 2)
+	expectedHash, err := providerkeeper.ConsumerValSetHash(valSet)
+	require.NoError(t, err)
+	valsetHash, found := providerKeeper.GetConsumerGenesisValsetHash(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, expectedHash, valsetHash)
+
+	res, err := providerKeeper.QueryConsumerGenesisValsetHash(ctx, &providertypes.QueryConsumerGenesisValsetHashRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, strings.ToUpper(hex.EncodeToString(expectedHash)), res.ValsetHash)
+
+	// the stored hash is not affected by later changes of the validator set
+	err = providerKeeper.SetConsumerValSet(ctx, consumerId, valSet[:1])
+	require.NoError(t, err)
+	valsetHash, found = providerKeeper.GetConsumerGenesisValsetHash(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, expectedHash, valsetHash)
+}
+
+// TestLaunchConsumerWithAllowlist tests that the initial validator set of an opt-in consumer chain
+// with an allowlist consists only of the allowlisted validators that opted in and are bonded
+func TestLaunchConsumerWithAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	// validators A, B, and C are bonded, while validator D is not
+	cryptoIds := []*cryptotestutil.CryptoIdentity{}
+	bondedValidators := []stakingtypes.Validator{}
+	for i := 1; i <= 4; i++ {
+		cryptoId := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+		cryptoIds = append(cryptoIds, cryptoId)
+		if i <= 3 {
+			bondedValidators = append(bondedValidators, cryptoId.SDKStakingValidator())
+			mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), cryptoId.SDKValOpAddress()).Return(int64(i), nil).AnyTimes()
+		}
+	}
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(6), nil).AnyTimes()
+
+	// validators A, B, and D are allowlisted
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	response, err := msgServer.CreateConsumer(ctx, &providertypes.MsgCreateConsumer{
+		Submitter:                "submitter",
+		ChainId:                  "consumer",
+		Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+		InitializationParameters: &initializationParameters,
+		PowerShapingParameters: &providertypes.PowerShapingParameters{
+			Top_N: 0,
+			Allowlist: []string{
+				cryptoIds[0].SDKValConsAddress().String(),
+				cryptoIds[1].SDKValConsAddress().String(),
+				cryptoIds[3].SDKValConsAddress().String(),
+			},
+		},
+	})
+	require.NoError(t, err)
+	consumerId := response.ConsumerId
+
+	// all the validators opt in
+	for _, cryptoId := range cryptoIds {
+		providerKeeper.SetOptedIn(ctx, consumerId, cryptoId.ProviderConsAddress())
+	}
+
+	gomock.InOrder(append(
+		testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour, 0),
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "consumer", clienttypes.NewHeight(0, 5))...,
+	)...)
+	err = providerKeeper.LaunchConsumer(ctx, bondedValidators, bondedValidators, consumerId)
+	require.NoError(t, err)
+
+	// the initial validator set consists exactly of validators A and B
+	valSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	providerAddrs := []sdk.ConsAddress{}
+	for _, val := range valSet {
+		providerAddrs = append(providerAddrs, val.ProviderConsAddr)
+	}
+	require.ElementsMatch(t, []sdk.ConsAddress{
+		cryptoIds[0].SDKValConsAddress(),
+		cryptoIds[1].SDKValConsAddress(),
+	}, providerAddrs)
+
+	genesis, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+	require.True(t, found)
+	require.Len(t, genesis.Provider.InitialValSet, 2)
 }
 
 func TestConsumeIdsFromTimeQueue(t *testing.T) {
@@ -968,6 +1313,51 @@ func TestStopConsumerChain(t *testing.T) {
 // Setters and Getters
 //
 
+// TestValidateSpawnTime tests that spawn times too far in the past, as well as spawn times that are not before
+// the scheduled removal time of the consumer chain, are rejected
+func TestValidateSpawnTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	removalTime := now.Add(time.Hour)
+
+	testCases := []struct {
+		name       string
+		spawnTime  time.Time
+		hasRemoval bool
+		expPass    bool
+	}{
+		{"zero spawn time", time.Time{}, true, true},
+		{"future spawn time", now.Add(time.Minute), false, true},
+		{"current spawn time", now, false, true},
+		{"past spawn time within tolerance", now.Add(-providertypes.SpawnTimePastTolerance), false, true},
+		{"past spawn time beyond tolerance", now.Add(-providertypes.SpawnTimePastTolerance - time.Second), false, false},
+		{"spawn time before the removal", removalTime.Add(-time.Second), true, true},
+		{"spawn time at the removal", removalTime, true, false},
+		{"spawn time after the removal", removalTime.Add(time.Second), true, false},
+		{"spawn time after the removal time of another consumer", removalTime.Add(time.Second), false, true},
+	}
+
+	// consumer "0" has a scheduled removal time, while consumer "1" does not
+	err := providerKeeper.SetConsumerRemovalTime(ctx, "0", removalTime)
+	require.NoError(t, err)
+	for _, tc := range testCases {
+		consumerId := "1"
+		if tc.hasRemoval {
+			consumerId = "0"
+		}
+
+		err := providerKeeper.ValidateSpawnTime(ctx, consumerId, tc.spawnTime)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
 // TestConsumerRemovalTime tests the getter, setter, and deletion of the consumer id to removal times methods
 func TestConsumerRemovalTime(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
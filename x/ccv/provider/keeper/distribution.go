@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -484,6 +485,31 @@ func (k Keeper) IdentifyConsumerIdFromIBCPacket(ctx sdk.Context, packet channelt
 	return consumerId, nil
 }
 
+// GetConsumerDistributionChannel returns the provider end of the IBC transfer channel
+// used to transmit rewards from the consumer chain with `consumerId`, i.e., a transfer
+// channel over a connection to the consumer client. If `counterpartyChannelId` is not empty,
+// only the channel whose counterparty is the given consumer-side channel is considered.
+func (k Keeper) GetConsumerDistributionChannel(ctx sdk.Context, consumerId, counterpartyChannelId string) (channeltypes.IdentifiedChannel, bool) {
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return channeltypes.IdentifiedChannel{}, false
+	}
+
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		if channel.PortId != transfertypes.PortID || len(channel.ConnectionHops) != 1 {
+			continue
+		}
+		if counterpartyChannelId != "" && channel.Counterparty.ChannelId != counterpartyChannelId {
+			continue
+		}
+		conn, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if found && conn.ClientId == clientId {
+			return channel, true
+		}
+	}
+	return channeltypes.IdentifiedChannel{}, false
+}
+
 // GetSourceChainIdFromIBCPacket returns the chain ID of the chain that sent this packet
 func (k Keeper) GetSourceChainIdFromIBCPacket(ctx sdk.Context, packet channeltypes.Packet) (string, error) {
 	channel, ok := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
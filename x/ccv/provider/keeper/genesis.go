@@ -3,6 +3,9 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -15,6 +18,10 @@ import (
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abci.ValidatorUpdate {
 	k.SetPort(ctx, ccv.ProviderPortID)
 
+	// the params are set first, as setting the phases of the consumer chains
+	// depends on them, e.g., on the MaxConsumerPhaseHistoryLength param
+	k.SetParams(ctx, genState.Params)
+
 	k.SetValidatorSetUpdateId(ctx, genState.ValsetUpdateId)
 	for _, v2h := range genState.ValsetUpdateIdToHeight {
 		k.SetValsetUpdateBlockHeight(ctx, v2h.ValsetUpdateId, v2h.Height)
@@ -25,6 +32,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 		chainID := cs.ChainId
 		k.SetConsumerClientId(ctx, chainID, cs.ClientId)
 		k.SetConsumerPhase(ctx, chainID, cs.Phase)
+		if len(cs.PhaseHistory) > 0 {
+			// restore the exported phase history instead of the transition recorded by SetConsumerPhase
+			k.SetConsumerPhaseHistory(ctx, chainID, cs.PhaseHistory)
+		}
 		if err := k.SetConsumerGenesis(ctx, chainID, cs.ConsumerGenesis); err != nil {
 			// An error here would indicate something is very wrong,
 			// the ConsumerGenesis validated in ConsumerState.Validate().
@@ -39,6 +50,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 		} else {
 			k.AppendPendingVSCPackets(ctx, chainID, cs.PendingValsetChanges...)
 		}
+		for _, pending := range cs.PendingDowntimeSlashes {
+			k.SetPendingDowntimeSlash(ctx, chainID, pending)
+		}
+		k.SetVSCSendingPaused(ctx, chainID, cs.VscSendingPaused)
+		k.SetSlashPacketsPaused(ctx, chainID, cs.SlashPacketsPaused)
+		k.SetConsumerSlashMode(ctx, chainID, cs.SlashMode)
+		if cs.CcvTimeoutPeriod > 0 {
+			k.SetConsumerCCVTimeoutPeriod(ctx, chainID, cs.CcvTimeoutPeriod)
+		}
+		if sms := cs.SlashMeterState; sms != nil {
+			// restore the slash meter of the consumer chain, which has the same allowance as the global slash meter;
+			// a slash meter above the allowance (e.g., as the total voting power decreased) is capped at the allowance
+			k.SetConsumerSlashMeter(ctx, chainID, math.MinInt(sms.Meter, k.GetSlashMeterAllowance(ctx)))
+			k.setConsumerSlashMeterReplenishTimeCandidate(ctx, chainID, sms.ReplenishTimeCandidate)
+		}
 	}
 
 	// Import key assignment state
@@ -60,8 +86,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 		}
 	}
 
-	k.SetParams(ctx, genState.Params)
-	k.InitializeSlashMeter(ctx)
+	for _, item := range genState.KeyAssignmentHeights {
+		providerAddr := types.NewProviderConsAddress(item.ProviderAddr)
+		k.SetKeyAssignmentHeight(ctx, item.ConsumerId, providerAddr, item.Height)
+	}
+
+	for _, item := range genState.KeyAssignmentNonces {
+		k.SetKeyAssignmentNonce(ctx, item.ValidatorAddr, item.Nonce)
+	}
+
+	for _, item := range genState.JailingReasons {
+		k.SetJailingReason(ctx, types.NewProviderConsAddress(item.ProviderAddr), item.Reason)
+	}
+
+	if sms := genState.SlashMeterState; sms != nil {
+		// restore the throttling state; note that the allowance depends on the total voting power
+		// of the provider chain, so the slash meter is capped at the allowance here, as is done
+		// when replenishing the slash meter
+		k.SetSlashMeter(ctx, math.MinInt(sms.Meter, k.GetSlashMeterAllowance(ctx)))
+		k.setSlashMeterReplenishTimeCandidate(ctx, sms.ReplenishTimeCandidate)
+	} else {
+		k.InitializeSlashMeter(ctx)
+	}
 
 	return k.InitGenesisValUpdates(ctx)
 }
@@ -112,51 +158,27 @@ func (k Keeper) InitGenesisValUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	launchedConsumerIds := k.GetAllConsumersWithIBCClients(ctx)
 
-	// export states for each consumer chains
+	// export states for each consumer chains;
+	// note that ConsumerAddrsToPrune are added only for registered consumer chains
 	var consumerStates []types.ConsumerState
+	consumerAddrsToPrune := []types.ConsumerAddrsToPruneV2{}
 	for _, consumerId := range launchedConsumerIds {
-		// no need for the second return value of GetConsumerClientId
-		// as GetAllConsumersWithIBCClients already iterated through
-		// the entire prefix range
-		clientId, _ := k.GetConsumerClientId(ctx, consumerId)
-		gen, found := k.GetConsumerGenesis(ctx, consumerId)
-		if !found {
-			panic(fmt.Errorf("cannot find genesis for consumer chain %s with client %s", consumerId, clientId))
-		}
-
-		// initial consumer chain states
-		cs := types.ConsumerState{
-			ChainId:         consumerId,
-			ClientId:        clientId,
-			ConsumerGenesis: gen,
-			Phase:           k.GetConsumerPhase(ctx, consumerId),
-		}
-
-		// try to find channel id for the current consumer chain
-		channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
-		if found {
-			cs.ChannelId = channelId
-			cs.InitialHeight, found = k.GetInitChainHeight(ctx, consumerId)
-			if !found {
-				panic(fmt.Errorf("cannot find init height for consumer chain %s", consumerId))
-			}
-			cs.SlashDowntimeAck = k.GetSlashAcks(ctx, consumerId)
+		consumerGenState, err := k.ExportConsumerGenesis(ctx, consumerId)
+		if err != nil {
+			panic(err)
 		}
-
-		cs.PendingValsetChanges = k.GetPendingVSCPackets(ctx, consumerId)
-		consumerStates = append(consumerStates, cs)
-	}
-
-	// ConsumerAddrsToPrune are added only for registered consumer chains
-	consumerAddrsToPrune := []types.ConsumerAddrsToPruneV2{}
-	for _, chainID := range launchedConsumerIds {
-		consumerAddrsToPrune = append(consumerAddrsToPrune, k.GetAllConsumerAddrsToPrune(ctx, chainID)...)
+		consumerStates = append(consumerStates, consumerGenState.ConsumerStates...)
+		consumerAddrsToPrune = append(consumerAddrsToPrune, consumerGenState.ConsumerAddrsToPruneV2...)
 	}
+	// export the lists in a deterministic order that is independent of the order of the consumer ids
+	SortConsumerAddrsToPrune(consumerAddrsToPrune)
 
 	params := k.GetParams(ctx)
 
 	// TODO (PERMISSIONLESS)
-	return types.NewGenesisState(
+	// the key assignments are exported for all the consumer chains,
+	// i.e., also for the ones that are not launched yet
+	genState := types.NewGenesisState(
 		k.GetValidatorSetUpdateId(ctx),
 		k.GetAllValsetUpdateBlockHeights(ctx),
 		consumerStates,
@@ -165,4 +187,82 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllValidatorsByConsumerAddr(ctx, nil),
 		consumerAddrsToPrune,
 	)
+	// export the key assignment heights, so that the KeyAssignmentMinInterval is enforced after import
+	genState.KeyAssignmentHeights = k.GetAllKeyAssignmentHeights(ctx, nil)
+	// export the key assignment nonces, so that replayed key assignments are rejected after import
+	genState.KeyAssignmentNonces = k.GetAllKeyAssignmentNonces(ctx)
+	// export the jailing reasons, so that QueryJailingReason returns the same result after import
+	genState.JailingReasons = k.GetAllJailingReasons(ctx)
+	// export the throttling state, so that it is not reset on import
+	genState.SlashMeterState = &types.SlashMeterState{
+		Meter:                  k.GetSlashMeter(ctx),
+		ReplenishTimeCandidate: k.GetSlashMeterReplenishTimeCandidate(ctx),
+	}
+
+	return genState
+}
+
+// ExportConsumerGenesis returns a genesis state that contains only the state scoped to
+// the consumer chain with the given `consumerId`, i.e., its consumer state, key assignments
+// and consumer addresses to prune, together with the provider params and the current vscID.
+// This is useful for debugging or for migrating a single consumer chain to a new provider chain.
+// It returns an error if there is no IBC client to the consumer chain.
+func (k Keeper) ExportConsumerGenesis(ctx sdk.Context, consumerId string) (*types.GenesisState, error) {
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return nil, errorsmod.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", consumerId)
+	}
+	gen, found := k.GetConsumerGenesis(ctx, consumerId)
+	if !found {
+		return nil, fmt.Errorf("cannot find genesis for consumer chain %s with client %s", consumerId, clientId)
+	}
+
+	// initial consumer chain states
+	cs := types.ConsumerState{
+		ChainId:         consumerId,
+		ClientId:        clientId,
+		ConsumerGenesis: gen,
+		Phase:           k.GetConsumerPhase(ctx, consumerId),
+	}
+
+	// try to find channel id for the current consumer chain
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if found {
+		cs.ChannelId = channelId
+		cs.InitialHeight, found = k.GetInitChainHeight(ctx, consumerId)
+		if !found {
+			return nil, fmt.Errorf("cannot find init height for consumer chain %s", consumerId)
+		}
+		cs.SlashDowntimeAck = k.GetSlashAcks(ctx, consumerId)
+	}
+
+	cs.PendingValsetChanges = k.GetPendingVSCPackets(ctx, consumerId)
+	cs.PendingDowntimeSlashes = k.GetPendingDowntimeSlashes(ctx, consumerId)
+	cs.VscSendingPaused = k.IsVSCSendingPaused(ctx, consumerId)
+	cs.SlashPacketsPaused = k.IsSlashPacketsPaused(ctx, consumerId)
+	cs.SlashMode = k.GetConsumerSlashMode(ctx, consumerId)
+	cs.PhaseHistory = k.GetConsumerPhaseHistory(ctx, consumerId)
+	if ccvTimeoutPeriod, overridden := k.GetConsumerCCVTimeoutPeriod(ctx, consumerId); overridden {
+		cs.CcvTimeoutPeriod = ccvTimeoutPeriod
+	}
+	if meter, found := k.GetConsumerSlashMeter(ctx, consumerId); found {
+		candidate, _ := k.GetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+		cs.SlashMeterState = &types.SlashMeterState{
+			Meter:                  meter,
+			ReplenishTimeCandidate: candidate,
+		}
+	}
+
+	genState := types.NewGenesisState(
+		k.GetValidatorSetUpdateId(ctx),
+		nil,
+		[]types.ConsumerState{cs},
+		k.GetParams(ctx),
+		k.GetAllValidatorConsumerPubKeys(ctx, &consumerId),
+		k.GetAllValidatorsByConsumerAddr(ctx, &consumerId),
+		k.GetAllConsumerAddrsToPrune(ctx, consumerId),
+	)
+	genState.KeyAssignmentHeights = k.GetAllKeyAssignmentHeights(ctx, &consumerId)
+
+	return genState, nil
 }
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -10,11 +13,16 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/simulation"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -87,6 +95,51 @@ func TestInitAndExportGenesis(t *testing.T) {
 			},
 		},
 	)
+	provGenesis.KeyAssignmentHeights = []providertypes.KeyAssignmentHeight{
+		{
+			ConsumerId:   cChainIDs[0],
+			ProviderAddr: provAddr.ToSdkConsAddr(),
+			Height:       3,
+		},
+	}
+	provGenesis.KeyAssignmentNonces = []providertypes.KeyAssignmentNonce{
+		{
+			ValidatorAddr: providerCryptoId.SDKValOpAddress(),
+			Nonce:         5,
+		},
+	}
+	provGenesis.JailingReasons = []providertypes.ValidatorJailingReason{
+		{
+			ProviderAddr: provAddr.ToSdkConsAddr(),
+			Reason: providertypes.JailingReason{
+				ConsumerId: cChainIDs[0],
+				Infraction: stakingtypes.Infraction_INFRACTION_DOWNTIME,
+			},
+		},
+	}
+	provGenesis.ConsumerStates[0].VscSendingPaused = true
+	provGenesis.ConsumerStates[0].SlashPacketsPaused = true
+	provGenesis.ConsumerStates[0].SlashMode = providertypes.SLASH_MODE_RECORD_ONLY
+	provGenesis.ConsumerStates[0].CcvTimeoutPeriod = 2 * time.Hour
+	provGenesis.ConsumerStates[0].SlashMeterState = &providertypes.SlashMeterState{
+		Meter:                  math.NewInt(-3),
+		ReplenishTimeCandidate: oneHourFromNow,
+	}
+	provGenesis.ConsumerStates[0].PhaseHistory = []providertypes.ConsumerPhaseTransition{
+		{Height: 1, OldPhase: providertypes.CONSUMER_PHASE_UNSPECIFIED, NewPhase: providertypes.CONSUMER_PHASE_REGISTERED},
+		{Height: 2, OldPhase: providertypes.CONSUMER_PHASE_REGISTERED, NewPhase: providertypes.CONSUMER_PHASE_INITIALIZED},
+		{Height: 3, OldPhase: providertypes.CONSUMER_PHASE_INITIALIZED, NewPhase: providertypes.CONSUMER_PHASE_LAUNCHED},
+	}
+	provGenesis.ConsumerStates[0].PendingDowntimeSlashes = []providertypes.PendingDowntimeSlash{
+		{
+			JailTime: oneHourFromNow,
+			SlashPacketData: *ccv.NewSlashPacketData(
+				abci.Validator{Address: consumerConsAddr.ToSdkConsAddr(), Power: 1},
+				vscID,
+				stakingtypes.Infraction_INFRACTION_DOWNTIME,
+			),
+		},
+	}
 
 	// Instantiate in-mem provider keeper with mocks
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -94,7 +147,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 
 	gomock.InOrder(
 		mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(
-			ctx).Return(math.NewInt(100), nil).Times(1), // Return total voting power as 100
+			ctx).Return(math.NewInt(100), nil).Times(2), // Return total voting power as 100, for the global and the consumer slash meters
 	)
 
 	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(
@@ -151,6 +204,15 @@ func TestInitAndExportGenesis(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, provAddr, providerAddr)
 
+	keyAssignmentHeight, found := pk.GetKeyAssignmentHeight(ctx, cChainIDs[0], provAddr)
+	require.True(t, found)
+	require.Equal(t, int64(3), keyAssignmentHeight)
+	require.Equal(t, uint64(5), pk.GetKeyAssignmentNonce(ctx, valAddr))
+
+	jailingReason, found := pk.GetJailingReason(ctx, provAddr)
+	require.True(t, found)
+	require.Equal(t, provGenesis.JailingReasons[0].Reason, jailingReason)
+
 	addrs := pk.GetConsumerAddrsToPrune(ctx, cChainIDs[0], oneHourFromNow)
 	// Expect same list as what was provided in provGenesis
 	expectedAddrList := providertypes.AddressList{Addresses: [][]byte{consumerConsAddr.ToSdkConsAddr()}}
@@ -159,10 +221,173 @@ func TestInitAndExportGenesis(t *testing.T) {
 	// check provider chain's consumer chain states
 	assertConsumerChainStates(t, ctx, pk, provGenesis.ConsumerStates...)
 
-	// check the exported genesis
+	// check the exported genesis, which also contains the initialized slash meter state
+	// and the phase transition recorded for the consumer chain without a phase history in genesis
+	provGenesis.ConsumerStates[1].PhaseHistory = []providertypes.ConsumerPhaseTransition{
+		{Height: ctx.BlockHeight(), OldPhase: providertypes.CONSUMER_PHASE_UNSPECIFIED, NewPhase: providertypes.CONSUMER_PHASE_LAUNCHED},
+	}
+	provGenesis.SlashMeterState = &providertypes.SlashMeterState{
+		Meter:                  expectedSlashMeterValue,
+		ReplenishTimeCandidate: expectedCandidate,
+	}
 	require.Equal(t, provGenesis, pk.ExportGenesis(ctx))
 }
 
+// TestInitAndExportGenesisSlashMeterState tests that the slash meter and its replenish time candidate
+// are restored from genesis, i.e., that the throttling state survives an export and import
+func TestInitAndExportGenesisSlashMeterState(t *testing.T) {
+	// a negative slash meter with a replenish time candidate in the future
+	slashMeterState := &providertypes.SlashMeterState{
+		Meter:                  math.NewInt(-20),
+		ReplenishTimeCandidate: time.Now().UTC().Add(time.Hour),
+	}
+	provGenesis := providertypes.DefaultGenesisState()
+	provGenesis.SlashMeterState = slashMeterState
+	require.NoError(t, provGenesis.Validate())
+
+	initGenesis := func(provGenesis *providertypes.GenesisState) (keeper.Keeper, sdk.Context) {
+		pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		t.Cleanup(ctrl.Finish)
+		mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(100), nil).AnyTimes()
+		mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return([]stakingtypes.Validator{}, nil).AnyTimes()
+		pk.InitGenesis(ctx, provGenesis)
+		return pk, ctx
+	}
+
+	// export the imported slash meter state and import it again
+	for i := 0; i < 2; i++ {
+		pk, ctx := initGenesis(provGenesis)
+		require.Equal(t, slashMeterState.Meter, pk.GetSlashMeter(ctx))
+		require.Equal(t, slashMeterState.ReplenishTimeCandidate, pk.GetSlashMeterReplenishTimeCandidate(ctx))
+
+		provGenesis = pk.ExportGenesis(ctx)
+		require.Equal(t, slashMeterState, provGenesis.SlashMeterState)
+	}
+
+	// a slash meter above its allowance, i.e., 5% of the total voting power, is capped at the allowance
+	provGenesis.SlashMeterState = &providertypes.SlashMeterState{
+		Meter:                  math.NewInt(6),
+		ReplenishTimeCandidate: slashMeterState.ReplenishTimeCandidate,
+	}
+	require.NoError(t, provGenesis.Validate())
+	pk, ctx := initGenesis(provGenesis)
+	require.Equal(t, math.NewInt(5), pk.GetSlashMeter(ctx))
+}
+
+// TestInitGenesisRandomizedKeyAssignments tests that the key assignments generated
+// by RandomizedGenState can be imported, are only assigned for existing consumer chains,
+// and satisfy the pruning property
+func TestInitGenesisRandomizedKeyAssignments(t *testing.T) {
+	inMemParams := testkeeper.NewInMemKeeperParams(t)
+
+	for seed := int64(0); seed < 10; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := &module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          inMemParams.Cdc,
+			Rand:         r,
+			GenState:     make(map[string]json.RawMessage),
+			Accounts:     simtypes.RandomAccounts(r, 10),
+			NumBonded:    5,
+			GenTimestamp: time.Now().UTC(),
+			UnbondTime:   time.Hour,
+		}
+		simulation.RandomizedGenState(simState)
+
+		var provGenesis providertypes.GenesisState
+		inMemParams.Cdc.MustUnmarshalJSON(simState.GenState[providertypes.ModuleName], &provGenesis)
+		require.NoError(t, provGenesis.Validate())
+
+		pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(100), nil).AnyTimes()
+		mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return([]stakingtypes.Validator{}, nil).AnyTimes()
+
+		pk.InitGenesis(ctx, &provGenesis)
+
+		consumerIds := map[string]bool{}
+		for _, item := range provGenesis.ValidatorConsumerPubkeys {
+			consumerIds[item.ChainId] = true
+			// keys are only assigned for consumer chains that exist
+			_, found := pk.GetConsumerClientId(ctx, item.ChainId)
+			require.True(t, found)
+			providerAddr := providertypes.NewProviderConsAddress(item.ProviderAddr)
+			consumerKey, found := pk.GetValidatorConsumerPubKey(ctx, item.ChainId, providerAddr)
+			require.True(t, found)
+			require.Equal(t, *item.ConsumerKey, consumerKey)
+		}
+		require.Len(t, pk.GetAllValidatorsByConsumerAddr(ctx, nil), len(provGenesis.ValidatorsByConsumerAddr))
+		for consumerId := range consumerIds {
+			require.True(t, checkCorrectPruningProperty(ctx, pk, consumerId))
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestExportConsumerGenesis tests that the per-consumer exports of two consumer chains
+// together contain the same consumer state as the full export
+func TestExportConsumerGenesis(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	pk.SetParams(ctx, providertypes.DefaultParams())
+	pk.SetValidatorSetUpdateId(ctx, 2)
+	pk.SetSlashMeter(ctx, math.NewInt(10))
+	pk.SetSlashMeterReplenishTimeCandidate(ctx)
+
+	providerAddr := crypto.NewCryptoIdentityFromIntSeed(7896).ProviderConsAddress()
+	pruneTs := time.Now().UTC().Add(time.Hour)
+	consumerIds := []string{"0", "1"}
+	for i, consumerId := range consumerIds {
+		pk.SetConsumerClientId(ctx, consumerId, fmt.Sprintf("client-%d", i))
+		pk.SetConsumerIdToChannelId(ctx, consumerId, fmt.Sprintf("channel-%d", i))
+		pk.SetInitChainHeight(ctx, consumerId, uint64(5+i))
+		pk.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerGenesis(ctx, consumerId, *ccv.DefaultConsumerGenesisState())
+		require.NoError(t, err)
+
+		consumerCryptoId := crypto.NewCryptoIdentityFromIntSeed(7897 + i)
+		pk.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, consumerCryptoId.TMProtoCryptoPublicKey())
+		pk.SetValidatorByConsumerAddr(ctx, consumerId, consumerCryptoId.ConsumerConsAddress(), providerAddr)
+		oldConsumerAddr := crypto.NewCryptoIdentityFromIntSeed(7997 + i).ConsumerConsAddress()
+		pk.SetValidatorByConsumerAddr(ctx, consumerId, oldConsumerAddr, providerAddr)
+		pk.AppendConsumerAddrsToPrune(ctx, consumerId, pruneTs, oldConsumerAddr)
+	}
+	pk.SetSlashAcks(ctx, consumerIds[0], []string{crypto.NewCryptoIdentityFromIntSeed(7895).SDKValConsAddress().String()})
+	pk.AppendPendingVSCPackets(ctx, consumerIds[1], ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+
+	fullGenesis := pk.ExportGenesis(ctx)
+
+	var (
+		consumerStates           []providertypes.ConsumerState
+		validatorConsumerPubKeys []providertypes.ValidatorConsumerPubKey
+		validatorsByConsumerAddr []providertypes.ValidatorByConsumerAddr
+		consumerAddrsToPrune     []providertypes.ConsumerAddrsToPruneV2
+	)
+	for _, consumerId := range consumerIds {
+		consumerGenesis, err := pk.ExportConsumerGenesis(ctx, consumerId)
+		require.NoError(t, err)
+		require.NoError(t, consumerGenesis.Validate())
+		require.Equal(t, fullGenesis.Params, consumerGenesis.Params)
+		require.Equal(t, fullGenesis.ValsetUpdateId, consumerGenesis.ValsetUpdateId)
+		require.Len(t, consumerGenesis.ConsumerStates, 1)
+		require.Equal(t, consumerId, consumerGenesis.ConsumerStates[0].ChainId)
+
+		consumerStates = append(consumerStates, consumerGenesis.ConsumerStates...)
+		validatorConsumerPubKeys = append(validatorConsumerPubKeys, consumerGenesis.ValidatorConsumerPubkeys...)
+		validatorsByConsumerAddr = append(validatorsByConsumerAddr, consumerGenesis.ValidatorsByConsumerAddr...)
+		consumerAddrsToPrune = append(consumerAddrsToPrune, consumerGenesis.ConsumerAddrsToPruneV2...)
+	}
+
+	require.Equal(t, fullGenesis.ConsumerStates, consumerStates)
+	require.ElementsMatch(t, fullGenesis.ValidatorConsumerPubkeys, validatorConsumerPubKeys)
+	require.ElementsMatch(t, fullGenesis.ValidatorsByConsumerAddr, validatorsByConsumerAddr)
+	require.ElementsMatch(t, fullGenesis.ConsumerAddrsToPruneV2, consumerAddrsToPrune)
+
+	// a consumer chain without an IBC client cannot be exported
+	_, err := pk.ExportConsumerGenesis(ctx, "2")
+	require.Error(t, err)
+}
+
 func assertConsumerChainStates(t *testing.T, ctx sdk.Context, pk keeper.Keeper, consumerStates ...providertypes.ConsumerState) {
 	t.Helper()
 	for _, cs := range consumerStates {
@@ -192,5 +417,24 @@ func assertConsumerChainStates(t *testing.T, ctx sdk.Context, pk keeper.Keeper,
 		}
 
 		require.Equal(t, cs.SlashDowntimeAck, pk.GetSlashAcks(ctx, chainID))
+		require.Equal(t, cs.PendingDowntimeSlashes, pk.GetPendingDowntimeSlashes(ctx, chainID))
+		require.Equal(t, cs.VscSendingPaused, pk.IsVSCSendingPaused(ctx, chainID))
+		require.Equal(t, cs.SlashPacketsPaused, pk.IsSlashPacketsPaused(ctx, chainID))
+		require.Equal(t, cs.SlashMode, pk.GetConsumerSlashMode(ctx, chainID))
+		ccvTimeoutPeriod, overridden := pk.GetConsumerCCVTimeoutPeriod(ctx, chainID)
+		require.Equal(t, cs.CcvTimeoutPeriod > 0, overridden)
+		if overridden {
+			require.Equal(t, cs.CcvTimeoutPeriod, ccvTimeoutPeriod)
+		}
+		meter, found := pk.GetConsumerSlashMeter(ctx, chainID)
+		require.Equal(t, cs.SlashMeterState != nil, found)
+		if found {
+			require.Equal(t, cs.SlashMeterState.Meter, meter)
+			candidate, _ := pk.GetConsumerSlashMeterReplenishTimeCandidate(ctx, chainID)
+			require.Equal(t, cs.SlashMeterState.ReplenishTimeCandidate, candidate)
+		}
+		if len(cs.PhaseHistory) > 0 {
+			require.Equal(t, cs.PhaseHistory, pk.GetConsumerPhaseHistory(ctx, chainID))
+		}
 	}
 }
@@ -4,18 +4,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -35,15 +41,32 @@ func (k Keeper) QueryConsumerGenesis(c context.Context, req *types.QueryConsumer
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// the genesis of a launched consumer chain is stored
 	gen, ok := k.GetConsumerGenesis(ctx, consumerId)
-	if !ok {
+	if ok {
+		return &types.QueryConsumerGenesisResponse{GenesisState: gen}, nil
+	}
+
+	switch phase := k.GetConsumerPhase(ctx, consumerId); phase {
+	case types.CONSUMER_PHASE_UNSPECIFIED:
 		return nil, status.Error(
 			codes.NotFound,
 			errorsmod.Wrap(types.ErrUnknownConsumerId, consumerId).Error(),
 		)
+	case types.CONSUMER_PHASE_INITIALIZED:
+		// the genesis of an initialized consumer chain is computed on demand
+		gen, err := k.ComputeConsumerGenesis(ctx, consumerId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot compute genesis for consumer id %s: %s", consumerId, err)
+		}
+		return &types.QueryConsumerGenesisResponse{GenesisState: gen}, nil
+	default:
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"consumer genesis is only available for initialized or launched consumer chains; consumer id %s is in phase %s",
+			consumerId, phase,
+		)
 	}
-
-	return &types.QueryConsumerGenesisResponse{GenesisState: gen}, nil
 }
 
 func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsumerChainsRequest) (*types.QueryConsumerChainsResponse, error) {
@@ -226,9 +249,13 @@ func (k Keeper) QueryThrottleState(goCtx context.Context, req *types.QueryThrott
 	candidate := k.GetSlashMeterReplenishTimeCandidate(ctx) // always UTC
 
 	return &types.QueryThrottleStateResponse{
-		SlashMeter:             meter.Int64(),
-		SlashMeterAllowance:    allowance.Int64(),
-		NextReplenishCandidate: candidate,
+		SlashMeter:                  meter.Int64(),
+		SlashMeterAllowance:         allowance.Int64(),
+		NextReplenishCandidate:      candidate,
+		SlashMeterReplenishFraction: k.GetSlashMeterReplenishFraction(ctx),
+		SlashMeterReplenishPeriod:   k.GetSlashMeterReplenishPeriod(ctx),
+		DowntimeSlashPackets:        k.GetInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOWNTIME),
+		DoubleSignSlashPackets:      k.GetInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN),
 	}, nil
 }
 
@@ -333,6 +360,18 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	validators, err := k.getConsumerValidators(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryConsumerValidatorsResponse{
+		Validators: validators,
+	}, nil
+}
+
+// getConsumerValidators returns the consumer validators of the consumer chain with `consumerId`,
+// i.e., the persisted validator set for launched chains and the computed one for chains not yet launched
+func (k Keeper) getConsumerValidators(ctx sdk.Context, consumerId string) ([]*types.QueryConsumerValidatorsValidator, error) {
 	// get the consumer phase
 	phase := k.GetConsumerPhase(ctx, consumerId)
 	if phase == types.CONSUMER_PHASE_UNSPECIFIED {
@@ -374,7 +413,12 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 			}
 		}
 
-		consumerValSet, err = k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
+		forcedConsumers, err := k.ComputeForcedConsumers(ctx, bondedValidators)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to compute forced consumers: %s", err))
+		}
+
+		consumerValSet, err = k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower, forcedConsumers)
 		if err != nil {
 			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to compute the next validators for chain %s: %s", consumerId, err))
 		}
@@ -426,9 +470,7 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 			ValidatesCurrentEpoch:   hasToValidate,
 		})
 	}
-	return &types.QueryConsumerValidatorsResponse{
-		Validators: validators,
-	}, nil
+	return validators, nil
 }
 
 // QueryConsumerChainsValidatorHasToValidate returns all consumer chains that the given validator has to validate now
@@ -449,8 +491,14 @@ func (k Keeper) QueryConsumerChainsValidatorHasToValidate(goCtx context.Context,
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	provAddr := types.NewProviderConsAddress(consAddr)
+	return &types.QueryConsumerChainsValidatorHasToValidateResponse{
+		ConsumerIds: k.consumerChainsValidatorHasToValidate(ctx, types.NewProviderConsAddress(consAddr)),
+	}, nil
+}
 
+// consumerChainsValidatorHasToValidate returns all consumer chains that the given validator has to validate now
+// or in the next epoch if nothing changes
+func (k Keeper) consumerChainsValidatorHasToValidate(ctx sdk.Context, provAddr types.ProviderConsAddress) []string {
 	// get all the consumer chains for which the validator is either already
 	// opted-in, currently a consumer validator or if its voting power is within the TopN validators
 	consumersToValidate := []string{}
@@ -461,10 +509,7 @@ func (k Keeper) QueryConsumerChainsValidatorHasToValidate(goCtx context.Context,
 			consumersToValidate = append(consumersToValidate, consumerId)
 		}
 	}
-
-	return &types.QueryConsumerChainsValidatorHasToValidateResponse{
-		ConsumerIds: consumersToValidate,
-	}, nil
+	return consumersToValidate
 }
 
 // hasToValidate checks if a validator needs to validate on a consumer chain
@@ -510,7 +555,11 @@ func (k Keeper) hasToValidate(
 	if err != nil {
 		return false, err
 	}
-	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, lastVals, powerShapingParameters, minPowerToOptIn)
+	forcedConsumers, err := k.ComputeForcedConsumers(ctx, lastVals)
+	if err != nil {
+		return false, err
+	}
+	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, lastVals, powerShapingParameters, minPowerToOptIn, forcedConsumers)
 	if err != nil {
 		return false, err
 	}
@@ -703,3 +752,1140 @@ func (k Keeper) QueryConsumerGenesisTime(goCtx context.Context, req *types.Query
 		GenesisTime: time.Unix(0, int64(cs.GetTimestamp())), // nolint:staticcheck
 	}, nil
 }
+
+// QueryVSCMaturationSchedule returns the maturity times of the VSCs sent to the consumer chain
+// associated with the provided consumer id that have not yet matured
+func (k Keeper) QueryVSCMaturationSchedule(goCtx context.Context, req *types.QueryVSCMaturationScheduleRequest) (*types.QueryVSCMaturationScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown consumer chain for consumer id: %s", consumerId)
+	}
+
+	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot retrieve the unbonding period: %s", err)
+	}
+
+	return &types.QueryVSCMaturationScheduleResponse{
+		Maturities: k.GetVSCMaturationSchedule(ctx, consumerId, unbondingPeriod),
+	}, nil
+}
+
+// QuerySimulateConsumerUpdate returns the validator set the given consumer chain would have
+// with the provided power-shaping parameters, as well as the validator updates with respect
+// to its current validator set, without updating the state
+func (k Keeper) QuerySimulateConsumerUpdate(goCtx context.Context, req *types.QuerySimulateConsumerUpdateRequest) (*types.QuerySimulateConsumerUpdateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.PowerShapingParams == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty power shaping parameters")
+	}
+	if err := types.ValidatePowerShapingParameters(*req.PowerShapingParams); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsConsumerActive(ctx, consumerId) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerId))
+	}
+
+	nextValidators, valUpdates, err := k.SimulateConsumerNextValSet(ctx, consumerId, *req.PowerShapingParams)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySimulateConsumerUpdateResponse{
+		NextValidators:   nextValidators,
+		ValidatorUpdates: valUpdates,
+	}, nil
+}
+
+// QueryConsumerValidatorSetHash returns the hash of the validator set the provider expects
+// the consumer chain with the given consumer id to use
+func (k Keeper) QueryConsumerValidatorSetHash(goCtx context.Context, req *types.QueryConsumerValidatorSetHashRequest) (*types.QueryConsumerValidatorSetHashResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase != types.CONSUMER_PHASE_LAUNCHED {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the validator set hash is only available for launched consumer chains: consumer chain %s is in phase %s", consumerId, phase)
+	}
+
+	consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	valSetHash, err := ConsumerValSetHash(consumerValSet)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerValidatorSetHashResponse{
+		ValsetHash: strings.ToUpper(hex.EncodeToString(valSetHash)),
+		ValsetSize: uint64(len(consumerValSet)),
+	}, nil
+}
+
+// QueryValsetUpdateIdToHeight returns the provider block height mapped to the given valset update id
+func (k Keeper) QueryValsetUpdateIdToHeight(goCtx context.Context, req *types.QueryValsetUpdateIdToHeightRequest) (*types.QueryValsetUpdateIdToHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	height, found := k.GetValsetUpdateBlockHeight(ctx, req.VscId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no block height found for valset update id %d", req.VscId)
+	}
+
+	return &types.QueryValsetUpdateIdToHeightResponse{Height: height}, nil
+}
+
+// QueryRecentValsetUpdateIds returns the most recent MaxValsetUpdateBlockHeights valset update ids
+// together with the provider block heights they are mapped to
+func (k Keeper) QueryRecentValsetUpdateIds(goCtx context.Context, req *types.QueryRecentValsetUpdateIdsRequest) (*types.QueryRecentValsetUpdateIdsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRecentValsetUpdateIdsResponse{
+		ValsetUpdateIdToHeight: k.GetAllRecentValsetUpdateBlockHeights(ctx),
+	}, nil
+}
+
+// QueryValidatorsUsingDefaultKey returns the validators in the validator set of the given
+// consumer chain that have not assigned a consumer key, i.e., that use their provider key
+func (k Keeper) QueryValidatorsUsingDefaultKey(goCtx context.Context, req *types.QueryValidatorsUsingDefaultKeyRequest) (*types.QueryValidatorsUsingDefaultKeyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase != types.CONSUMER_PHASE_LAUNCHED {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"the validator set is only available for launched consumer chains: consumer chain %s is in phase %s", consumerId, phase)
+	}
+
+	consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defaultKeyVals := []string{}
+	for _, v := range consumerValSet {
+		providerAddr := types.NewProviderConsAddress(v.ProviderConsAddr)
+		if _, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); !found {
+			defaultKeyVals = append(defaultKeyVals, providerAddr.ToSdkConsAddr().String())
+		}
+	}
+
+	return &types.QueryValidatorsUsingDefaultKeyResponse{
+		ValidatorsProviderAddresses: defaultKeyVals,
+	}, nil
+}
+
+// QueryConsumerRewardsAddress returns the address of the module account that collects
+// the rewards sent by the given consumer chain
+func (k Keeper) QueryConsumerRewardsAddress(goCtx context.Context, req *types.QueryConsumerRewardsAddressRequest) (*types.QueryConsumerRewardsAddressResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	// the rewards of all the consumer chains are collected by the same module account
+	return &types.QueryConsumerRewardsAddressResponse{
+		RewardsAddress: k.GetConsumerRewardsPoolAddressStr(ctx),
+	}, nil
+}
+
+// QueryTopNThreshold returns the minimum power needed for a validator to belong
+// to the top N% of the current active validators
+func (k Keeper) QueryTopNThreshold(goCtx context.Context, req *types.QueryTopNThresholdRequest) (*types.QueryTopNThresholdResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TopN == 0 || req.TopN > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid top N: %d, it has to be in (0, 100]", req.TopN)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minPower, err := k.ComputeTopNThreshold(ctx, req.TopN)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute top N threshold: %s", err)
+	}
+
+	return &types.QueryTopNThresholdResponse{MinPower: minPower}, nil
+}
+
+// QueryConsumerPhaseHistory returns the most recent phase transitions of the given consumer chain
+func (k Keeper) QueryConsumerPhaseHistory(goCtx context.Context, req *types.QueryConsumerPhaseHistoryRequest) (*types.QueryConsumerPhaseHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	return &types.QueryConsumerPhaseHistoryResponse{
+		Transitions: k.GetConsumerPhaseHistory(ctx, consumerId),
+	}, nil
+}
+
+// QueryConsumerAddrsToPrune returns the consumer addresses of the given consumer chain
+// that are scheduled for pruning, together with their prune timestamps
+func (k Keeper) QueryConsumerAddrsToPrune(goCtx context.Context, req *types.QueryConsumerAddrsToPruneRequest) (*types.QueryConsumerAddrsToPruneResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	return &types.QueryConsumerAddrsToPruneResponse{
+		ConsumerAddrsToPrune: k.GetAllConsumerAddrsToPrune(ctx, consumerId),
+	}, nil
+}
+
+// QueryRecentKeyAssignments returns the consumer key rotations of the validators on the given consumer chain
+// that became effective after the VSC packet with the given id was sent
+func (k Keeper) QueryRecentKeyAssignments(goCtx context.Context, req *types.QueryRecentKeyAssignmentsRequest) (*types.QueryRecentKeyAssignmentsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	// a key rotation becomes effective in the first VSC packet sent after it took place
+	sendTs, found := k.GetVscSendTimestamp(ctx, consumerId, req.SinceVscId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no send timestamp for VSC packet %d on consumer chain %s", req.SinceVscId, consumerId)
+	}
+
+	keyRotations, err := k.GetKeyRotationsAfter(ctx, consumerId, sendTs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecentKeyAssignmentsResponse{KeyRotations: keyRotations}, nil
+}
+
+// QueryJailingReason returns whether the given jailed validator was jailed because of
+// a slash packet sent by a consumer chain, together with the reported infraction,
+// or on the provider chain
+func (k Keeper) QueryJailingReason(goCtx context.Context, req *types.QueryJailingReasonRequest) (*types.QueryJailingReasonResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	providerAddrTmp, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator not found: %s", req.ProviderAddress)
+	}
+	if !validator.IsJailed() {
+		return nil, status.Errorf(codes.NotFound, "validator is not jailed: %s", req.ProviderAddress)
+	}
+
+	reason, found := k.GetJailingReason(ctx, providerAddr)
+	if !found {
+		return &types.QueryJailingReasonResponse{JailedBy: types.JailedByProvider}, nil
+	}
+
+	return &types.QueryJailingReasonResponse{
+		JailedBy:   reason.ConsumerId,
+		Infraction: reason.Infraction,
+	}, nil
+}
+
+// QueryConsumerSlashPacketRate returns the number of slash packets received
+// from a consumer chain in the last SlashPacketRateWindow blocks
+func (k Keeper) QueryConsumerSlashPacketRate(goCtx context.Context, req *types.QueryConsumerSlashPacketRateRequest) (*types.QueryConsumerSlashPacketRateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	return &types.QueryConsumerSlashPacketRateResponse{
+		Count:        k.GetSlashPacketRate(ctx, consumerId),
+		WindowBlocks: types.SlashPacketRateWindow,
+	}, nil
+}
+
+// QueryEffectiveConsumerKey returns the key a validator uses on a consumer chain, i.e.,
+// the assigned consumer key if any, and the validator's provider consensus key otherwise
+func (k Keeper) QueryEffectiveConsumerKey(goCtx context.Context, req *types.QueryEffectiveConsumerKeyRequest) (*types.QueryEffectiveConsumerKeyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	providerAddrTmp, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); found {
+		return &types.QueryEffectiveConsumerKeyResponse{
+			ConsumerKey: &consumerKey,
+			IsDefault:   false,
+		}, nil
+	}
+
+	// the validator did not assign a consumer key and thus uses its provider key
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator not found: %s", req.ProviderAddress)
+	}
+	providerKey, err := validator.CmtConsPublicKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting consensus public key of validator %s: %s", req.ProviderAddress, err)
+	}
+
+	return &types.QueryEffectiveConsumerKeyResponse{
+		ConsumerKey: &providerKey,
+		IsDefault:   true,
+	}, nil
+}
+
+// QueryConsumerLaunchFailure returns the number of failed attempts to launch
+// a consumer chain and the error of the last failed attempt
+func (k Keeper) QueryConsumerLaunchFailure(goCtx context.Context, req *types.QueryConsumerLaunchFailureRequest) (*types.QueryConsumerLaunchFailureResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	failure, _ := k.GetConsumerLaunchFailure(ctx, consumerId)
+	return &types.QueryConsumerLaunchFailureResponse{
+		Attempts: failure.Attempts,
+		Error:    failure.Error,
+		Phase:    phase,
+	}, nil
+}
+
+// QuerySlashPacketBySeq returns how the slash packet received from a consumer chain
+// with the given IBC sequence number was processed
+func (k Keeper) QuerySlashPacketBySeq(goCtx context.Context, req *types.QuerySlashPacketBySeqRequest) (*types.QuerySlashPacketBySeqResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	record, found := k.GetSlashPacketRecord(ctx, consumerId, req.IbcSeq)
+	if !found {
+		return &types.QuerySlashPacketBySeqResponse{Status: types.SLASH_PACKET_STATUS_UNKNOWN}, nil
+	}
+
+	return &types.QuerySlashPacketBySeqResponse{
+		Status:          record.Status,
+		ProviderAddress: sdk.ConsAddress(record.ProviderAddr).String(),
+		Infraction:      record.Infraction,
+		Jailed:          record.Jailed,
+	}, nil
+}
+
+// QueryConsumersWithThrottledSlashing returns the consumer chains that currently
+// have slash packets throttled, together with the number of throttled slash packets
+func (k Keeper) QueryConsumersWithThrottledSlashing(goCtx context.Context, req *types.QueryConsumersWithThrottledSlashingRequest) (*types.QueryConsumersWithThrottledSlashingResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryConsumersWithThrottledSlashingResponse{
+		Consumers: k.GetConsumersWithThrottledSlashPackets(ctx),
+	}, nil
+}
+
+// QueryValidatorTopNObligations returns the launched Top N consumer chains that the given
+// validator is forced to validate, i.e., the chains for which the validator's power
+// is at or above the Top N threshold computed with the current active validators
+func (k Keeper) QueryValidatorTopNObligations(goCtx context.Context, req *types.QueryValidatorTopNObligationsRequest) (*types.QueryValidatorTopNObligationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown validator: %s", req.ProviderAddress)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get validator power: %s", err)
+	}
+
+	forcedConsumers, err := k.ComputeForcedConsumers(ctx, []stakingtypes.Validator{validator})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute forced consumers: %s", err)
+	}
+
+	obligations := []types.TopNObligation{}
+	// the thresholds are computed only once for every N
+	thresholds := map[uint32]int64{}
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get power shaping params for chain %s: %s", consumerId, err)
+		}
+		topN := powerShapingParameters.Top_N
+		if topN == 0 {
+			continue
+		}
+
+		threshold, found := thresholds[topN]
+		if !found {
+			threshold, err = k.ComputeTopNThreshold(ctx, topN)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to compute top N threshold for chain %s: %s", consumerId, err)
+			}
+			thresholds[topN] = threshold
+		}
+
+		// validators without power are not in the active set and thus not forced to validate
+		if power > 0 && power >= threshold {
+			// validators that reached the max forced consumers are not forced to validate the chain
+			reachedMax, err := k.HasReachedMaxForcedConsumers(ctx, forcedConsumers, consumerId, types.NewProviderConsAddress(consAddr))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check forced consumers for chain %s: %s", consumerId, err)
+			}
+			if reachedMax {
+				continue
+			}
+			obligations = append(obligations, types.TopNObligation{
+				ConsumerId:      consumerId,
+				Top_N:           topN,
+				MinPowerInTop_N: threshold,
+			})
+		}
+	}
+
+	return &types.QueryValidatorTopNObligationsResponse{
+		Power:       power,
+		Obligations: obligations,
+	}, nil
+}
+
+// QueryConsumerGenesisValsetHash returns the hash of the initial validator set
+// of the consumer chain with the given consumer id, computed when the chain launched
+func (k Keeper) QueryConsumerGenesisValsetHash(goCtx context.Context, req *types.QueryConsumerGenesisValsetHashRequest) (*types.QueryConsumerGenesisValsetHashResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valsetHash, found := k.GetConsumerGenesisValsetHash(ctx, consumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no genesis validator set hash for consumer chain: %s", consumerId)
+	}
+
+	return &types.QueryConsumerGenesisValsetHashResponse{
+		ValsetHash: strings.ToUpper(hex.EncodeToString(valsetHash)),
+	}, nil
+}
+
+// QueryConsumerDistribution returns the distribution transmission channel of the consumer chain
+// with the given consumer id, whether the channel is open, and whether IBC transfers are enabled
+func (k Keeper) QueryConsumerDistribution(goCtx context.Context, req *types.QueryConsumerDistributionRequest) (*types.QueryConsumerDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot retrieve initialization parameters for consumer chain %s: %s", consumerId, err)
+	}
+
+	transferParams := k.transferKeeper.GetParams(ctx)
+	resp := &types.QueryConsumerDistributionResponse{
+		DistributionChannelId: initializationParameters.DistributionTransmissionChannel,
+		SendEnabled:           transferParams.SendEnabled,
+		ReceiveEnabled:        transferParams.ReceiveEnabled,
+	}
+
+	// if no distribution channel was configured, the consumer chain opens
+	// a new transfer channel over the CCV connection
+	channel, found := k.GetConsumerDistributionChannel(ctx, consumerId, initializationParameters.DistributionTransmissionChannel)
+	if found {
+		resp.DistributionChannelId = channel.Counterparty.ChannelId
+		resp.ProviderChannelId = channel.ChannelId
+		resp.Open = channel.State == channeltypes.OPEN
+	}
+
+	return resp, nil
+}
+
+// QueryConsumerValidatorSets returns the validator sets of the consumer chains with the given consumer ids
+func (k Keeper) QueryConsumerValidatorSets(goCtx context.Context, req *types.QueryConsumerValidatorSetsRequest) (*types.QueryConsumerValidatorSetsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.ConsumerIds) > types.MaxConsumerIdsPerValidatorSetsQuery {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot query more than %d consumer chains at once, got %d",
+			types.MaxConsumerIdsPerValidatorSetsQuery, len(req.ConsumerIds))
+	}
+
+	seen := map[string]bool{}
+	for _, consumerId := range req.ConsumerIds {
+		if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if seen[consumerId] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate consumer id: %s", consumerId)
+		}
+		seen[consumerId] = true
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validatorSets := []types.ConsumerValidatorSet{}
+	for _, consumerId := range req.ConsumerIds {
+		validators, err := k.getConsumerValidators(ctx, consumerId)
+		if err != nil {
+			return nil, err
+		}
+		validatorSets = append(validatorSets, types.ConsumerValidatorSet{
+			ConsumerId: consumerId,
+			Validators: validators,
+		})
+	}
+
+	return &types.QueryConsumerValidatorSetsResponse{ValidatorSets: validatorSets}, nil
+}
+
+// QueryValidatorKeyConflicts returns the consumer keys that the given validator assigned on more than one consumer chain
+func (k Keeper) QueryValidatorKeyConflicts(goCtx context.Context, req *types.QueryValidatorKeyConflictsRequest) (*types.QueryValidatorKeyConflictsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	conflicts, err := k.GetValidatorKeyConflicts(ctx, types.NewProviderConsAddress(consAddr))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorKeyConflictsResponse{Conflicts: conflicts}, nil
+}
+
+// QueryConsumerRemovalETA returns the scheduled removal time of the consumer chain with the given consumer id
+// and the time remaining until then, relative to the current block time
+func (k Keeper) QueryConsumerRemovalETA(goCtx context.Context, req *types.QueryConsumerRemovalETARequest) (*types.QueryConsumerRemovalETAResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot retrieve chain id for consumer id: %s", consumerId)
+	}
+
+	// the removal time is only set for stopped consumer chains that are
+	// scheduled to be removed by BeginBlockRemoveConsumers
+	if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_STOPPED {
+		return &types.QueryConsumerRemovalETAResponse{}, nil
+	}
+	removalTime, err := k.GetConsumerRemovalTime(ctx, consumerId)
+	if err != nil {
+		return &types.QueryConsumerRemovalETAResponse{}, nil
+	}
+
+	timeRemaining := removalTime.Sub(ctx.BlockTime())
+	if timeRemaining < 0 {
+		timeRemaining = 0
+	}
+
+	return &types.QueryConsumerRemovalETAResponse{
+		RemovalScheduled: true,
+		RemovalTime:      removalTime,
+		TimeRemaining:    timeRemaining,
+	}, nil
+}
+
+// QueryConsumerJailedPower returns the cumulative power of the validators jailed because of
+// the slash packets received from the consumer chain with the given consumer id
+func (k Keeper) QueryConsumerJailedPower(goCtx context.Context, req *types.QueryConsumerJailedPowerRequest) (*types.QueryConsumerJailedPowerResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot retrieve chain id for consumer id: %s", consumerId)
+	}
+
+	return &types.QueryConsumerJailedPowerResponse{
+		JailedPower: k.GetConsumerJailedPower(ctx, consumerId),
+	}, nil
+}
+
+// QueryValidatorDenylistedConsumers returns the ids of the active consumer chains on which the given validator is denylisted
+func (k Keeper) QueryValidatorDenylistedConsumers(goCtx context.Context, req *types.QueryValidatorDenylistedConsumersRequest) (*types.QueryValidatorDenylistedConsumersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerIds := []string{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		if k.IsDenylisted(ctx, consumerId, providerAddr) {
+			consumerIds = append(consumerIds, consumerId)
+		}
+	}
+
+	return &types.QueryValidatorDenylistedConsumersResponse{ConsumerIds: consumerIds}, nil
+}
+
+// QueryValidatorHasToValidate returns whether a given validator has to validate
+// a given consumer chain, along with the reason why
+func (k Keeper) QueryValidatorHasToValidate(goCtx context.Context, req *types.QueryValidatorHasToValidateRequest) (*types.QueryValidatorHasToValidateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, req.ConsumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot retrieve chain id for consumer id: %s", req.ConsumerId)
+	}
+
+	hasToValidate, err := k.hasToValidate(ctx, providerAddr, req.ConsumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !hasToValidate {
+		return &types.QueryValidatorHasToValidateResponse{
+			HasToValidate: false,
+			Reason:        types.HAS_TO_VALIDATE_REASON_UNSPECIFIED,
+		}, nil
+	}
+
+	reason, err := k.hasToValidateReason(ctx, providerAddr, req.ConsumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorHasToValidateResponse{
+		HasToValidate: true,
+		Reason:        reason,
+	}, nil
+}
+
+// hasToValidateReason returns the reason why a validator that has to validate
+// a consumer chain has to do so
+func (k Keeper) hasToValidateReason(
+	ctx sdk.Context,
+	providerAddr types.ProviderConsAddress,
+	consumerId string,
+) (types.HasToValidateReason, error) {
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return types.HAS_TO_VALIDATE_REASON_UNSPECIFIED, err
+	}
+
+	if powerShapingParameters.Top_N > 0 {
+		activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
+		if err != nil {
+			return types.HAS_TO_VALIDATE_REASON_UNSPECIFIED, err
+		}
+		minPowerToOptIn, err := k.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
+		if err != nil {
+			return types.HAS_TO_VALIDATE_REASON_UNSPECIFIED, err
+		}
+		hasMinPower, err := k.HasMinPower(ctx, providerAddr, minPowerToOptIn)
+		if err != nil {
+			return types.HAS_TO_VALIDATE_REASON_UNSPECIFIED, err
+		}
+		if hasMinPower {
+			return types.HAS_TO_VALIDATE_REASON_TOP_N, nil
+		}
+	}
+
+	if k.IsOptedIn(ctx, consumerId, providerAddr) {
+		return types.HAS_TO_VALIDATE_REASON_OPTED_IN, nil
+	}
+
+	if !k.IsAllowlistEmpty(ctx, consumerId) && k.IsAllowlisted(ctx, consumerId, providerAddr) {
+		return types.HAS_TO_VALIDATE_REASON_ALLOWLISTED, nil
+	}
+
+	return types.HAS_TO_VALIDATE_REASON_CONSUMER_VALIDATOR, nil
+}
+
+// QueryConsumerCCVTimeout returns the effective timeout period of the CCV packets
+// sent to a given consumer chain
+func (k Keeper) QueryConsumerCCVTimeout(goCtx context.Context, req *types.QueryConsumerCCVTimeoutRequest) (*types.QueryConsumerCCVTimeoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, req.ConsumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot retrieve chain id for consumer id: %s", req.ConsumerId)
+	}
+
+	timeoutPeriod, overridden := k.GetConsumerCCVTimeoutPeriod(ctx, req.ConsumerId)
+
+	return &types.QueryConsumerCCVTimeoutResponse{
+		CcvTimeoutPeriod: timeoutPeriod,
+		Overridden:       overridden,
+	}, nil
+}
+
+// QueryKeyAssignmentStats returns the number of assigned consumer keys and
+// of consumer addresses pending pruning, in total and for every consumer chain
+func (k Keeper) QueryKeyAssignmentStats(goCtx context.Context, req *types.QueryKeyAssignmentStatsRequest) (*types.QueryKeyAssignmentStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := &types.QueryKeyAssignmentStatsResponse{
+		ConsumerStats: k.GetKeyAssignmentStats(ctx),
+	}
+	for _, consumerStats := range resp.ConsumerStats {
+		resp.TotalAssignedKeys += consumerStats.AssignedKeys
+		resp.TotalAddrsToPrune += consumerStats.AddrsToPrune
+	}
+
+	return resp, nil
+}
+
+// QuerySlashMeterHistory returns the values of the slash meter at the end of the most recent blocks
+func (k Keeper) QuerySlashMeterHistory(goCtx context.Context, req *types.QuerySlashMeterHistoryRequest) (*types.QuerySlashMeterHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySlashMeterHistoryResponse{
+		Entries: k.GetSlashMeterHistory(ctx),
+	}, nil
+}
+
+// QueryValidatorAllConsumerKeys returns the consumer keys assigned by the given validator on all the consumer chains
+func (k Keeper) QueryValidatorAllConsumerKeys(goCtx context.Context, req *types.QueryValidatorAllConsumerKeysRequest) (*types.QueryValidatorAllConsumerKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerKeys, err := k.validatorAllConsumerKeys(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorAllConsumerKeysResponse{ConsumerKeys: consumerKeys}, nil
+}
+
+// validatorAllConsumerKeys returns the consumer keys assigned by the given validator on all the consumer chains
+func (k Keeper) validatorAllConsumerKeys(ctx sdk.Context, consAddr sdk.ConsAddress) ([]types.AssignedConsumerKey, error) {
+	consumerKeys := []types.AssignedConsumerKey{}
+	for _, assignment := range k.GetAllValidatorConsumerPubKeys(ctx, nil) {
+		if !bytes.Equal(assignment.ProviderAddr, consAddr) {
+			continue
+		}
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*assignment.ConsumerKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "getting consumer address on consumer chain %s: %s", assignment.ChainId, err)
+		}
+		consumerKeys = append(consumerKeys, types.AssignedConsumerKey{
+			ConsumerId:      assignment.ChainId,
+			ConsumerAddress: consumerAddr.String(),
+			ConsumerKey:     assignment.ConsumerKey,
+		})
+	}
+	return consumerKeys, nil
+}
+
+// QueryMaxProviderConsensusValidators returns the MaxProviderConsensusValidators param
+// and the number of validators in the last provider consensus validator set
+func (k Keeper) QueryMaxProviderConsensusValidators(goCtx context.Context, req *types.QueryMaxProviderConsensusValidatorsRequest) (*types.QueryMaxProviderConsensusValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	activeValidators, err := k.GetLastProviderConsensusValSet(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last provider consensus validator set: %s", err)
+	}
+
+	return &types.QueryMaxProviderConsensusValidatorsResponse{
+		MaxProviderConsensusValidators: k.GetMaxProviderConsensusValidators(ctx),
+		ActiveValidators:               int64(len(activeValidators)),
+	}, nil
+}
+
+// QueryConsumersByOwner returns the consumer chains owned by the given address, together with their phases
+func (k Keeper) QueryConsumersByOwner(goCtx context.Context, req *types.QueryConsumersByOwnerRequest) (*types.QueryConsumersByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.OwnerAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumers := []types.OwnedConsumer{}
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		ownerAddress, err := k.GetConsumerOwnerAddress(ctx, consumerId)
+		if err != nil || ownerAddress != req.OwnerAddress {
+			continue
+		}
+		consumers = append(consumers, types.OwnedConsumer{
+			ConsumerId: consumerId,
+			Phase:      k.GetConsumerPhase(ctx, consumerId),
+		})
+	}
+
+	return &types.QueryConsumersByOwnerResponse{Consumers: consumers}, nil
+}
+
+// QueryConsumerChainIdAvailable returns whether the given chain id is not used by any active consumer chain,
+// i.e., any registered, initialized, or launched consumer chain
+func (k Keeper) QueryConsumerChainIdAvailable(goCtx context.Context, req *types.QueryConsumerChainIdAvailableRequest) (*types.QueryConsumerChainIdAvailableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateChainId("ChainId", req.ChainId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			continue
+		}
+		if chainId == req.ChainId {
+			return &types.QueryConsumerChainIdAvailableResponse{
+				Available:             false,
+				ConflictingConsumerId: consumerId,
+			}, nil
+		}
+	}
+
+	return &types.QueryConsumerChainIdAvailableResponse{Available: true}, nil
+}
+
+// QueryNextConsumerId returns the consumer id that is assigned to the next created consumer chain
+func (k Keeper) QueryNextConsumerId(goCtx context.Context, req *types.QueryNextConsumerIdRequest) (*types.QueryNextConsumerIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the stored consumer id is the first one that can be used, see FetchAndIncrementConsumerId
+	consumerId, _ := k.GetConsumerId(ctx)
+
+	return &types.QueryNextConsumerIdResponse{ConsumerId: strconv.FormatUint(consumerId, 10)}, nil
+}
+
+// QueryConsumerVSCLatency returns the average and maximum round-trip latency
+// of the most recently acknowledged VSC packets of the given consumer chain
+func (k Keeper) QueryConsumerVSCLatency(goCtx context.Context, req *types.QueryConsumerVSCLatencyRequest) (*types.QueryConsumerVSCLatencyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	numVSCPackets, average, maximum := k.GetVSCLatencyStats(ctx, consumerId)
+	return &types.QueryConsumerVSCLatencyResponse{
+		NumVscPackets:  numVSCPackets,
+		AverageLatency: average,
+		MaxLatency:     maximum,
+	}, nil
+}
+
+// QueryConsumersByClientId returns the ids of all the consumer chains whose IBC client is the given client
+func (k Keeper) QueryConsumersByClientId(goCtx context.Context, req *types.QueryConsumersByClientIdRequest) (*types.QueryConsumersByClientIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ClientId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty client id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// scan all the consumer chains instead of using the client id to consumer id index,
+	// as the index only keeps track of the last consumer chain set for a given client
+	consumerIds := []string{}
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		clientId, found := k.GetConsumerClientId(ctx, consumerId)
+		if found && clientId == req.ClientId {
+			consumerIds = append(consumerIds, consumerId)
+		}
+	}
+
+	return &types.QueryConsumersByClientIdResponse{ConsumerIds: consumerIds}, nil
+}
+
+// QueryPruningInvariant checks, on the current state, that every consumer address of the given consumer chain
+// is either currently assigned or scheduled for pruning, and returns the consumer addresses violating it
+func (k Keeper) QueryPruningInvariant(goCtx context.Context, req *types.QueryPruningInvariantRequest) (*types.QueryPruningInvariantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer chain: %s", consumerId)
+	}
+
+	holds, violations := k.CheckPruningInvariant(ctx, consumerId)
+	return &types.QueryPruningInvariantResponse{
+		Holds:                  holds,
+		ViolatingConsumerAddrs: violations,
+	}, nil
+}
+
+// QueryValidatorsConsumerObligations returns, for each of the given validators, the consumer chains
+// it has to validate and the consumer keys it assigned
+func (k Keeper) QueryValidatorsConsumerObligations(goCtx context.Context, req *types.QueryValidatorsConsumerObligationsRequest) (*types.QueryValidatorsConsumerObligationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.ProviderAddrs) > types.MaxProviderAddrsPerConsumerObligationsQuery {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot query more than %d validators at once, got %d",
+			types.MaxProviderAddrsPerConsumerObligationsQuery, len(req.ProviderAddrs))
+	}
+
+	consAddrs := make([]sdk.ConsAddress, len(req.ProviderAddrs))
+	seen := map[string]bool{}
+	for i, providerAddr := range req.ProviderAddrs {
+		consAddr, err := sdk.ConsAddressFromBech32(providerAddr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid provider address: %s", providerAddr)
+		}
+		if seen[consAddr.String()] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate provider address: %s", providerAddr)
+		}
+		seen[consAddr.String()] = true
+		consAddrs[i] = consAddr
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	obligations := []types.ValidatorConsumerObligations{}
+	for i, consAddr := range consAddrs {
+		consumerKeys, err := k.validatorAllConsumerKeys(ctx, consAddr)
+		if err != nil {
+			return nil, err
+		}
+		obligations = append(obligations, types.ValidatorConsumerObligations{
+			ProviderAddress: req.ProviderAddrs[i],
+			ConsumerIds:     k.consumerChainsValidatorHasToValidate(ctx, types.NewProviderConsAddress(consAddr)),
+			ConsumerKeys:    consumerKeys,
+		})
+	}
+
+	return &types.QueryValidatorsConsumerObligationsResponse{Obligations: obligations}, nil
+}
+
+// QueryAllDefaultKeyUsers returns, for every launched consumer chain, the validators in the consumer
+// validator set that use their provider key on the consumer chain
+func (k Keeper) QueryAllDefaultKeyUsers(goCtx context.Context, req *types.QueryAllDefaultKeyUsersRequest) (*types.QueryAllDefaultKeyUsersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumers, err := k.GetAllDefaultKeyUsers(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDefaultKeyUsersResponse{Consumers: consumers}, nil
+}
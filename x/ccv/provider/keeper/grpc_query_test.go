@@ -2,13 +2,18 @@ package keeper_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/golang/mock/gomock"
@@ -295,6 +300,71 @@ func TestQueryConsumerValidators(t *testing.T) {
 	require.Equal(t, val1.Commission.Rate, res.Validators[0].ConsumerCommissionRate)
 }
 
+func TestQueryConsumerValidatorSets(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	// consumer "0" is validated by validators 1 and 2, and consumer "1" by validator 3
+	valSets := map[string][]types.ConsensusValidator{}
+	for i, consumerId := range []string{"0", "0", "1"} {
+		val := createStakingValidator(ctx, mocks, int64(i+1), i+1)
+		val.Tokens = sdk.TokensFromConsensusPower(int64(i+1), sdk.DefaultPowerReduction)
+		consAddr, _ := val.GetConsAddr()
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consAddr).Return(val, nil).AnyTimes()
+		consumerKey, _ := val.CmtConsPublicKey()
+		valSets[consumerId] = append(valSets[consumerId], types.ConsensusValidator{
+			ProviderConsAddr: consAddr,
+			Power:            int64(i + 1),
+			PublicKey:        &consumerKey,
+		})
+	}
+	for consumerId, valSet := range valSets {
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerValSet(ctx, consumerId, valSet)
+		require.NoError(t, err)
+	}
+
+	// the validator sets are returned in the order of the requested consumer ids
+	res, err := pk.QueryConsumerValidatorSets(ctx, &types.QueryConsumerValidatorSetsRequest{
+		ConsumerIds: []string{"1", "0"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.ValidatorSets, 2)
+	for i, consumerId := range []string{"1", "0"} {
+		single, err := pk.QueryConsumerValidators(ctx, &types.QueryConsumerValidatorsRequest{ConsumerId: consumerId})
+		require.NoError(t, err)
+		require.Len(t, single.Validators, len(valSets[consumerId]))
+		require.Equal(t, types.ConsumerValidatorSet{
+			ConsumerId: consumerId,
+			Validators: single.Validators,
+		}, res.ValidatorSets[i])
+	}
+
+	// duplicate consumer ids
+	_, err = pk.QueryConsumerValidatorSets(ctx, &types.QueryConsumerValidatorSetsRequest{
+		ConsumerIds: []string{"0", "0"},
+	})
+	require.Error(t, err)
+
+	// unknown consumer chain
+	_, err = pk.QueryConsumerValidatorSets(ctx, &types.QueryConsumerValidatorSetsRequest{
+		ConsumerIds: []string{"0", "2"},
+	})
+	require.Error(t, err)
+
+	// too many consumer ids
+	consumerIds := []string{}
+	for i := 0; i <= types.MaxConsumerIdsPerValidatorSetsQuery; i++ {
+		consumerIds = append(consumerIds, strconv.Itoa(i))
+	}
+	_, err = pk.QueryConsumerValidatorSets(ctx, &types.QueryConsumerValidatorSetsRequest{
+		ConsumerIds: consumerIds,
+	})
+	require.Error(t, err)
+}
+
 func TestQueryConsumerChainsValidatorHasToValidate(t *testing.T) {
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -370,6 +440,278 @@ func TestQueryConsumerChainsValidatorHasToValidate(t *testing.T) {
 	require.Equal(t, expectedChains, res.ConsumerIds)
 }
 
+// TestQueryValidatorsConsumerObligations tests that the batched query returns,
+// for every validator, the same obligations as the individual queries
+func TestQueryValidatorsConsumerObligations(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	pk.SetParams(ctx, types.DefaultParams())
+
+	validators := []stakingtypes.Validator{
+		createStakingValidator(ctx, mocks, 2, 1),
+		createStakingValidator(ctx, mocks, 1, 2),
+	}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 2, validators, -1)
+	providerAddrs := []string{}
+	for _, val := range validators {
+		valConsAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, sdk.ConsAddress(valConsAddr)).Return(val, nil).AnyTimes()
+		providerAddrs = append(providerAddrs, sdk.ConsAddress(valConsAddr).String())
+	}
+
+	for _, consumerId := range []string{"0", "1"} {
+		pk.SetConsumerClientId(ctx, consumerId, "client-"+consumerId)
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+		require.NoError(t, err)
+	}
+
+	// the first validator validates consumer "0" and assigned a consumer key on consumer "1",
+	// while the second validator validates consumer "1"
+	for i, consumerId := range []string{"0", "1"} {
+		consAddr, err := sdk.ConsAddressFromBech32(providerAddrs[i])
+		require.NoError(t, err)
+		err = pk.SetConsumerValidator(ctx, consumerId, types.ConsensusValidator{
+			ProviderConsAddr: consAddr,
+			Power:            1,
+			PublicKey:        &crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte{1}}},
+		})
+		require.NoError(t, err)
+	}
+	consAddrA, err := sdk.ConsAddressFromBech32(providerAddrs[0])
+	require.NoError(t, err)
+	pk.SetValidatorConsumerPubKey(ctx, "1", types.NewProviderConsAddress(consAddrA),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(3).TMProtoCryptoPublicKey())
+
+	res, err := pk.QueryValidatorsConsumerObligations(ctx, &types.QueryValidatorsConsumerObligationsRequest{ProviderAddrs: providerAddrs})
+	require.NoError(t, err)
+	require.Len(t, res.Obligations, 2)
+	for i, providerAddr := range providerAddrs {
+		hasToValidate, err := pk.QueryConsumerChainsValidatorHasToValidate(ctx,
+			&types.QueryConsumerChainsValidatorHasToValidateRequest{ProviderAddress: providerAddr})
+		require.NoError(t, err)
+		consumerKeys, err := pk.QueryValidatorAllConsumerKeys(ctx,
+			&types.QueryValidatorAllConsumerKeysRequest{ProviderAddress: providerAddr})
+		require.NoError(t, err)
+
+		require.Equal(t, providerAddr, res.Obligations[i].ProviderAddress)
+		require.Equal(t, hasToValidate.ConsumerIds, res.Obligations[i].ConsumerIds)
+		require.Equal(t, consumerKeys.ConsumerKeys, res.Obligations[i].ConsumerKeys)
+	}
+	require.Equal(t, []string{"0"}, res.Obligations[0].ConsumerIds)
+	require.Len(t, res.Obligations[0].ConsumerKeys, 1)
+	require.Equal(t, []string{"1"}, res.Obligations[1].ConsumerIds)
+	require.Empty(t, res.Obligations[1].ConsumerKeys)
+
+	// invalid requests
+	_, err = pk.QueryValidatorsConsumerObligations(ctx, nil)
+	require.Error(t, err)
+	_, err = pk.QueryValidatorsConsumerObligations(ctx, &types.QueryValidatorsConsumerObligationsRequest{ProviderAddrs: []string{"invalid"}})
+	require.Error(t, err)
+	_, err = pk.QueryValidatorsConsumerObligations(ctx, &types.QueryValidatorsConsumerObligationsRequest{
+		ProviderAddrs: []string{providerAddrs[0], providerAddrs[0]},
+	})
+	require.Error(t, err)
+	tooMany := make([]string, types.MaxProviderAddrsPerConsumerObligationsQuery+1)
+	for i := range tooMany {
+		tooMany[i] = cryptotestutil.NewCryptoIdentityFromIntSeed(10 + i).SDKValConsAddress().String()
+	}
+	_, err = pk.QueryValidatorsConsumerObligations(ctx, &types.QueryValidatorsConsumerObligationsRequest{ProviderAddrs: tooMany})
+	require.Error(t, err)
+}
+
+// TestQueryAllDefaultKeyUsers tests that, for every launched consumer chain, the validators
+// in the consumer validator set without an assigned consumer key are returned
+func TestQueryAllDefaultKeyUsers(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	identities := []*cryptotestutil.CryptoIdentity{}
+	providerAddrs := []types.ProviderConsAddress{}
+	for i := 0; i < 3; i++ {
+		identity := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+		identities = append(identities, identity)
+		providerAddrs = append(providerAddrs, identity.ProviderConsAddress())
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), identity.SDKValConsAddress()).
+			Return(identity.SDKStakingValidator(), nil).AnyTimes()
+	}
+	consumerKey := func(i int) crypto.PublicKey {
+		return cryptotestutil.NewCryptoIdentityFromIntSeed(10 + i).TMProtoCryptoPublicKey()
+	}
+
+	// consumer "0" is validated by all the validators and only the first one uses a consumer key,
+	// consumer "1" is validated by the first two validators and both use a consumer key,
+	// while consumer "2" is not launched yet
+	consumerValSets := map[string][]int{
+		"0": {0, 1, 2},
+		"1": {0, 1},
+	}
+	assignments := map[string][]int{
+		"0": {0},
+		"1": {0, 1},
+		"2": {0, 1, 2},
+	}
+	for _, consumerId := range []string{"0", "1", "2"} {
+		require.Equal(t, consumerId, pk.FetchAndIncrementConsumerId(ctx))
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		for _, i := range assignments[consumerId] {
+			pk.SetValidatorConsumerPubKey(ctx, consumerId, providerAddrs[i], consumerKey(i))
+		}
+		for _, i := range consumerValSets[consumerId] {
+			publicKey := identities[i].TMProtoCryptoPublicKey()
+			if key, found := pk.GetValidatorConsumerPubKey(ctx, consumerId, providerAddrs[i]); found {
+				publicKey = key
+			}
+			err := pk.SetConsumerValidator(ctx, consumerId, types.ConsensusValidator{
+				ProviderConsAddr: providerAddrs[i].ToSdkConsAddr(),
+				Power:            1,
+				PublicKey:        &publicKey,
+			})
+			require.NoError(t, err)
+		}
+	}
+	pk.SetConsumerPhase(ctx, "2", types.CONSUMER_PHASE_INITIALIZED)
+
+	res, err := pk.QueryAllDefaultKeyUsers(ctx, &types.QueryAllDefaultKeyUsersRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Consumers, 2)
+	require.Equal(t, "0", res.Consumers[0].ConsumerId)
+	require.ElementsMatch(t, []string{providerAddrs[1].String(), providerAddrs[2].String()}, res.Consumers[0].ProviderAddresses)
+	require.Equal(t, "1", res.Consumers[1].ConsumerId)
+	require.Empty(t, res.Consumers[1].ProviderAddresses)
+
+	// once the first validator removes its assignment on consumer "1", it still uses its consumer key
+	// until the consumer validator set is updated at the end of the epoch
+	pk.DeleteValidatorConsumerPubKey(ctx, "1", providerAddrs[0])
+	res, err = pk.QueryAllDefaultKeyUsers(ctx, &types.QueryAllDefaultKeyUsersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Consumers[1].ProviderAddresses)
+
+	providerKey := identities[0].TMProtoCryptoPublicKey()
+	err = pk.SetConsumerValidator(ctx, "1", types.ConsensusValidator{
+		ProviderConsAddr: providerAddrs[0].ToSdkConsAddr(),
+		Power:            1,
+		PublicKey:        &providerKey,
+	})
+	require.NoError(t, err)
+	res, err = pk.QueryAllDefaultKeyUsers(ctx, &types.QueryAllDefaultKeyUsersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{providerAddrs[0].String()}, res.Consumers[1].ProviderAddresses)
+
+	_, err = pk.QueryAllDefaultKeyUsers(ctx, nil)
+	require.Error(t, err)
+}
+
+// TestQueryValidatorTopNObligations tests that a validator is only bound by
+// the launched Top N consumer chains whose threshold it reaches
+func TestQueryValidatorTopNObligations(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	pk.SetParams(ctx, types.DefaultParams())
+
+	// the validators hold 40%, 30%, 20% and 10% of the total power, respectively,
+	// i.e., the Top 50 threshold is 3000 and the Top 100 threshold is 1000
+	validators := []stakingtypes.Validator{
+		createStakingValidator(ctx, mocks, 4000, 1),
+		createStakingValidator(ctx, mocks, 3000, 2),
+		createStakingValidator(ctx, mocks, 2000, 3),
+		createStakingValidator(ctx, mocks, 1000, 4),
+	}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 4, validators, -1)
+	val := validators[2]
+	valConsAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, sdk.ConsAddress(valConsAddr)).Return(val, nil).AnyTimes()
+
+	// consumer "0" is a launched Top 50 chain, consumer "1" is a launched Top 100 chain,
+	// consumer "2" is a launched opt-in chain, and consumer "3" is a Top 100 chain that is not launched
+	for consumerId, topN := range map[string]uint32{"0": 50, "1": 100, "2": 0, "3": 100} {
+		pk.SetConsumerClientId(ctx, consumerId, "client-"+consumerId)
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{Top_N: topN})
+		require.NoError(t, err)
+	}
+	pk.SetConsumerPhase(ctx, "3", types.CONSUMER_PHASE_INITIALIZED)
+
+	// the validator is bound by the Top 100 chain, but not by the Top 50 chain with a higher threshold
+	res, err := pk.QueryValidatorTopNObligations(ctx, &types.QueryValidatorTopNObligationsRequest{
+		ProviderAddress: sdk.ConsAddress(valConsAddr).String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryValidatorTopNObligationsResponse{
+		Power: 2000,
+		Obligations: []types.TopNObligation{
+			{ConsumerId: "1", Top_N: 100, MinPowerInTop_N: 1000},
+		},
+	}, res)
+
+	_, err = pk.QueryValidatorTopNObligations(ctx, &types.QueryValidatorTopNObligationsRequest{ProviderAddress: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryValidatorTopNObligations(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryConsumerDistribution(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	pk.SetConsumerChainId(ctx, consumerId, "chain1")
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.DistributionTransmissionChannel = "channel-1"
+	err := pk.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+	require.NoError(t, err)
+	pk.SetConsumerClientId(ctx, consumerId, "clientID")
+
+	// both provider transfer channels have "channel-1" as counterparty,
+	// but only "channel-5" is over a connection to the consumer client
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID).Return(
+			[]channeltypes.IdentifiedChannel{
+				{
+					State:          channeltypes.OPEN,
+					Counterparty:   channeltypes.NewCounterparty(transfertypes.PortID, "channel-1"),
+					ConnectionHops: []string{"connection-1"},
+					PortId:         transfertypes.PortID,
+					ChannelId:      "channel-4",
+				},
+				{
+					State:          channeltypes.OPEN,
+					Counterparty:   channeltypes.NewCounterparty(transfertypes.PortID, "channel-1"),
+					ConnectionHops: []string{"connection-0"},
+					PortId:         transfertypes.PortID,
+					ChannelId:      "channel-5",
+				},
+			},
+		),
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connection-1").Return(
+			conntypes.ConnectionEnd{ClientId: "otherClientID"}, true,
+		),
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connection-0").Return(
+			conntypes.ConnectionEnd{ClientId: "clientID"}, true,
+		),
+	)
+	mocks.MockIBCTransferKeeper.EXPECT().GetParams(ctx).Return(transfertypes.Params{
+		SendEnabled:    true,
+		ReceiveEnabled: false,
+	})
+
+	res, err := pk.QueryConsumerDistribution(ctx, &types.QueryConsumerDistributionRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerDistributionResponse{
+		DistributionChannelId: "channel-1",
+		ProviderChannelId:     "channel-5",
+		Open:                  true,
+		SendEnabled:           true,
+		ReceiveEnabled:        false,
+	}, res)
+
+	// unknown consumer chain
+	_, err = pk.QueryConsumerDistribution(ctx, &types.QueryConsumerDistributionRequest{ConsumerId: "1"})
+	require.Error(t, err)
+}
+
 func TestQueryValidatorConsumerCommissionRate(t *testing.T) {
 	consumerId := "0"
 
@@ -639,6 +981,39 @@ func TestQueryConsumerChain(t *testing.T) {
 	require.Equal(t, &express, res)
 }
 
+// TestQueryConsumerChainAfterCreateConsumer tests that QueryConsumerChain returns, in a single
+// response, the phase, metadata and initialization parameters of a consumer chain as set by MsgCreateConsumer
+func TestQueryConsumerChainAfterCreateConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21*24*time.Hour, nil).AnyTimes()
+
+	metadata := testkeeper.GetTestConsumerMetadata()
+	initParams := testkeeper.GetTestInitializationParameters()
+	initParams.SpawnTime = ctx.BlockTime().Add(time.Hour)
+	initParams.DistributionTransmissionChannel = "channel-12"
+
+	msgServer := keeper.NewMsgServerImpl(&providerKeeper)
+	createRes, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+		Submitter:                "submitter",
+		ChainId:                  "consumer",
+		Metadata:                 metadata,
+		InitializationParameters: &initParams,
+	})
+	require.NoError(t, err)
+
+	res, err := providerKeeper.QueryConsumerChain(ctx, &types.QueryConsumerChainRequest{ConsumerId: createRes.ConsumerId})
+	require.NoError(t, err)
+	require.Equal(t, createRes.ConsumerId, res.ConsumerId)
+	require.Equal(t, "consumer", res.ChainId)
+	require.Equal(t, types.CONSUMER_PHASE_INITIALIZED.String(), res.Phase)
+	require.Equal(t, metadata, res.Metadata)
+	require.Equal(t, &initParams, res.InitParams)
+}
+
 func TestQueryConsumerIdFromClientId(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -931,3 +1306,832 @@ func TestQueryConsumerGenesisTime(t *testing.T) {
 		})
 	}
 }
+
+// TestQuerySimulateConsumerUpdate tests that a dry-run of a TopN change does not update the state
+// and returns the same validator set and updates as a subsequent real apply
+func TestQuerySimulateConsumerUpdate(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// the simulation runs on a cached context, hence the mocks need to accept any context
+	powers := []int64{40, 30, 20, 10}
+	var validators []stakingtypes.Validator
+	for i, power := range powers {
+		cryptoId := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+		val := cryptoId.SDKStakingValidator()
+		val.Tokens = math.NewInt(power)
+		val.Status = stakingtypes.Bonded
+		validators = append(validators, val)
+
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), cryptoId.SDKValOpAddress()).Return(power, nil).AnyTimes()
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), cryptoId.SDKValConsAddress()).Return(val, nil).AnyTimes()
+	}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, uint32(len(validators)), validators, -1)
+
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = int64(len(validators))
+	pk.SetParams(ctx, params)
+
+	// an opt-in consumer chain with only the first validator opted in
+	consumerId := "0"
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+	require.NoError(t, err)
+	firstProviderAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	pk.SetOptedIn(ctx, consumerId, types.NewProviderConsAddress(firstProviderAddr))
+	_, err = pk.ComputeConsumerNextValSet(ctx, validators, validators, consumerId, []types.ConsensusValidator{}, nil)
+	require.NoError(t, err)
+	currentValSet, err := pk.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, currentValSet, 1)
+
+	// invalid requests
+	_, err = pk.QuerySimulateConsumerUpdate(ctx, nil)
+	require.Error(t, err)
+	_, err = pk.QuerySimulateConsumerUpdate(ctx, &types.QuerySimulateConsumerUpdateRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+	_, err = pk.QuerySimulateConsumerUpdate(ctx, &types.QuerySimulateConsumerUpdateRequest{
+		ConsumerId:         "1",
+		PowerShapingParams: &types.PowerShapingParameters{},
+	})
+	require.Error(t, err)
+	_, err = pk.QuerySimulateConsumerUpdate(ctx, &types.QuerySimulateConsumerUpdateRequest{
+		ConsumerId:         consumerId,
+		PowerShapingParams: &types.PowerShapingParameters{Top_N: 10},
+	})
+	require.Error(t, err)
+
+	// dry-run the change to a Top N chain
+	proposedParams := types.PowerShapingParameters{Top_N: 100}
+	res, err := pk.QuerySimulateConsumerUpdate(ctx, &types.QuerySimulateConsumerUpdateRequest{
+		ConsumerId:         consumerId,
+		PowerShapingParams: &proposedParams,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.NextValidators, len(validators))
+	require.Len(t, res.ValidatorUpdates, len(validators)-1)
+
+	// the state is not updated
+	powerShapingParams, err := pk.GetConsumerPowerShapingParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, types.PowerShapingParameters{}, powerShapingParams)
+	_, found := pk.GetMinimumPowerInTopN(ctx, consumerId)
+	require.False(t, found)
+	require.Len(t, pk.GetAllOptedIn(ctx, consumerId), 1)
+	valSet, err := pk.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, currentValSet, valSet)
+
+	// apply the change and compare with the dry-run
+	err = pk.SetConsumerPowerShapingParameters(ctx, consumerId, proposedParams)
+	require.NoError(t, err)
+	err = pk.UpdateMinimumPowerInTopN(ctx, consumerId, 0, proposedParams.Top_N)
+	require.NoError(t, err)
+	valUpdates, err := pk.ComputeConsumerNextValSet(ctx, validators, validators, consumerId, currentValSet, nil)
+	require.NoError(t, err)
+	nextValSet, err := pk.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, nextValSet, res.NextValidators)
+	require.ElementsMatch(t, valUpdates, res.ValidatorUpdates)
+}
+
+// TestQueryConsumerGenesis tests that the genesis of a consumer chain can be queried
+// once the consumer chain is initialized
+func TestQueryConsumerGenesis(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// the genesis of an initialized chain is computed on a cached context,
+	// hence the mocks need to accept any context
+	cryptoId := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	val := cryptoId.SDKStakingValidator()
+	val.Tokens = math.NewInt(100)
+	val.Status = stakingtypes.Bonded
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{val}, -1)
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), cryptoId.SDKValOpAddress()).Return(int64(100), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21*24*time.Hour, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), ctx.BlockHeight()).AnyTimes()
+
+	params := types.DefaultParams()
+	params.MaxProviderConsensusValidators = 1
+	pk.SetParams(ctx, params)
+
+	// unknown consumer chain
+	consumerId := "0"
+	req := &types.QueryConsumerGenesisRequest{ConsumerId: consumerId}
+	_, err := pk.QueryConsumerGenesis(ctx, req)
+	require.Error(t, err)
+
+	// create a consumer chain with an opted-in validator
+	pk.SetConsumerChainId(ctx, consumerId, "consumer")
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+	err = pk.SetConsumerPowerShapingParameters(ctx, consumerId, testkeeper.GetTestPowerShapingParameters())
+	require.NoError(t, err)
+	pk.SetOptedIn(ctx, consumerId, cryptoId.ProviderConsAddress())
+
+	// the genesis of a registered consumer chain cannot be queried
+	_, err = pk.QueryConsumerGenesis(ctx, req)
+	require.ErrorContains(t, err, "only available for initialized or launched consumer chains")
+
+	// initialize the consumer chain
+	err = pk.SetConsumerInitializationParameters(ctx, consumerId, testkeeper.GetTestInitializationParameters())
+	require.NoError(t, err)
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_INITIALIZED)
+
+	res, err := pk.QueryConsumerGenesis(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.GenesisState.NewChain)
+	require.Equal(t, consumerId, res.GenesisState.Params.ConsumerId)
+	require.Len(t, res.GenesisState.Provider.InitialValSet, 1)
+	require.Equal(t, int64(100), res.GenesisState.Provider.InitialValSet[0].Power)
+	require.Equal(t, cryptoId.TMProtoCryptoPublicKey(), res.GenesisState.Provider.InitialValSet[0].PubKey)
+
+	// the state is not updated by the query
+	_, found := pk.GetConsumerGenesis(ctx, consumerId)
+	require.False(t, found)
+	valSet, err := pk.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, valSet)
+
+	// the stored genesis of a launched consumer chain is returned
+	err = pk.SetConsumerGenesis(ctx, consumerId, res.GenesisState)
+	require.NoError(t, err)
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	launchedRes, err := pk.QueryConsumerGenesis(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, res.GenesisState.Provider.InitialValSet, launchedRes.GenesisState.Provider.InitialValSet)
+	require.Equal(t, res.GenesisState.Provider.ClientState, launchedRes.GenesisState.Provider.ClientState)
+}
+
+func TestQueryConsumerValidatorSetHash(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	req := &types.QueryConsumerValidatorSetHashRequest{ConsumerId: CONSUMER_ID}
+
+	// the consumer chain is not launched
+	pk.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_INITIALIZED)
+	_, err := pk.QueryConsumerValidatorSetHash(ctx, req)
+	require.Error(t, err)
+
+	pk.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+	valA, _ := createConsumerValidator(1, 1, 1)
+	valA.ProviderConsAddr = cryptotestutil.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress()
+	valB, _ := createConsumerValidator(2, 2, 2)
+	valB.ProviderConsAddr = cryptotestutil.NewCryptoIdentityFromIntSeed(2).SDKValConsAddress()
+	err = pk.SetConsumerValSet(ctx, CONSUMER_ID, []types.ConsensusValidator{valA, valB})
+	require.NoError(t, err)
+
+	res, err := pk.QueryConsumerValidatorSetHash(ctx, req)
+	require.NoError(t, err)
+	expectedHash, err := keeper.ConsumerValSetHash([]types.ConsensusValidator{valA, valB})
+	require.NoError(t, err)
+	require.Equal(t, strings.ToUpper(hex.EncodeToString(expectedHash)), res.ValsetHash)
+	require.Equal(t, uint64(2), res.ValsetSize)
+}
+
+func TestQueryThrottleState(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryThrottleState(ctx, nil)
+	require.Error(t, err)
+
+	params := types.DefaultParams()
+	params.SlashMeterReplenishPeriod = time.Hour
+	params.SlashMeterReplenishFraction = "0.05"
+	pk.SetParams(ctx, params)
+
+	// the total voting power of the provider validators is 1000
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	pk.InitializeSlashMeter(ctx)
+	pk.SetSlashMeter(ctx, math.NewInt(-20))
+
+	res, err := pk.QueryThrottleState(ctx, &types.QueryThrottleStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pk.GetSlashMeter(ctx).Int64(), res.SlashMeter)
+	require.Equal(t, int64(-20), res.SlashMeter)
+	require.Equal(t, pk.GetSlashMeterAllowance(ctx).Int64(), res.SlashMeterAllowance)
+	require.Equal(t, int64(50), res.SlashMeterAllowance)
+	require.Equal(t, pk.GetSlashMeterReplenishFraction(ctx), res.SlashMeterReplenishFraction)
+	require.Equal(t, pk.GetSlashMeterReplenishPeriod(ctx), res.SlashMeterReplenishPeriod)
+	require.Equal(t, pk.GetSlashMeterReplenishTimeCandidate(ctx), res.NextReplenishCandidate)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), res.NextReplenishCandidate)
+	require.Zero(t, res.DowntimeSlashPackets)
+	require.Zero(t, res.DoubleSignSlashPackets)
+
+	// the slash packets applied per infraction type are counted separately
+	pk.IncrementInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	pk.IncrementInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	pk.IncrementInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	res, err = pk.QueryThrottleState(ctx, &types.QueryThrottleStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.DowntimeSlashPackets)
+	require.Equal(t, uint64(2), res.DoubleSignSlashPackets)
+}
+
+func TestQueryValsetUpdateIdToHeight(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryValsetUpdateIdToHeight(ctx, nil)
+	require.Error(t, err)
+	_, err = pk.QueryRecentValsetUpdateIds(ctx, nil)
+	require.Error(t, err)
+
+	// record the block heights of the last 3 valset update ids
+	params := types.DefaultParams()
+	params.MaxValsetUpdateBlockHeights = 3
+	pk.SetParams(ctx, params)
+
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+
+	// increment the valset update id across several blocks
+	for height := int64(10); height < 15; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		pk.IncrementValidatorSetUpdateId(ctx)
+		pk.EndBlockCIS(ctx)
+	}
+	require.Equal(t, uint64(5), pk.GetValidatorSetUpdateId(ctx))
+
+	// the vscIDs are mapped to the height of the block following the increment
+	for vscId, expectedHeight := range map[uint64]uint64{3: 13, 4: 14, 5: 15} {
+		res, err := pk.QueryValsetUpdateIdToHeight(ctx, &types.QueryValsetUpdateIdToHeightRequest{VscId: vscId})
+		require.NoError(t, err)
+		require.Equal(t, expectedHeight, res.Height)
+	}
+
+	// the block heights of the older vscIDs are still mapped, as they are needed to handle slash packets
+	for vscId, expectedHeight := range map[uint64]uint64{1: 11, 2: 12} {
+		res, err := pk.QueryValsetUpdateIdToHeight(ctx, &types.QueryValsetUpdateIdToHeightRequest{VscId: vscId})
+		require.NoError(t, err)
+		require.Equal(t, expectedHeight, res.Height)
+	}
+	_, err = pk.QueryValsetUpdateIdToHeight(ctx, &types.QueryValsetUpdateIdToHeightRequest{VscId: 6})
+	require.Error(t, err)
+
+	// only the block heights of the recent vscIDs are recorded
+
+	res, err := pk.QueryRecentValsetUpdateIds(ctx, &types.QueryRecentValsetUpdateIdsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValsetUpdateIdToHeight{
+		{ValsetUpdateId: 3, Height: 13},
+		{ValsetUpdateId: 4, Height: 14},
+		{ValsetUpdateId: 5, Height: 15},
+	}, res.ValsetUpdateIdToHeight)
+
+	// disabling the recording deletes the recorded block heights,
+	// while the block heights of all the vscIDs are still mapped
+	params.MaxValsetUpdateBlockHeights = 0
+	pk.SetParams(ctx, params)
+	for height := int64(15); height < 20; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		pk.IncrementValidatorSetUpdateId(ctx)
+		pk.EndBlockCIS(ctx)
+	}
+	res, err = pk.QueryRecentValsetUpdateIds(ctx, &types.QueryRecentValsetUpdateIdsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.ValsetUpdateIdToHeight)
+	require.Len(t, pk.GetAllValsetUpdateBlockHeights(ctx), 10)
+}
+
+func TestQueryValidatorsUsingDefaultKey(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	req := &types.QueryValidatorsUsingDefaultKeyRequest{ConsumerId: CONSUMER_ID}
+
+	// the consumer chain is not launched
+	pk.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_INITIALIZED)
+	_, err := pk.QueryValidatorsUsingDefaultKey(ctx, req)
+	require.Error(t, err)
+
+	pk.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+	var consumerValSet []types.ConsensusValidator
+	for i := 0; i < 4; i++ {
+		val, _ := createConsumerValidator(i, int64(i+1), i)
+		val.ProviderConsAddr = cryptotestutil.NewCryptoIdentityFromIntSeed(i).SDKValConsAddress()
+		consumerValSet = append(consumerValSet, val)
+	}
+	err = pk.SetConsumerValSet(ctx, CONSUMER_ID, consumerValSet)
+	require.NoError(t, err)
+
+	// the validators 1 and 3 assigned consumer keys
+	for _, i := range []int{1, 3} {
+		providerAddr := types.NewProviderConsAddress(consumerValSet[i].ProviderConsAddr)
+		pk.SetValidatorConsumerPubKey(ctx, CONSUMER_ID, providerAddr,
+			cryptotestutil.NewCryptoIdentityFromIntSeed(100+i).TMProtoCryptoPublicKey())
+	}
+	// a consumer key assigned on a different consumer chain is not relevant
+	pk.SetValidatorConsumerPubKey(ctx, "1", types.NewProviderConsAddress(consumerValSet[0].ProviderConsAddr),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(100).TMProtoCryptoPublicKey())
+
+	res, err := pk.QueryValidatorsUsingDefaultKey(ctx, req)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		sdk.ConsAddress(consumerValSet[0].ProviderConsAddr).String(),
+		sdk.ConsAddress(consumerValSet[2].ProviderConsAddr).String(),
+	}, res.ValidatorsProviderAddresses)
+}
+
+func TestQueryConsumerAddrsToPrune(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	req := &types.QueryConsumerAddrsToPruneRequest{ConsumerId: CONSUMER_ID}
+
+	_, err := pk.QueryConsumerAddrsToPrune(ctx, nil)
+	require.Error(t, err)
+
+	// the consumer chain does not exist
+	_, err = pk.QueryConsumerAddrsToPrune(ctx, req)
+	require.Error(t, err)
+
+	pk.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+	res, err := pk.QueryConsumerAddrsToPrune(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, res.ConsumerAddrsToPrune)
+
+	ts1 := ctx.BlockTime()
+	ts2 := ts1.Add(time.Hour)
+	consumerAddr1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+	consumerAddr2 := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress()
+	consumerAddr3 := cryptotestutil.NewCryptoIdentityFromIntSeed(3).ConsumerConsAddress()
+	pk.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, ts2, consumerAddr3)
+	pk.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, ts1, consumerAddr1)
+	pk.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, ts1, consumerAddr2)
+	// an address to prune on a different consumer chain is not relevant
+	pk.AppendConsumerAddrsToPrune(ctx, "1", ts1, consumerAddr3)
+
+	res, err = pk.QueryConsumerAddrsToPrune(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []types.ConsumerAddrsToPruneV2{
+		{
+			ChainId:       CONSUMER_ID,
+			PruneTs:       ts1,
+			ConsumerAddrs: &types.AddressList{Addresses: [][]byte{consumerAddr1.ToSdkConsAddr(), consumerAddr2.ToSdkConsAddr()}},
+		},
+		{
+			ChainId:       CONSUMER_ID,
+			PruneTs:       ts2,
+			ConsumerAddrs: &types.AddressList{Addresses: [][]byte{consumerAddr3.ToSdkConsAddr()}},
+		},
+	}, res.ConsumerAddrsToPrune)
+}
+
+func TestQueryEffectiveConsumerKey(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	valAProviderKey, _ := valA.CmtConsPublicKey()
+	valB := createStakingValidator(ctx, mocks, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valAConsAddr).Return(valA, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valBConsAddr).Return(valB, nil).AnyTimes()
+
+	req := &types.QueryEffectiveConsumerKeyRequest{
+		ConsumerId:      CONSUMER_ID,
+		ProviderAddress: sdk.ConsAddress(valAConsAddr).String(),
+	}
+
+	// the consumer chain does not exist
+	_, err := pk.QueryEffectiveConsumerKey(ctx, req)
+	require.Error(t, err)
+
+	pk.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+
+	// validator A did not assign a consumer key and uses its provider key
+	res, err := pk.QueryEffectiveConsumerKey(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.IsDefault)
+	require.Equal(t, &valAProviderKey, res.ConsumerKey)
+
+	// validator B assigned a consumer key
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(100).TMProtoCryptoPublicKey()
+	pk.SetValidatorConsumerPubKey(ctx, CONSUMER_ID, types.NewProviderConsAddress(valBConsAddr), consumerKey)
+	res, err = pk.QueryEffectiveConsumerKey(ctx, &types.QueryEffectiveConsumerKeyRequest{
+		ConsumerId:      CONSUMER_ID,
+		ProviderAddress: sdk.ConsAddress(valBConsAddr).String(),
+	})
+	require.NoError(t, err)
+	require.False(t, res.IsDefault)
+	require.Equal(t, &consumerKey, res.ConsumerKey)
+}
+
+func TestQueryConsumerRemovalETA(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	req := types.QueryConsumerRemovalETARequest{ConsumerId: consumerId}
+
+	// expect an error for an invalid or an unknown consumer id
+	_, err := pk.QueryConsumerRemovalETA(ctx, &types.QueryConsumerRemovalETARequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerRemovalETA(ctx, &req)
+	require.Error(t, err)
+
+	// no removal is scheduled for a launched consumer chain
+	pk.SetConsumerChainId(ctx, consumerId, "chain1")
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	res, err := pk.QueryConsumerRemovalETA(ctx, &req)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerRemovalETAResponse{}, res)
+
+	// schedule the removal of the consumer chain
+	unbondingPeriod := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod, nil).AnyTimes()
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	removalTime := ctx.BlockTime().Add(unbondingPeriod)
+	require.NoError(t, pk.StopAndPrepareForConsumerRemoval(ctx, consumerId))
+
+	res, err = pk.QueryConsumerRemovalETA(ctx, &req)
+	require.NoError(t, err)
+	require.True(t, res.RemovalScheduled)
+	require.Equal(t, removalTime, res.RemovalTime)
+	require.Equal(t, unbondingPeriod, res.TimeRemaining)
+
+	// the remaining time decreases across blocks
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = pk.QueryConsumerRemovalETA(ctx, &req)
+	require.NoError(t, err)
+	require.True(t, res.RemovalScheduled)
+	require.Equal(t, removalTime, res.RemovalTime)
+	require.Equal(t, unbondingPeriod-time.Hour, res.TimeRemaining)
+
+	// the remaining time is zero once the removal time is reached
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(removalTime.Add(time.Hour))
+	res, err = pk.QueryConsumerRemovalETA(ctx, &req)
+	require.NoError(t, err)
+	require.True(t, res.RemovalScheduled)
+	require.Equal(t, time.Duration(0), res.TimeRemaining)
+}
+
+func TestQueryValidatorDenylistedConsumers(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	otherProviderAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+
+	// expect an error for an invalid provider address
+	_, err := pk.QueryValidatorDenylistedConsumers(ctx, &types.QueryValidatorDenylistedConsumersRequest{ProviderAddress: "invalid"})
+	require.Error(t, err)
+
+	// create three consumer chains, the last of which is stopped
+	for _, phase := range []types.ConsumerPhase{
+		types.CONSUMER_PHASE_REGISTERED,
+		types.CONSUMER_PHASE_LAUNCHED,
+		types.CONSUMER_PHASE_STOPPED,
+	} {
+		consumerId := pk.FetchAndIncrementConsumerId(ctx)
+		pk.SetConsumerPhase(ctx, consumerId, phase)
+	}
+
+	req := types.QueryValidatorDenylistedConsumersRequest{ProviderAddress: providerAddr.String()}
+	res, err := pk.QueryValidatorDenylistedConsumers(ctx, &req)
+	require.NoError(t, err)
+	require.Empty(t, res.ConsumerIds)
+
+	// denylist the validator on one consumer chain and another validator on a different one
+	pk.SetDenylist(ctx, "1", providerAddr)
+	pk.SetDenylist(ctx, "0", otherProviderAddr)
+	res, err = pk.QueryValidatorDenylistedConsumers(ctx, &req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, res.ConsumerIds)
+
+	// stopped consumer chains are not returned
+	pk.SetDenylist(ctx, "2", providerAddr)
+	res, err = pk.QueryValidatorDenylistedConsumers(ctx, &req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, res.ConsumerIds)
+}
+
+// TestQueryValidatorHasToValidate tests that the query returns whether a validator
+// has to validate a given consumer chain, along with the reason why
+func TestQueryValidatorHasToValidate(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	pk.SetParams(ctx, types.DefaultParams())
+
+	// the validators hold 40%, 30%, 20% and 10% of the total power, respectively,
+	// i.e., the Top 50 threshold is 3000 and the Top 100 threshold is 1000
+	validators, providerAddrs := createStakingValidatorsAndMocks(ctx, mocks, 4000, 3000, 2000, 1000)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 4, validators, -1)
+	providerAddr := providerAddrs[2]
+
+	// consumer "0" is a launched Top 50 chain, consumer "1" is a launched Top 100 chain,
+	// and consumer "2" is a launched opt-in chain
+	for consumerId, topN := range map[string]uint32{"0": 50, "1": 100, "2": 0} {
+		pk.SetConsumerChainId(ctx, consumerId, "chain-"+consumerId)
+		pk.SetConsumerClientId(ctx, consumerId, "client-"+consumerId)
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{Top_N: topN})
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name             string
+		consumerId       string
+		setup            func()
+		expectedResponse types.QueryValidatorHasToValidateResponse
+	}{
+		{
+			name:             "validator below the Top N threshold does not have to validate",
+			consumerId:       "0",
+			setup:            func() {},
+			expectedResponse: types.QueryValidatorHasToValidateResponse{HasToValidate: false, Reason: types.HAS_TO_VALIDATE_REASON_UNSPECIFIED},
+		},
+		{
+			name:             "validator within the Top N has to validate",
+			consumerId:       "1",
+			setup:            func() {},
+			expectedResponse: types.QueryValidatorHasToValidateResponse{HasToValidate: true, Reason: types.HAS_TO_VALIDATE_REASON_TOP_N},
+		},
+		{
+			name:             "validator not opted in to an opt-in chain does not have to validate",
+			consumerId:       "2",
+			setup:            func() {},
+			expectedResponse: types.QueryValidatorHasToValidateResponse{HasToValidate: false, Reason: types.HAS_TO_VALIDATE_REASON_UNSPECIFIED},
+		},
+		{
+			name:       "validator opted in to an opt-in chain has to validate",
+			consumerId: "2",
+			setup: func() {
+				pk.SetOptedIn(ctx, "2", providerAddr)
+			},
+			expectedResponse: types.QueryValidatorHasToValidateResponse{HasToValidate: true, Reason: types.HAS_TO_VALIDATE_REASON_OPTED_IN},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup()
+			res, err := pk.QueryValidatorHasToValidate(ctx, &types.QueryValidatorHasToValidateRequest{
+				ProviderAddress: providerAddr.String(),
+				ConsumerId:      tc.consumerId,
+			})
+			require.NoError(t, err)
+			require.Equal(t, &tc.expectedResponse, res)
+		})
+	}
+
+	_, err := pk.QueryValidatorHasToValidate(ctx, &types.QueryValidatorHasToValidateRequest{ProviderAddress: "invalid", ConsumerId: "0"})
+	require.Error(t, err)
+	_, err = pk.QueryValidatorHasToValidate(ctx, &types.QueryValidatorHasToValidateRequest{ProviderAddress: providerAddr.String(), ConsumerId: "10"})
+	require.Error(t, err)
+	_, err = pk.QueryValidatorHasToValidate(ctx, nil)
+	require.Error(t, err)
+}
+
+// TestQueryConsumerCCVTimeout tests that the query returns the `CcvTimeoutPeriod` param,
+// unless the timeout period is overridden for the consumer chain through a `MsgUpdateConsumer`
+func TestQueryConsumerCCVTimeout(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	params := types.DefaultParams()
+	params.CcvTimeoutPeriod = 4 * time.Hour
+	pk.SetParams(ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(&pk)
+	resp, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+		Submitter: "submitter", ChainId: "chain-1", Metadata: types.ConsumerMetadata{Name: "name"},
+	})
+	require.NoError(t, err)
+	consumerId := resp.ConsumerId
+
+	// the param is returned if no override is set
+	res, err := pk.QueryConsumerCCVTimeout(ctx, &types.QueryConsumerCCVTimeoutRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerCCVTimeoutResponse{CcvTimeoutPeriod: 4 * time.Hour, Overridden: false}, res)
+
+	// the timeout period of the initialization parameters is used by the consumer chain and is not an override
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.InitialHeight = clienttypes.NewHeight(1, 1)
+	initializationParameters.SpawnTime = time.Time{}
+	err = pk.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+	require.NoError(t, err)
+	res, err = pk.QueryConsumerCCVTimeout(ctx, &types.QueryConsumerCCVTimeoutRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerCCVTimeoutResponse{CcvTimeoutPeriod: 4 * time.Hour, Overridden: false}, res)
+
+	// the override is returned once set
+	ccvTimeoutPeriod := 2 * time.Hour
+	_, err = msgServer.UpdateConsumer(ctx, &types.MsgUpdateConsumer{
+		Owner: "submitter", ConsumerId: consumerId, CcvTimeoutPeriod: &ccvTimeoutPeriod,
+	})
+	require.NoError(t, err)
+	res, err = pk.QueryConsumerCCVTimeout(ctx, &types.QueryConsumerCCVTimeoutRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerCCVTimeoutResponse{CcvTimeoutPeriod: 2 * time.Hour, Overridden: true}, res)
+
+	// a zero timeout period removes the override
+	ccvTimeoutPeriod = 0
+	_, err = msgServer.UpdateConsumer(ctx, &types.MsgUpdateConsumer{
+		Owner: "submitter", ConsumerId: consumerId, CcvTimeoutPeriod: &ccvTimeoutPeriod,
+	})
+	require.NoError(t, err)
+	res, err = pk.QueryConsumerCCVTimeout(ctx, &types.QueryConsumerCCVTimeoutRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerCCVTimeoutResponse{CcvTimeoutPeriod: 4 * time.Hour, Overridden: false}, res)
+
+	_, err = pk.QueryConsumerCCVTimeout(ctx, &types.QueryConsumerCCVTimeoutRequest{ConsumerId: "10"})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerCCVTimeout(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryMaxProviderConsensusValidators(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// bond validators, in descending order of power
+	validators := []stakingtypes.Validator{}
+	for i := 0; i < 5; i++ {
+		validators = append(validators, createStakingValidator(ctx, mocks, int64(50-10*i), i))
+	}
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(ctx).Return(validators, nil).AnyTimes()
+
+	_, err := pk.QueryMaxProviderConsensusValidators(ctx, nil)
+	require.Error(t, err)
+
+	for _, maxValidators := range []int64{1, 3, 5, 10} {
+		params := pk.GetParams(ctx)
+		params.MaxProviderConsensusValidators = maxValidators
+		pk.SetParams(ctx, params)
+
+		_, err := pk.ProviderValidatorUpdates(ctx)
+		require.NoError(t, err)
+
+		res, err := pk.QueryMaxProviderConsensusValidators(ctx, &types.QueryMaxProviderConsensusValidatorsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, maxValidators, res.MaxProviderConsensusValidators)
+		// the number of active validators never exceeds the param
+		require.LessOrEqual(t, res.ActiveValidators, res.MaxProviderConsensusValidators)
+		require.Equal(t, min(maxValidators, int64(len(validators))), res.ActiveValidators)
+	}
+}
+
+func TestQueryConsumersByOwner(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := keeper.NewMsgServerImpl(&pk)
+	ownerA := sdk.AccAddress([]byte("ownerA")).String()
+	ownerB := sdk.AccAddress([]byte("ownerB")).String()
+
+	// ownerA creates consumers "0" and "2", while ownerB creates consumer "1"
+	for _, owner := range []string{ownerA, ownerB, ownerA} {
+		_, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			Submitter: owner, ChainId: "chainId", Metadata: types.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: &types.ConsumerInitializationParameters{},
+			PowerShapingParameters:   &types.PowerShapingParameters{},
+		})
+		require.NoError(t, err)
+	}
+	pk.SetConsumerPhase(ctx, "2", types.CONSUMER_PHASE_INITIALIZED)
+
+	_, err := pk.QueryConsumersByOwner(ctx, nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumersByOwner(ctx, &types.QueryConsumersByOwnerRequest{OwnerAddress: "invalid"})
+	require.Error(t, err)
+
+	res, err := pk.QueryConsumersByOwner(ctx, &types.QueryConsumersByOwnerRequest{OwnerAddress: ownerA})
+	require.NoError(t, err)
+	require.Equal(t, []types.OwnedConsumer{
+		{ConsumerId: "0", Phase: types.CONSUMER_PHASE_REGISTERED},
+		{ConsumerId: "2", Phase: types.CONSUMER_PHASE_INITIALIZED},
+	}, res.Consumers)
+
+	res, err = pk.QueryConsumersByOwner(ctx, &types.QueryConsumersByOwnerRequest{OwnerAddress: ownerB})
+	require.NoError(t, err)
+	require.Equal(t, []types.OwnedConsumer{
+		{ConsumerId: "1", Phase: types.CONSUMER_PHASE_REGISTERED},
+	}, res.Consumers)
+
+	// an address that does not own any consumer chain
+	res, err = pk.QueryConsumersByOwner(ctx, &types.QueryConsumersByOwnerRequest{OwnerAddress: sdk.AccAddress([]byte("ownerC")).String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Consumers)
+}
+
+func TestQueryConsumerChainIdAvailable(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := keeper.NewMsgServerImpl(&pk)
+	for _, chainId := range []string{"consumerA", "consumerB"} {
+		_, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: chainId, Metadata: types.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: &types.ConsumerInitializationParameters{},
+			PowerShapingParameters:   &types.PowerShapingParameters{},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := pk.QueryConsumerChainIdAvailable(ctx, nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerChainIdAvailable(ctx, &types.QueryConsumerChainIdAvailableRequest{ChainId: ""})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerChainIdAvailable(ctx, &types.QueryConsumerChainIdAvailableRequest{ChainId: "stride-1"})
+	require.Error(t, err)
+
+	// the chain id of a registered consumer chain is not available
+	res, err := pk.QueryConsumerChainIdAvailable(ctx, &types.QueryConsumerChainIdAvailableRequest{ChainId: "consumerB"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerChainIdAvailableResponse{Available: false, ConflictingConsumerId: "1"}, res)
+
+	// a fresh chain id is available
+	res, err = pk.QueryConsumerChainIdAvailable(ctx, &types.QueryConsumerChainIdAvailableRequest{ChainId: "consumerC"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerChainIdAvailableResponse{Available: true}, res)
+
+	// the chain id of a stopped consumer chain is available again
+	pk.SetConsumerPhase(ctx, "1", types.CONSUMER_PHASE_STOPPED)
+	res, err = pk.QueryConsumerChainIdAvailable(ctx, &types.QueryConsumerChainIdAvailableRequest{ChainId: "consumerB"})
+	require.NoError(t, err)
+	require.True(t, res.Available)
+}
+
+func TestQueryNextConsumerId(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	_, err := pk.QueryNextConsumerId(ctx, nil)
+	require.Error(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(&pk)
+	createConsumer := func() string {
+		res, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId", Metadata: types.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: &types.ConsumerInitializationParameters{},
+			PowerShapingParameters:   &types.PowerShapingParameters{},
+		})
+		require.NoError(t, err)
+		return res.ConsumerId
+	}
+	queryNextConsumerId := func() string {
+		res, err := pk.QueryNextConsumerId(ctx, &types.QueryNextConsumerIdRequest{})
+		require.NoError(t, err)
+		return res.ConsumerId
+	}
+
+	for _, expectedConsumerId := range []string{"0", "1"} {
+		require.Equal(t, expectedConsumerId, queryNextConsumerId())
+		require.Equal(t, expectedConsumerId, createConsumer())
+	}
+	require.Equal(t, "2", queryNextConsumerId())
+
+	// remove the first consumer chain
+	pk.SetConsumerPhase(ctx, "0", types.CONSUMER_PHASE_STOPPED)
+	err = pk.DeleteConsumerChain(ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, types.CONSUMER_PHASE_DELETED, pk.GetConsumerPhase(ctx, "0"))
+
+	// the id of the removed consumer chain is not reused
+	require.Equal(t, "2", queryNextConsumerId())
+	require.Equal(t, "2", createConsumer())
+	require.Equal(t, "3", queryNextConsumerId())
+}
+
+func TestQueryConsumersByClientId(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryConsumersByClientId(ctx, nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumersByClientId(ctx, &types.QueryConsumersByClientIdRequest{ClientId: ""})
+	require.Error(t, err)
+
+	// two consumer chains reference distinct clients
+	consumerIdA := pk.FetchAndIncrementConsumerId(ctx)
+	consumerIdB := pk.FetchAndIncrementConsumerId(ctx)
+	pk.SetConsumerClientId(ctx, consumerIdA, "07-tendermint-0")
+	pk.SetConsumerClientId(ctx, consumerIdB, "07-tendermint-1")
+
+	res, err := pk.QueryConsumersByClientId(ctx, &types.QueryConsumersByClientIdRequest{ClientId: "07-tendermint-1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{consumerIdB}, res.ConsumerIds)
+
+	// unknown client
+	res, err = pk.QueryConsumersByClientId(ctx, &types.QueryConsumersByClientIdRequest{ClientId: "07-tendermint-2"})
+	require.NoError(t, err)
+	require.Empty(t, res.ConsumerIds)
+}
@@ -63,6 +63,15 @@ func (h Hooks) AfterValidatorRemoved(goCtx context.Context, valConsAddr sdk.Cons
 		}
 	}
 
+	for _, keyAssignmentHeight := range h.k.GetAllKeyAssignmentHeights(ctx, nil) {
+		if sdk.ConsAddress(keyAssignmentHeight.ProviderAddr).Equals(valConsAddr) {
+			h.k.DeleteKeyAssignmentHeight(ctx, keyAssignmentHeight.ConsumerId, providertypes.NewProviderConsAddress(valConsAddr))
+		}
+	}
+
+	h.k.DeleteJailingReason(ctx, providertypes.NewProviderConsAddress(valConsAddr))
+	h.k.DeleteKeyAssignmentNonce(ctx, valAddr)
+
 	return nil
 }
 
@@ -86,7 +95,11 @@ func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) erro
 	return nil
 }
 
-func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBonded(goCtx context.Context, valConsAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// a bonded validator is not jailed anymore
+	h.k.DeleteJailingReason(ctx, providertypes.NewProviderConsAddress(valConsAddr))
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -90,3 +91,33 @@ func TestValidatorConsensusKeyInUse(t *testing.T) {
 		})
 	}
 }
+
+// TestAfterValidatorRemoved tests that the key assignment state of a removed validator is deleted
+func TestAfterValidatorRemoved(t *testing.T) {
+	k, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	removedValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	otherValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+
+	k.SetValidatorConsumerPubKey(ctx, "0", removedValidator.ProviderConsAddress(), removedValidator.TMProtoCryptoPublicKey())
+	k.SetValidatorByConsumerAddr(ctx, "0", removedValidator.ConsumerConsAddress(), removedValidator.ProviderConsAddress())
+	k.SetKeyAssignmentNonce(ctx, removedValidator.SDKValOpAddress(), 5)
+	k.SetKeyAssignmentNonce(ctx, otherValidator.SDKValOpAddress(), 7)
+	k.SetKeyAssignmentHeight(ctx, "0", removedValidator.ProviderConsAddress(), 3)
+	k.SetKeyAssignmentHeight(ctx, "1", removedValidator.ProviderConsAddress(), 4)
+	k.SetKeyAssignmentHeight(ctx, "0", otherValidator.ProviderConsAddress(), 5)
+
+	err := k.Hooks().AfterValidatorRemoved(ctx, removedValidator.SDKValConsAddress(), removedValidator.SDKValOpAddress())
+	require.NoError(t, err)
+
+	_, found := k.GetValidatorConsumerPubKey(ctx, "0", removedValidator.ProviderConsAddress())
+	require.False(t, found)
+	_, found = k.GetValidatorByConsumerAddr(ctx, "0", removedValidator.ConsumerConsAddress())
+	require.False(t, found)
+	require.Zero(t, k.GetKeyAssignmentNonce(ctx, removedValidator.SDKValOpAddress()))
+	require.Equal(t, []types.KeyAssignmentNonce{{ValidatorAddr: otherValidator.SDKValOpAddress(), Nonce: 7}},
+		k.GetAllKeyAssignmentNonces(ctx))
+	require.Equal(t, []types.KeyAssignmentHeight{{ConsumerId: "0", ProviderAddr: otherValidator.SDKValConsAddress(), Height: 5}},
+		k.GetAllKeyAssignmentHeights(ctx, nil))
+}
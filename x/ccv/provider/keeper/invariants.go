@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -16,6 +18,9 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 
 	ir.RegisterRoute(types.ModuleName, "staking-keeper-equivalence",
 		StakingKeeperEquivalenceInvariant(*k))
+
+	ir.RegisterRoute(types.ModuleName, "consumer-power-bounded-by-provider",
+		ConsumerPowerBoundedByProviderInvariant(*k))
 }
 
 // MaxProviderConsensusValidatorsInvariant checks that the number of provider consensus validators
@@ -147,3 +152,46 @@ func StakingKeeperEquivalenceInvariant(k Keeper) sdk.Invariant {
 		return "", false
 	}
 }
+
+// ConsumerPowerBoundedByProviderInvariant checks that, for every launched consumer chain,
+// the total power of the consumer validator set does not exceed the provider total bonded power
+// at the time the consumer validator set was computed. Note that the consumer validator sets are only
+// updated at the end of epochs (and not while the VSC sending is paused), while the provider total power
+// changes in every block, and hence the bound is checked against the recorded provider power.
+// Note also that power shaping (e.g., power capping) can only decrease the powers
+// of the consumer validators, and hence the bound must hold for all consumer chains.
+func ConsumerPowerBoundedByProviderInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, consumerId := range k.GetAllConsumerIds(ctx) {
+			if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+				continue
+			}
+
+			providerTotalPower, found := k.GetConsumerValSetProviderPower(ctx, consumerId)
+			if !found {
+				// the validator set was not computed since the provider power is recorded,
+				// e.g., the chain was imported from genesis
+				continue
+			}
+
+			consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "consumer-power-bounded-by-provider",
+					fmt.Sprintf("error getting validator set of consumer chain %s: %v", consumerId, err)), true
+			}
+
+			consumerTotalPower := math.ZeroInt()
+			for _, val := range consumerValSet {
+				consumerTotalPower = consumerTotalPower.Add(math.NewInt(val.Power))
+			}
+
+			if consumerTotalPower.GT(providerTotalPower) {
+				return sdk.FormatInvariant(types.ModuleName, "consumer-power-bounded-by-provider",
+					fmt.Sprintf("total power of consumer chain %s: %v, exceeds provider total bonded power: %v",
+						consumerId, consumerTotalPower, providerTotalPower)), true
+			}
+		}
+
+		return "", false
+	}
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumerPowerBoundedByProviderInvariant tests that the invariant breaks
+// when the total power of a launched consumer exceeds the provider total bonded power
+// at the time the consumer validator set was computed
+func TestConsumerPowerBoundedByProviderInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	invariant := keeper.ConsumerPowerBoundedByProviderInvariant(providerKeeper)
+
+	// no consumer chains
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	createValidator := func(power int64, seed int) types.ConsensusValidator {
+		cryptoId := cryptotestutil.NewCryptoIdentityFromIntSeed(seed)
+		publicKey := cryptoId.TMProtoCryptoPublicKey()
+		return types.ConsensusValidator{
+			ProviderConsAddr: cryptoId.SDKValConsAddress(),
+			Power:            power,
+			PublicKey:        &publicKey,
+		}
+	}
+	valA := createValidator(60, 1)
+	valB := createValidator(40, 2)
+	valC := createValidator(70, 3)
+
+	// two launched consumer chains with validator sets bounded by the provider power
+	for _, consumerId := range []string{
+		providerKeeper.FetchAndIncrementConsumerId(ctx),
+		providerKeeper.FetchAndIncrementConsumerId(ctx),
+	} {
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerValSet(ctx, consumerId, []types.ConsensusValidator{valA, valB})
+		require.NoError(t, err)
+		providerKeeper.SetConsumerValSetProviderPower(ctx, consumerId, math.NewInt(100))
+	}
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a consumer chain without a recorded provider power is not checked
+	unrecordedConsumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, unrecordedConsumerId, types.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerValSet(ctx, unrecordedConsumerId, []types.ConsensusValidator{valA, valB, valC})
+	require.NoError(t, err)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a consumer chain that is not launched is not checked
+	notLaunchedConsumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, notLaunchedConsumerId, types.CONSUMER_PHASE_STOPPED)
+	err = providerKeeper.SetConsumerValSet(ctx, notLaunchedConsumerId, []types.ConsensusValidator{valA, valB, valC})
+	require.NoError(t, err)
+	providerKeeper.SetConsumerValSetProviderPower(ctx, notLaunchedConsumerId, math.NewInt(100))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// corrupt the power of a validator on the second consumer chain
+	valB.Power = 41
+	err = providerKeeper.SetConsumerValidator(ctx, "1", valB)
+	require.NoError(t, err)
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "total power of consumer chain 1: 101")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	gomath "math"
 	"reflect"
 	"time"
 
@@ -46,7 +47,9 @@ type Keeper struct {
 	distributionKeeper ccv.DistributionKeeper
 	bankKeeper         ccv.BankKeeper
 	govKeeper          govkeeper.Keeper
+	transferKeeper     ccv.IBCTransferKeeper
 	feeCollectorName   string
+	hooks              types.ProviderHooks
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
@@ -106,10 +109,12 @@ func (k Keeper) ConsensusAddressCodec() addresscodec.Codec {
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 15 {
-		panic(fmt.Sprintf("number of fields in provider keeper is not 15 - have %d", reflect.ValueOf(k).NumField()))
+	if reflect.ValueOf(k).NumField() != 17 {
+		panic(fmt.Sprintf("number of fields in provider keeper is not 17 - have %d", reflect.ValueOf(k).NumField()))
 	}
 
+	// Note that hooks and the transfer keeper are explicitly set after the constructor
+
 	if k.validatorAddressCodec == nil || k.consensusAddressCodec == nil {
 		panic("validator and/or consensus address codec are nil")
 	}
@@ -137,6 +142,24 @@ func (k *Keeper) SetGovKeeper(govKeeper govkeeper.Keeper) {
 	k.govKeeper = govKeeper
 }
 
+// SetTransferKeeper sets the IBC transfer keeper, which is created after the provider keeper
+func (k *Keeper) SetTransferKeeper(transferKeeper ccv.IBCTransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
+// SetHooks sets the provider hooks
+func (k *Keeper) SetHooks(ph types.ProviderHooks) *Keeper {
+	if k.hooks != nil {
+		// This should never happen as SetHooks is expected
+		// to be called only once in app.go
+		panic("cannot set provider hooks twice")
+	}
+
+	k.hooks = ph
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -281,35 +304,54 @@ func (k Keeper) DeleteConsumerGenesis(ctx sdk.Context, consumerId string) {
 	store.Delete(types.ConsumerGenesisKey(consumerId))
 }
 
+// SetConsumerGenesisValsetHash sets the hash of the initial validator set of the consumer chain with `consumerId`
+func (k Keeper) SetConsumerGenesisValsetHash(ctx sdk.Context, consumerId string, valsetHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerGenesisValsetHashKey(consumerId), valsetHash)
+}
+
+// GetConsumerGenesisValsetHash returns the hash of the initial validator set of the consumer chain with `consumerId`
+func (k Keeper) GetConsumerGenesisValsetHash(ctx sdk.Context, consumerId string) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerGenesisValsetHashKey(consumerId))
+	return bz, bz != nil
+}
+
+// DeleteConsumerGenesisValsetHash deletes the hash of the initial validator set of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerGenesisValsetHash(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerGenesisValsetHashKey(consumerId))
+}
+
 // VerifyConsumerChain verifies that the chain trying to connect on the channel handshake
-// is the expected consumer chain.
-func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectionHops []string) error {
+// is the expected consumer chain. It returns the consumer id of the chain.
+func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectionHops []string) (string, error) {
 	if len(connectionHops) != 1 {
-		return errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to provider chain")
+		return "", errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to provider chain")
 	}
 	connectionID := connectionHops[0]
 	clientId, _, err := k.getUnderlyingClient(ctx, connectionID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	consumerId, found := k.GetClientIdToConsumerId(ctx, clientId)
 	if !found {
-		return errorsmod.Wrapf(ccv.ErrConsumerChainNotFound, "cannot find consumer id associated with client id: %s", clientId)
+		return "", errorsmod.Wrapf(ccv.ErrConsumerChainNotFound, "cannot find consumer id associated with client id: %s", clientId)
 	}
 	ccvClientId, found := k.GetConsumerClientId(ctx, consumerId)
 	if !found {
-		return errorsmod.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", consumerId)
+		return "", errorsmod.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", consumerId)
 	}
 	if ccvClientId != clientId {
-		return errorsmod.Wrapf(types.ErrInvalidConsumerClient, "CCV channel must be built on top of CCV client. expected %s, got %s", ccvClientId, clientId)
+		return "", errorsmod.Wrapf(types.ErrInvalidConsumerClient, "CCV channel must be built on top of CCV client. expected %s, got %s", ccvClientId, clientId)
 	}
 
 	// Verify that there isn't already a CCV channel for the consumer chain
 	if prevChannel, ok := k.GetConsumerIdToChannelId(ctx, consumerId); ok {
-		return errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s", prevChannel, consumerId)
+		return "", errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s", prevChannel, consumerId)
 	}
-	return nil
+	return consumerId, nil
 }
 
 // SetConsumerChain ensures that the consumer chain has not already been
@@ -464,6 +506,59 @@ func (k Keeper) DeleteValsetUpdateBlockHeight(ctx sdk.Context, valsetUpdateId ui
 	store.Delete(types.ValsetUpdateBlockHeightKey(valsetUpdateId))
 }
 
+// SetRecentValsetUpdateBlockHeight records the block height of a recent valset update id.
+// Note that, unlike the mapping set by SetValsetUpdateBlockHeight, the recent block heights
+// are bounded by MaxValsetUpdateBlockHeights and only used for queries.
+func (k Keeper) SetRecentValsetUpdateBlockHeight(ctx sdk.Context, valsetUpdateId, blockHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, blockHeight)
+	store.Set(types.RecentValsetUpdateBlockHeightKey(valsetUpdateId), heightBytes)
+}
+
+// GetAllRecentValsetUpdateBlockHeights gets the recorded block heights of the recent valset update ids,
+// in ascending order of valset update ids
+func (k Keeper) GetAllRecentValsetUpdateBlockHeights(ctx sdk.Context) (valsetUpdateBlockHeights []types.ValsetUpdateIdToHeight) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.RecentValsetUpdateBlockHeightKeyPrefix()})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		valsetUpdateBlockHeights = append(valsetUpdateBlockHeights, types.ValsetUpdateIdToHeight{
+			ValsetUpdateId: binary.BigEndian.Uint64(iterator.Key()[1:]),
+			Height:         binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+
+	return valsetUpdateBlockHeights
+}
+
+// RecordRecentValsetUpdateBlockHeight records the block height of the given valset update id and
+// deletes the recorded block heights of all the valset update ids that are not among the most recent
+// MaxValsetUpdateBlockHeights ids. Nothing is recorded if MaxValsetUpdateBlockHeights is zero.
+func (k Keeper) RecordRecentValsetUpdateBlockHeight(ctx sdk.Context, valsetUpdateId, blockHeight uint64) {
+	maxHeights := k.GetMaxValsetUpdateBlockHeights(ctx)
+	if maxHeights > 0 {
+		k.SetRecentValsetUpdateBlockHeight(ctx, valsetUpdateId, blockHeight)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.RecentValsetUpdateBlockHeightKeyPrefix()})
+	defer iterator.Close()
+
+	// the valset update ids are iterated in ascending order
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if maxHeights > 0 && binary.BigEndian.Uint64(iterator.Key()[1:])+uint64(maxHeights) > valsetUpdateId {
+			break
+		}
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
 // SetSlashAcks sets the slash acks under the given chain ID
 //
 // TODO: SlashAcks should be persisted as a list of ConsumerConsAddr types, not strings.
@@ -529,6 +624,164 @@ func (k Keeper) AppendSlashAck(ctx sdk.Context, consumerId,
 	k.SetSlashAcks(ctx, consumerId, acks)
 }
 
+// SetSlashPacketsPaused sets whether the handling of the slash packets received
+// from the consumer chain with the given consumer id is paused
+func (k Keeper) SetSlashPacketsPaused(ctx sdk.Context, consumerId string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.SlashPacketsPausedKey(consumerId))
+		return
+	}
+	store.Set(types.SlashPacketsPausedKey(consumerId), []byte{})
+}
+
+// IsSlashPacketsPaused returns true if the handling of the slash packets received
+// from the consumer chain with the given consumer id is paused
+func (k Keeper) IsSlashPacketsPaused(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SlashPacketsPausedKey(consumerId))
+}
+
+// SetConsumerSlashMode sets how the downtime slash packets received
+// from the consumer chain with the given consumer id are handled
+func (k Keeper) SetConsumerSlashMode(ctx sdk.Context, consumerId string, slashMode types.ConsumerSlashMode) {
+	store := ctx.KVStore(k.storeKey)
+	if slashMode == types.SLASH_MODE_JAIL {
+		store.Delete(types.ConsumerSlashModeKey(consumerId))
+		return
+	}
+	store.Set(types.ConsumerSlashModeKey(consumerId), sdk.Uint64ToBigEndian(uint64(slashMode)))
+}
+
+// GetConsumerSlashMode returns how the downtime slash packets received
+// from the consumer chain with the given consumer id are handled
+func (k Keeper) GetConsumerSlashMode(ctx sdk.Context, consumerId string) types.ConsumerSlashMode {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerSlashModeKey(consumerId))
+	if bz == nil {
+		return types.SLASH_MODE_JAIL
+	}
+	return types.ConsumerSlashMode(sdk.BigEndianToUint64(bz))
+}
+
+// SetVSCSendingPaused sets whether the sending of VSC packets to the consumer chain
+// with the given consumer id is paused
+func (k Keeper) SetVSCSendingPaused(ctx sdk.Context, consumerId string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.VSCSendingPausedKey(consumerId))
+		return
+	}
+	store.Set(types.VSCSendingPausedKey(consumerId), []byte{})
+}
+
+// IsVSCSendingPaused returns true if the sending of VSC packets to the consumer chain
+// with the given consumer id is paused
+func (k Keeper) IsVSCSendingPaused(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.VSCSendingPausedKey(consumerId))
+}
+
+// SetConsumerCCVTimeoutPeriod sets the timeout period of the CCV packets sent to the given consumer chain,
+// overriding the `CcvTimeoutPeriod` param
+func (k Keeper) SetConsumerCCVTimeoutPeriod(ctx sdk.Context, consumerId string, timeoutPeriod time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerCCVTimeoutPeriodKey(consumerId), sdk.Uint64ToBigEndian(uint64(timeoutPeriod)))
+}
+
+// GetConsumerCCVTimeoutPeriod returns the timeout period of the CCV packets sent to the given consumer chain,
+// i.e., the consumer-specific timeout period if one is set, or the `CcvTimeoutPeriod` param otherwise;
+// the returned boolean is true if a consumer-specific timeout period is set
+func (k Keeper) GetConsumerCCVTimeoutPeriod(ctx sdk.Context, consumerId string) (time.Duration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerCCVTimeoutPeriodKey(consumerId))
+	if bz == nil {
+		return k.GetCCVTimeoutPeriod(ctx), false
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz)), true
+}
+
+// DeleteConsumerCCVTimeoutPeriod deletes the consumer-specific timeout period of the CCV packets
+// sent to the given consumer chain
+func (k Keeper) DeleteConsumerCCVTimeoutPeriod(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerCCVTimeoutPeriodKey(consumerId))
+}
+
+// SetPendingDowntimeSlash stores a downtime slash packet received from the given consumer chain
+// whose handling is deferred until the jail time of the pending downtime slash
+func (k Keeper) SetPendingDowntimeSlash(ctx sdk.Context, consumerId string, pending types.PendingDowntimeSlash) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := pending.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the PendingDowntimeSlash is instantiated by the caller.
+		panic(fmt.Errorf("failed to marshal PendingDowntimeSlash: %w", err))
+	}
+	consumerAddr := types.NewConsumerConsAddress(pending.SlashPacketData.Validator.Address)
+	store.Set(types.PendingDowntimeSlashKey(consumerId, consumerAddr), bz)
+}
+
+// GetPendingDowntimeSlash returns the deferred downtime slash packet received from the given
+// consumer chain for the validator with `consumerAddr`
+func (k Keeper) GetPendingDowntimeSlash(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+) (pending types.PendingDowntimeSlash, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingDowntimeSlashKey(consumerId, consumerAddr))
+	if bz == nil {
+		return pending, false
+	}
+	if err := pending.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the PendingDowntimeSlash is assumed to be correctly serialized in SetPendingDowntimeSlash.
+		panic(fmt.Errorf("failed to unmarshal PendingDowntimeSlash: %w", err))
+	}
+	return pending, true
+}
+
+// GetPendingDowntimeSlashes returns all the deferred downtime slash packets received from the given consumer chain
+func (k Keeper) GetPendingDowntimeSlashes(ctx sdk.Context, consumerId string) (pendings []types.PendingDowntimeSlash) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.PendingDowntimeSlashKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingDowntimeSlash
+		if err := pending.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the PendingDowntimeSlash is assumed to be correctly serialized in SetPendingDowntimeSlash.
+			panic(fmt.Errorf("failed to unmarshal PendingDowntimeSlash: %w", err))
+		}
+		pendings = append(pendings, pending)
+	}
+	return pendings
+}
+
+// DeletePendingDowntimeSlash deletes the deferred downtime slash packet received from the given
+// consumer chain for the validator with `consumerAddr`
+func (k Keeper) DeletePendingDowntimeSlash(ctx sdk.Context, consumerId string, consumerAddr types.ConsumerConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingDowntimeSlashKey(consumerId, consumerAddr))
+}
+
+// DeleteAllPendingDowntimeSlashes deletes all the deferred downtime slash packets received from the given consumer chain
+func (k Keeper) DeleteAllPendingDowntimeSlashes(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.PendingDowntimeSlashKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
 // SetInitChainHeight sets the provider block height when the given consumer chain was initiated
 func (k Keeper) SetInitChainHeight(ctx sdk.Context, consumerId string, height uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -594,6 +847,170 @@ func (k Keeper) DeletePendingVSCPackets(ctx sdk.Context, consumerId string) {
 	store.Delete(types.PendingVSCsKey(consumerId))
 }
 
+// SetVscSendTimestamp sets the time when the VSC packet with the given vscId was sent to the consumer chain.
+// Note that the send timestamps are only read by queries and for the VSC latencies,
+// and are pruned once the VSC packets mature (see PruneMaturedVscSendTimestamps).
+func (k Keeper) SetVscSendTimestamp(ctx sdk.Context, consumerId string, vscId uint64, timestamp time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VscSendTimestampKey(consumerId, vscId), sdk.FormatTimeBytes(timestamp))
+}
+
+// GetVscSendTimestamp returns the time when the VSC packet with the given vscId was sent to the consumer chain
+func (k Keeper) GetVscSendTimestamp(ctx sdk.Context, consumerId string, vscId uint64) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VscSendTimestampKey(consumerId, vscId))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the timestamp is assumed to be correctly serialized in SetVscSendTimestamp.
+		panic(fmt.Errorf("failed to parse VSC send timestamp: %w", err))
+	}
+	return ts, true
+}
+
+// DeleteVscSendTimestamp deletes the send time of the VSC packet with the given vscId
+func (k Keeper) DeleteVscSendTimestamp(ctx sdk.Context, consumerId string, vscId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VscSendTimestampKey(consumerId, vscId))
+}
+
+// GetVSCMaturationSchedule returns, for every VSC packet sent to the consumer chain
+// that is still stored, the time when the VSC matures, i.e., its send time plus the given unbonding period.
+//
+// Note that the VSC send timestamps are stored under keys with the following format:
+// VscSendTimestampKeyPrefix | len(consumerId) | consumerId | vscId
+// Thus, the returned array is in ascending order of vscIDs.
+func (k Keeper) GetVSCMaturationSchedule(ctx sdk.Context, consumerId string, unbondingPeriod time.Duration) []types.VSCMaturity {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.VscSendTimestampKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	maturities := []types.VSCMaturity{}
+	for ; iterator.Valid(); iterator.Next() {
+		_, vscId, err := types.ParseStringIdAndUintIdKey(types.VscSendTimestampKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetVscSendTimestamp.
+			panic(fmt.Errorf("failed to parse VSC send timestamp key: %w", err))
+		}
+		ts, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the timestamp is assumed to be correctly serialized in SetVscSendTimestamp.
+			panic(fmt.Errorf("failed to parse VSC send timestamp: %w", err))
+		}
+		maturities = append(maturities, types.VSCMaturity{
+			VscId:        vscId,
+			MaturityTime: ts.Add(unbondingPeriod),
+		})
+	}
+
+	return maturities
+}
+
+// PruneMaturedVscSendTimestamps deletes the send timestamps of all the VSC packets
+// sent to the consumer chain that matured by the current block time
+func (k Keeper) PruneMaturedVscSendTimestamps(ctx sdk.Context, consumerId string, unbondingPeriod time.Duration) {
+	for _, maturity := range k.GetVSCMaturationSchedule(ctx, consumerId, unbondingPeriod) {
+		if maturity.MaturityTime.After(ctx.BlockTime()) {
+			// VSC packets are sent in ascending order of vscIDs,
+			// thus, none of the remaining VSCs has matured
+			break
+		}
+		k.DeleteVscSendTimestamp(ctx, consumerId, maturity.VscId)
+	}
+}
+
+// DeleteVscSendTimestampsForConsumer deletes all the VSC send timestamps of the given consumer chain
+func (k Keeper) DeleteVscSendTimestampsForConsumer(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.VscSendTimestampKeyPrefix(), consumerId))
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// SetVSCLatency sets the round-trip latency of the VSC packet with the given vscId sent to the consumer chain
+func (k Keeper) SetVSCLatency(ctx sdk.Context, consumerId string, vscId uint64, latency time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VSCLatencyKey(consumerId, vscId), sdk.Uint64ToBigEndian(uint64(latency)))
+}
+
+// GetAllVSCLatencies returns the stored round-trip latencies of the VSC packets
+// sent to the consumer chain, in ascending order of vscIDs
+func (k Keeper) GetAllVSCLatencies(ctx sdk.Context, consumerId string) []time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.VSCLatencyKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	latencies := []time.Duration{}
+	for ; iterator.Valid(); iterator.Next() {
+		latencies = append(latencies, time.Duration(sdk.BigEndianToUint64(iterator.Value())))
+	}
+	return latencies
+}
+
+// PruneVSCLatencies deletes the round-trip latencies of the VSC packets sent to the consumer chain
+// that are not among the VSCLatencyWindow most recent ones, i.e., the ones with the highest vscIDs
+func (k Keeper) PruneVSCLatencies(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.VSCLatencyKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if len(keys) <= types.VSCLatencyWindow {
+		return
+	}
+	for _, delKey := range keys[:len(keys)-types.VSCLatencyWindow] {
+		store.Delete(delKey)
+	}
+}
+
+// DeleteVSCLatenciesForConsumer deletes all the VSC round-trip latencies of the given consumer chain
+func (k Keeper) DeleteVSCLatenciesForConsumer(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.VSCLatencyKeyPrefix(), consumerId))
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// GetVSCLatencyStats returns the number of the most recently acknowledged VSC packets of the consumer chain
+// together with their average and maximum round-trip latency
+func (k Keeper) GetVSCLatencyStats(ctx sdk.Context, consumerId string) (numVSCPackets uint64, average, maximum time.Duration) {
+	latencies := k.GetAllVSCLatencies(ctx, consumerId)
+	if len(latencies) == 0 {
+		return 0, 0, 0
+	}
+
+	var total time.Duration
+	for _, latency := range latencies {
+		total += latency
+		if latency > maximum {
+			maximum = latency
+		}
+	}
+	return uint64(len(latencies)), total / time.Duration(len(latencies)), maximum
+}
+
 // SetConsumerClientId sets the client id for the given consumer id.
 // Note that the method also stores a reverse index that can be accessed
 // by calling GetClientIdToConsumerId.
@@ -666,6 +1083,333 @@ func (k Keeper) GetSlashLog(
 	return bz != nil
 }
 
+// SetJailingReason stores the consumer chain and the infraction
+// for which the validator with `providerAddr` was jailed
+func (k Keeper) SetJailingReason(
+	ctx sdk.Context,
+	providerAddr types.ProviderConsAddress,
+	reason types.JailingReason,
+) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := reason.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the reason is assumed to be correctly serialized in SetJailingReason.
+		panic(fmt.Errorf("failed to marshal JailingReason: %w", err))
+	}
+	store.Set(types.JailingReasonKey(providerAddr), bz)
+}
+
+// GetJailingReason returns the consumer chain and the infraction for which
+// the validator with `providerAddr` was jailed; found is false if the validator
+// was not jailed because of a slash packet sent by a consumer chain
+func (k Keeper) GetJailingReason(
+	ctx sdk.Context,
+	providerAddr types.ProviderConsAddress,
+) (reason types.JailingReason, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.JailingReasonKey(providerAddr))
+	if bz == nil {
+		return reason, false
+	}
+	if err := reason.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the reason is assumed to be correctly serialized in SetJailingReason.
+		panic(fmt.Errorf("failed to unmarshal JailingReason: %w", err))
+	}
+	return reason, true
+}
+
+// GetAllJailingReasons returns the jailing reasons of all the validators
+// that were jailed because of a slash packet sent by a consumer chain
+func (k Keeper) GetAllJailingReasons(ctx sdk.Context) (reasons []types.ValidatorJailingReason) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.JailingReasonKeyPrefix()})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reason types.JailingReason
+		if err := reason.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the reason is assumed to be correctly serialized in SetJailingReason.
+			panic(fmt.Errorf("failed to unmarshal JailingReason: %w", err))
+		}
+		reasons = append(reasons, types.ValidatorJailingReason{
+			ProviderAddr: iterator.Key()[1:],
+			Reason:       reason,
+		})
+	}
+
+	return reasons
+}
+
+// DeleteJailingReason deletes the jailing reason of the validator with `providerAddr`
+func (k Keeper) DeleteJailingReason(
+	ctx sdk.Context,
+	providerAddr types.ProviderConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.JailingReasonKey(providerAddr))
+}
+
+// DeleteUnjailedValidatorsJailingReasons deletes the jailing reasons of the validators that are
+// not jailed anymore, e.g., because they unjailed themselves, which triggers no staking hook
+func (k Keeper) DeleteUnjailedValidatorsJailingReasons(ctx sdk.Context) {
+	for _, item := range k.GetAllJailingReasons(ctx) {
+		providerAddr := types.NewProviderConsAddress(item.ProviderAddr)
+		validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+		if err == nil && validator.IsJailed() {
+			continue
+		}
+		k.DeleteJailingReason(ctx, providerAddr)
+	}
+}
+
+// IncrementSlashPacketCount increments the number of slash packets
+// received from the given consumer chain at the current block height
+func (k Keeper) IncrementSlashPacketCount(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SlashPacketCountKey(consumerId, uint64(ctx.BlockHeight()))
+	count := uint64(0)
+	if bz := store.Get(key); bz != nil {
+		count = binary.BigEndian.Uint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+}
+
+// IncreaseConsumerJailedPower adds `power` to the cumulative power of the validators
+// jailed because of the slash packets received from the given consumer chain.
+// Non-positive powers are ignored and the cumulative power saturates at math.MaxInt64.
+func (k Keeper) IncreaseConsumerJailedPower(ctx sdk.Context, consumerId string, power int64) {
+	if power <= 0 {
+		return
+	}
+	jailedPower := k.GetConsumerJailedPower(ctx, consumerId)
+	if jailedPower > gomath.MaxInt64-power {
+		jailedPower = gomath.MaxInt64
+	} else {
+		jailedPower += power
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerJailedPowerKey(consumerId), sdk.Uint64ToBigEndian(uint64(jailedPower)))
+}
+
+// GetConsumerJailedPower returns the cumulative power of the validators jailed
+// because of the slash packets received from the given consumer chain
+func (k Keeper) GetConsumerJailedPower(ctx sdk.Context, consumerId string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerJailedPowerKey(consumerId))
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// DeleteConsumerJailedPower deletes the cumulative jailed power of the given consumer chain
+func (k Keeper) DeleteConsumerJailedPower(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerJailedPowerKey(consumerId))
+}
+
+// GetSlashPacketRate returns the number of slash packets received from the given
+// consumer chain in the last SlashPacketRateWindow blocks, including the current block
+//
+// Note that the slash packet counts are stored under keys with the following format:
+// SlashPacketCountKeyPrefix | len(consumerId) | consumerId | height
+// Thus, the iteration is in ascending order of block heights.
+func (k Keeper) GetSlashPacketRate(ctx sdk.Context, consumerId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.SlashPacketCountKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	rate := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		_, height, err := types.ParseStringIdAndUintIdKey(types.SlashPacketCountKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in IncrementSlashPacketCount.
+			panic(fmt.Errorf("failed to parse slash packet count key: %w", err))
+		}
+		if !k.isInSlashPacketRateWindow(ctx, height) {
+			continue
+		}
+		rate += binary.BigEndian.Uint64(iterator.Value())
+	}
+
+	return rate
+}
+
+// PruneSlashPacketCounts deletes the slash packet counts of the given consumer chain
+// for the block heights that are no longer in the window of the current block
+func (k Keeper) PruneSlashPacketCounts(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.SlashPacketCountKeyPrefix(), consumerId))
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, height, err := types.ParseStringIdAndUintIdKey(types.SlashPacketCountKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in IncrementSlashPacketCount.
+			panic(fmt.Errorf("failed to parse slash packet count key: %w", err))
+		}
+		if k.isInSlashPacketRateWindow(ctx, height) {
+			// the counts are in ascending order of block heights,
+			// thus, all the remaining counts are in the window
+			break
+		}
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// DeleteSlashPacketCountsForConsumer deletes all the slash packet counts of the given consumer chain
+func (k Keeper) DeleteSlashPacketCountsForConsumer(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.SlashPacketCountKeyPrefix(), consumerId))
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// SetSlashPacketRecord stores how the slash packet received from the given consumer chain
+// with the given IBC sequence number was processed
+func (k Keeper) SetSlashPacketRecord(ctx sdk.Context, consumerId string, ibcSeq uint64, record types.SlashPacketRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly serialized in SetSlashPacketRecord.
+		panic(fmt.Errorf("failed to marshal SlashPacketRecord: %w", err))
+	}
+	store.Set(types.SlashPacketRecordKey(consumerId, ibcSeq), bz)
+}
+
+// GetSlashPacketRecord returns how the slash packet received from the given consumer chain
+// with the given IBC sequence number was processed; found is false if there is no such record
+func (k Keeper) GetSlashPacketRecord(ctx sdk.Context, consumerId string, ibcSeq uint64) (record types.SlashPacketRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SlashPacketRecordKey(consumerId, ibcSeq))
+	if bz == nil {
+		return record, false
+	}
+	if err := record.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly serialized in SetSlashPacketRecord.
+		panic(fmt.Errorf("failed to unmarshal SlashPacketRecord: %w", err))
+	}
+	return record, true
+}
+
+// PruneSlashPacketRecords deletes the records of the slash packets received
+// from the given consumer chain more than an unbonding period ago
+func (k Keeper) PruneSlashPacketRecords(ctx sdk.Context, consumerId string, unbondingPeriod time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.SlashPacketRecordKeyPrefix(), consumerId))
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashPacketRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in SetSlashPacketRecord.
+			panic(fmt.Errorf("failed to unmarshal SlashPacketRecord: %w", err))
+		}
+		if record.ReceivedTime.Add(unbondingPeriod).After(ctx.BlockTime()) {
+			// slash packets are received on an ordered channel in ascending order of sequence numbers,
+			// thus, none of the remaining records is older than the unbonding period
+			break
+		}
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// DeleteSlashPacketRecordsForConsumer deletes all the slash packet records of the given consumer chain
+func (k Keeper) DeleteSlashPacketRecordsForConsumer(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.SlashPacketRecordKeyPrefix(), consumerId))
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// GetConsumersWithThrottledSlashPackets returns, for every consumer chain that currently
+// has throttled slash packets, the number of such packets. As a consumer chain retries
+// a bounced slash packet until it is handled, a slash packet is considered throttled
+// if the last record of its validator and infraction on that consumer chain is throttled.
+// The consumer chains are returned in the order of their slash packet records.
+func (k Keeper) GetConsumersWithThrottledSlashPackets(ctx sdk.Context) []types.ConsumerThrottledSlashPackets {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.SlashPacketRecordKeyPrefix()})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	// the last status per consumer id and per validator and infraction
+	lastStatuses := map[string]map[string]types.SlashPacketStatus{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, _, err := types.ParseStringIdAndUintIdKey(types.SlashPacketRecordKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetSlashPacketRecord.
+			panic(fmt.Errorf("failed to parse SlashPacketRecordKey: %w", err))
+		}
+		var record types.SlashPacketRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in SetSlashPacketRecord.
+			panic(fmt.Errorf("failed to unmarshal SlashPacketRecord: %w", err))
+		}
+
+		if _, found := lastStatuses[consumerId]; !found {
+			consumerIds = append(consumerIds, consumerId)
+			lastStatuses[consumerId] = map[string]types.SlashPacketStatus{}
+		}
+		// the records of a consumer chain are iterated in ascending order of sequence numbers
+		lastStatuses[consumerId][fmt.Sprintf("%x/%s", record.ProviderAddr, record.Infraction)] = record.Status
+	}
+
+	consumers := []types.ConsumerThrottledSlashPackets{}
+	for _, consumerId := range consumerIds {
+		count := uint32(0)
+		for _, status := range lastStatuses[consumerId] {
+			if status == types.SLASH_PACKET_STATUS_THROTTLED {
+				count++
+			}
+		}
+		if count > 0 {
+			consumers = append(consumers, types.ConsumerThrottledSlashPackets{
+				ConsumerId: consumerId,
+				Count:      count,
+			})
+		}
+	}
+	return consumers
+}
+
+// isInSlashPacketRateWindow returns true if the given block height
+// is one of the last SlashPacketRateWindow blocks
+func (k Keeper) isInSlashPacketRateWindow(ctx sdk.Context, height uint64) bool {
+	return height+types.SlashPacketRateWindow > uint64(ctx.BlockHeight())
+}
+
 func (k Keeper) BondDenom(ctx sdk.Context) (string, error) {
 	return k.stakingKeeper.BondDenom(ctx)
 }
@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -149,12 +154,137 @@ func (k Keeper) GetAllValidatorConsumerPubKeys(ctx sdk.Context, consumerId *stri
 	return validatorConsumerPubKeys
 }
 
+// GetValidatorKeyConflicts returns the consumer keys that the validator with `providerAddr`
+// assigned on more than one consumer chain, in ascending order of consumer addresses.
+// Reusing a consumer key across consumer chains is allowed, so this is only informational.
+func (k Keeper) GetValidatorKeyConflicts(ctx sdk.Context, providerAddr types.ProviderConsAddress) ([]types.ConsumerKeyConflict, error) {
+	conflicts := map[string]*types.ConsumerKeyConflict{}
+	for _, assignment := range k.GetAllValidatorConsumerPubKeys(ctx, nil) {
+		if !bytes.Equal(assignment.ProviderAddr, providerAddr.ToSdkConsAddr()) {
+			continue
+		}
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*assignment.ConsumerKey)
+		if err != nil {
+			return nil, err
+		}
+		conflict, found := conflicts[consumerAddr.String()]
+		if !found {
+			conflict = &types.ConsumerKeyConflict{
+				ConsumerAddress: consumerAddr.String(),
+				ConsumerKey:     assignment.ConsumerKey,
+			}
+			conflicts[consumerAddr.String()] = conflict
+		}
+		conflict.ConsumerIds = append(conflict.ConsumerIds, assignment.ChainId)
+	}
+
+	keyConflicts := []types.ConsumerKeyConflict{}
+	for _, conflict := range conflicts {
+		if len(conflict.ConsumerIds) > 1 {
+			sort.Strings(conflict.ConsumerIds)
+			keyConflicts = append(keyConflicts, *conflict)
+		}
+	}
+	sort.Slice(keyConflicts, func(i, j int) bool {
+		return keyConflicts[i].ConsumerAddress < keyConflicts[j].ConsumerAddress
+	})
+
+	return keyConflicts, nil
+}
+
 // DeleteValidatorConsumerPubKey deletes a validator's public key assigned for a consumer chain
 func (k Keeper) DeleteValidatorConsumerPubKey(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerValidatorsKey(consumerId, providerAddr))
 }
 
+// GetKeyAssignmentHeight returns the block height of the last key assignment
+// of a validator on a consumer chain
+func (k Keeper) GetKeyAssignmentHeight(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) (height int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAssignmentHeightKey(consumerId, providerAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetKeyAssignmentHeight sets the block height of the last key assignment
+// of a validator on a consumer chain
+func (k Keeper) SetKeyAssignmentHeight(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	height int64,
+) {
+	store := ctx.KVStore(k.storeKey)
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	store.Set(types.KeyAssignmentHeightKey(consumerId, providerAddr), heightBytes)
+}
+
+// DeleteKeyAssignmentHeight deletes the block height of the last key assignment
+// of a validator on a consumer chain
+func (k Keeper) DeleteKeyAssignmentHeight(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAssignmentHeightKey(consumerId, providerAddr))
+}
+
+// GetAllKeyAssignmentHeights gets the block heights of the last key assignments of all the validators
+// on the consumer chain with `consumerId`, or on all consumer chains if `consumerId` is nil
+func (k Keeper) GetAllKeyAssignmentHeights(ctx sdk.Context, consumerId *string) (heights []types.KeyAssignmentHeight) {
+	store := ctx.KVStore(k.storeKey)
+	var prefix []byte
+	keyAssignmentHeightKeyPrefix := types.KeyAssignmentHeightKeyPrefix()
+	if consumerId == nil {
+		prefix = []byte{keyAssignmentHeightKeyPrefix}
+	} else {
+		prefix = types.StringIdWithLenKey(keyAssignmentHeightKeyPrefix, *consumerId)
+	}
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, providerAddr, err := types.ParseStringIdAndConsAddrKey(keyAssignmentHeightKeyPrefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetKeyAssignmentHeight.
+			panic(err)
+		}
+		heights = append(heights, types.KeyAssignmentHeight{
+			ConsumerId:   consumerId,
+			ProviderAddr: providerAddr,
+			Height:       int64(binary.BigEndian.Uint64(iterator.Value())),
+		})
+	}
+
+	return heights
+}
+
+// DeleteAllKeyAssignmentHeights deletes the block heights of the last key assignments
+// of all the validators on a consumer chain
+func (k Keeper) DeleteAllKeyAssignmentHeights(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.StringIdWithLenKey(types.KeyAssignmentHeightKeyPrefix(), consumerId)
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
 // GetValidatorByConsumerAddr returns a validator's consensus address on the provider
 // given the validator's consensus address on a consumer
 func (k Keeper) GetValidatorByConsumerAddr(
@@ -235,6 +365,75 @@ func (k Keeper) DeleteValidatorByConsumerAddr(ctx sdk.Context, consumerId string
 	store.Delete(types.ValidatorsByConsumerAddrKey(consumerId, consumerAddr))
 }
 
+// GetConsumerAddrReplacementTime returns the time when the given consumer address was replaced
+// on the consumer chain with `consumerId`, i.e., when it was scheduled for pruning
+func (k Keeper) GetConsumerAddrReplacementTime(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerAddrReplacementTimeKey(consumerId, consumerAddr))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the timestamp is assumed to be correctly serialized in SetConsumerAddrReplacementTime.
+		panic(fmt.Errorf("failed to parse consumer address replacement time: %w", err))
+	}
+	return ts, true
+}
+
+// SetConsumerAddrReplacementTime sets the time when the given consumer address was replaced
+// on the consumer chain with `consumerId`
+func (k Keeper) SetConsumerAddrReplacementTime(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+	ts time.Time,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerAddrReplacementTimeKey(consumerId, consumerAddr), sdk.FormatTimeBytes(ts))
+}
+
+// DeleteConsumerAddrReplacementTime deletes the time when the given consumer address was replaced
+// on the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerAddrReplacementTime(ctx sdk.Context, consumerId string, consumerAddr types.ConsumerConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerAddrReplacementTimeKey(consumerId, consumerAddr))
+}
+
+// DeleteAllConsumerAddrReplacementTimes deletes the replacement times of all the consumer addresses
+// on the consumer chain with `consumerId`
+func (k Keeper) DeleteAllConsumerAddrReplacementTimes(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.StringIdWithLenKey(types.ConsumerAddrReplacementTimeKeyPrefix(), consumerId)
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// GetKeyPruningPeriod returns the period after which consumer addresses that are
+// no longer in use are pruned for the consumer chain with `consumerId`, i.e., the
+// KeyPruningPeriod from the consumer's initialization parameters if set, and
+// the unbonding period of the provider otherwise
+func (k Keeper) GetKeyPruningPeriod(ctx sdk.Context, consumerId string) (time.Duration, error) {
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err == nil && initializationParameters.KeyPruningPeriod > 0 {
+		return initializationParameters.KeyPruningPeriod, nil
+	}
+	return k.stakingKeeper.UnbondingTime(ctx)
+}
+
 // AppendConsumerAddrsToPrune appends a consumer validator address to the list of consumer addresses
 // that can be pruned once the block time is at least pruneTs.
 //
@@ -243,6 +442,8 @@ func (k Keeper) DeleteValidatorByConsumerAddr(ctx sdk.Context, consumerId string
 //   - either there exists a provider address pAddr in ValidatorConsumerPubKey,
 //     s.t. hash(ValidatorConsumerPubKey(pAddr)) = cAddr
 //   - or there exists a timestamp in ConsumerAddrsToPrune s.t. cAddr in ConsumerAddrsToPrune(timestamp)
+//
+// Note that a consumer address that is already scheduled for pruning at pruneTs is not appended again.
 func (k Keeper) AppendConsumerAddrsToPrune(
 	ctx sdk.Context,
 	consumerId string,
@@ -261,6 +462,12 @@ func (k Keeper) AppendConsumerAddrsToPrune(
 			panic(err)
 		}
 	}
+	if slices.ContainsFunc(consumerAddrsToPrune.Addresses, func(addr []byte) bool {
+		return bytes.Equal(addr, consumerAddr.ToSdkConsAddr())
+	}) {
+		// the address is already scheduled for pruning at pruneTs, so the invariant still holds
+		return
+	}
 	consumerAddrsToPrune.Addresses = append(consumerAddrsToPrune.Addresses, consumerAddr.ToSdkConsAddr())
 	bz, err := consumerAddrsToPrune.Marshal()
 	if err != nil {
@@ -356,7 +563,9 @@ func (k Keeper) ConsumeConsumerAddrsToPrune(
 //
 // Note that the list of all consumer addresses is stored under keys with the following format:
 // ConsumerAddrsToPruneV2BytePrefix | len(consumerId) | consumerId | timestamp
-// Thus, the returned array is in ascending order of timestamps.
+// and that AppendConsumerAddrsToPrune merges all the addresses with the same timestamp into one list.
+// Thus, the returned array is in strictly ascending order of timestamps, i.e., its order is fully
+// determined by the (PruneTs, ChainId) sort key used by SortConsumerAddrsToPrune.
 func (k Keeper) GetAllConsumerAddrsToPrune(ctx sdk.Context, consumerId string) (consumerAddrsToPrune []types.ConsumerAddrsToPruneV2) {
 	store := ctx.KVStore(k.storeKey)
 	consumerAddrsToPruneKeyPrefix := types.ConsumerAddrsToPruneV2KeyPrefix()
@@ -388,12 +597,125 @@ func (k Keeper) GetAllConsumerAddrsToPrune(ctx sdk.Context, consumerId string) (
 	return consumerAddrsToPrune
 }
 
+// SortConsumerAddrsToPrune sorts the given lists of consumer addresses to prune
+// in ascending order of (PruneTs, ChainId), which is a unique key for every list.
+func SortConsumerAddrsToPrune(consumerAddrsToPrune []types.ConsumerAddrsToPruneV2) {
+	sort.Slice(consumerAddrsToPrune, func(i, j int) bool {
+		if !consumerAddrsToPrune[i].PruneTs.Equal(consumerAddrsToPrune[j].PruneTs) {
+			return consumerAddrsToPrune[i].PruneTs.Before(consumerAddrsToPrune[j].PruneTs)
+		}
+		return consumerAddrsToPrune[i].ChainId < consumerAddrsToPrune[j].ChainId
+	})
+}
+
 // DeleteConsumerAddrsToPrune deletes the list of consumer addresses mapped to a timestamp
 func (k Keeper) DeleteConsumerAddrsToPrune(ctx sdk.Context, consumerId string, pruneTs time.Time) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerAddrsToPruneV2Key(consumerId, pruneTs))
 }
 
+// GetKeyAssignmentStats returns the number of assigned consumer keys and of consumer addresses
+// pending pruning for every consumer chain, in ascending order of consumer ids.
+//
+// Note that only the counts are kept in memory, i.e., neither the assigned consumer keys
+// nor the lists of consumer addresses to prune are materialized.
+func (k Keeper) GetKeyAssignmentStats(ctx sdk.Context) []types.ConsumerKeyAssignmentStats {
+	store := ctx.KVStore(k.storeKey)
+	statsByConsumer := map[string]*types.ConsumerKeyAssignmentStats{}
+	getStats := func(consumerId string) *types.ConsumerKeyAssignmentStats {
+		if _, found := statsByConsumer[consumerId]; !found {
+			statsByConsumer[consumerId] = &types.ConsumerKeyAssignmentStats{ConsumerId: consumerId}
+		}
+		return statsByConsumer[consumerId]
+	}
+
+	consumerValidatorsKeyPrefix := types.ConsumerValidatorsKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{consumerValidatorsKeyPrefix})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, _, err := types.ParseStringIdAndConsAddrKey(consumerValidatorsKeyPrefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetValidatorConsumerPubKey.
+			panic(err)
+		}
+		getStats(consumerId).AssignedKeys++
+	}
+
+	consumerAddrsToPruneKeyPrefix := types.ConsumerAddrsToPruneV2KeyPrefix()
+	pruneIterator := storetypes.KVStorePrefixIterator(store, []byte{consumerAddrsToPruneKeyPrefix})
+	defer pruneIterator.Close()
+	for ; pruneIterator.Valid(); pruneIterator.Next() {
+		consumerId, _, err := types.ParseStringIdAndTsKey(consumerAddrsToPruneKeyPrefix, pruneIterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// store keys are assumed to be correctly serialized in AppendConsumerAddrsToPrune.
+			panic(err)
+		}
+		var addrs types.AddressList
+		if err := addrs.Unmarshal(pruneIterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the list of consumer addresses is assumed to be correctly serialized in AppendConsumerAddrsToPrune.
+			panic(err)
+		}
+		getStats(consumerId).AddrsToPrune += uint64(len(addrs.Addresses))
+	}
+
+	stats := make([]types.ConsumerKeyAssignmentStats, 0, len(statsByConsumer))
+	for _, consumerStats := range statsByConsumer {
+		stats = append(stats, *consumerStats)
+	}
+	// consumer ids are decimal numbers, hence shorter ids are smaller
+	sort.Slice(stats, func(i, j int) bool {
+		if len(stats[i].ConsumerId) != len(stats[j].ConsumerId) {
+			return len(stats[i].ConsumerId) < len(stats[j].ConsumerId)
+		}
+		return stats[i].ConsumerId < stats[j].ConsumerId
+	})
+
+	return stats
+}
+
+// GetKeyAssignmentNonce returns the last nonce of the key assignments processed for
+// the validator with the given operator address, or zero if no nonce was processed
+func (k Keeper) GetKeyAssignmentNonce(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAssignmentNonceKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetKeyAssignmentNonce sets the last nonce of the key assignments processed for
+// the validator with the given operator address
+func (k Keeper) SetKeyAssignmentNonce(ctx sdk.Context, valAddr sdk.ValAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAssignmentNonceKey(valAddr), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetAllKeyAssignmentNonces gets the last nonces of the key assignments processed for all the validators
+func (k Keeper) GetAllKeyAssignmentNonces(ctx sdk.Context) (nonces []types.KeyAssignmentNonce) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.KeyAssignmentNonceKeyPrefix()})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		nonces = append(nonces, types.KeyAssignmentNonce{
+			ValidatorAddr: iterator.Key()[1:],
+			Nonce:         sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return nonces
+}
+
+// DeleteKeyAssignmentNonce deletes the last nonce of the key assignments processed for
+// the validator with the given operator address
+func (k Keeper) DeleteKeyAssignmentNonce(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAssignmentNonceKey(valAddr))
+}
+
 // AssignConsumerKey assigns the consumerKey to the validator with providerAddr
 // on the consumer chain with the given `consumerId`, if it is either registered or currently
 // voted on in a ConsumerAddition governance proposal
@@ -422,12 +744,26 @@ func (k Keeper) AssignConsumerKey(
 	}
 	providerAddr := types.NewProviderConsAddress(consAddrTmp)
 
+	// rate limit the key assignments of a validator on the same consumer chain;
+	// note that the first key assignment is always allowed
+	if minInterval := k.GetKeyAssignmentMinInterval(ctx); minInterval > 0 {
+		if lastHeight, found := k.GetKeyAssignmentHeight(ctx, consumerId, providerAddr); found &&
+			ctx.BlockHeight()-lastHeight < minInterval {
+			return errorsmod.Wrapf(
+				types.ErrKeyAssignmentTooFrequent,
+				"the last key assignment on consumer chain %s was at height %d, the next one is allowed at height %d",
+				consumerId, lastHeight, lastHeight+minInterval,
+			)
+		}
+	}
+
 	if existingVal, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consumerAddr.ToSdkConsAddr()); err == nil {
 		// If there is already a different validator using the consumer key to validate on the provider
 		// we prevent assigning the consumer key.
 		if existingVal.OperatorAddress != validator.OperatorAddress {
 			return errorsmod.Wrapf(
-				types.ErrConsumerKeyInUse, "a different validator already uses the consumer key",
+				types.ErrConsumerKeyIsProviderKey, "validator %s already uses the consumer key on the provider chain",
+				existingVal.OperatorAddress,
 			)
 		}
 		// We prevent a validator from assigning the default provider key as a consumer key
@@ -441,6 +777,17 @@ func (k Keeper) AssignConsumerKey(
 		}
 	}
 
+	// A validator that has already assigned a different consumer key can switch back to
+	// using its provider key on the consumer chain, i.e., to the default key assignment.
+	// Note that the old consumer address is scheduled for pruning by UnassignConsumerKey.
+	if consumerAddr.ToSdkConsAddr().Equals(providerAddr.ToSdkConsAddr()) {
+		if err := k.UnassignConsumerKey(ctx, consumerId, providerAddr); err != nil {
+			return err
+		}
+		k.SetKeyAssignmentHeight(ctx, consumerId, providerAddr, ctx.BlockHeight())
+		return nil
+	}
+
 	if _, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr); found {
 		// This consumer key is already in use, or it is to be pruned. With this check we prevent another validator
 		// from assigning the same consumer key as some other validator. Additionally, we prevent a validator from
@@ -461,18 +808,20 @@ func (k Keeper) AssignConsumerKey(
 		// check whether the consumer chain has already launched (i.e., a client to the consumer was already created)
 		phase := k.GetConsumerPhase(ctx, consumerId)
 		if phase == types.CONSUMER_PHASE_LAUNCHED {
-			// mark the old consumer address as prunable once UnbondingPeriod elapses;
+			// mark the old consumer address as prunable once the key pruning period elapses;
 			// note: this state is removed on EndBlock
-			unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+			pruningPeriod, err := k.GetKeyPruningPeriod(ctx, consumerId)
 			if err != nil {
 				return err
 			}
 			k.AppendConsumerAddrsToPrune(
 				ctx,
 				consumerId,
-				ctx.BlockTime().Add(unbondingPeriod),
+				ctx.BlockTime().Add(pruningPeriod),
 				oldConsumerAddr,
 			)
+			// record the time of the key rotation, as the pruning of the old consumer address may be postponed
+			k.SetConsumerAddrReplacementTime(ctx, consumerId, oldConsumerAddr, ctx.BlockTime())
 		} else {
 			// if the consumer chain is not registered, then remove the mapping
 			// from the old consumer address to the provider address
@@ -490,6 +839,57 @@ func (k Keeper) AssignConsumerKey(
 	// note: this state must be deleted through the pruning mechanism
 	k.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, providerAddr)
 
+	// record the height of this key assignment to rate limit future key assignments
+	k.SetKeyAssignmentHeight(ctx, consumerId, providerAddr, ctx.BlockHeight())
+
+	return nil
+}
+
+// UnassignConsumerKey removes the consumer key assigned by the validator with providerAddr
+// on the consumer chain with the given `consumerId`, e.g., when the validator is tombstoned.
+// Afterwards, the validator uses its provider key on the consumer chain.
+// If the consumer chain is launched, the old consumer address is kept in the reverse index
+// and marked as prunable once the key pruning period elapses, so that it can still be referenced
+// in slash requests; otherwise, the old consumer address is deleted directly.
+// It is a no-op if the validator has not assigned a consumer key.
+func (k Keeper) UnassignConsumerKey(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) error {
+	consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+	if !found {
+		return nil
+	}
+
+	consumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	if err != nil {
+		return err
+	}
+	consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_LAUNCHED {
+		// mark the consumer address as prunable once the key pruning period elapses;
+		// note: this state is removed on EndBlock
+		pruningPeriod, err := k.GetKeyPruningPeriod(ctx, consumerId)
+		if err != nil {
+			return err
+		}
+		k.AppendConsumerAddrsToPrune(
+			ctx,
+			consumerId,
+			ctx.BlockTime().Add(pruningPeriod),
+			consumerAddr,
+		)
+		k.SetConsumerAddrReplacementTime(ctx, consumerId, consumerAddr, ctx.BlockTime())
+	} else {
+		// if the consumer chain is not launched, then remove the mapping
+		// from the consumer address to the provider address
+		k.DeleteValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+	}
+
+	k.DeleteValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+
 	return nil
 }
 
@@ -509,6 +909,84 @@ func (k Keeper) GetProviderAddrFromConsumerAddr(
 	return types.NewProviderConsAddress(consumerAddr.ToSdkConsAddr())
 }
 
+// GetKeyRotationsAfter returns the key rotations of the validators on the consumer chain
+// with the given `consumerId` that took place after the given time, ordered by the time
+// they took place.
+//
+// The key rotations are derived from the consumer addresses scheduled for pruning together
+// with the times they were replaced, which are recorded separately as the pruning of
+// a consumer address may be postponed (see PostponeKeyAssignmentsPruning).
+// The new consumer address of a rotation is the old consumer address of the next rotation
+// of the same validator or, if there is none, the consumer address currently in use.
+// Note that the first key assignment of a validator, i.e., the one replacing its provider key,
+// does not schedule any consumer address for pruning and is thus not returned.
+func (k Keeper) GetKeyRotationsAfter(
+	ctx sdk.Context,
+	consumerId string,
+	after time.Time,
+) ([]types.KeyRotation, error) {
+	type replacedAddr struct {
+		providerAddr types.ProviderConsAddress
+		consumerAddr types.ConsumerConsAddress
+		replacedAt   time.Time
+	}
+	replacedAddrs := []replacedAddr{}
+	for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
+		for _, addrBz := range consumerAddrsToPrune.ConsumerAddrs.Addresses {
+			consumerAddr := types.NewConsumerConsAddress(addrBz)
+			providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+			if !found {
+				// the reverse index of a consumer address is only removed when it is pruned
+				continue
+			}
+			// note that the replacement time is not found for the consumer addresses replaced before
+			// it was recorded, in which case the rotation is considered to have taken place long ago
+			replacedAt, _ := k.GetConsumerAddrReplacementTime(ctx, consumerId, consumerAddr)
+			replacedAddrs = append(replacedAddrs, replacedAddr{
+				providerAddr: providerAddr,
+				consumerAddr: consumerAddr,
+				replacedAt:   replacedAt,
+			})
+		}
+	}
+	// order the replaced addresses as the rotations took place; the prune timestamps
+	// do not preserve this order, e.g., if the key pruning period was changed
+	sort.SliceStable(replacedAddrs, func(i, j int) bool {
+		return replacedAddrs[i].replacedAt.Before(replacedAddrs[j].replacedAt)
+	})
+
+	// iterate backwards to find the consumer address that replaced each address
+	keyRotations := []types.KeyRotation{}
+	nextConsumerAddrs := map[string]types.ConsumerConsAddress{}
+	for i := len(replacedAddrs) - 1; i >= 0; i-- {
+		replaced := replacedAddrs[i]
+		newConsumerAddr, found := nextConsumerAddrs[replaced.providerAddr.String()]
+		if !found {
+			// the replacing address is the one currently in use
+			newConsumerAddr = types.NewConsumerConsAddress(replaced.providerAddr.ToSdkConsAddr())
+			if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, replaced.providerAddr); found {
+				consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+				if err != nil {
+					return nil, err
+				}
+				newConsumerAddr = types.NewConsumerConsAddress(consumerAddr)
+			}
+		}
+		nextConsumerAddrs[replaced.providerAddr.String()] = replaced.consumerAddr
+
+		if replaced.replacedAt.After(after) {
+			keyRotations = append(keyRotations, types.KeyRotation{
+				ProviderAddress:    replaced.providerAddr.String(),
+				OldConsumerAddress: replaced.consumerAddr.String(),
+				NewConsumerAddress: newConsumerAddr.String(),
+			})
+		}
+	}
+	slices.Reverse(keyRotations)
+
+	return keyRotations, nil
+}
+
 // PruneKeyAssignments prunes the consumer addresses no longer needed
 // as they cannot be referenced in slash requests (by a correct consumer)
 func (k Keeper) PruneKeyAssignments(ctx sdk.Context, consumerId string) {
@@ -518,6 +996,7 @@ func (k Keeper) PruneKeyAssignments(ctx sdk.Context, consumerId string) {
 	for _, addrBz := range consumerAddrs.Addresses {
 		consumerAddr := types.NewConsumerConsAddress(addrBz)
 		k.DeleteValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+		k.DeleteConsumerAddrReplacementTime(ctx, consumerId, consumerAddr)
 		k.Logger(ctx).Info("consumer address was pruned",
 			"consumer consumerId", consumerId,
 			"consumer consensus addr", consumerAddr.String(),
@@ -525,6 +1004,178 @@ func (k Keeper) PruneKeyAssignments(ctx sdk.Context, consumerId string) {
 	}
 }
 
+// PostponeKeyAssignmentsPruning postpones to pruneTs the pruning of all the consumer addresses
+// of the consumer chain with `consumerId` that are scheduled to be pruned before pruneTs
+func (k Keeper) PostponeKeyAssignmentsPruning(ctx sdk.Context, consumerId string, pruneTs time.Time) {
+	consumerAddrs := k.ConsumeConsumerAddrsToPrune(ctx, consumerId, pruneTs)
+	for _, addrBz := range consumerAddrs.Addresses {
+		k.AppendConsumerAddrsToPrune(ctx, consumerId, pruneTs, types.NewConsumerConsAddress(addrBz))
+	}
+}
+
+// CheckPruningInvariant checks, on the current state, that every consumer address stored for the consumer chain
+// with `consumerId` in ValidatorByConsumerAddr is either the address of a consumer key currently assigned
+// in ValidatorConsumerPubKey or scheduled for pruning in ConsumerAddrsToPrune, i.e., that no consumer address
+// is kept forever. It returns whether the invariant holds together with the consumer addresses that violate it.
+// The method is read-only and is meant to detect store corruption.
+func (k Keeper) CheckPruningInvariant(ctx sdk.Context, consumerId string) (bool, []string) {
+	willBePruned := map[string]bool{}
+	for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
+		for _, addrBz := range consumerAddrsToPrune.ConsumerAddrs.Addresses {
+			willBePruned[string(addrBz)] = true
+		}
+	}
+
+	isCurrentlyAssigned := map[string]bool{}
+	for _, validatorConsumerPubKey := range k.GetAllValidatorConsumerPubKeys(ctx, &consumerId) {
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*validatorConsumerPubKey.ConsumerKey)
+		if err != nil {
+			continue
+		}
+		isCurrentlyAssigned[string(consumerAddr)] = true
+	}
+
+	violations := []string{}
+	for _, validatorByConsumerAddr := range k.GetAllValidatorsByConsumerAddr(ctx, &consumerId) {
+		addr := string(validatorByConsumerAddr.ConsumerAddr)
+		if willBePruned[addr] || isCurrentlyAssigned[addr] {
+			continue
+		}
+		violations = append(violations, sdk.ConsAddress(validatorByConsumerAddr.ConsumerAddr).String())
+	}
+
+	return len(violations) == 0, violations
+}
+
+// GetAllDefaultKeyUsers returns, for every launched consumer chain in ascending order of the consumer ids,
+// the provider consensus addresses of the validators in the consumer validator set whose public key is their
+// provider consensus key, i.e., the validators that use their provider key on the consumer chain.
+// Note that the consumer validator set is compared rather than the key assignments, as key assignments
+// only take effect on the consumer chain at the end of the epoch in which they are made.
+func (k Keeper) GetAllDefaultKeyUsers(ctx sdk.Context) ([]types.ConsumerDefaultKeyUsers, error) {
+	consumers := []types.ConsumerDefaultKeyUsers{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+
+		consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+		if err != nil {
+			return nil, fmt.Errorf("getting validator set of consumer chain %s: %w", consumerId, err)
+		}
+
+		providerAddrs := []string{}
+		for _, val := range consumerValSet {
+			providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+			validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+			if err != nil {
+				return nil, fmt.Errorf("getting validator %s: %w", providerAddr.String(), err)
+			}
+			providerKey, err := validator.CmtConsPublicKey()
+			if err != nil {
+				return nil, fmt.Errorf("getting consensus public key of validator %s: %w", providerAddr.String(), err)
+			}
+			if !val.PublicKey.Equal(&providerKey) {
+				continue
+			}
+			providerAddrs = append(providerAddrs, providerAddr.String())
+		}
+
+		consumers = append(consumers, types.ConsumerDefaultKeyUsers{
+			ConsumerId:        consumerId,
+			ProviderAddresses: providerAddrs,
+		})
+	}
+
+	return consumers, nil
+}
+
+// EmitUpcomingKeyPruneWarnings emits an event for every prune timestamp of the consumer chain
+// with `consumerId` that is within the key prune warning window from the current block time,
+// listing the consumer addresses that are about to be pruned. The event is emitted only once
+// per prune timestamp. This is purely informational, e.g., for operators of consumer nodes.
+func (k Keeper) EmitUpcomingKeyPruneWarnings(ctx sdk.Context, consumerId string) {
+	window := k.GetKeyPruneWarningWindow(ctx)
+	if window == 0 {
+		return
+	}
+	lastWarnedTs, warned := k.GetLastKeyPruneWarningTs(ctx, consumerId)
+
+	store := ctx.KVStore(k.storeKey)
+	consumerAddrsToPruneKeyPrefix := types.ConsumerAddrsToPruneV2KeyPrefix()
+	iterator := store.Iterator(types.StringIdWithLenKey(consumerAddrsToPruneKeyPrefix, consumerId),
+		storetypes.InclusiveEndBytes(types.ConsumerAddrsToPruneV2Key(consumerId, ctx.BlockTime().Add(window))))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, pruneTs, err := types.ParseStringIdAndTsKey(consumerAddrsToPruneKeyPrefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// store keys are assumed to be correctly serialized in AppendConsumerAddrsToPrune.
+			panic(err)
+		}
+		if warned && !pruneTs.After(lastWarnedTs) {
+			// a warning was already emitted for this prune timestamp
+			continue
+		}
+		var addrs types.AddressList
+		if err := addrs.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the list of consumer addresses is assumed to be correctly serialized in AppendConsumerAddrsToPrune.
+			panic(err)
+		}
+
+		consumerAddrs := make([]string, len(addrs.Addresses))
+		for i, addrBz := range addrs.Addresses {
+			consumerAddrs[i] = sdk.ConsAddress(addrBz).String()
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpcomingKeyPrune,
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributePruneTime, pruneTs.String()),
+				sdk.NewAttribute(types.AttributeConsumerAddresses, strings.Join(consumerAddrs, ",")),
+			),
+		)
+		lastWarnedTs, warned = pruneTs, true
+	}
+
+	if warned {
+		k.SetLastKeyPruneWarningTs(ctx, consumerId, lastWarnedTs)
+	}
+}
+
+// GetLastKeyPruneWarningTs returns the latest prune timestamp for which
+// a warning was emitted on the consumer chain with `consumerId`
+func (k Keeper) GetLastKeyPruneWarningTs(ctx sdk.Context, consumerId string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastKeyPruneWarningTsKey(consumerId))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the timestamp is assumed to be correctly serialized in SetLastKeyPruneWarningTs.
+		panic(fmt.Errorf("failed to parse last key prune warning timestamp: %w", err))
+	}
+	return ts, true
+}
+
+// SetLastKeyPruneWarningTs sets the latest prune timestamp for which
+// a warning was emitted on the consumer chain with `consumerId`
+func (k Keeper) SetLastKeyPruneWarningTs(ctx sdk.Context, consumerId string, ts time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastKeyPruneWarningTsKey(consumerId), sdk.FormatTimeBytes(ts))
+}
+
+// DeleteLastKeyPruneWarningTs deletes the latest prune timestamp for which
+// a warning was emitted on the consumer chain with `consumerId`
+func (k Keeper) DeleteLastKeyPruneWarningTs(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LastKeyPruneWarningTsKey(consumerId))
+}
+
 // DeleteKeyAssignments deletes all the state needed for key assignments on a consumer chain
 func (k Keeper) DeleteKeyAssignments(ctx sdk.Context, consumerId string) {
 	// delete ValidatorConsumerPubKey
@@ -543,6 +1194,15 @@ func (k Keeper) DeleteKeyAssignments(ctx sdk.Context, consumerId string) {
 	for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
 		k.DeleteConsumerAddrsToPrune(ctx, consumerId, consumerAddrsToPrune.PruneTs)
 	}
+
+	// delete ConsumerAddrReplacementTime
+	k.DeleteAllConsumerAddrReplacementTimes(ctx, consumerId)
+
+	// delete KeyAssignmentHeight
+	k.DeleteAllKeyAssignmentHeights(ctx, consumerId)
+
+	// delete LastKeyPruneWarningTs
+	k.DeleteLastKeyPruneWarningTs(ctx, consumerId)
 }
 
 // ValidatorConsensusKeyInUse checks if the given consensus key is already
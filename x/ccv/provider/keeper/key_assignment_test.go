@@ -2,11 +2,14 @@ package keeper_test
 
 import (
 	"bytes"
+	"fmt"
 	"math/rand"
+	"slices"
 	"sort"
 	"testing"
 	"time"
 
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -104,6 +107,47 @@ func TestGetAllValidatorConsumerPubKey(t *testing.T) {
 	require.Len(t, result, len(testAssignments))
 }
 
+// TestGetValidatorKeyConflicts tests that a consumer key assigned by a validator on multiple
+// consumer chains is reported, while keys used on a single consumer chain are not
+func TestGetValidatorKeyConflicts(t *testing.T) {
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	otherProviderAddr := types.NewProviderConsAddress([]byte("otherProviderAddr"))
+	sharedKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	otherKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()
+
+	// no key assignments, no conflicts
+	conflicts, err := keeper.GetValidatorKeyConflicts(ctx, providerAddr)
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+
+	// the validator assigns the same key on consumers "0" and "2", and a different key on consumer "1";
+	// another validator also uses the shared key on consumer "3"
+	keeper.SetValidatorConsumerPubKey(ctx, "2", providerAddr, sharedKey)
+	keeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr, sharedKey)
+	keeper.SetValidatorConsumerPubKey(ctx, "1", providerAddr, otherKey)
+	keeper.SetValidatorConsumerPubKey(ctx, "3", otherProviderAddr, sharedKey)
+
+	sharedConsumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(sharedKey)
+	require.NoError(t, err)
+	conflicts, err = keeper.GetValidatorKeyConflicts(ctx, providerAddr)
+	require.NoError(t, err)
+	require.Equal(t, []types.ConsumerKeyConflict{
+		{
+			ConsumerAddress: sharedConsumerAddr.String(),
+			ConsumerKey:     &sharedKey,
+			ConsumerIds:     []string{"0", "2"},
+		},
+	}, conflicts)
+
+	// the other validator uses the shared key on a single consumer chain
+	conflicts, err = keeper.GetValidatorKeyConflicts(ctx, otherProviderAddr)
+	require.NoError(t, err)
+	require.Empty(t, conflicts)
+}
+
 func TestValidatorByConsumerAddrCRUD(t *testing.T) {
 	chainID := CONSUMER_CHAIN_ID
 	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
@@ -235,6 +279,27 @@ func TestConsumerAddrsToPruneCRUD(t *testing.T) {
 	require.Equal(t, addrsToPrune[0], consumerAddr2.ToSdkConsAddr().Bytes())
 }
 
+// TestAppendConsumerAddrsToPruneNoDuplicates tests that appending the same consumer address
+// twice for the same timestamp stores it only once
+func TestAppendConsumerAddrsToPruneNoDuplicates(t *testing.T) {
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerAddr := types.NewConsumerConsAddress([]byte("consumerAddr"))
+	ts := ctx.BlockTime()
+
+	keeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_CHAIN_ID, ts, consumerAddr)
+	keeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_CHAIN_ID, ts, consumerAddr)
+
+	addrsToPrune := keeper.GetConsumerAddrsToPrune(ctx, CONSUMER_CHAIN_ID, ts).Addresses
+	require.Len(t, addrsToPrune, 1)
+	require.Equal(t, consumerAddr.ToSdkConsAddr().Bytes(), addrsToPrune[0])
+
+	// the address is still appended for a different timestamp
+	keeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_CHAIN_ID, ts.Add(time.Hour), consumerAddr)
+	require.Len(t, keeper.GetConsumerAddrsToPrune(ctx, CONSUMER_CHAIN_ID, ts.Add(time.Hour)).Addresses, 1)
+}
+
 func TestGetAllConsumerAddrsToPrune(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -243,56 +308,64 @@ func TestGetAllConsumerAddrsToPrune(t *testing.T) {
 	rng := rand.New(rand.NewSource(seed))
 
 	chainIDs := []string{"consumer-1", "consumer-2", "consumer-3"}
+	// use only a few timestamps so that many assignments collide,
+	// as it is common when many addresses are appended in one block
+	now := time.Now().UTC()
+	pruneTimestamps := []time.Time{now, now.Add(time.Second), now.Add(time.Hour)}
 	numAssignments := 10
-	testAssignments := []types.ConsumerAddrsToPruneV2{}
+	// the addresses with the same chain id and the same timestamp are merged into one list
+	expectedByKey := map[string]*types.ConsumerAddrsToPruneV2{}
 	for i := 0; i < numAssignments; i++ {
-		consumerAddresses := types.AddressList{}
-		for j := 0; j < 2*(i+1); j++ {
-			addr := cryptotestutil.NewCryptoIdentityFromIntSeed(i * j).SDKValConsAddress()
-			consumerAddresses.Addresses = append(consumerAddresses.Addresses, addr)
-		}
-		testAssignments = append(testAssignments,
-			types.ConsumerAddrsToPruneV2{
-				ChainId:       chainIDs[rng.Intn(len(chainIDs))],
-				PruneTs:       time.Now().UTC(),
-				ConsumerAddrs: &consumerAddresses,
-			},
-		)
-	}
-	// select a consumerId with more than two assignments
-	var chainID string
-	for i := range chainIDs {
-		chainID = chainIDs[i]
-		count := 0
-		for _, assignment := range testAssignments {
-			if assignment.ChainId == chainID {
-				count++
+		chainID := chainIDs[rng.Intn(len(chainIDs))]
+		pruneTs := pruneTimestamps[rng.Intn(len(pruneTimestamps))]
+		key := fmt.Sprintf("%s-%d", chainID, pruneTs.UnixNano())
+		if _, ok := expectedByKey[key]; !ok {
+			expectedByKey[key] = &types.ConsumerAddrsToPruneV2{
+				ChainId:       chainID,
+				PruneTs:       pruneTs,
+				ConsumerAddrs: &types.AddressList{},
 			}
 		}
-		if count > 2 {
-			break
+		for j := 0; j < 2*(i+1); j++ {
+			addr := cryptotestutil.NewCryptoIdentityFromIntSeed(i*numAssignments*2 + j).ConsumerConsAddress()
+			pk.AppendConsumerAddrsToPrune(ctx, chainID, pruneTs, addr)
+			expectedByKey[key].ConsumerAddrs.Addresses = append(expectedByKey[key].ConsumerAddrs.Addresses, addr.ToSdkConsAddr())
 		}
 	}
-	expectedGetAllOrder := []types.ConsumerAddrsToPruneV2{}
-	for _, assignment := range testAssignments {
-		if assignment.ChainId == chainID {
-			expectedGetAllOrder = append(expectedGetAllOrder, assignment)
-		}
+	expected := []types.ConsumerAddrsToPruneV2{}
+	for _, consumerAddrsToPrune := range expectedByKey {
+		expected = append(expected, *consumerAddrsToPrune)
+	}
+	// sorting by (PruneTs, ChainId)
+	providerkeeper.SortConsumerAddrsToPrune(expected)
+	for i := 1; i < len(expected); i++ {
+		require.True(t, expected[i-1].PruneTs.Before(expected[i].PruneTs) ||
+			(expected[i-1].PruneTs.Equal(expected[i].PruneTs) && expected[i-1].ChainId < expected[i].ChainId))
 	}
-	// sorting by ConsumerAddrsToPrune.PruneTs
-	sort.Slice(expectedGetAllOrder, func(i, j int) bool {
-		return expectedGetAllOrder[i].PruneTs.Before(expectedGetAllOrder[j].PruneTs)
-	})
 
-	for _, assignment := range testAssignments {
-		for _, addr := range assignment.ConsumerAddrs.Addresses {
-			consumerAddr := types.NewConsumerConsAddress(addr)
-			pk.AppendConsumerAddrsToPrune(ctx, assignment.ChainId, assignment.PruneTs, consumerAddr)
+	result := []types.ConsumerAddrsToPruneV2{}
+	for _, chainID := range chainIDs {
+		expectedForChain := []types.ConsumerAddrsToPruneV2{}
+		for _, consumerAddrsToPrune := range expected {
+			if consumerAddrsToPrune.ChainId == chainID {
+				expectedForChain = append(expectedForChain, consumerAddrsToPrune)
+			}
+		}
+		resultForChain := pk.GetAllConsumerAddrsToPrune(ctx, chainID)
+		if len(expectedForChain) == 0 {
+			require.Empty(t, resultForChain)
+		} else {
+			require.Equal(t, expectedForChain, resultForChain)
 		}
+		result = append(result, resultForChain...)
 	}
 
-	result := pk.GetAllConsumerAddrsToPrune(ctx, chainID)
-	require.Equal(t, expectedGetAllOrder, result)
+	// the order is stable regardless of the order in which the consumer chains are iterated
+	providerkeeper.SortConsumerAddrsToPrune(result)
+	require.Equal(t, expected, result)
+	slices.Reverse(result)
+	providerkeeper.SortConsumerAddrsToPrune(result)
+	require.Equal(t, expected, result)
 }
 
 // checkCorrectPruningProperty checks that the pruning property is correct for a given
@@ -312,7 +385,7 @@ func checkCorrectPruningProperty(ctx sdk.Context, k providerkeeper.Keeper, chain
 	}
 
 	good := true
-	for _, valByConsAddr := range k.GetAllValidatorsByConsumerAddr(ctx, nil) {
+	for _, valByConsAddr := range k.GetAllValidatorsByConsumerAddr(ctx, &chainID) {
 		valByConsAddr := valByConsAddr // Fix linter error G601
 		if _, ok := willBePruned[string(valByConsAddr.ConsumerAddr)]; ok {
 			// Address will be pruned, everything is fine.
@@ -339,6 +412,42 @@ func checkCorrectPruningProperty(ctx sdk.Context, k providerkeeper.Keeper, chain
 	return good
 }
 
+// TestQueryPruningInvariant tests that the pruning invariant query reports
+// the consumer addresses that are neither assigned nor scheduled for pruning
+func TestQueryPruningInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryPruningInvariant(ctx, &types.QueryPruningInvariantRequest{ConsumerId: CONSUMER_ID})
+	require.Error(t, err)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+
+	// the validator is currently assigned a consumer key and its previous consumer address is scheduled for pruning
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	currentConsumerId := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	previousConsumerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress()
+	providerKeeper.SetValidatorConsumerPubKey(ctx, CONSUMER_ID, providerAddr, currentConsumerId.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, currentConsumerId.ConsumerConsAddress(), providerAddr)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, previousConsumerAddr, providerAddr)
+	pruneTs := ctx.BlockTime().Add(time.Hour)
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, pruneTs, previousConsumerAddr)
+
+	res, err := providerKeeper.QueryPruningInvariant(ctx, &types.QueryPruningInvariantRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.True(t, res.Holds)
+	require.Empty(t, res.ViolatingConsumerAddrs)
+	require.True(t, checkCorrectPruningProperty(ctx, providerKeeper, CONSUMER_ID))
+
+	// corrupt the state by deleting the prune entry of the previous consumer address
+	providerKeeper.DeleteConsumerAddrsToPrune(ctx, CONSUMER_ID, pruneTs)
+
+	res, err = providerKeeper.QueryPruningInvariant(ctx, &types.QueryPruningInvariantRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.False(t, res.Holds)
+	require.Equal(t, []string{previousConsumerAddr.ToSdkConsAddr().String()}, res.ViolatingConsumerAddrs)
+	require.False(t, checkCorrectPruningProperty(ctx, providerKeeper, CONSUMER_ID))
+}
+
 func TestAssignConsensusKeyForConsumerChain(t *testing.T) {
 	consumerId := "0"
 	providerIdentities := []*cryptotestutil.CryptoIdentity{
@@ -457,7 +566,7 @@ func TestAssignConsensusKeyForConsumerChain(t *testing.T) {
 					providerIdentities[1].SDKStakingValidator(),
 					consumerIdentities[0].TMProtoCryptoPublicKey(),
 				)
-				require.Error(t, err)
+				require.ErrorIs(t, err, types.ErrConsumerKeyInUse)
 				providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId,
 					consumerIdentities[0].ConsumerConsAddress())
 				require.True(t, found)
@@ -479,7 +588,7 @@ func TestAssignConsensusKeyForConsumerChain(t *testing.T) {
 					providerIdentities[1].SDKStakingValidator(),
 					providerIdentities[0].TMProtoCryptoPublicKey(),
 				)
-				require.Error(t, err)
+				require.ErrorIs(t, err, types.ErrConsumerKeyIsProviderKey)
 			},
 		},
 		{
@@ -557,7 +666,7 @@ func TestAssignConsensusKeyForConsumerChain(t *testing.T) {
 					providerIdentities[1].SDKStakingValidator(),
 					consumerIdentities[0].TMProtoCryptoPublicKey(),
 				)
-				require.Error(t, err)
+				require.ErrorIs(t, err, types.ErrConsumerKeyInUse)
 				providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId,
 					consumerIdentities[0].ConsumerConsAddress())
 				require.True(t, found)
@@ -579,7 +688,7 @@ func TestAssignConsensusKeyForConsumerChain(t *testing.T) {
 					providerIdentities[1].SDKStakingValidator(),
 					providerIdentities[0].TMProtoCryptoPublicKey(),
 				)
-				require.Error(t, err)
+				require.ErrorIs(t, err, types.ErrConsumerKeyIsProviderKey)
 			},
 		},
 	}
@@ -627,6 +736,392 @@ func TestCannotReassignDefaultKeyAssignment(t *testing.T) {
 	require.Equal(t, "a validator cannot assign the default key assignment unless its key on that consumer has already been assigned: cannot re-assign default key assignment", err.Error())
 }
 
+// TestReassignProviderKeyAfterKeyAssignment tests that a validator that has assigned a consumer key
+// can switch back to using its provider key on the consumer chain
+func TestReassignProviderKeyAfterKeyAssignment(t *testing.T) {
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(49827489)
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(49827490)
+	providerAddr := providerIdentity.ProviderConsAddress()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+
+	// the provider key PK0 is used by the validator on the provider, while CK1 is not used by any validator
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), providerIdentity.SDKValConsAddress()).
+		Return(providerIdentity.SDKStakingValidator(), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consumerIdentity.SDKValConsAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+
+	// assign CK1
+	ctx = ctx.WithBlockHeight(100)
+	err := providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+		providerIdentity.SDKStakingValidator(), consumerIdentity.TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+
+	// assign the provider key PK0
+	ctx = ctx.WithBlockHeight(101)
+	err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+		providerIdentity.SDKStakingValidator(), providerIdentity.TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+
+	// the validator uses its provider key on the consumer chain
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, found)
+	require.Equal(t, providerAddr, providerKeeper.GetProviderAddrFromConsumerAddr(ctx, CONSUMER_ID,
+		types.NewConsumerConsAddress(providerIdentity.SDKValConsAddress())))
+
+	// CK1 is scheduled for pruning, but it can still be referenced until then
+	consumerAddr := consumerIdentity.ConsumerConsAddress()
+	addrsToPrune := providerKeeper.GetConsumerAddrsToPrune(ctx, CONSUMER_ID, ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, [][]byte{consumerAddr.ToSdkConsAddr()}, addrsToPrune.Addresses)
+	gotProviderAddr, found := providerKeeper.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, consumerAddr)
+	require.True(t, found)
+	require.Equal(t, providerAddr, gotProviderAddr)
+
+	// the provider key cannot be assigned again as it is already used by default
+	ctx = ctx.WithBlockHeight(102)
+	err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+		providerIdentity.SDKStakingValidator(), providerIdentity.TMProtoCryptoPublicKey())
+	require.ErrorIs(t, err, types.ErrCannotAssignDefaultKeyAssignment)
+}
+
+// TestKeyAssignmentMinInterval tests that a validator cannot reassign its consumer key
+// on the same consumer chain more often than the key assignment min interval allows
+func TestKeyAssignmentMinInterval(t *testing.T) {
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentities := cryptotestutil.GenMultipleCryptoIds(3, 1)
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.KeyAssignmentMinInterval = 10
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_INITIALIZED)
+
+	// the consumer keys are not used by any validator on the provider
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	// the first key assignment is always allowed
+	ctx = ctx.WithBlockHeight(100)
+	err := providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+		providerIdentity.SDKStakingValidator(), consumerIdentities[0].TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+
+	// an immediate reassignment is rejected
+	ctx = ctx.WithBlockHeight(109)
+	err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+		providerIdentity.SDKStakingValidator(), consumerIdentities[1].TMProtoCryptoPublicKey())
+	require.ErrorIs(t, err, types.ErrKeyAssignmentTooFrequent)
+	consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, CONSUMER_ID, providerIdentity.ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, consumerIdentities[0].TMProtoCryptoPublicKey(), consumerKey)
+
+	// a reassignment after the min interval is allowed
+	ctx = ctx.WithBlockHeight(110)
+	err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+		providerIdentity.SDKStakingValidator(), consumerIdentities[2].TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+	consumerKey, found = providerKeeper.GetValidatorConsumerPubKey(ctx, CONSUMER_ID, providerIdentity.ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, consumerIdentities[2].TMProtoCryptoPublicKey(), consumerKey)
+	height, found := providerKeeper.GetKeyAssignmentHeight(ctx, CONSUMER_ID, providerIdentity.ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, int64(110), height)
+
+	// the heights are deleted together with the key assignments
+	providerKeeper.DeleteKeyAssignments(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetKeyAssignmentHeight(ctx, CONSUMER_ID, providerIdentity.ProviderConsAddress())
+	require.False(t, found)
+}
+
+// TestUnassignConsumerKey tests that the consumer key of a tombstoned validator can be unassigned
+// and that the pruning property still holds afterwards
+func TestUnassignConsumerKey(t *testing.T) {
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	providerAddr := providerIdentity.ProviderConsAddress()
+	consumerAddr := consumerIdentity.ConsumerConsAddress()
+
+	for _, phase := range []types.ConsumerPhase{
+		types.CONSUMER_PHASE_INITIALIZED,
+		types.CONSUMER_PHASE_LAUNCHED,
+	} {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		unbondingPeriod := 21 * 24 * time.Hour
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx,
+			consumerIdentity.SDKValConsAddress(),
+		).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound)
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(unbondingPeriod, nil).AnyTimes()
+
+		providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, phase)
+
+		// unassigning without an assigned key is a no-op
+		err := providerKeeper.UnassignConsumerKey(ctx, CONSUMER_ID, providerAddr)
+		require.NoError(t, err)
+
+		err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+			providerIdentity.SDKStakingValidator(),
+			consumerIdentity.TMProtoCryptoPublicKey(),
+		)
+		require.NoError(t, err)
+		require.True(t, checkCorrectPruningProperty(ctx, providerKeeper, CONSUMER_ID))
+
+		// the validator is tombstoned and hence its consumer key is unassigned
+		err = providerKeeper.UnassignConsumerKey(ctx, CONSUMER_ID, providerAddr)
+		require.NoError(t, err)
+		require.True(t, checkCorrectPruningProperty(ctx, providerKeeper, CONSUMER_ID))
+
+		_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, CONSUMER_ID, providerAddr)
+		require.False(t, found)
+
+		_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, consumerAddr)
+		if phase == types.CONSUMER_PHASE_LAUNCHED {
+			// the consumer address can still be referenced until it is pruned
+			require.True(t, found)
+			pruneTs := ctx.BlockTime().Add(unbondingPeriod)
+			require.Equal(t,
+				types.AddressList{Addresses: [][]byte{consumerAddr.ToSdkConsAddr()}},
+				providerKeeper.GetConsumerAddrsToPrune(ctx, CONSUMER_ID, pruneTs),
+			)
+
+			ctx = ctx.WithBlockTime(pruneTs)
+			providerKeeper.PruneKeyAssignments(ctx, CONSUMER_ID)
+			_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, consumerAddr)
+		}
+		require.False(t, found)
+		require.Empty(t, providerKeeper.GetAllConsumerAddrsToPrune(ctx, CONSUMER_ID))
+		require.True(t, checkCorrectPruningProperty(ctx, providerKeeper, CONSUMER_ID))
+
+		// the validator uses its provider key on the consumer chain
+		require.Equal(t, providerAddr,
+			providerKeeper.GetProviderAddrFromConsumerAddr(ctx, CONSUMER_ID,
+				types.NewConsumerConsAddress(providerAddr.ToSdkConsAddr())))
+
+		ctrl.Finish()
+	}
+}
+
+// TestKeyPruningPeriod tests that the consumer addresses of a consumer chain with a
+// KeyPruningPeriod are pruned after that period instead of the unbonding period of the provider
+func TestKeyPruningPeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	keyPruningPeriod := 7 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, gomock.Any()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(unbondingPeriod, nil).AnyTimes()
+
+	// consumer "0" uses the unbonding period of the provider,
+	// while consumer "1" uses a shorter key pruning period
+	defaultConsumerId, overrideConsumerId := "0", "1"
+	for _, consumerId := range []string{defaultConsumerId, overrideConsumerId} {
+		providerKeeper.SetConsumerChainId(ctx, consumerId, "chain"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	}
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.KeyPruningPeriod = keyPruningPeriod
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, overrideConsumerId, initializationParameters)
+	require.NoError(t, err)
+
+	pruningPeriod, err := providerKeeper.GetKeyPruningPeriod(ctx, defaultConsumerId)
+	require.NoError(t, err)
+	require.Equal(t, unbondingPeriod, pruningPeriod)
+	pruningPeriod, err = providerKeeper.GetKeyPruningPeriod(ctx, overrideConsumerId)
+	require.NoError(t, err)
+	require.Equal(t, keyPruningPeriod, pruningPeriod)
+
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	providerAddr := providerIdentity.ProviderConsAddress()
+	consumerAddr := consumerIdentity.ConsumerConsAddress()
+	for _, consumerId := range []string{defaultConsumerId, overrideConsumerId} {
+		err = providerKeeper.AssignConsumerKey(ctx, consumerId,
+			providerIdentity.SDKStakingValidator(),
+			consumerIdentity.TMProtoCryptoPublicKey(),
+		)
+		require.NoError(t, err)
+		err = providerKeeper.UnassignConsumerKey(ctx, consumerId, providerAddr)
+		require.NoError(t, err)
+	}
+
+	// once the key pruning period elapses, only the consumer address of consumer "1" is pruned
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(keyPruningPeriod))
+	for _, consumerId := range []string{defaultConsumerId, overrideConsumerId} {
+		providerKeeper.PruneKeyAssignments(ctx, consumerId)
+		require.True(t, checkCorrectPruningProperty(ctx, providerKeeper, consumerId))
+	}
+	_, found := providerKeeper.GetValidatorByConsumerAddr(ctx, defaultConsumerId, consumerAddr)
+	require.True(t, found)
+	_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, overrideConsumerId, consumerAddr)
+	require.False(t, found)
+
+	// once the unbonding period elapses, the consumer address of consumer "0" is pruned as well
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingPeriod - keyPruningPeriod))
+	providerKeeper.PruneKeyAssignments(ctx, defaultConsumerId)
+	_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, defaultConsumerId, consumerAddr)
+	require.False(t, found)
+	require.True(t, checkCorrectPruningProperty(ctx, providerKeeper, defaultConsumerId))
+}
+
+// TestEmitUpcomingKeyPruneWarnings tests that a warning event is emitted exactly once
+// for every prune timestamp once it is within the key prune warning window
+func TestEmitUpcomingKeyPruneWarnings(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	window := time.Hour
+	params := providerKeeper.GetParams(ctx)
+	params.KeyPruneWarningWindow = window
+	providerKeeper.SetParams(ctx, params)
+
+	now := time.Now().UTC()
+	pruneTs := now.Add(3 * time.Hour)
+	consumerAddrs := []types.ConsumerConsAddress{
+		cryptotestutil.NewCryptoIdentityFromIntSeed(0).ConsumerConsAddress(),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress(),
+	}
+	for _, consumerAddr := range consumerAddrs {
+		providerKeeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, pruneTs, consumerAddr)
+	}
+
+	// counts the warning events emitted while advancing the block time by the given durations
+	countWarnings := func(durations ...time.Duration) (events []sdk.Event) {
+		for _, d := range durations {
+			now = now.Add(d)
+			ctx = ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+			providerKeeper.EmitUpcomingKeyPruneWarnings(ctx, CONSUMER_ID)
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeUpcomingKeyPrune {
+					events = append(events, event)
+				}
+			}
+		}
+		return events
+	}
+
+	// no warning is emitted before the prune timestamp is within the window
+	require.Empty(t, countWarnings(time.Hour, 59*time.Minute))
+
+	// the warning is emitted once the prune timestamp is within the window, and only once
+	events := countWarnings(time.Minute, time.Minute, 30*time.Minute)
+	require.Len(t, events, 1)
+	require.Equal(t, sdk.NewEvent(types.EventTypeUpcomingKeyPrune,
+		sdk.NewAttribute(types.AttributeConsumerId, CONSUMER_ID),
+		sdk.NewAttribute(types.AttributePruneTime, pruneTs.String()),
+		sdk.NewAttribute(types.AttributeConsumerAddresses,
+			consumerAddrs[0].String()+","+consumerAddrs[1].String()),
+	), events[0])
+
+	// a later prune timestamp is warned about separately
+	laterPruneTs := pruneTs.Add(time.Hour)
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, laterPruneTs,
+		cryptotestutil.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress())
+	require.Empty(t, countWarnings(time.Minute))
+	events = countWarnings(time.Hour, time.Minute)
+	require.Len(t, events, 1)
+	require.Equal(t, laterPruneTs.String(), events[0].Attributes[1].Value)
+
+	// no warning is emitted if the window is zero
+	params.KeyPruneWarningWindow = 0
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, now,
+		cryptotestutil.NewCryptoIdentityFromIntSeed(3).ConsumerConsAddress())
+	require.Empty(t, countWarnings(0))
+}
+
+// TestRecentKeyAssignments tests that the key rotations of a validator are returned
+// only for the VSC packets sent before the rotations took place
+func TestRecentKeyAssignments(t *testing.T) {
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentities := cryptotestutil.GenMultipleCryptoIds(3, 1)
+	providerAddr := providerIdentity.ProviderConsAddress()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21*24*time.Hour, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "CCVChannelID").
+		Return(channeltypes.Channel{}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccvtypes.ProviderPortID, "CCVChannelID",
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil).AnyTimes()
+
+	// in every block, the validator assigns the next consumer key and a VSC packet is sent
+	for i, consumerIdentity := range consumerIdentities {
+		ctx = ctx.WithBlockHeight(int64(10 * (i + 1))).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		err := providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID,
+			providerIdentity.SDKStakingValidator(), consumerIdentity.TMProtoCryptoPublicKey())
+		require.NoError(t, err)
+
+		providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccvtypes.ValidatorSetChangePacketData{ValsetUpdateId: uint64(i + 1)})
+		err = providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID")
+		require.NoError(t, err)
+	}
+
+	rotation := func(oldIdx, newIdx int) types.KeyRotation {
+		return types.KeyRotation{
+			ProviderAddress:    providerAddr.String(),
+			OldConsumerAddress: consumerIdentities[oldIdx].SDKValConsAddress().String(),
+			NewConsumerAddress: consumerIdentities[newIdx].SDKValConsAddress().String(),
+		}
+	}
+
+	// the first assignment replaces the provider key and is thus not a key rotation
+	testCases := []struct {
+		sinceVscId        uint64
+		expectedRotations []types.KeyRotation
+	}{
+		{sinceVscId: 1, expectedRotations: []types.KeyRotation{rotation(0, 1), rotation(1, 2)}},
+		{sinceVscId: 2, expectedRotations: []types.KeyRotation{rotation(1, 2)}},
+		{sinceVscId: 3, expectedRotations: []types.KeyRotation{}},
+	}
+	for _, tc := range testCases {
+		res, err := providerKeeper.QueryRecentKeyAssignments(ctx, &types.QueryRecentKeyAssignmentsRequest{
+			ConsumerId: CONSUMER_ID,
+			SinceVscId: tc.sinceVscId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedRotations, res.KeyRotations, "since vsc id %d", tc.sinceVscId)
+	}
+
+	// postponing the pruning of the replaced consumer addresses does not change the key rotations
+	providerKeeper.PostponeKeyAssignmentsPruning(ctx, CONSUMER_ID, ctx.BlockTime().Add(30*24*time.Hour))
+	for _, tc := range testCases {
+		res, err := providerKeeper.QueryRecentKeyAssignments(ctx, &types.QueryRecentKeyAssignmentsRequest{
+			ConsumerId: CONSUMER_ID,
+			SinceVscId: tc.sinceVscId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedRotations, res.KeyRotations, "since vsc id %d after postponing", tc.sinceVscId)
+	}
+
+	// the replacement times are deleted once the consumer addresses are pruned
+	providerKeeper.PruneKeyAssignments(ctx.WithBlockTime(ctx.BlockTime().Add(30*24*time.Hour)), CONSUMER_ID)
+	for _, consumerIdentity := range consumerIdentities[:2] {
+		_, found := providerKeeper.GetConsumerAddrReplacementTime(ctx, CONSUMER_ID, consumerIdentity.ConsumerConsAddress())
+		require.False(t, found)
+	}
+
+	// no VSC packet with the given id was sent
+	_, err := providerKeeper.QueryRecentKeyAssignments(ctx, &types.QueryRecentKeyAssignmentsRequest{
+		ConsumerId: CONSUMER_ID,
+		SinceVscId: 4,
+	})
+	require.Error(t, err)
+}
+
 // Represents the validator set of a chain
 type ValSet struct {
 	identities []*cryptotestutil.CryptoIdentity
@@ -965,3 +1460,155 @@ func TestSimulatedAssignmentsAndUpdateApplication(t *testing.T) {
 		runRandomExecution()
 	}
 }
+
+// TestKeyAssignmentAndPowerChangeInSameBlock tests that, when a validator assigns a new consumer key and
+// its power changes in the same block, the VSC packet queued at the end of the block removes the previous
+// consumer key and adds the new consumer key with the new power
+func TestKeyAssignmentAndPowerChangeInSameBlock(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// the validator has power 1 on the consumer chain and uses its provider key
+	validator := createStakingValidator(ctx, mocks, 2, 0) // the power changed to 2 during the block
+	providerConsAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	providerAddr := types.NewProviderConsAddress(providerConsAddr)
+	providerKey, err := validator.CmtConsPublicKey()
+	require.NoError(t, err)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), providerConsAddr).Return(validator, nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{validator}, -1)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, types.PowerShapingParameters{})
+	require.NoError(t, err)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr)
+	err = providerKeeper.SetConsumerValidator(ctx, CONSUMER_ID, types.ConsensusValidator{
+		ProviderConsAddr: providerConsAddr,
+		Power:            1,
+		PublicKey:        &providerKey,
+	})
+	require.NoError(t, err)
+
+	// the validator assigns a new consumer key during the same block
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	consumerKey := consumerIdentity.TMProtoCryptoPublicKey()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consumerIdentity.SDKValConsAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID, validator, consumerKey)
+	require.NoError(t, err)
+
+	// at the end of the block, a single VSC packet removes the previous key and adds the new key with the new power
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pendingPackets, 1)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: providerKey, Power: 0},
+		{PubKey: consumerKey, Power: 2},
+	}, pendingPackets[0].ValidatorUpdates)
+
+	// the consumer validator set uses the new key with the new power
+	consumerValidator, found := providerKeeper.GetConsumerValidator(ctx, CONSUMER_ID, providerAddr)
+	require.True(t, found)
+	require.Equal(t, consumerKey, *consumerValidator.PublicKey)
+	require.Equal(t, int64(2), consumerValidator.Power)
+}
+
+// TestQueryKeyAssignmentStats tests that the numbers of assigned consumer keys and of
+// consumer addresses pending pruning are counted correctly across consumer chains
+func TestQueryKeyAssignmentStats(t *testing.T) {
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := func(seed int) types.ProviderConsAddress {
+		return types.NewProviderConsAddress(cryptotestutil.NewCryptoIdentityFromIntSeed(seed).SDKValConsAddress())
+	}
+	consumerKey := func(seed int) tmprotocrypto.PublicKey {
+		return cryptotestutil.NewCryptoIdentityFromIntSeed(seed).TMProtoCryptoPublicKey()
+	}
+	consumerAddr := func(seed int) types.ConsumerConsAddress {
+		return types.NewConsumerConsAddress(cryptotestutil.NewCryptoIdentityFromIntSeed(seed).SDKValConsAddress())
+	}
+
+	// no key assignments
+	res, err := keeper.QueryKeyAssignmentStats(ctx, &types.QueryKeyAssignmentStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryKeyAssignmentStatsResponse{ConsumerStats: []types.ConsumerKeyAssignmentStats{}}, res)
+
+	// three keys are assigned on consumer "0" and one on consumer "10"
+	keeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr(1), consumerKey(11))
+	keeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr(2), consumerKey(12))
+	keeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr(3), consumerKey(13))
+	keeper.SetValidatorConsumerPubKey(ctx, "10", providerAddr(1), consumerKey(14))
+
+	// two addresses of consumer "0" are pending pruning at the same time, and
+	// three addresses of consumer "10" are pending pruning at different times
+	pruneTs := time.Now().UTC()
+	keeper.AppendConsumerAddrsToPrune(ctx, "0", pruneTs, consumerAddr(21))
+	keeper.AppendConsumerAddrsToPrune(ctx, "0", pruneTs, consumerAddr(22))
+	keeper.AppendConsumerAddrsToPrune(ctx, "10", pruneTs, consumerAddr(23))
+	keeper.AppendConsumerAddrsToPrune(ctx, "10", pruneTs.Add(time.Hour), consumerAddr(24))
+	keeper.AppendConsumerAddrsToPrune(ctx, "10", pruneTs.Add(time.Hour), consumerAddr(25))
+
+	res, err = keeper.QueryKeyAssignmentStats(ctx, &types.QueryKeyAssignmentStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryKeyAssignmentStatsResponse{
+		TotalAssignedKeys: 4,
+		TotalAddrsToPrune: 5,
+		ConsumerStats: []types.ConsumerKeyAssignmentStats{
+			{ConsumerId: "0", AssignedKeys: 3, AddrsToPrune: 2},
+			{ConsumerId: "10", AssignedKeys: 1, AddrsToPrune: 3},
+		},
+	}, res)
+
+	_, err = keeper.QueryKeyAssignmentStats(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryValidatorAllConsumerKeys(t *testing.T) {
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := types.NewProviderConsAddress(cryptotestutil.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress())
+	otherProviderAddr := types.NewProviderConsAddress(cryptotestutil.NewCryptoIdentityFromIntSeed(2).SDKValConsAddress())
+	consumerIdentity0 := cryptotestutil.NewCryptoIdentityFromIntSeed(10)
+	consumerIdentity1 := cryptotestutil.NewCryptoIdentityFromIntSeed(11)
+
+	query := func(addr string) *types.QueryValidatorAllConsumerKeysResponse {
+		res, err := keeper.QueryValidatorAllConsumerKeys(ctx, &types.QueryValidatorAllConsumerKeysRequest{ProviderAddress: addr})
+		require.NoError(t, err)
+		return res
+	}
+
+	// no key assignments
+	require.Empty(t, query(providerAddr.String()).ConsumerKeys)
+
+	// the validator assigns keys on consumers "0" and "1", while another validator assigns a key on consumer "0"
+	consumerKey0 := consumerIdentity0.TMProtoCryptoPublicKey()
+	consumerKey1 := consumerIdentity1.TMProtoCryptoPublicKey()
+	keeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr, consumerKey0)
+	keeper.SetValidatorConsumerPubKey(ctx, "1", providerAddr, consumerKey1)
+	keeper.SetValidatorConsumerPubKey(ctx, "0", otherProviderAddr, cryptotestutil.NewCryptoIdentityFromIntSeed(12).TMProtoCryptoPublicKey())
+
+	require.Equal(t, []types.AssignedConsumerKey{
+		{
+			ConsumerId:      "0",
+			ConsumerAddress: consumerIdentity0.SDKValConsAddress().String(),
+			ConsumerKey:     &consumerKey0,
+		},
+		{
+			ConsumerId:      "1",
+			ConsumerAddress: consumerIdentity1.SDKValConsAddress().String(),
+			ConsumerKey:     &consumerKey1,
+		},
+	}, query(providerAddr.String()).ConsumerKeys)
+	require.Len(t, query(otherProviderAddr.String()).ConsumerKeys, 1)
+
+	// invalid requests
+	_, err := keeper.QueryValidatorAllConsumerKeys(ctx, &types.QueryValidatorAllConsumerKeysRequest{ProviderAddress: "invalid"})
+	require.Error(t, err)
+	_, err = keeper.QueryValidatorAllConsumerKeys(ctx, nil)
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -65,6 +66,14 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 		return nil, err
 	}
 
+	// the nonce is optional; if set, it protects against replaying the message
+	if msg.Nonce != 0 {
+		if lastNonce := k.GetKeyAssignmentNonce(ctx, providerValidatorAddr); msg.Nonce <= lastNonce {
+			return nil, errorsmod.Wrapf(types.ErrStaleKeyAssignmentNonce,
+				"nonce (%d) must be greater than the last processed nonce (%d)", msg.Nonce, lastNonce)
+		}
+	}
+
 	consumerTMPublicKey, err := k.ParseConsumerKey(msg.ConsumerKey)
 	if err != nil {
 		return nil, err
@@ -74,6 +83,10 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 		return nil, err
 	}
 
+	if msg.Nonce != 0 {
+		k.SetKeyAssignmentNonce(ctx, providerValidatorAddr, msg.Nonce)
+	}
+
 	chainId, err := k.GetConsumerChainId(ctx, msg.ConsumerId)
 	if err != nil {
 		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
@@ -121,6 +134,238 @@ func (k msgServer) ChangeRewardDenoms(goCtx context.Context, msg *types.MsgChang
 	return &types.MsgChangeRewardDenomsResponse{}, nil
 }
 
+// SetSlashPacketsPaused defines a rpc handler method for MsgSetSlashPacketsPaused
+func (k msgServer) SetSlashPacketsPaused(goCtx context.Context, msg *types.MsgSetSlashPacketsPaused) (*types.MsgSetSlashPacketsPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if !k.IsConsumerActive(ctx, msg.ConsumerId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot pause the slash packets of a chain that is not active: %s", msg.ConsumerId)
+	}
+
+	k.Keeper.SetSlashPacketsPaused(ctx, msg.ConsumerId, msg.Paused)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetSlashPacketsPaused,
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeSlashPacketsPaused, strconv.FormatBool(msg.Paused)),
+		),
+	)
+
+	return &types.MsgSetSlashPacketsPausedResponse{}, nil
+}
+
+// SetConsumerSlashMode defines a rpc handler method for MsgSetConsumerSlashMode
+func (k msgServer) SetConsumerSlashMode(goCtx context.Context, msg *types.MsgSetConsumerSlashMode) (*types.MsgSetConsumerSlashModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if !k.IsConsumerActive(ctx, msg.ConsumerId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot set the slash mode of a chain that is not active: %s", msg.ConsumerId)
+	}
+
+	k.Keeper.SetConsumerSlashMode(ctx, msg.ConsumerId, msg.SlashMode)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConsumerSlashMode,
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeSlashMode, msg.SlashMode.String()),
+		),
+	)
+
+	return &types.MsgSetConsumerSlashModeResponse{}, nil
+}
+
+// SetVSCSendingPaused defines a rpc handler method for MsgSetVSCSendingPaused
+func (k msgServer) SetVSCSendingPaused(goCtx context.Context, msg *types.MsgSetVSCSendingPaused) (*types.MsgSetVSCSendingPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if !k.IsConsumerActive(ctx, msg.ConsumerId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot pause the VSC packets of a chain that is not active: %s", msg.ConsumerId)
+	}
+
+	if !msg.Paused && k.IsVSCSendingPaused(ctx, msg.ConsumerId) {
+		// the consumer chain learns of the key assignments made while the sending was paused
+		// only once it is resumed, so the consumer addresses replaced in the meantime must be
+		// kept for at least a key pruning period from now
+		keyPruningPeriod, err := k.GetKeyPruningPeriod(ctx, msg.ConsumerId)
+		if err != nil {
+			return nil, err
+		}
+		k.PostponeKeyAssignmentsPruning(ctx, msg.ConsumerId, ctx.BlockTime().Add(keyPruningPeriod))
+	}
+
+	k.Keeper.SetVSCSendingPaused(ctx, msg.ConsumerId, msg.Paused)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetVSCSendingPaused,
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeVSCSendingPaused, strconv.FormatBool(msg.Paused)),
+		),
+	)
+
+	return &types.MsgSetVSCSendingPausedResponse{}, nil
+}
+
+// RemoveConsumers defines a rpc handler method for MsgRemoveConsumers
+func (k msgServer) RemoveConsumers(goCtx context.Context, msg *types.MsgRemoveConsumers) (*types.MsgRemoveConsumersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.StopAndPrepareForConsumersRemoval(ctx, msg.ConsumerIds, msg.StopTime); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMsgRemoveConsumers, "cannot remove consumers: %s", err.Error())
+	}
+
+	for _, consumerId := range msg.ConsumerIds {
+		// the chain id exists, as it is checked in StopAndPrepareForConsumersRemoval
+		chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+
+		k.Logger(ctx).Info("stopped consumer by governance",
+			"consumerId", consumerId,
+			"chainId", chainId,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveConsumer,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+				sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Authority),
+			),
+		)
+	}
+
+	return &types.MsgRemoveConsumersResponse{}, nil
+}
+
+// RemoveConsumerKeyAssignment defines a rpc handler method for MsgRemoveConsumerKeyAssignment
+func (k msgServer) RemoveConsumerKeyAssignment(goCtx context.Context, msg *types.MsgRemoveConsumerKeyAssignment) (*types.MsgRemoveConsumerKeyAssignmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	providerAddrTmp, err := sdk.ConsAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+
+	if _, found := k.GetValidatorConsumerPubKey(ctx, msg.ConsumerId, providerAddr); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMsgRemoveConsumerKeyAssignment,
+			"validator %s has no consumer key assigned on consumer chain %s", msg.ProviderAddr, msg.ConsumerId)
+	}
+
+	if err := k.Keeper.UnassignConsumerKey(ctx, msg.ConsumerId, providerAddr); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("consumer key assignment removed by governance",
+		"consumerId", msg.ConsumerId,
+		"provider cons addr", msg.ProviderAddr,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnassignConsumerKey,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+		),
+	)
+
+	return &types.MsgRemoveConsumerKeyAssignmentResponse{}, nil
+}
+
+// CancelPendingDowntimeSlash defines a rpc handler method for MsgCancelPendingDowntimeSlash
+func (k msgServer) CancelPendingDowntimeSlash(goCtx context.Context, msg *types.MsgCancelPendingDowntimeSlash) (*types.MsgCancelPendingDowntimeSlashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// besides governance, the owner of the consumer chain can cancel a deferred downtime slash packet,
+	// so that it can be cancelled within a grace period that is shorter than the voting period
+	if k.GetAuthority() != msg.Authority {
+		ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, msg.ConsumerId)
+		if err != nil || ownerAddress != msg.Authority {
+			return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s or the owner of consumer chain %s, got %s",
+				k.GetAuthority(), msg.ConsumerId, msg.Authority)
+		}
+	}
+
+	providerAddrTmp, err := sdk.ConsAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+
+	// the deferred downtime slash packet is stored under the consumer address of the validator
+	// at the time of the infraction, which may differ from its current consumer address
+	for _, pending := range k.GetPendingDowntimeSlashes(ctx, msg.ConsumerId) {
+		consumerAddr := types.NewConsumerConsAddress(pending.SlashPacketData.Validator.Address)
+		if addr := k.GetProviderAddrFromConsumerAddr(ctx, msg.ConsumerId, consumerAddr); !addr.ToSdkConsAddr().Equals(providerAddr.ToSdkConsAddr()) {
+			continue
+		}
+		k.Keeper.CancelPendingDowntimeSlash(ctx, msg.ConsumerId, consumerAddr)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCancelDowntimeSlash,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+				sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+			),
+		)
+		return &types.MsgCancelPendingDowntimeSlashResponse{}, nil
+	}
+
+	return nil, errorsmod.Wrapf(types.ErrNoPendingDowntimeSlash,
+		"validator %s has no deferred downtime slash packet from consumer chain %s", msg.ProviderAddr, msg.ConsumerId)
+}
+
+// ResendConsumerValidatorSet defines a rpc handler method for MsgResendConsumerValidatorSet
+func (k msgServer) ResendConsumerValidatorSet(goCtx context.Context, msg *types.MsgResendConsumerValidatorSet) (*types.MsgResendConsumerValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.QueueFullVSCPacket(ctx, msg.ConsumerId); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMsgResendConsumerValidatorSet,
+			"cannot resend the validator set of consumer chain %s: %s", msg.ConsumerId, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResendConsumerValSet,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+		),
+	)
+
+	return &types.MsgResendConsumerValidatorSetResponse{}, nil
+}
+
 func (k msgServer) SubmitConsumerMisbehaviour(goCtx context.Context, msg *types.MsgSubmitConsumerMisbehaviour) (*types.MsgSubmitConsumerMisbehaviourResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.Keeper.HandleConsumerMisbehaviour(ctx, msg.ConsumerId, *msg.Misbehaviour); err != nil {
@@ -425,6 +670,31 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 			"cannot set consumer infraction parameters: %s", err.Error())
 	}
 
+	// the consumer chain to inherit from is optional; if set, it must be stopped and
+	// owned by the submitter, unless the submitter is the governance module
+	if msg.InheritFromConsumerId != "" {
+		if phase := k.Keeper.GetConsumerPhase(ctx, msg.InheritFromConsumerId); phase != types.CONSUMER_PHASE_STOPPED {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
+				"cannot inherit from consumer chain (%s) in phase %s", msg.InheritFromConsumerId, phase)
+		}
+		inheritedOwner, err := k.Keeper.GetConsumerOwnerAddress(ctx, msg.InheritFromConsumerId)
+		if err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress,
+				"cannot retrieve owner address of consumer chain (%s): %s", msg.InheritFromConsumerId, err.Error())
+		}
+		if msg.Submitter != inheritedOwner && msg.Submitter != k.GetAuthority() {
+			return &resp, errorsmod.Wrapf(types.ErrUnauthorized,
+				"cannot inherit from consumer chain (%s): expected owner address %s or %s, got %s",
+				msg.InheritFromConsumerId, inheritedOwner, k.GetAuthority(), msg.Submitter)
+		}
+		k.Keeper.SetInheritedConsumerId(ctx, consumerId, msg.InheritFromConsumerId)
+	}
+
+	if err := k.Keeper.ValidateSpawnTime(ctx, consumerId, initializationParameters.SpawnTime); err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
+			"invalid spawn time: %s", err.Error())
+	}
+
 	if spawnTime, initialized := k.Keeper.InitializeConsumer(ctx, consumerId); initialized {
 		if err := k.Keeper.PrepareConsumerForLaunch(ctx, consumerId, time.Time{}, spawnTime); err != nil {
 			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
@@ -523,11 +793,9 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 	// The new owner address can be empty, in which case the consumer chain does not change its owner.
 	// However, if the new owner address is not empty, we verify that it's a valid account address.
 	if strings.TrimSpace(msg.NewOwnerAddress) != "" {
-		if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.NewOwnerAddress); err != nil {
-			return &resp, errorsmod.Wrapf(types.ErrInvalidNewOwnerAddress, "invalid new owner address %s", msg.NewOwnerAddress)
+		if err := k.setConsumerOwner(ctx, consumerId, msg.NewOwnerAddress); err != nil {
+			return &resp, err
 		}
-
-		k.Keeper.SetConsumerOwnerAddress(ctx, consumerId, msg.NewOwnerAddress)
 	}
 
 	if msg.Metadata != nil {
@@ -555,9 +823,20 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 					"do not provide any initialization parameters when updating a launched chain")
 		}
 
+		if err := k.Keeper.ValidateSpawnTime(ctx, consumerId, msg.InitializationParameters.SpawnTime); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
+				"invalid spawn time: %s", err.Error())
+		}
+
 		phase := k.GetConsumerPhase(ctx, consumerId)
 		if msg.InitializationParameters.SpawnTime.IsZero() {
 			if phase == types.CONSUMER_PHASE_INITIALIZED {
+				// the launch can only be cancelled before the spawn time, as afterwards
+				// the consumer chain is launched at the beginning of the next block
+				if !ctx.BlockTime().Before(previousSpawnTime) {
+					return &resp, errorsmod.Wrapf(types.ErrInvalidMsgUpdateConsumer,
+						"cannot cancel the launch of a consumer chain after its spawn time: %s", previousSpawnTime)
+				}
 				// chain was previously ready to launch at `previousSpawnTime` so we remove the
 				// consumer from getting launched and move it back to the Registered phase
 				err = k.RemoveConsumerToBeLaunched(ctx, consumerId, previousSpawnTime)
@@ -643,6 +922,14 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 
 	}
 
+	if msg.CcvTimeoutPeriod != nil {
+		if *msg.CcvTimeoutPeriod == 0 {
+			k.Keeper.DeleteConsumerCCVTimeoutPeriod(ctx, consumerId)
+		} else {
+			k.Keeper.SetConsumerCCVTimeoutPeriod(ctx, consumerId, *msg.CcvTimeoutPeriod)
+		}
+	}
+
 	// A Top N cannot change its owner address to something different from the gov module if the chain
 	// remains a Top N chain.
 	currentOwnerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
@@ -655,9 +942,8 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot retrieve power shaping parameters: %s", err.Error())
 	}
 
-	if currentPowerShapingParameters.Top_N != 0 && currentOwnerAddress != k.GetAuthority() {
-		return &resp, errorsmod.Wrapf(types.ErrInvalidTransformToOptIn,
-			"a move to a new owner address that is not the gov module can only be done if `Top N` is set to 0")
+	if err := k.validateConsumerOwner(currentOwnerAddress, currentPowerShapingParameters.Top_N); err != nil {
+		return &resp, err
 	}
 
 	if spawnTime, initialized := k.Keeper.InitializeConsumer(ctx, consumerId); initialized {
@@ -747,3 +1033,125 @@ func (k msgServer) RemoveConsumer(goCtx context.Context, msg *types.MsgRemoveCon
 
 	return &resp, err
 }
+
+// ResumeConsumer resumes a stopped consumer chain whose state has not been removed yet
+func (k msgServer) ResumeConsumer(goCtx context.Context, msg *types.MsgResumeConsumer) (*types.MsgResumeConsumerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgResumeConsumerResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if err := k.Keeper.ResumeConsumer(ctx, consumerId); err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidMsgResumeConsumer,
+			"cannot resume consumer chain %s: %s", consumerId, err.Error())
+	}
+
+	k.Logger(ctx).Info("resumed consumer",
+		"consumerId", consumerId,
+		"chainId", chainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeConsumer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// TransferConsumerOwnership defines a rpc handler method for MsgTransferConsumerOwnership
+func (k msgServer) TransferConsumerOwnership(goCtx context.Context, msg *types.MsgTransferConsumerOwnership) (*types.MsgTransferConsumerOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgTransferConsumerOwnershipResponse{}
+
+	consumerId := msg.ConsumerId
+	if !k.Keeper.IsConsumerActive(ctx, consumerId) {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot transfer the ownership of a consumer chain that is not in the registered, initialized, or launched phase: %s", consumerId)
+	}
+
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	// as with MsgUpdateConsumer, a Top N chain must be owned by the gov module
+	powerShapingParameters, err := k.Keeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot retrieve power shaping parameters: %s", err.Error())
+	}
+	if err := k.validateConsumerOwner(msg.NewOwner, powerShapingParameters.Top_N); err != nil {
+		return &resp, err
+	}
+
+	if err := k.setConsumerOwner(ctx, consumerId, msg.NewOwner); err != nil {
+		return &resp, err
+	}
+
+	k.Logger(ctx).Info("transferred consumer ownership",
+		"consumerId", consumerId,
+		"chainId", chainId,
+		"owner", msg.NewOwner,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferConsumerOwnership,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+			sdk.NewAttribute(types.AttributeConsumerOwner, msg.NewOwner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// setConsumerOwner validates the new owner address and sets it as the owner of the consumer chain with `consumerId`.
+// It is used by both MsgUpdateConsumer and MsgTransferConsumerOwnership.
+func (k msgServer) setConsumerOwner(ctx sdk.Context, consumerId, newOwner string) error {
+	if _, err := k.accountKeeper.AddressCodec().StringToBytes(newOwner); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidNewOwnerAddress, "invalid new owner address %s", newOwner)
+	}
+	k.Keeper.SetConsumerOwnerAddress(ctx, consumerId, newOwner)
+	return nil
+}
+
+// validateConsumerOwner checks that a Top N chain (i.e., `topN` is not zero) is owned by the gov module
+func (k msgServer) validateConsumerOwner(ownerAddress string, topN uint32) error {
+	if topN != 0 && ownerAddress != k.GetAuthority() {
+		return errorsmod.Wrapf(types.ErrInvalidTransformToOptIn,
+			"a move to a new owner address that is not the gov module can only be done if `Top N` is set to 0")
+	}
+	return nil
+}
@@ -1,20 +1,29 @@
 package keeper_test
 
 import (
+	"encoding/base64"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 func TestCreateConsumer(t *testing.T) {
@@ -73,6 +82,18 @@ func TestCreateConsumer(t *testing.T) {
 	require.Equal(t, "submitter2", ownerAddress)
 	phase = providerKeeper.GetConsumerPhase(ctx, "1")
 	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, phase)
+
+	// cannot create a consumer chain with a spawn time too far in the past
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	_, err = msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter3", ChainId: "chainId", Metadata: consumerMetadata,
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{
+				SpawnTime: ctx.BlockTime().Add(-providertypes.SpawnTimePastTolerance - time.Second),
+			},
+			PowerShapingParameters: &providertypes.PowerShapingParameters{},
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerInitializationParameters)
 }
 
 func TestUpdateConsumer(t *testing.T) {
@@ -382,3 +403,628 @@ func TestUpdateConsumer(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedInitializationParameters, actualInitializationParameters)
 }
+
+// TestCancelConsumerLaunch tests that a scheduled launch can be cancelled with a MsgUpdateConsumer
+// setting the spawn time to zero before the spawn time, but not afterwards
+func TestCancelConsumerLaunch(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21*24*time.Hour, nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	scheduleLaunch := func(spawnTime time.Time) (string, providertypes.ConsumerInitializationParameters) {
+		initParams := testkeeper.GetTestInitializationParameters()
+		initParams.SpawnTime = spawnTime
+		res, err := msgServer.CreateConsumer(ctx, &providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId", Metadata: testkeeper.GetTestConsumerMetadata(),
+			InitializationParameters: &initParams,
+		})
+		require.NoError(t, err)
+		require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, res.ConsumerId))
+		return res.ConsumerId, initParams
+	}
+	cancelLaunch := func(ctx sdk.Context, consumerId string, initParams providertypes.ConsumerInitializationParameters) error {
+		initParams.SpawnTime = time.Time{}
+		_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId, InitializationParameters: &initParams,
+		})
+		return err
+	}
+
+	// cancel the launch before the spawn time
+	spawnTime := ctx.BlockTime().Add(time.Hour)
+	consumerId, initParams := scheduleLaunch(spawnTime)
+	require.NoError(t, cancelLaunch(ctx, consumerId, initParams))
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	// the consumer chain is not launched once the original spawn time passed
+	err := providerKeeper.BeginBlockLaunchConsumers(ctx.WithBlockTime(spawnTime.Add(time.Second)))
+	require.NoError(t, err)
+	_, found := providerKeeper.GetConsumerClientId(ctx, consumerId)
+	require.False(t, found)
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	// the launch cannot be cancelled once the spawn time passed
+	consumerId, initParams = scheduleLaunch(spawnTime)
+	err = cancelLaunch(ctx.WithBlockTime(spawnTime), consumerId, initParams)
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgUpdateConsumer)
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+}
+
+func TestSetSlashPacketsPaused(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := "0"
+
+	// only governance can pause the slash packets of a consumer chain
+	_, err := msgServer.SetSlashPacketsPaused(ctx, &providertypes.MsgSetSlashPacketsPaused{
+		ConsumerId: consumerId, Paused: true, Authority: "invalid authority",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the consumer chain has to be active
+	_, err = msgServer.SetSlashPacketsPaused(ctx, &providertypes.MsgSetSlashPacketsPaused{
+		ConsumerId: consumerId, Paused: true, Authority: providerKeeper.GetAuthority(),
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+	require.False(t, providerKeeper.IsSlashPacketsPaused(ctx, consumerId))
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.SetSlashPacketsPaused(ctx, &providertypes.MsgSetSlashPacketsPaused{
+		ConsumerId: consumerId, Paused: true, Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsSlashPacketsPaused(ctx, consumerId))
+
+	// resume the handling of the slash packets
+	_, err = msgServer.SetSlashPacketsPaused(ctx, &providertypes.MsgSetSlashPacketsPaused{
+		ConsumerId: consumerId, Paused: false, Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsSlashPacketsPaused(ctx, consumerId))
+}
+
+func TestSetConsumerSlashMode(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := "0"
+
+	// only governance can set the slash mode of a consumer chain
+	_, err := msgServer.SetConsumerSlashMode(ctx, &providertypes.MsgSetConsumerSlashMode{
+		ConsumerId: consumerId, SlashMode: providertypes.SLASH_MODE_RECORD_ONLY, Authority: "invalid authority",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the consumer chain has to be active
+	_, err = msgServer.SetConsumerSlashMode(ctx, &providertypes.MsgSetConsumerSlashMode{
+		ConsumerId: consumerId, SlashMode: providertypes.SLASH_MODE_RECORD_ONLY, Authority: providerKeeper.GetAuthority(),
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+	require.Equal(t, providertypes.SLASH_MODE_JAIL, providerKeeper.GetConsumerSlashMode(ctx, consumerId))
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.SetConsumerSlashMode(ctx, &providertypes.MsgSetConsumerSlashMode{
+		ConsumerId: consumerId, SlashMode: providertypes.SLASH_MODE_RECORD_ONLY, Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.SLASH_MODE_RECORD_ONLY, providerKeeper.GetConsumerSlashMode(ctx, consumerId))
+
+	// switch back to the default slash mode
+	_, err = msgServer.SetConsumerSlashMode(ctx, &providertypes.MsgSetConsumerSlashMode{
+		ConsumerId: consumerId, SlashMode: providertypes.SLASH_MODE_JAIL, Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.SLASH_MODE_JAIL, providerKeeper.GetConsumerSlashMode(ctx, consumerId))
+}
+
+func TestSetVSCSendingPaused(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod, nil).AnyTimes()
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := "0"
+
+	// only governance can pause the sending of VSC packets to a consumer chain
+	_, err := msgServer.SetVSCSendingPaused(ctx, &providertypes.MsgSetVSCSendingPaused{
+		ConsumerId: consumerId, Paused: true, Authority: "invalid authority",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the consumer chain has to be active
+	_, err = msgServer.SetVSCSendingPaused(ctx, &providertypes.MsgSetVSCSendingPaused{
+		ConsumerId: consumerId, Paused: true, Authority: providerKeeper.GetAuthority(),
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+	require.False(t, providerKeeper.IsVSCSendingPaused(ctx, consumerId))
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.SetVSCSendingPaused(ctx, &providertypes.MsgSetVSCSendingPaused{
+		ConsumerId: consumerId, Paused: true, Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsVSCSendingPaused(ctx, consumerId))
+
+	// a consumer address replaced while the sending is paused is not pruned
+	consumerAddr := providertypes.NewConsumerConsAddress([]byte{0x01})
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, providertypes.NewProviderConsAddress([]byte{0x02}))
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, consumerId, ctx.BlockTime().Add(time.Hour), consumerAddr)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	providerKeeper.EndBlockCIS(ctx)
+	_, found := providerKeeper.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+	require.True(t, found)
+
+	// resume the sending of VSC packets
+	_, err = msgServer.SetVSCSendingPaused(ctx, &providertypes.MsgSetVSCSendingPaused{
+		ConsumerId: consumerId, Paused: false, Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsVSCSendingPaused(ctx, consumerId))
+
+	// the pruning of the consumer address is postponed by an unbonding period from the resumption
+	pruneTs := ctx.BlockTime().Add(unbondingPeriod).UTC()
+	consumerAddrsToPrune := providerKeeper.GetAllConsumerAddrsToPrune(ctx, consumerId)
+	require.Len(t, consumerAddrsToPrune, 1)
+	require.Equal(t, pruneTs, consumerAddrsToPrune[0].PruneTs)
+	require.Equal(t, [][]byte{consumerAddr.ToSdkConsAddr()}, consumerAddrsToPrune[0].ConsumerAddrs.Addresses)
+}
+
+func TestRemoveConsumers(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod, nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	for _, consumerId := range []string{"0", "1", "2"} {
+		providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	}
+	requireLaunched := func() {
+		for _, consumerId := range []string{"0", "1", "2"} {
+			require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+			_, err := providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+			require.Error(t, err)
+		}
+	}
+
+	// only governance can remove several consumer chains at once
+	_, err := msgServer.RemoveConsumers(ctx, &providertypes.MsgRemoveConsumers{
+		ConsumerIds: []string{"0", "1", "2"}, Authority: "invalid authority",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	requireLaunched()
+
+	// a consumer chain that does not exist reverts the removal of all the consumer chains
+	_, err = msgServer.RemoveConsumers(ctx, &providertypes.MsgRemoveConsumers{
+		ConsumerIds: []string{"0", "1", "2", "3"}, Authority: providerKeeper.GetAuthority(),
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgRemoveConsumers)
+	requireLaunched()
+
+	// so does a consumer chain that is already scheduled to be removed
+	providerKeeper.SetConsumerChainId(ctx, "3", "chain-3")
+	providerKeeper.SetConsumerPhase(ctx, "3", providertypes.CONSUMER_PHASE_STOPPED)
+	_, err = msgServer.RemoveConsumers(ctx, &providertypes.MsgRemoveConsumers{
+		ConsumerIds: []string{"0", "3", "1", "2"}, Authority: providerKeeper.GetAuthority(),
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgRemoveConsumers)
+	requireLaunched()
+
+	// so does a stop time before the unbonding period elapses
+	_, err = msgServer.RemoveConsumers(ctx, &providertypes.MsgRemoveConsumers{
+		ConsumerIds: []string{"0", "1", "2"}, Authority: providerKeeper.GetAuthority(),
+		StopTime: ctx.BlockTime().Add(unbondingPeriod - time.Hour),
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgRemoveConsumers)
+	requireLaunched()
+
+	// remove three consumer chains at once
+	_, err = msgServer.RemoveConsumers(ctx, &providertypes.MsgRemoveConsumers{
+		ConsumerIds: []string{"0", "1", "2"}, Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	expectedRemovalTime := ctx.BlockTime().Add(unbondingPeriod)
+	for _, consumerId := range []string{"0", "1", "2"} {
+		require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+		removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+		require.NoError(t, err)
+		require.Equal(t, expectedRemovalTime, removalTime)
+	}
+	consumersToBeRemoved, err := providerKeeper.GetConsumersToBeRemoved(ctx, expectedRemovalTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0", "1", "2"}, consumersToBeRemoved.Ids)
+
+	// the state of the consumer chains is removed at the stop time, if set
+	stopTime := ctx.BlockTime().Add(unbondingPeriod + time.Hour)
+	for _, consumerId := range []string{"4", "5"} {
+		providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	}
+	_, err = msgServer.RemoveConsumers(ctx, &providertypes.MsgRemoveConsumers{
+		ConsumerIds: []string{"4", "5"}, Authority: providerKeeper.GetAuthority(), StopTime: stopTime,
+	})
+	require.NoError(t, err)
+	for _, consumerId := range []string{"4", "5"} {
+		require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+		removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+		require.NoError(t, err)
+		require.Equal(t, stopTime, removalTime)
+	}
+	consumersToBeRemoved, err = providerKeeper.GetConsumersToBeRemoved(ctx, stopTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{"4", "5"}, consumersToBeRemoved.Ids)
+}
+
+func TestRemoveConsumerKeyAssignment(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := "0"
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	providerAddr := providerIdentity.ProviderConsAddress()
+	consumerAddr := consumerIdentity.ConsumerConsAddress()
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx,
+		consumerIdentity.SDKValConsAddress(),
+	).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(unbondingPeriod, nil).AnyTimes()
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.AssignConsumerKey(ctx, consumerId,
+		providerIdentity.SDKStakingValidator(),
+		consumerIdentity.TMProtoCryptoPublicKey(),
+	)
+	require.NoError(t, err)
+
+	// only governance can remove the consumer key assignment of a validator
+	_, err = msgServer.RemoveConsumerKeyAssignment(ctx, &providertypes.MsgRemoveConsumerKeyAssignment{
+		ConsumerId: consumerId, ProviderAddr: providerAddr.String(), Authority: "invalid authority",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+	require.True(t, found)
+
+	_, err = msgServer.RemoveConsumerKeyAssignment(ctx, &providertypes.MsgRemoveConsumerKeyAssignment{
+		ConsumerId: consumerId, ProviderAddr: providerAddr.String(), Authority: providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+
+	// the validator uses its provider key on the consumer chain
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+	require.False(t, found)
+	require.Equal(t, providerAddr, providerKeeper.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerAddr))
+	require.Equal(t,
+		[][]byte{consumerAddr.ToSdkConsAddr()},
+		providerKeeper.GetConsumerAddrsToPrune(ctx, consumerId, ctx.BlockTime().Add(unbondingPeriod)).Addresses,
+	)
+	require.True(t, checkCorrectPruningProperty(ctx, providerKeeper, consumerId))
+
+	// the consumer key assignment cannot be removed twice
+	_, err = msgServer.RemoveConsumerKeyAssignment(ctx, &providertypes.MsgRemoveConsumerKeyAssignment{
+		ConsumerId: consumerId, ProviderAddr: providerAddr.String(), Authority: providerKeeper.GetAuthority(),
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgRemoveConsumerKeyAssignment)
+}
+
+func TestResendConsumerValidatorSet(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := "0"
+	msg := &providertypes.MsgResendConsumerValidatorSet{
+		ConsumerId: consumerId,
+		Authority:  providerKeeper.GetAuthority(),
+	}
+
+	var valSet []providertypes.ConsensusValidator
+	for i := 0; i < 3; i++ {
+		cryptoId := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+		publicKey := cryptoId.TMProtoCryptoPublicKey()
+		valSet = append(valSet, providertypes.ConsensusValidator{
+			ProviderConsAddr: cryptoId.SDKValConsAddress(),
+			Power:            int64(i + 1),
+			PublicKey:        &publicKey,
+		})
+	}
+	err := providerKeeper.SetConsumerValSet(ctx, consumerId, valSet)
+	require.NoError(t, err)
+
+	// the consumer chain is not launched
+	_, err = msgServer.ResendConsumerValidatorSet(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgResendConsumerValidatorSet)
+
+	// the consumer chain has no established CCV channel
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.ResendConsumerValidatorSet(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgResendConsumerValidatorSet)
+
+	// the CCV channel of the consumer chain is not open
+	providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channel-0")
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "channel-0").
+			Return(channeltypes.Channel{State: channeltypes.CLOSED}, true),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "channel-0").
+			Return(channeltypes.Channel{State: channeltypes.OPEN}, true).AnyTimes(),
+	)
+	_, err = msgServer.ResendConsumerValidatorSet(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgResendConsumerValidatorSet)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, consumerId))
+
+	// only governance can resend the validator set
+	_, err = msgServer.ResendConsumerValidatorSet(ctx, &providertypes.MsgResendConsumerValidatorSet{
+		ConsumerId: consumerId,
+		Authority:  "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, consumerId))
+
+	// the entire validator set is queued even though no staking change occurred,
+	// together with the pending slash acks of the consumer chain
+	providerKeeper.AppendSlashAck(ctx, consumerId, "cosmosvalcons1ack")
+	valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+	_, err = msgServer.ResendConsumerValidatorSet(ctx, msg)
+	require.NoError(t, err)
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, consumerId)
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, valUpdateID, pendingPackets[0].ValsetUpdateId)
+	require.Equal(t, []string{"cosmosvalcons1ack"}, pendingPackets[0].SlashAcks)
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, consumerId))
+	require.Len(t, pendingPackets[0].ValidatorUpdates, len(valSet))
+	for _, val := range valSet {
+		require.Contains(t, pendingPackets[0].ValidatorUpdates, abci.ValidatorUpdate{PubKey: *val.PublicKey, Power: val.Power})
+	}
+
+	// the valset update id of the packet is mapped to the next block height and not reused
+	height, found := providerKeeper.GetValsetUpdateBlockHeight(ctx, valUpdateID)
+	require.True(t, found)
+	require.Equal(t, uint64(ctx.BlockHeight())+1, height)
+	require.Equal(t, valUpdateID+1, providerKeeper.GetValidatorSetUpdateId(ctx))
+	_, err = msgServer.ResendConsumerValidatorSet(ctx, msg)
+	require.NoError(t, err)
+	pendingPackets = providerKeeper.GetPendingVSCPackets(ctx, consumerId)
+	require.Len(t, pendingPackets, 2)
+	require.Equal(t, valUpdateID+1, pendingPackets[1].ValsetUpdateId)
+}
+
+func TestResumeConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := "0"
+	owner := "owner"
+	msg := &providertypes.MsgResumeConsumer{ConsumerId: consumerId, Owner: owner}
+
+	// mock 2 bonded validators
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	valAPubKey, _ := valA.CmtConsPublicKey()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valAConsAddr).Return(valA, nil).AnyTimes()
+	valB := createStakingValidator(ctx, mocks, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	valBPubKey, _ := valB.CmtConsPublicKey()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valBConsAddr).Return(valB, nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 2, []stakingtypes.Validator{valA, valB}, -1)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21*24*time.Hour, nil).AnyTimes()
+
+	// set up a launched consumer chain with an open CCV channel and validator A as its only consumer validator
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, owner)
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain1")
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channel-0")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "channel-0").
+		Return(channeltypes.Channel{State: channeltypes.OPEN}, true).AnyTimes()
+	providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(valAConsAddr))
+	err = providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+		ProviderConsAddr: valAConsAddr,
+		Power:            1,
+		PublicKey:        &valAPubKey,
+	})
+	require.NoError(t, err)
+
+	// a launched consumer chain cannot be resumed
+	_, err = msgServer.ResumeConsumer(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgResumeConsumer)
+
+	// stop the consumer chain
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: consumerId, Owner: owner})
+	require.NoError(t, err)
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+	require.NoError(t, err)
+
+	// no VSC packets are queued for the stopped consumer chain, even though validator B opted in
+	providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(valBConsAddr))
+	require.NoError(t, providerKeeper.QueueVSCPackets(ctx))
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, consumerId))
+
+	// a stopped consumer chain that another consumer chain inherits from cannot be resumed
+	providerKeeper.SetInheritedConsumerId(ctx, "1", consumerId)
+	require.Equal(t, []string{"1"}, providerKeeper.GetInheritingConsumerIds(ctx, consumerId))
+	_, err = msgServer.ResumeConsumer(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgResumeConsumer)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	providerKeeper.DeleteInheritedConsumerId(ctx, "1")
+
+	// only the owner can resume the consumer chain
+	_, err = msgServer.ResumeConsumer(ctx, &providertypes.MsgResumeConsumer{ConsumerId: consumerId, Owner: "notOwner"})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// resume the consumer chain before its state is removed
+	_, err = msgServer.ResumeConsumer(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	_, err = providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+	require.Error(t, err)
+	consumersToBeRemoved, err := providerKeeper.GetConsumersToBeRemoved(ctx, removalTime)
+	require.NoError(t, err)
+	require.Empty(t, consumersToBeRemoved.Ids)
+
+	// the entire consumer validator set is resent
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, consumerId)
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: valAPubKey, Power: 1}}, pendingPackets[0].ValidatorUpdates)
+
+	// the VSC flow resumes and validator B is added to the consumer validator set
+	require.NoError(t, providerKeeper.QueueVSCPackets(ctx))
+	pendingPackets = providerKeeper.GetPendingVSCPackets(ctx, consumerId)
+	require.Len(t, pendingPackets, 2)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: valBPubKey, Power: 2}}, pendingPackets[1].ValidatorUpdates)
+	// the VSC packets have distinct valset update ids
+	require.Less(t, pendingPackets[0].ValsetUpdateId, pendingPackets[1].ValsetUpdateId)
+
+	// a stopped consumer chain whose state was removed cannot be resumed
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: consumerId, Owner: owner})
+	require.NoError(t, err)
+	providerKeeper.DeleteConsumerRemovalTime(ctx, consumerId)
+	_, err = msgServer.ResumeConsumer(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgResumeConsumer)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+}
+
+// TestAssignConsumerKeyNonce tests that a key assignment with a nonce is rejected
+// unless the nonce is greater than the last nonce processed for the validator
+func TestAssignConsumerKeyNonce(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-1")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	validator := providerIdentity.SDKStakingValidator()
+	mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), providerIdentity.SDKValOpAddress()).Return(validator, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+
+	// assignConsumerKey assigns a new consumer key with the given nonce in a new block
+	assignConsumerKey := func(seed int, nonce uint64) error {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		consumerKey := fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`,
+			base64.StdEncoding.EncodeToString(cryptotestutil.NewCryptoIdentityFromIntSeed(seed).ConsensusSDKPubKey().Bytes()))
+		_, err := msgServer.AssignConsumerKey(ctx, &providertypes.MsgAssignConsumerKey{
+			ConsumerId:   consumerId,
+			ProviderAddr: providerIdentity.SDKValOpAddressString(),
+			ConsumerKey:  consumerKey,
+			Signer:       providerIdentity.SDKValOpAddressString(),
+			Nonce:        nonce,
+		})
+		return err
+	}
+
+	// the first nonce is accepted
+	require.NoError(t, assignConsumerKey(1, 5))
+	require.Equal(t, uint64(5), providerKeeper.GetKeyAssignmentNonce(ctx, providerIdentity.SDKValOpAddress()))
+
+	// replaying the same nonce is rejected
+	require.ErrorIs(t, assignConsumerKey(2, 5), providertypes.ErrStaleKeyAssignmentNonce)
+	// a lower nonce is rejected
+	require.ErrorIs(t, assignConsumerKey(2, 4), providertypes.ErrStaleKeyAssignmentNonce)
+
+	// a higher nonce is accepted
+	require.NoError(t, assignConsumerKey(2, 6))
+	require.Equal(t, uint64(6), providerKeeper.GetKeyAssignmentNonce(ctx, providerIdentity.SDKValOpAddress()))
+
+	// no nonce means no check and does not change the last processed nonce
+	require.NoError(t, assignConsumerKey(3, 0))
+	require.Equal(t, uint64(6), providerKeeper.GetKeyAssignmentNonce(ctx, providerIdentity.SDKValOpAddress()))
+}
+
+func TestTransferConsumerOwnership(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	oldOwner := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+	newOwner := sdk.AccAddress([]byte("newOwner")).String()
+
+	// cannot transfer the ownership of a non-existing consumer chain
+	_, err := msgServer.TransferConsumerOwnership(ctx,
+		&providertypes.MsgTransferConsumerOwnership{Owner: oldOwner, ConsumerId: "0", NewOwner: newOwner})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: oldOwner, ChainId: "chainId-1",
+			Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: nil,
+			PowerShapingParameters:   nil,
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	// only the owner can transfer the ownership
+	_, err = msgServer.TransferConsumerOwnership(ctx,
+		&providertypes.MsgTransferConsumerOwnership{Owner: newOwner, ConsumerId: consumerId, NewOwner: newOwner})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the new owner must be a valid address
+	_, err = msgServer.TransferConsumerOwnership(ctx,
+		&providertypes.MsgTransferConsumerOwnership{Owner: oldOwner, ConsumerId: consumerId, NewOwner: "invalid"})
+	require.ErrorIs(t, err, providertypes.ErrInvalidNewOwnerAddress)
+
+	_, err = msgServer.TransferConsumerOwnership(ctx,
+		&providertypes.MsgTransferConsumerOwnership{Owner: oldOwner, ConsumerId: consumerId, NewOwner: newOwner})
+	require.NoError(t, err)
+	ownerAddress, err := providerKeeper.GetConsumerOwnerAddress(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, ownerAddress)
+
+	// the old owner cannot update the consumer chain anymore, while the new one can
+	metadata := providertypes.ConsumerMetadata{Name: "name2", Description: "description2"}
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{Owner: oldOwner, ConsumerId: consumerId, Metadata: &metadata})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{Owner: newOwner, ConsumerId: consumerId, Metadata: &metadata})
+	require.NoError(t, err)
+	actualMetadata, err := providerKeeper.GetConsumerMetadata(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, metadata, actualMetadata)
+
+	// the old owner cannot transfer the ownership back
+	_, err = msgServer.TransferConsumerOwnership(ctx,
+		&providertypes.MsgTransferConsumerOwnership{Owner: oldOwner, ConsumerId: consumerId, NewOwner: oldOwner})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// a Top N chain can only be owned by the gov module
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+	_, err = msgServer.TransferConsumerOwnership(ctx,
+		&providertypes.MsgTransferConsumerOwnership{Owner: newOwner, ConsumerId: consumerId, NewOwner: oldOwner})
+	require.ErrorIs(t, err, providertypes.ErrInvalidTransformToOptIn)
+	_, err = msgServer.TransferConsumerOwnership(ctx,
+		&providertypes.MsgTransferConsumerOwnership{Owner: newOwner, ConsumerId: consumerId, NewOwner: providerKeeper.GetAuthority()})
+	require.NoError(t, err)
+	ownerAddress, err = providerKeeper.GetConsumerOwnerAddress(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, providerKeeper.GetAuthority(), ownerAddress)
+}
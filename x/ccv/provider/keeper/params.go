@@ -45,6 +45,12 @@ func (k Keeper) GetSlashMeterReplenishFraction(ctx sdk.Context) string {
 	return params.SlashMeterReplenishFraction
 }
 
+// GetSlashMeterMinAbsoluteAllowance returns the minimal allowance of the slash meter in units of voting power
+func (k Keeper) GetSlashMeterMinAbsoluteAllowance(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.SlashMeterMinAbsoluteAllowance
+}
+
 func (k Keeper) GetConsumerRewardDenomRegistrationFee(ctx sdk.Context) sdk.Coin {
 	// Due to difficulties doing migrations in coordinated upgrades, this param is hardcoded to 10 ATOM in v1.1.0-multiden.
 	// The below code is the proper way to store the param. A future scheduled upgrade will
@@ -73,6 +79,68 @@ func (k Keeper) GetMaxProviderConsensusValidators(ctx sdk.Context) int64 {
 	return params.MaxProviderConsensusValidators
 }
 
+// GetKeyAssignmentMinInterval returns the minimum number of blocks between two consecutive
+// key assignments of a validator on the same consumer chain
+func (k Keeper) GetKeyAssignmentMinInterval(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.KeyAssignmentMinInterval
+}
+
+// GetMaxValsetUpdateBlockHeights returns the maximal number of most recent valset update ids
+// for which the provider block height is retained
+func (k Keeper) GetMaxValsetUpdateBlockHeights(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxValsetUpdateBlockHeights
+}
+
+// GetDowntimeSlashGracePeriod returns the period by which the jailing of a validator
+// for a downtime infraction on a consumer chain is deferred
+func (k Keeper) GetDowntimeSlashGracePeriod(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.DowntimeSlashGracePeriod
+}
+
+// GetKeyPruneWarningWindow returns the period before a consumer address is pruned
+// during which a warning event is emitted
+func (k Keeper) GetKeyPruneWarningWindow(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.KeyPruneWarningWindow
+}
+
+// GetMaxForcedConsumersPerValidator returns the maximum number of Top N consumer chains
+// a validator can be forced to validate
+func (k Keeper) GetMaxForcedConsumersPerValidator(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxForcedConsumersPerValidator
+}
+
+// GetSlashMeterHistoryLength returns the number of most recent blocks
+// for which the slash meter value is recorded
+func (k Keeper) GetSlashMeterHistoryLength(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.SlashMeterHistoryLength
+}
+
+// GetPerConsumerSlashMeters returns whether every consumer chain has its own slash meter
+func (k Keeper) GetPerConsumerSlashMeters(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.PerConsumerSlashMeters
+}
+
+// GetRejectUnknownSlashValidators returns whether slash packets for validators
+// that do not exist on the provider chain are rejected with an error acknowledgement
+func (k Keeper) GetRejectUnknownSlashValidators(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.RejectUnknownSlashValidators
+}
+
+// GetMaxConsumerPhaseHistoryLength returns the maximal number of most recent phase transitions
+// that are recorded for every consumer chain
+func (k Keeper) GetMaxConsumerPhaseHistoryLength(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxConsumerPhaseHistoryLength
+}
+
 // GetParams returns the paramset for the provider module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
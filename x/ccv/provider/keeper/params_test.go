@@ -51,6 +51,16 @@ func TestParams(t *testing.T) {
 		600,
 		24,
 		10,
+		5,
+		100,
+		time.Minute,
+		20,
+		50,
+		time.Hour,
+		3,
+		true,
+		100,
+		true,
 	)
 	providerKeeper.SetParams(ctx, newParams)
 	params = providerKeeper.GetParams(ctx)
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -89,7 +91,17 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 				"Could not find minimum power in top N for chain with consumer id: %s", consumerId)
 		}
 
-		if power >= minPowerInTopN {
+		// validators that reached the max forced consumers are not forced to validate the chain
+		forcedConsumers, err := k.ComputeForcedConsumers(ctx, []stakingtypes.Validator{validator})
+		if err != nil {
+			return err
+		}
+		reachedMax, err := k.HasReachedMaxForcedConsumers(ctx, forcedConsumers, consumerId, providerAddr)
+		if err != nil {
+			return err
+		}
+
+		if power >= minPowerInTopN && !reachedMax {
 			return errorsmod.Wrapf(
 				types.ErrCannotOptOutFromTopN,
 				"validator with power (%d) cannot opt out from Top N chain with consumer id (%s) because all validators"+
@@ -102,12 +114,14 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 	return nil
 }
 
-// OptInTopNValidators opts in to `consumerId` all the `bondedValidators` that have at least `minPowerToOptIn` power
+// OptInTopNValidators opts in to `consumerId` all the `bondedValidators` that have at least `minPowerToOptIn` power,
+// except for the validators that reached the max forced consumers according to `forcedConsumers`
 func (k Keeper) OptInTopNValidators(
 	ctx sdk.Context,
 	consumerId string,
 	bondedValidators []stakingtypes.Validator,
 	minPowerToOptIn int64,
+	forcedConsumers ForcedConsumers,
 ) error {
 	for _, val := range bondedValidators {
 		// log the validator
@@ -133,6 +147,17 @@ func (k Keeper) OptInTopNValidators(
 					consumerId, val.GetOperator(), err)
 			}
 
+			reachedMax, err := k.HasReachedMaxForcedConsumers(ctx, forcedConsumers, consumerId, types.NewProviderConsAddress(consAddr))
+			if err != nil {
+				return fmt.Errorf("checking forced consumers, consumerId(%s), validator(%s): %w",
+					consumerId, val.GetOperator(), err)
+			}
+			if reachedMax {
+				k.Logger(ctx).Debug("Not opting in validator that reached the max forced consumers",
+					"consumerId", consumerId, "validator", val.GetOperator())
+				continue
+			}
+
 			k.Logger(ctx).Debug("Opting in validator", "consumerId", consumerId, "validator", val.GetOperator())
 
 			// if validator is already opted in, it gets overwritten
@@ -142,6 +167,104 @@ func (k Keeper) OptInTopNValidators(
 	return nil
 }
 
+// ForcedConsumers maps the provider consensus addresses of validators to the ids of the launched
+// Top N consumer chains that force them to validate, in ascending order of consumer ids
+type ForcedConsumers map[string][]uint64
+
+// ComputeForcedConsumers computes for every validator in `validators` the launched Top N consumer chains
+// that force the validator to validate them, i.e., the chains for which the validator has at least the
+// minimum power in the top N and is not denylisted. Nothing is computed if `MaxForcedConsumersPerValidator`
+// is zero. The result is meant to be computed once and reused for all the validators and consumer chains
+// in an epoch, instead of iterating over all the consumer chains for every validator.
+func (k Keeper) ComputeForcedConsumers(ctx sdk.Context, validators []stakingtypes.Validator) (ForcedConsumers, error) {
+	forcedConsumers := ForcedConsumers{}
+	if k.GetMaxForcedConsumersPerValidator(ctx) == 0 {
+		return forcedConsumers, nil
+	}
+
+	consumerIds := []uint64{}
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		id, err := strconv.ParseUint(consumerId, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		consumerIds = append(consumerIds, id)
+	}
+	slices.Sort(consumerIds)
+
+	powers := make([]int64, len(validators))
+	consAddrs := make([]types.ProviderConsAddress, len(validators))
+	for i, val := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+		powers[i], err = k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		consAddrs[i] = types.NewProviderConsAddress(consAddr)
+	}
+
+	for _, id := range consumerIds {
+		consumerId := strconv.FormatUint(id, 10)
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return nil, err
+		}
+		if powerShapingParameters.Top_N == 0 {
+			continue
+		}
+		minPowerInTopN, found := k.GetMinimumPowerInTopN(ctx, consumerId)
+		if !found {
+			continue
+		}
+		for i, consAddr := range consAddrs {
+			// denylisted validators cannot validate the chain and hence are not forced to
+			if powers[i] >= minPowerInTopN && !k.IsDenylisted(ctx, consumerId, consAddr) {
+				forcedConsumers[consAddr.String()] = append(forcedConsumers[consAddr.String()], id)
+			}
+		}
+	}
+
+	return forcedConsumers, nil
+}
+
+// HasReachedMaxForcedConsumers returns true if the validator `providerAddr` is already forced to validate
+// `MaxForcedConsumersPerValidator` many launched Top N consumer chains that take precedence over the
+// consumer chain with `consumerId`, i.e., that have a lower consumer id. Such a validator is not forced
+// to validate the consumer chain with `consumerId` and is treated as opted out unless it opts in.
+// The forced consumer chains are looked up in `forcedConsumers` (see ComputeForcedConsumers).
+func (k Keeper) HasReachedMaxForcedConsumers(
+	ctx sdk.Context,
+	forcedConsumers ForcedConsumers,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) (bool, error) {
+	maxForcedConsumers := k.GetMaxForcedConsumersPerValidator(ctx)
+	if maxForcedConsumers == 0 {
+		return false, nil
+	}
+
+	id, err := strconv.ParseUint(consumerId, 10, 64)
+	if err != nil {
+		return false, err
+	}
+
+	// the ids are in ascending order, so the number of chains that take precedence is
+	// the position at which `id` would be inserted
+	precedingConsumers, _ := slices.BinarySearch(forcedConsumers[providerAddr.String()], id)
+
+	return int64(precedingConsumers) >= maxForcedConsumers, nil
+}
+
 //
 // Setters and getters
 //
@@ -14,6 +14,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -187,7 +188,7 @@ func TestOptInTopNValidators(t *testing.T) {
 	valDConsAddr, _ := valD.GetConsAddr()
 
 	// Start Test 1: opt in all validators with power >= 0
-	err := providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0)
+	err := providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0, nil)
 	require.NoError(t, err)
 	expectedOptedInValidators := []providertypes.ProviderConsAddress{
 		providertypes.NewProviderConsAddress(valAConsAddr),
@@ -217,7 +218,7 @@ func TestOptInTopNValidators(t *testing.T) {
 	// Start Test 2: opt in all validators with power >= 1
 	// We expect the same `expectedOptedInValidators` as when we opted in all validators with power >= 0 because the
 	// validators with the smallest power have power == 1
-	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0, nil)
 	require.NoError(t, err)
 	actualOptedInValidators = providerKeeper.GetAllOptedIn(ctx, CONSUMER_ID)
 	sortUpdates(actualOptedInValidators)
@@ -229,7 +230,7 @@ func TestOptInTopNValidators(t *testing.T) {
 	providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valDConsAddr))
 
 	// Start Test 3: opt in all validators with power >= 2 and hence we do not expect to opt in validator A
-	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 2)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 2, nil)
 	require.NoError(t, err)
 	expectedOptedInValidators = []providertypes.ProviderConsAddress{
 		providertypes.NewProviderConsAddress(valBConsAddr),
@@ -249,11 +250,106 @@ func TestOptInTopNValidators(t *testing.T) {
 	providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valDConsAddr))
 
 	// Start Test 4: opt in all validators with power >= 4 and hence we do not expect any opted-in validators
-	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 4)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 4, nil)
 	require.NoError(t, err)
 	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, CONSUMER_ID))
 }
 
+// TestOptInTopNValidatorsMaxForcedConsumers checks that a validator that is already forced to validate
+// `MaxForcedConsumersPerValidator` older Top N chains is not forced to validate a newer Top N chain
+func TestOptInTopNValidatorsMaxForcedConsumers(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MaxForcedConsumersPerValidator = 1
+	providerKeeper.SetParams(ctx, params)
+
+	// create 3 validators with powers 30, 20, and 10 respectively
+	validators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 30, 20, 10)
+
+	// consumer chain "0" is a launched Top N chain that forces validators with power >= 20 to validate it
+	providerKeeper.SetConsumerClientId(ctx, "0", "clientId0")
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, "0", providertypes.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+	providerKeeper.SetMinimumPowerInTopN(ctx, "0", 20)
+
+	// consumer chain "1" is a newer launched Top N chain that would force all validators to validate it
+	providerKeeper.SetConsumerClientId(ctx, "1", "clientId1")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, "1", providertypes.PowerShapingParameters{Top_N: 100})
+	require.NoError(t, err)
+	providerKeeper.SetMinimumPowerInTopN(ctx, "1", 10)
+
+	// the forced consumer chains are computed once for all validators and consumer chains
+	forcedConsumers, err := providerKeeper.ComputeForcedConsumers(ctx, validators)
+	require.NoError(t, err)
+	require.Equal(t, keeper.ForcedConsumers{
+		consAddrs[0].String(): {0, 1},
+		consAddrs[1].String(): {0, 1},
+		consAddrs[2].String(): {1},
+	}, forcedConsumers)
+
+	// the older chain takes precedence and hence the max is never reached for it
+	reachedMax, err := providerKeeper.HasReachedMaxForcedConsumers(ctx, forcedConsumers, "0", consAddrs[0])
+	require.NoError(t, err)
+	require.False(t, reachedMax)
+
+	// the first two validators are bound by the max on the newer chain, while the third one is not
+	reachedMax, err = providerKeeper.HasReachedMaxForcedConsumers(ctx, forcedConsumers, "1", consAddrs[0])
+	require.NoError(t, err)
+	require.True(t, reachedMax)
+	reachedMax, err = providerKeeper.HasReachedMaxForcedConsumers(ctx, forcedConsumers, "1", consAddrs[2])
+	require.NoError(t, err)
+	require.False(t, reachedMax)
+
+	err = providerKeeper.OptInTopNValidators(ctx, "1", validators, 10, forcedConsumers)
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsOptedIn(ctx, "1", consAddrs[0]))
+	require.False(t, providerKeeper.IsOptedIn(ctx, "1", consAddrs[1]))
+	require.True(t, providerKeeper.IsOptedIn(ctx, "1", consAddrs[2]))
+
+	// the validators bound by the max are treated as opted out of the newer chain
+	canValidate, err := providerKeeper.CanValidateChain(ctx, "1", consAddrs[0], 100, 10, forcedConsumers)
+	require.NoError(t, err)
+	require.False(t, canValidate)
+	canValidate, err = providerKeeper.CanValidateChain(ctx, "1", consAddrs[2], 100, 10, forcedConsumers)
+	require.NoError(t, err)
+	require.True(t, canValidate)
+
+	// a validator that opts in to the newer chain can still validate it
+	providerKeeper.SetOptedIn(ctx, "1", consAddrs[1])
+	canValidate, err = providerKeeper.CanValidateChain(ctx, "1", consAddrs[1], 100, 10, forcedConsumers)
+	require.NoError(t, err)
+	require.True(t, canValidate)
+
+	// and can opt out again, even though it belongs to the Top N validators of the newer chain
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "1", consAddrs[1]))
+	require.False(t, providerKeeper.IsOptedIn(ctx, "1", consAddrs[1]))
+
+	// a validator denylisted on the older chain is not forced to validate it
+	// and hence is forced to validate the newer chain
+	providerKeeper.SetDenylist(ctx, "0", consAddrs[1])
+	forcedConsumers, err = providerKeeper.ComputeForcedConsumers(ctx, validators)
+	require.NoError(t, err)
+	reachedMax, err = providerKeeper.HasReachedMaxForcedConsumers(ctx, forcedConsumers, "1", consAddrs[1])
+	require.NoError(t, err)
+	require.False(t, reachedMax)
+	providerKeeper.DeleteDenylist(ctx, "0")
+	forcedConsumers, err = providerKeeper.ComputeForcedConsumers(ctx, validators)
+	require.NoError(t, err)
+
+	// once the max is raised, all validators are forced to validate the newer chain
+	params.MaxForcedConsumersPerValidator = 2
+	providerKeeper.SetParams(ctx, params)
+	err = providerKeeper.OptInTopNValidators(ctx, "1", validators, 10, forcedConsumers)
+	require.NoError(t, err)
+	for _, consAddr := range consAddrs {
+		require.True(t, providerKeeper.IsOptedIn(ctx, "1", consAddr))
+	}
+}
+
 func TestGetAllOptedIn(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strconv"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -31,7 +33,8 @@ func (k Keeper) GetConsumerId(ctx sdk.Context) (uint64, bool) {
 }
 
 // FetchAndIncrementConsumerId fetches the first consumer id that can be used and increments the
-// underlying consumer id
+// underlying consumer id. Consumer ids are allocated in increasing order and are never reused,
+// i.e., the id of a removed consumer chain is not assigned to a new consumer chain.
 func (k Keeper) FetchAndIncrementConsumerId(ctx sdk.Context) string {
 	consumerId, _ := k.GetConsumerId(ctx)
 	k.setConsumerId(ctx, consumerId+1)
@@ -129,11 +132,6 @@ func (k Keeper) GetConsumerInitializationParameters(ctx sdk.Context, consumerId
 
 // SetConsumerInitializationParameters sets the initialization parameters associated with this consumer id
 func (k Keeper) SetConsumerInitializationParameters(ctx sdk.Context, consumerId string, parameters types.ConsumerInitializationParameters) error {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := parameters.Marshal()
-	if err != nil {
-		return fmt.Errorf("failed to marshal initialization parameters (%+v) for consumer id (%s): %w", parameters, consumerId, err)
-	}
 	chainId, err := k.GetConsumerChainId(ctx, consumerId)
 	if err != nil {
 		return fmt.Errorf("failed to get consumer chain ID for consumer id (%s): %w", consumerId, err)
@@ -142,6 +140,17 @@ func (k Keeper) SetConsumerInitializationParameters(ctx sdk.Context, consumerId
 	if err := types.ValidateInitialHeight(parameters.InitialHeight, chainId); err != nil {
 		return fmt.Errorf("invalid initial height for consumer id (%s): %w", consumerId, err)
 	}
+	return k.setConsumerInitializationParameters(ctx, consumerId, parameters)
+}
+
+// setConsumerInitializationParameters stores the initialization parameters associated with this consumer id
+// without validating them
+func (k Keeper) setConsumerInitializationParameters(ctx sdk.Context, consumerId string, parameters types.ConsumerInitializationParameters) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := parameters.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal initialization parameters (%+v) for consumer id (%s): %w", parameters, consumerId, err)
+	}
 	store.Set(types.ConsumerIdToInitializationParametersKey(consumerId), bz)
 	return nil
 }
@@ -164,17 +173,127 @@ func (k Keeper) GetConsumerPhase(ctx sdk.Context, consumerId string) types.Consu
 }
 
 // SetConsumerPhase sets the phase associated with this consumer id
+// and records the phase transition in the phase history of the consumer chain
 func (k Keeper) SetConsumerPhase(ctx sdk.Context, consumerId string, phase types.ConsumerPhase) {
+	oldPhase := k.GetConsumerPhase(ctx, consumerId)
+
 	store := ctx.KVStore(k.storeKey)
 	phaseBytes := make([]byte, 8)
 	binary.BigEndian.PutUint32(phaseBytes, uint32(phase))
 	store.Set(types.ConsumerIdToPhaseKey(consumerId), phaseBytes)
+
+	if oldPhase != phase {
+		k.AppendConsumerPhaseTransition(ctx, consumerId, types.ConsumerPhaseTransition{
+			Height:   ctx.BlockHeight(),
+			OldPhase: oldPhase,
+			NewPhase: phase,
+		})
+	}
 }
 
 // DeleteConsumerPhase deletes the phase associated with this consumer id
 func (k Keeper) DeleteConsumerPhase(ctx sdk.Context, consumerId string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerIdToPhaseKey(consumerId))
+	store.Delete(types.ConsumerIdToPhaseHistoryKey(consumerId))
+}
+
+// GetConsumerPhaseHistory returns the most recent phase transitions of the consumer chain
+// with this consumer id, ordered from the oldest to the newest
+func (k Keeper) GetConsumerPhaseHistory(ctx sdk.Context, consumerId string) []types.ConsumerPhaseTransition {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToPhaseHistoryKey(consumerId))
+	if bz == nil {
+		return []types.ConsumerPhaseTransition{}
+	}
+
+	var history types.ConsumerPhaseHistory
+	if err := history.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the phase history is assumed to be correctly serialized in SetConsumerPhaseHistory.
+		panic(fmt.Errorf("failed to unmarshal phase history for consumer id (%s): %w", consumerId, err))
+	}
+	return history.Transitions
+}
+
+// AppendConsumerPhaseTransition appends the given phase transition to the phase history of the consumer chain
+// with this consumer id, while only retaining the `MaxConsumerPhaseHistoryLength` most recent transitions
+func (k Keeper) AppendConsumerPhaseTransition(ctx sdk.Context, consumerId string, transition types.ConsumerPhaseTransition) {
+	maxLength := k.GetMaxConsumerPhaseHistoryLength(ctx)
+	if maxLength <= 0 {
+		return
+	}
+
+	transitions := append(k.GetConsumerPhaseHistory(ctx, consumerId), transition)
+	if int64(len(transitions)) > maxLength {
+		transitions = transitions[int64(len(transitions))-maxLength:]
+	}
+	k.SetConsumerPhaseHistory(ctx, consumerId, transitions)
+}
+
+// SetConsumerPhaseHistory sets the most recent phase transitions of the consumer chain with this consumer id,
+// ordered from the oldest to the newest
+func (k Keeper) SetConsumerPhaseHistory(ctx sdk.Context, consumerId string, transitions []types.ConsumerPhaseTransition) {
+	history := types.ConsumerPhaseHistory{Transitions: transitions}
+	bz, err := history.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the phase history is obtained from the provider keeper.
+		panic(fmt.Errorf("failed to marshal phase history for consumer id (%s): %w", consumerId, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToPhaseHistoryKey(consumerId), bz)
+}
+
+// GetInheritedConsumerId returns the id of the consumer chain from which the consumer chain
+// with this consumer id inherits its key assignments and validator set at launch
+func (k Keeper) GetInheritedConsumerId(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToInheritedConsumerIdKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetInheritedConsumerId sets the id of the consumer chain from which the consumer chain
+// with this consumer id inherits its key assignments and validator set at launch
+func (k Keeper) SetInheritedConsumerId(ctx sdk.Context, consumerId, inheritedConsumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToInheritedConsumerIdKey(consumerId), []byte(inheritedConsumerId))
+}
+
+// DeleteInheritedConsumerId deletes the id of the consumer chain from which the consumer chain
+// with this consumer id inherits at launch
+func (k Keeper) DeleteInheritedConsumerId(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToInheritedConsumerIdKey(consumerId))
+}
+
+// GetInheritingConsumerIds returns the ids of the consumer chains that inherit from
+// the consumer chain with `inheritedConsumerId` at launch
+func (k Keeper) GetInheritingConsumerIds(ctx sdk.Context, inheritedConsumerId string) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerIdToInheritedConsumerIdKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Value()) != inheritedConsumerId {
+			continue
+		}
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is set by the provider keeper.
+			panic(fmt.Errorf("failed to parse inherited consumer id key: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+
+	return consumerIds
 }
 
 // IsConsumerPrelaunched checks if a consumer chain is in its prelaunch phase
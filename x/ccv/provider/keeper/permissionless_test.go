@@ -199,6 +199,48 @@ func TestConsumerPhase(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, phase)
 }
 
+// TestConsumerPhaseHistory tests that the phase transitions of a consumer chain are recorded
+// in a bounded history that can be queried
+func TestConsumerPhaseHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	_, err := providerKeeper.QueryConsumerPhaseHistory(ctx, &providertypes.QueryConsumerPhaseHistoryRequest{ConsumerId: CONSUMER_ID})
+	require.Error(t, err)
+
+	// advance the consumer chain through its phases across blocks
+	providerKeeper.SetConsumerPhase(ctx.WithBlockHeight(10), CONSUMER_ID, providertypes.CONSUMER_PHASE_REGISTERED)
+	providerKeeper.SetConsumerPhase(ctx.WithBlockHeight(12), CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	// setting the same phase again is not a transition
+	providerKeeper.SetConsumerPhase(ctx.WithBlockHeight(13), CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	providerKeeper.SetConsumerPhase(ctx.WithBlockHeight(15), CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	expectedHistory := []providertypes.ConsumerPhaseTransition{
+		{Height: 10, OldPhase: providertypes.CONSUMER_PHASE_UNSPECIFIED, NewPhase: providertypes.CONSUMER_PHASE_REGISTERED},
+		{Height: 12, OldPhase: providertypes.CONSUMER_PHASE_REGISTERED, NewPhase: providertypes.CONSUMER_PHASE_INITIALIZED},
+		{Height: 15, OldPhase: providertypes.CONSUMER_PHASE_INITIALIZED, NewPhase: providertypes.CONSUMER_PHASE_LAUNCHED},
+	}
+	require.Equal(t, expectedHistory, providerKeeper.GetConsumerPhaseHistory(ctx, CONSUMER_ID))
+
+	res, err := providerKeeper.QueryConsumerPhaseHistory(ctx, &providertypes.QueryConsumerPhaseHistoryRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, expectedHistory, res.Transitions)
+
+	// only the most recent transitions are retained
+	params := providerKeeper.GetParams(ctx)
+	params.MaxConsumerPhaseHistoryLength = 2
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.SetConsumerPhase(ctx.WithBlockHeight(20), CONSUMER_ID, providertypes.CONSUMER_PHASE_STOPPED)
+	require.Equal(t, []providertypes.ConsumerPhaseTransition{
+		expectedHistory[2],
+		{Height: 20, OldPhase: providertypes.CONSUMER_PHASE_LAUNCHED, NewPhase: providertypes.CONSUMER_PHASE_STOPPED},
+	}, providerKeeper.GetConsumerPhaseHistory(ctx, CONSUMER_ID))
+
+	// the history of other consumer chains is not affected
+	require.Empty(t, providerKeeper.GetConsumerPhaseHistory(ctx, "1"))
+}
+
 func TestIsConsumerPrelaunched(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -64,6 +65,17 @@ func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakin
 	return 0, fmt.Errorf("should never reach this point with topN (%d), totalPower (%d), and powerSum (%d)", topN, totalPower, powerSum)
 }
 
+// ComputeTopNThreshold returns the minimum power needed for a validator to belong to
+// the `topN`% of the current active validators, i.e., the power threshold a Top N chain
+// with the given `topN` would have if it was computed now.
+func (k Keeper) ComputeTopNThreshold(ctx sdk.Context, topN uint32) (int64, error) {
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return k.ComputeMinPowerInTopN(ctx, activeValidators, topN)
+}
+
 // UpdateMinimumPowerInTopN populates the minimum power in Top N for the consumer chain with this consumer id
 func (k Keeper) UpdateMinimumPowerInTopN(ctx sdk.Context, consumerId string, oldTopN, newTopN uint32) error {
 	// if the top N changes, we need to update the new minimum power in top N
@@ -90,6 +102,8 @@ func (k Keeper) UpdateMinimumPowerInTopN(ctx sdk.Context, consumerId string, old
 
 // CapValidatorSet caps the provided `validators` if chain with `consumerId` is an Opt In chain with a validator-set cap.
 // If cap is `k`, `CapValidatorSet` returns the first `k` validators from `validators`.
+// The `validators` are expected to be ordered as returned by PartitionBasedOnPriorityList, i.e., the prioritylisted
+// validators first, then by descending power, and then by ascending consensus address for validators with the same power.
 func (k Keeper) CapValidatorSet(
 	ctx sdk.Context,
 	powerShapingParameters types.PowerShapingParameters,
@@ -234,6 +248,7 @@ func (k Keeper) CanValidateChain(
 	providerAddr types.ProviderConsAddress,
 	topN uint32,
 	minPowerToOptIn int64,
+	forcedConsumers ForcedConsumers,
 ) (bool, error) {
 	// check if the validator is already opted-in
 	optedIn := k.IsOptedIn(ctx, consumerId, providerAddr)
@@ -245,6 +260,14 @@ func (k Keeper) CanValidateChain(
 		if err != nil {
 			return false, err
 		}
+		if optedIn {
+			// validators that reached the max forced consumers are not forced to validate the chain
+			reachedMax, err := k.HasReachedMaxForcedConsumers(ctx, forcedConsumers, consumerId, providerAddr)
+			if err != nil {
+				return false, err
+			}
+			optedIn = !reachedMax
+		}
 	}
 
 	// only consider opted-in validators
@@ -346,6 +369,14 @@ func (k Keeper) SetConsumerPowerShapingParameters(ctx sdk.Context, consumerId st
 		k.UpdatePrioritylist(ctx, consumerId, parameters.Prioritylist)
 	}
 
+	// warn about prioritized validators that cannot validate the consumer chain as they are not allowlisted
+	for _, address := range types.GetPrioritizedValidatorsNotAllowlisted(parameters) {
+		k.Logger(ctx).Warn("prioritized validator is not allowlisted and cannot validate the consumer chain",
+			"consumerId", consumerId,
+			"validator", address,
+		)
+	}
+
 	return nil
 }
 
@@ -616,7 +647,8 @@ func (k Keeper) UpdatePrioritylist(ctx sdk.Context, consumerId string, priorityl
 }
 
 // PartitionBasedOnPriorityList filters the priority list to include only validators that can validate the chain
-// and splits the validators into priority and non-priority sets.
+// and splits the validators into priority and non-priority sets. Both sets are sorted by descending power and,
+// for validators with the same power, by ascending consensus address, so that the order is deterministic.
 func (k Keeper) PartitionBasedOnPriorityList(ctx sdk.Context, consumerId string, nextValidators []types.ConsensusValidator) ([]types.ConsensusValidator, []types.ConsensusValidator) {
 	priorityValidators := make([]types.ConsensusValidator, 0)
 	nonPriorityValidators := make([]types.ConsensusValidator, 0)
@@ -632,13 +664,19 @@ func (k Keeper) PartitionBasedOnPriorityList(ctx sdk.Context, consumerId string,
 		}
 	}
 
-	sort.Slice(priorityValidators, func(i, j int) bool {
-		return priorityValidators[i].Power > priorityValidators[j].Power
-	})
-
-	sort.Slice(nonPriorityValidators, func(i, j int) bool {
-		return nonPriorityValidators[i].Power > nonPriorityValidators[j].Power
-	})
+	sortByPowerAndAddress(priorityValidators)
+	sortByPowerAndAddress(nonPriorityValidators)
 
 	return priorityValidators, nonPriorityValidators
 }
+
+// sortByPowerAndAddress sorts the validators by descending power and,
+// for validators with the same power, by ascending consensus address
+func sortByPowerAndAddress(validators []types.ConsensusValidator) {
+	sort.Slice(validators, func(i, j int) bool {
+		if validators[i].Power != validators[j].Power {
+			return validators[i].Power > validators[j].Power
+		}
+		return bytes.Compare(validators[i].ProviderConsAddr, validators[j].ProviderConsAddr) < 0
+	})
+}
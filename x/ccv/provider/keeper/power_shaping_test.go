@@ -20,6 +20,7 @@ import (
 
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -181,6 +182,39 @@ func TestComputeMinPowerInTopN(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestComputeTopNThreshold tests the computation of the Top N power threshold
+// against the current active validators, as well as its query wrapper
+func TestComputeTopNThreshold(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// 4 validators with equal powers, i.e., each validator holds 25% of the total power
+	activeValidators := []stakingtypes.Validator{
+		createStakingValidator(ctx, mocks, 1000, 1),
+		createStakingValidator(ctx, mocks, 1000, 2),
+		createStakingValidator(ctx, mocks, 1000, 3),
+		createStakingValidator(ctx, mocks, 1000, 4),
+	}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 4, activeValidators, -1)
+
+	threshold, err := providerKeeper.ComputeTopNThreshold(ctx, 67)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), threshold)
+
+	res, err := providerKeeper.QueryTopNThreshold(ctx, &providertypes.QueryTopNThresholdRequest{TopN: 67})
+	require.NoError(t, err)
+	require.Equal(t, threshold, res.MinPower)
+
+	// N has to be in (0, 100]
+	_, err = providerKeeper.ComputeTopNThreshold(ctx, 0)
+	require.Error(t, err)
+	_, err = providerKeeper.QueryTopNThreshold(ctx, &providertypes.QueryTopNThresholdRequest{TopN: 101})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryTopNThreshold(ctx, nil)
+	require.Error(t, err)
+}
+
 // TestCanValidateChain returns true if `validator` is opted in, in `consumerId.
 func TestCanValidateChain(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -195,7 +229,7 @@ func TestCanValidateChain(t *testing.T) {
 	// with no allowlist or denylist, the validator has to be opted in, in order to consider it
 	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerID)
 	require.Error(t, err)
-	canValidateChain, err := providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 0)
+	canValidateChain, err := providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 0, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 
@@ -206,17 +240,17 @@ func TestCanValidateChain(t *testing.T) {
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerID)
 	require.NoError(t, err)
 	// validator's power is LT the min power
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 	// validator's power is GTE the min power
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
 	// when validator is opted-in it can validate regardless of its min power
 	providerKeeper.SetOptedIn(ctx, consumerID, providertypes.NewProviderConsAddress(consAddr))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
@@ -225,7 +259,7 @@ func TestCanValidateChain(t *testing.T) {
 	require.NoError(t, err)
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerID)
 	require.NoError(t, err)
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
@@ -233,22 +267,22 @@ func TestCanValidateChain(t *testing.T) {
 	validatorA := createStakingValidator(ctx, mocks, 1, 2)
 	consAddrA, _ := validatorA.GetConsAddr()
 	providerKeeper.SetAllowlist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddrA))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 	providerKeeper.SetAllowlist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddr))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
 	// create a denylist but do not add validator `providerAddr` to it
 	providerKeeper.SetDenylist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddrA))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 	// add validator `providerAddr` to the denylist
 	providerKeeper.SetDenylist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddr))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 }
@@ -376,6 +410,55 @@ func TestCapValidatorSet(t *testing.T) {
 	}
 }
 
+// TestCapValidatorSetOrdering tests that a validator-set cap selects the prioritylisted validators first,
+// then the validators with the highest power, and breaks ties in power by consensus address
+func TestCapValidatorSetOrdering(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	createValidator := func(power int64, seed int) providertypes.ConsensusValidator {
+		return providertypes.ConsensusValidator{
+			ProviderConsAddr: cryptotestutil.NewCryptoIdentityFromIntSeed(seed).SDKValConsAddress(),
+			Power:            power,
+			PublicKey:        &crypto.PublicKey{},
+		}
+	}
+	// validators A and B are prioritylisted and have the lowest power
+	validatorA := createValidator(1, 1)
+	validatorB := createValidator(2, 2)
+	validatorC := createValidator(3, 3)
+	validatorD := createValidator(5, 4)
+	validatorE := createValidator(10, 5)
+	validators := []providertypes.ConsensusValidator{validatorA, validatorB, validatorC, validatorD, validatorE}
+
+	powerShapingParameters := providertypes.PowerShapingParameters{
+		ValidatorSetCap: 3,
+		Prioritylist: []string{
+			sdk.ConsAddress(validatorA.ProviderConsAddr).String(),
+			sdk.ConsAddress(validatorB.ProviderConsAddr).String(),
+		},
+	}
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, powerShapingParameters)
+	require.NoError(t, err)
+
+	priorityValidators, nonPriorityValidators := providerKeeper.PartitionBasedOnPriorityList(ctx, CONSUMER_ID, validators)
+	consumerValidators := providerKeeper.CapValidatorSet(ctx, powerShapingParameters, append(priorityValidators, nonPriorityValidators...))
+	require.Equal(t, []providertypes.ConsensusValidator{validatorB, validatorA, validatorE}, consumerValidators)
+
+	// if validators D and E have the same power, the one with the lower consensus address is selected
+	validatorD.Power = validatorE.Power
+	validators = []providertypes.ConsensusValidator{validatorA, validatorB, validatorC, validatorD, validatorE}
+	expectedThird := validatorD
+	if bytes.Compare(validatorE.ProviderConsAddr, validatorD.ProviderConsAddr) < 0 {
+		expectedThird = validatorE
+	}
+	for _, ordering := range [][]providertypes.ConsensusValidator{validators, {validatorE, validatorD, validatorC, validatorB, validatorA}} {
+		priorityValidators, nonPriorityValidators = providerKeeper.PartitionBasedOnPriorityList(ctx, CONSUMER_ID, ordering)
+		consumerValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, append(priorityValidators, nonPriorityValidators...))
+		require.Equal(t, []providertypes.ConsensusValidator{validatorB, validatorA, expectedThird}, consumerValidators)
+	}
+}
+
 // Helper function to handle address conversion
 func consAddressFromBech32(addr string) sdk.ConsAddress {
 	consAddr, err := sdk.ConsAddressFromBech32(addr)
@@ -748,7 +831,7 @@ func TestIfInactiveValsDisallowedProperty(t *testing.T) {
 		require.NoError(t, err)
 
 		// Compute the next validators
-		nextVals, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0)
+		nextVals, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0, nil)
 		require.NoError(t, err)
 
 		// Check that the length of nextVals is at most maxProviderConsensusVals
@@ -854,6 +937,78 @@ func TestConsumerPowerShapingParameters(t *testing.T) {
 	require.Equal(t, expectedPrioritylist, providerKeeper.GetPriorityList(ctx, consumerId))
 }
 
+// TestToggleAllowInactiveVals checks that turning `AllowInactiveVals` off through `MsgUpdateConsumer`
+// drops bonded but inactive validators from the consumer validator set and that the resulting
+// validator updates remove them from the consumer chain
+func TestToggleAllowInactiveVals(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// only the first two validators are part of the active provider set
+	params := providertypes.DefaultParams()
+	params.MaxProviderConsensusValidators = 2
+	providerKeeper.SetParams(ctx, params)
+
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 30, 20, 10)
+
+	// set up a launched opt-in consumer chain that allows inactive validators
+	owner := "owner"
+	providerKeeper.SetConsumerOwnerAddress(ctx, CONSUMER_ID, owner)
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters())
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{
+		AllowInactiveVals: true,
+	})
+	require.NoError(t, err)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, consAddr)
+	}
+
+	// inactive validators are allowed, hence all three validators validate the consumer chain
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	currentVals, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, currentVals, 3)
+	err = providerKeeper.SetConsumerValSet(ctx, CONSUMER_ID, currentVals)
+	require.NoError(t, err)
+
+	// toggle inactive validators off
+	msgServer := keeper.NewMsgServerImpl(&providerKeeper)
+	_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+		Owner:                  owner,
+		ConsumerId:             CONSUMER_ID,
+		PowerShapingParameters: &providertypes.PowerShapingParameters{AllowInactiveVals: false},
+	})
+	require.NoError(t, err)
+	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.False(t, powerShapingParameters.AllowInactiveVals)
+
+	nextVals, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, nextVals, 2)
+	for _, val := range nextVals {
+		require.NotEqual(t, consAddrs[2].ToSdkConsAddr().Bytes(), val.ProviderConsAddr)
+	}
+
+	// the inactive validator is removed from the consumer chain
+	inactiveConsAddr, err := vals[2].GetConsAddr()
+	require.NoError(t, err)
+	var inactivePubKey crypto.PublicKey
+	for _, val := range currentVals {
+		if bytes.Equal(val.ProviderConsAddr, inactiveConsAddr) {
+			inactivePubKey = *val.PublicKey
+		}
+	}
+	updates := keeper.DiffValidators(currentVals, nextVals)
+	require.Len(t, updates, 1)
+	require.Equal(t, inactivePubKey, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+}
+
 // TestAllowlist tests the `SetAllowlist`, `IsAllowlisted`, `DeleteAllowlist`, and `IsAllowlistEmpty` methods
 func TestAllowlist(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
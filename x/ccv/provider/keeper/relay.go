@@ -1,15 +1,21 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -34,6 +40,10 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		}
 		return errorsmod.Wrapf(providertypes.ErrUnknownConsumerChannelId, "recv ErrorAcknowledgement on unknown channel %s", packet.SourceChannel)
 	}
+	// record the round-trip latency of the successfully acknowledged VSC packet
+	if consumerId, ok := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel); ok {
+		k.recordVSCLatency(ctx, consumerId, packet)
+	}
 	return nil
 }
 
@@ -87,19 +97,54 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
 // The maximum number of validators is determined by the `maxValidators` parameter.
 // The function returns the difference between the current validator set and the next validator set as a list of `abci.ValidatorUpdate` objects.
 func (k Keeper) ProviderValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	currentValidators, nextValidators, err := k.computeNextProviderConsensusValSet(ctx)
+	if err != nil {
+		return []abci.ValidatorUpdate{}, err
+	}
+
+	// store the validator set we will send to consensus
+	err = k.SetLastProviderConsensusValSet(ctx, nextValidators)
+	if err != nil {
+		return []abci.ValidatorUpdate{}, fmt.Errorf("setting the last provider consensus validator set: %w", err)
+	}
+
+	valUpdates := DiffValidators(currentValidators, nextValidators)
+
+	return valUpdates, nil
+}
+
+// RecomputeProviderConsensusValSet reselects the top `MaxProviderConsensusValidators` bonded validators
+// and returns the difference with the last provider consensus validator set.
+// Unlike ProviderValidatorUpdates, the recomputed validator set is not stored.
+func (k Keeper) RecomputeProviderConsensusValSet(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	currentValidators, nextValidators, err := k.computeNextProviderConsensusValSet(ctx)
+	if err != nil {
+		return []abci.ValidatorUpdate{}, err
+	}
+
+	return DiffValidators(currentValidators, nextValidators), nil
+}
+
+// computeNextProviderConsensusValSet returns the last validator set sent to consensus
+// and the next one, i.e., the first `MaxProviderConsensusValidators` bonded validators
+func (k Keeper) computeNextProviderConsensusValSet(ctx sdk.Context) (
+	currentValidators []providertypes.ConsensusValidator,
+	nextValidators []providertypes.ConsensusValidator,
+	err error,
+) {
 	// get the bonded validators from the staking module
 	bondedValidators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
 	if err != nil {
-		return []abci.ValidatorUpdate{}, fmt.Errorf("getting bonded validators: %w", err)
+		return nil, nil, fmt.Errorf("getting bonded validators: %w", err)
 	}
 
 	// get the last validator set sent to consensus
-	currentValidators, err := k.GetLastProviderConsensusValSet(ctx)
+	currentValidators, err = k.GetLastProviderConsensusValSet(ctx)
 	if err != nil {
-		return []abci.ValidatorUpdate{}, fmt.Errorf("getting last provider consensus validator set: %w", err)
+		return nil, nil, fmt.Errorf("getting last provider consensus validator set: %w", err)
 	}
 
-	nextValidators := []providertypes.ConsensusValidator{}
+	nextValidators = []providertypes.ConsensusValidator{}
 	maxValidators := k.GetMaxProviderConsensusValidators(ctx)
 	// avoid out of range errors by bounding the max validators to the number of bonded validators
 	if maxValidators > int64(len(bondedValidators)) {
@@ -108,21 +153,12 @@ func (k Keeper) ProviderValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdat
 	for _, val := range bondedValidators[:maxValidators] {
 		nextValidator, err := k.CreateProviderConsensusValidator(ctx, val)
 		if err != nil {
-			return []abci.ValidatorUpdate{},
-				fmt.Errorf("creating provider consensus validator(%s): %w", val.OperatorAddress, err)
+			return nil, nil, fmt.Errorf("creating provider consensus validator(%s): %w", val.OperatorAddress, err)
 		}
 		nextValidators = append(nextValidators, nextValidator)
 	}
 
-	// store the validator set we will send to consensus
-	err = k.SetLastProviderConsensusValSet(ctx, nextValidators)
-	if err != nil {
-		return []abci.ValidatorUpdate{}, fmt.Errorf("setting the last provider consensus validator set: %w", err)
-	}
-
-	valUpdates := DiffValidators(currentValidators, nextValidators)
-
-	return valUpdates, nil
+	return currentValidators, nextValidators, nil
 }
 
 // BlocksUntilNextEpoch returns the number of blocks until the next epoch starts
@@ -151,8 +187,31 @@ func (k Keeper) SendVSCPackets(ctx sdk.Context) error {
 			continue
 		}
 
+		if k.IsVSCSendingPaused(ctx, consumerId) {
+			// the already queued VSCPackets are sent once the sending is resumed
+			continue
+		}
+
 		// check if CCV channel is established and send
 		if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
+			if channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID); !found || channel.State != channeltypes.OPEN {
+				// the CCV channel is not open (e.g., it closed unexpectedly), so the VSCPackets
+				// remain queued until the channel reopens or the consumer chain is removed
+				k.Logger(ctx).Error("CCV channel is not open, cannot send VSCPackets, leaving packet data stored:",
+					"consumerId", consumerId,
+					"channelId", channelID,
+					"channelState", channel.State.String(),
+				)
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						providertypes.EventTypeSkipVSCChannelNotOpen,
+						sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+						sdk.NewAttribute(providertypes.AttributeChannelId, channelID),
+						sdk.NewAttribute(providertypes.AttributeChannelState, channel.State.String()),
+					),
+				)
+				continue
+			}
 			if err := k.SendVSCPacketsToChain(ctx, consumerId, channelID); err != nil {
 				return fmt.Errorf("sending VSCPacket to consumer, consumerId(%s): %w", consumerId, err)
 			}
@@ -164,6 +223,7 @@ func (k Keeper) SendVSCPackets(ctx sdk.Context) error {
 // SendVSCPacketsToChain sends all queued VSC packets to the specified chain
 func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId string) error {
 	pendingPackets := k.GetPendingVSCPackets(ctx, consumerId)
+	timeoutPeriod, _ := k.GetConsumerCCVTimeoutPeriod(ctx, consumerId)
 	for _, data := range pendingPackets {
 		// send packet over IBC
 		err := ccv.SendIBCPacket(
@@ -172,7 +232,7 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 			channelId,          // source channel id
 			ccv.ProviderPortID, // source port id
 			data.GetBytes(),
-			k.GetCCVTimeoutPeriod(ctx),
+			timeoutPeriod,
 		)
 		if err != nil {
 			if errors.Is(err, clienttypes.ErrClientNotActive) {
@@ -195,6 +255,14 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 			}
 			return nil
 		}
+		// store the send time to keep track of when the VSC matures
+		k.SetVscSendTimestamp(ctx, consumerId, data.ValsetUpdateId, ctx.BlockTime())
+
+		telemetry.IncrCounterWithLabels(
+			[]string{providertypes.ModuleName, providertypes.MetricKeyVSCPacketsSent},
+			1,
+			[]metrics.Label{telemetry.NewLabel(providertypes.MetricLabelConsumerId, consumerId)},
+		)
 	}
 	k.DeletePendingVSCPackets(ctx, consumerId)
 
@@ -220,36 +288,109 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) error {
 		return fmt.Errorf("getting provider active validators: %w", err)
 	}
 
+	// the forced consumer chains of the validators are computed once for all the consumer chains
+	forcedConsumers, err := k.ComputeForcedConsumers(ctx, bondedValidators)
+	if err != nil {
+		return fmt.Errorf("computing forced consumers: %w", err)
+	}
+
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
 		if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
 			// only queue VSCPackets to launched chains
 			continue
 		}
 
+		if k.IsVSCSendingPaused(ctx, consumerId) {
+			// the consumer validator set is frozen while the sending is paused, i.e.,
+			// the validator updates accumulate and, once the sending is resumed,
+			// the net change is queued in a single VSCPacket
+			continue
+		}
+
 		currentValSet, err := k.GetConsumerValSet(ctx, consumerId)
 		if err != nil {
 			return fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
 		}
 
 		// compute consumer next validator set
-		valUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet)
+		valUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet, forcedConsumers)
 		if err != nil {
 			return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
 		}
 
 		// check whether there are changes in the validator set
 		if len(valUpdates) != 0 {
-			// construct validator set change packet data
-			packet := ccv.NewValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
-			k.AppendPendingVSCPackets(ctx, consumerId, packet)
-			k.Logger(ctx).Info("VSCPacket enqueued:",
+			k.appendVSCPacket(ctx, consumerId, valUpdateID, valUpdates)
+		}
+	}
+
+	k.IncrementValidatorSetUpdateId(ctx)
+
+	return nil
+}
+
+// appendVSCPacket enqueues a VSC packet with the given validator updates to the consumer chain with `consumerId`,
+// together with the slash acks of the consumer chain, and runs the AfterVSCPacketSent hook
+func (k Keeper) appendVSCPacket(ctx sdk.Context, consumerId string, valUpdateID uint64, valUpdates []abci.ValidatorUpdate) {
+	// construct validator set change packet data
+	packet := ccv.NewValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
+	k.AppendPendingVSCPackets(ctx, consumerId, packet)
+	k.Logger(ctx).Info("VSCPacket enqueued:",
+		"consumerId", consumerId,
+		"vscID", valUpdateID,
+		"len updates", len(valUpdates),
+	)
+
+	if k.hooks != nil {
+		// run the hooks in a cached context, so that a failing hook neither halts
+		// the provider chain nor leaves partial state changes behind
+		cachedCtx, writeFn := ctx.CacheContext()
+		if err := k.hooks.AfterVSCPacketSent(cachedCtx, consumerId, valUpdateID, valUpdates); err != nil {
+			k.Logger(ctx).Error("AfterVSCPacketSent hook failed",
 				"consumerId", consumerId,
 				"vscID", valUpdateID,
-				"len updates", len(valUpdates),
+				"error", err,
 			)
+		} else {
+			writeFn()
 		}
 	}
+}
+
+// QueueFullVSCPacket enqueues a VSC packet that contains the entire current validator set
+// of the launched consumer chain with `consumerId`, i.e., not only the changes to the validator set.
+// It can be used to resynchronize the validator set on the consumer chain with the provider's view.
+// The CCV channel to the consumer chain must be open.
+// Note that the validators that are only in the validator set on the consumer chain are not removed.
+func (k Keeper) QueueFullVSCPacket(ctx sdk.Context, consumerId string) error {
+	if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+		return errorsmod.Wrapf(providertypes.ErrInvalidPhase, "consumer chain %s is not launched", consumerId)
+	}
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "consumer chain %s has no established CCV channel", consumerId)
+	}
+	if channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelId); !found || channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "CCV channel %s of consumer chain %s is not open", channelId, consumerId)
+	}
 
+	currentValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
+	}
+	valUpdates := make([]abci.ValidatorUpdate, 0, len(currentValSet))
+	for _, val := range currentValSet {
+		valUpdates = append(valUpdates, abci.ValidatorUpdate{
+			PubKey: *val.PublicKey,
+			Power:  val.Power,
+		})
+	}
+
+	// the packet gets its own valset update id, which is mapped to the next block height
+	// like the ids of the VSC packets queued at the end of the block
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+	k.appendVSCPacket(ctx, consumerId, valUpdateID, valUpdates)
+	k.setNextValsetUpdateBlockHeight(ctx)
 	k.IncrementValidatorSetUpdateId(ctx)
 
 	return nil
@@ -268,21 +409,65 @@ func (k Keeper) BeginBlockCIS(ctx sdk.Context) {
 	// - Marshaling and/or store corruption errors.
 	// - Setting invalid slash meter values (see SetSlashMeter).
 	k.CheckForSlashMeterReplenishment(ctx)
+
+	perConsumerSlashMeters := k.GetPerConsumerSlashMeters(ctx)
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if perConsumerSlashMeters {
+			// replenish the slash meter of the consumer chain if necessary
+			k.CheckForConsumerSlashMeterReplenishment(ctx, consumerId)
+		}
+		// prune the slash packet counts that are no longer in the rate window
+		k.PruneSlashPacketCounts(ctx, consumerId)
+		// warn about the consumer addresses that are about to be pruned
+		k.EmitUpcomingKeyPruneWarnings(ctx, consumerId)
+	}
 }
 
-// EndBlockCIS contains the EndBlock logic needed for
-// the Consumer Initiated Slashing sub-protocol
-func (k Keeper) EndBlockCIS(ctx sdk.Context) {
-	// set the ValsetUpdateBlockHeight
+// setNextValsetUpdateBlockHeight maps the current valset update id to the next block height
+func (k Keeper) setNextValsetUpdateBlockHeight(ctx sdk.Context) {
 	blockHeight := uint64(ctx.BlockHeight()) + 1
 	valUpdateID := k.GetValidatorSetUpdateId(ctx)
 	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
 	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
+	k.RecordRecentValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
+}
+
+// EndBlockCIS contains the EndBlock logic needed for
+// the Consumer Initiated Slashing sub-protocol
+func (k Keeper) EndBlockCIS(ctx sdk.Context) {
+	// set the ValsetUpdateBlockHeight
+	k.setNextValsetUpdateBlockHeight(ctx)
+
+	// record the slash meter value of this block
+	k.RecordSlashMeterHistory(ctx)
+
+	// handle the deferred downtime slash packets whose grace period elapsed
+	k.HandlePendingDowntimeSlashes(ctx)
+
+	// clear the jailing reasons of the validators that were unjailed
+	k.DeleteUnjailedValidatorsJailingReasons(ctx)
 
 	// prune previous consumer validator addresses that are no longer needed
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.IsVSCSendingPaused(ctx, consumerId) {
+			// the consumer chain is not aware of the key assignments made while the sending
+			// of VSC packets is paused, so the pruning is postponed until the sending is resumed
+			continue
+		}
 		k.PruneKeyAssignments(ctx, consumerId)
 	}
+
+	// prune the send timestamps of the VSCs that matured and the records of the slash packets
+	// received more than an unbonding period ago
+	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("cannot prune VSC send timestamps, unbonding time not found", "error", err.Error())
+		return
+	}
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		k.PruneMaturedVscSendTimestamps(ctx, consumerId, unbondingPeriod)
+		k.PruneSlashPacketRecords(ctx, consumerId, unbondingPeriod)
+	}
 }
 
 // OnRecvSlashPacket delivers a received slash packet, validates it and
@@ -291,6 +476,366 @@ func (k Keeper) OnRecvSlashPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data ccv.SlashPacketData,
+) (ccv.PacketAckResult, error) {
+	ackResults, errs := k.OnRecvSlashPackets(ctx, []channeltypes.Packet{packet}, []ccv.SlashPacketData{data})
+	return ackResults[0], errs[0]
+}
+
+// OnRecvSlashPackets delivers a batch of received slash packets. Every packet is handled
+// as in OnRecvSlashPacket and the i-th ack result and error correspond to the i-th packet.
+// The slash meter and the infraction parameters are read at most once for the whole batch
+// and the slash meter is written back a single time once all the packets are handled.
+// If per consumer slash meters are enabled, the same holds for the slash meter of every consumer chain,
+// which is consumed together with the global slash meter.
+func (k Keeper) OnRecvSlashPackets(
+	ctx sdk.Context,
+	packets []channeltypes.Packet,
+	datas []ccv.SlashPacketData,
+) (ackResults []ccv.PacketAckResult, errs []error) {
+	if len(packets) != len(datas) {
+		panic(fmt.Errorf("number of slash packets (%d) does not match number of slash packet data (%d)", len(packets), len(datas)))
+	}
+
+	cache := newSlashPacketsCache(k.GetPerConsumerSlashMeters(ctx))
+	ackResults = make([]ccv.PacketAckResult, len(packets))
+	errs = make([]error, len(packets))
+	for i := range packets {
+		cache.jailed = false
+		cache.recorded = false
+		cache.deferred = false
+		ackResults[i], errs[i] = k.onRecvSlashPacket(ctx, packets[i], datas[i], cache)
+		if errs[i] == nil {
+			k.incrSlashPacketsCounter(ctx, packets[i], datas[i], ackResults[i])
+			k.recordSlashPacket(ctx, packets[i], datas[i], ackResults[i], cache)
+		}
+	}
+
+	if cache.meterUpdated {
+		k.SetSlashMeter(ctx, *cache.meter)
+	}
+	for _, consumerId := range cache.updatedConsumerMeters {
+		k.SetConsumerSlashMeter(ctx, consumerId, cache.consumerMeters[consumerId])
+	}
+
+	return ackResults, errs
+}
+
+// recordVSCLatency records the round-trip latency of an acknowledged VSC packet,
+// i.e., the time between sending the VSC packet and receiving its acknowledgement.
+// Note that consumer chains no longer send VSCMatured packets (see ADR 018).
+func (k Keeper) recordVSCLatency(ctx sdk.Context, consumerId string, packet channeltypes.Packet) {
+	var data ccv.ValidatorSetChangePacketData
+	if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// the packet data was serialized by the provider, so this should never happen
+		k.Logger(ctx).Error("cannot unmarshal VSC packet data",
+			"consumerId", consumerId,
+			"error", err,
+		)
+		return
+	}
+
+	sendTs, found := k.GetVscSendTimestamp(ctx, consumerId, data.ValsetUpdateId)
+	if !found {
+		// the send timestamp was already pruned, so the latency cannot be measured
+		k.Logger(ctx).Debug("no send timestamp for acknowledged VSC packet",
+			"consumerId", consumerId,
+			"vscID", data.ValsetUpdateId,
+		)
+		return
+	}
+
+	k.SetVSCLatency(ctx, consumerId, data.ValsetUpdateId, ctx.BlockTime().Sub(sendTs))
+	k.PruneVSCLatencies(ctx, consumerId)
+
+	_, average, maximum := k.GetVSCLatencyStats(ctx, consumerId)
+	labels := []metrics.Label{telemetry.NewLabel(providertypes.MetricLabelConsumerId, consumerId)}
+	telemetry.SetGaugeWithLabels(
+		[]string{providertypes.ModuleName, providertypes.MetricKeyVSCLatencyAverage},
+		float32(average.Milliseconds()),
+		labels,
+	)
+	telemetry.SetGaugeWithLabels(
+		[]string{providertypes.ModuleName, providertypes.MetricKeyVSCLatencyMax},
+		float32(maximum.Milliseconds()),
+		labels,
+	)
+}
+
+// incrSlashPacketsCounter increments the telemetry counter of either the handled or
+// the throttled slash packets, depending on the ack result of the given slash packet
+func (k Keeper) incrSlashPacketsCounter(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.SlashPacketData,
+	ackResult ccv.PacketAckResult,
+) {
+	metricKey := providertypes.MetricKeySlashPacketsHandled
+	if bytes.Equal(ackResult, ccv.SlashPacketBouncedResult) {
+		metricKey = providertypes.MetricKeySlashPacketsThrottled
+	}
+	// the channel is known, as otherwise onRecvSlashPacket panics
+	consumerId, _ := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
+	telemetry.IncrCounterWithLabels(
+		[]string{providertypes.ModuleName, metricKey},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(providertypes.MetricLabelConsumerId, consumerId),
+			telemetry.NewLabel(providertypes.MetricLabelInfraction, data.Infraction.String()),
+		},
+	)
+}
+
+// recordSlashPacket stores how the given slash packet was processed,
+// so that it can be queried by its IBC sequence number
+func (k Keeper) recordSlashPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.SlashPacketData,
+	ackResult ccv.PacketAckResult,
+	cache *slashPacketsCache,
+) {
+	// the channel is known, as otherwise onRecvSlashPacket panics
+	consumerId, _ := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
+	providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, providertypes.NewConsumerConsAddress(data.Validator.Address))
+
+	status := providertypes.SLASH_PACKET_STATUS_HANDLED
+	if bytes.Equal(ackResult, ccv.SlashPacketBouncedResult) {
+		status = providertypes.SLASH_PACKET_STATUS_THROTTLED
+	} else if cache.recorded {
+		status = providertypes.SLASH_PACKET_STATUS_RECORDED
+	} else if cache.deferred {
+		status = providertypes.SLASH_PACKET_STATUS_PENDING
+	}
+	k.SetSlashPacketRecord(ctx, consumerId, packet.Sequence, providertypes.SlashPacketRecord{
+		Status:       status,
+		ProviderAddr: providerConsAddr.ToSdkConsAddr(),
+		Infraction:   data.Infraction,
+		Jailed:       cache.jailed,
+		ReceivedTime: ctx.BlockTime(),
+	})
+}
+
+// completePendingSlashPacketRecord updates the record of a deferred downtime slash packet
+// once it is handled, dropped or cancelled. Nothing is done if the record was already pruned.
+func (k Keeper) completePendingSlashPacketRecord(
+	ctx sdk.Context,
+	consumerId string,
+	sequence uint64,
+	status providertypes.SlashPacketStatus,
+	jailed bool,
+) {
+	record, found := k.GetSlashPacketRecord(ctx, consumerId, sequence)
+	if !found || record.Status != providertypes.SLASH_PACKET_STATUS_PENDING {
+		return
+	}
+	record.Status = status
+	record.Jailed = jailed
+	k.SetSlashPacketRecord(ctx, consumerId, sequence, record)
+}
+
+// slashPacketsCache caches the state read while handling a batch of slash packets
+type slashPacketsCache struct {
+	// the slash meter; nil until it is first read
+	meter *math.Int
+	// whether the slash meter needs to be written back
+	meterUpdated bool
+	// whether every consumer chain has its own slash meter
+	perConsumerMeters bool
+	// the slash meters per consumer id, used if perConsumerMeters is true in addition to the global slash meter
+	consumerMeters map[string]math.Int
+	// the consumer ids whose slash meters need to be written back, in update order
+	updatedConsumerMeters []string
+	// the infraction parameters per consumer id
+	infractionParams map[string]providertypes.InfractionParameters
+	// whether the last handled slash packet jailed its validator
+	jailed bool
+	// whether the last handled slash packet was only recorded, as its consumer chain
+	// is in the record-only slash mode
+	recorded bool
+	// whether the last handled slash packet was deferred by the downtime slash grace period
+	deferred bool
+}
+
+// newSlashPacketsCache returns an empty slashPacketsCache
+func newSlashPacketsCache(perConsumerMeters bool) *slashPacketsCache {
+	return &slashPacketsCache{
+		perConsumerMeters: perConsumerMeters,
+		consumerMeters:    map[string]math.Int{},
+		infractionParams:  map[string]providertypes.InfractionParameters{},
+	}
+}
+
+// getSlashMeter returns the cached slash meter applying to the slash packets of the given consumer chain,
+// reading it from the store on first use. If per consumer slash meters are enabled, this is the minimum
+// of the slash meter of the consumer chain and of the global slash meter, as the global slash meter remains
+// an overall cap on the voting power jailed by all consumer chains. A consumer slash meter that is not set yet
+// is full, i.e., equal to the allowance.
+func (k Keeper) getSlashMeter(ctx sdk.Context, consumerId string, cache *slashPacketsCache) math.Int {
+	if cache.meter == nil {
+		meter := k.GetSlashMeter(ctx)
+		cache.meter = &meter
+	}
+	if !cache.perConsumerMeters {
+		return *cache.meter
+	}
+	consumerMeter, found := cache.consumerMeters[consumerId]
+	if !found {
+		consumerMeter, found = k.GetConsumerSlashMeter(ctx, consumerId)
+		if !found {
+			consumerMeter = k.GetSlashMeterAllowance(ctx)
+		}
+		cache.consumerMeters[consumerId] = consumerMeter
+	}
+	return math.MinInt(consumerMeter, *cache.meter)
+}
+
+// decreaseSlashMeter subtracts the given voting power from the cached global slash meter and, if per consumer
+// slash meters are enabled, from the cached slash meter of the given consumer chain
+func (k Keeper) decreaseSlashMeter(ctx sdk.Context, consumerId string, cache *slashPacketsCache, power math.Int) {
+	// read the slash meters on first use
+	k.getSlashMeter(ctx, consumerId, cache)
+
+	meter := cache.meter.Sub(power)
+	cache.meter = &meter
+	cache.meterUpdated = true
+	if cache.perConsumerMeters {
+		if !slices.Contains(cache.updatedConsumerMeters, consumerId) {
+			cache.updatedConsumerMeters = append(cache.updatedConsumerMeters, consumerId)
+		}
+		cache.consumerMeters[consumerId] = cache.consumerMeters[consumerId].Sub(power)
+	}
+}
+
+// getInfractionParameters returns the cached infraction parameters of the given consumer chain,
+// reading them from the store on first use
+func (k Keeper) getInfractionParameters(ctx sdk.Context, consumerId string, cache *slashPacketsCache) (providertypes.InfractionParameters, error) {
+	if params, found := cache.infractionParams[consumerId]; found {
+		return params, nil
+	}
+	params, err := k.GetInfractionParameters(ctx, consumerId)
+	if err != nil {
+		return params, err
+	}
+	cache.infractionParams[consumerId] = params
+	return params, nil
+}
+
+// slashPacketOutcome is the outcome of handling a received slash packet, see classifySlashPacket
+type slashPacketOutcome int
+
+const (
+	// the slash packet is invalid and acknowledged with an error
+	slashPacketInvalid slashPacketOutcome = iota
+	// the slash packet is for a double-sign infraction, which is only logged
+	slashPacketDoubleSign
+	// the consumer chain is not launched, so the slash packet is dropped
+	slashPacketConsumerNotLaunched
+	// the handling of the slash packets of the consumer chain is paused, so the slash packet is dropped
+	slashPacketConsumerPaused
+	// the validator does not exist on the provider chain, so the slash packet is dropped
+	slashPacketUnknownValidator
+	// the validator does not exist on the provider chain and RejectUnknownSlashValidators is enabled,
+	// so the slash packet is acknowledged with an error
+	slashPacketUnknownValidatorRejected
+	// the validator does not belong to the consumer valset, so the slash packet is dropped
+	slashPacketNotConsumerValidator
+	// the consumer chain is in the record-only slash mode, so the slash packet is only recorded
+	slashPacketRecordOnly
+	// the validator is already jailed, so the slash packet is acknowledged without being throttled
+	slashPacketValidatorJailed
+	// the slash meter is negative, so the slash packet is bounced
+	slashPacketBounced
+	// the handling of the slash packet is deferred by the downtime slash grace period
+	slashPacketDeferred
+	// the slash packet consumes the slash meter and jails the validator
+	slashPacketJail
+)
+
+// handled returns whether a slash packet with this outcome is acknowledged with SlashPacketHandledResult
+func (o slashPacketOutcome) handled() bool {
+	switch o {
+	case slashPacketInvalid, slashPacketUnknownValidatorRejected, slashPacketBounced:
+		return false
+	default:
+		return true
+	}
+}
+
+// classifySlashPacket returns, without mutating state, the outcome of handling the given slash packet
+// received from the consumer chain with the given `consumerId`, where `getMeter` returns the current value
+// of its slash meter and is only called for downtime slash packets that could consume the slash meter.
+// It is used both to handle the received slash packets and to estimate their cost, so that the two never diverge.
+// An error is returned together with slashPacketInvalid and slashPacketUnknownValidatorRejected.
+func (k Keeper) classifySlashPacket(
+	ctx sdk.Context,
+	consumerId string,
+	data ccv.SlashPacketData,
+	getMeter func() math.Int,
+) (slashPacketOutcome, error) {
+	if err := data.Validate(); err != nil {
+		return slashPacketInvalid, errorsmod.Wrapf(err, "error validating SlashPacket data")
+	}
+	if err := k.validateSlashPacketData(ctx, consumerId, data); err != nil {
+		return slashPacketInvalid, err
+	}
+
+	if data.Infraction == stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN {
+		return slashPacketDoubleSign, nil
+	}
+
+	if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+		return slashPacketConsumerNotLaunched, nil
+	}
+	if k.IsSlashPacketsPaused(ctx, consumerId) {
+		return slashPacketConsumerPaused, nil
+	}
+
+	// a stale or malicious consumer chain may send slash packets for arbitrary validators;
+	// such packets never consume the slash meter
+	providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, providertypes.NewConsumerConsAddress(data.Validator.Address))
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr())
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		if k.GetRejectUnknownSlashValidators(ctx) {
+			return slashPacketUnknownValidatorRejected, errorsmod.Wrapf(providertypes.ErrUnknownSlashPacketValidator,
+				"provider cons addr %s on consumer chain %s", providerConsAddr.String(), consumerId)
+		}
+		return slashPacketUnknownValidator, nil
+	}
+
+	if !k.IsConsumerValidator(ctx, consumerId, providerConsAddr) {
+		return slashPacketNotConsumerValidator, nil
+	}
+
+	// in the record-only slash mode, the validator is neither jailed nor slashed
+	// and the slash meter is not consumed
+	if k.GetConsumerSlashMode(ctx, consumerId) == providertypes.SLASH_MODE_RECORD_ONLY {
+		return slashPacketRecordOnly, nil
+	}
+
+	// a validator that is already jailed cannot be jailed again, so redundant packets
+	// neither consume the slash meter nor get bounced when the meter is negative
+	if err == nil && validator.IsJailed() {
+		return slashPacketValidatorJailed, nil
+	}
+
+	if getMeter().IsNegative() {
+		return slashPacketBounced, nil
+	}
+
+	// the slash meter is consumed once the deferred slash packet is handled, see HandlePendingDowntimeSlashes
+	if k.GetDowntimeSlashGracePeriod(ctx) > 0 {
+		return slashPacketDeferred, nil
+	}
+
+	return slashPacketJail, nil
+}
+
+// onRecvSlashPacket handles a single received slash packet using the given cache
+func (k Keeper) onRecvSlashPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.SlashPacketData,
+	cache *slashPacketsCache,
 ) (ccv.PacketAckResult, error) {
 	// check that the channel is established, panic if not
 	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
@@ -303,12 +848,21 @@ func (k Keeper) OnRecvSlashPacket(
 		panic(fmt.Errorf("SlashPacket received on unknown channel %s", packet.DestinationChannel))
 	}
 
-	// validate packet data upon receiving
-	if err := data.Validate(); err != nil {
-		return nil, errorsmod.Wrapf(err, "error validating SlashPacket data")
-	}
+	// count every received slash packet that is acknowledged with a result; note that the invalid
+	// slash packets are not counted, as the state changes of a packet acknowledged with an error are reverted
+	k.IncrementSlashPacketCount(ctx, consumerId)
 
-	if err := k.ValidateSlashPacket(ctx, consumerId, packet, data); err != nil {
+	outcome, err := k.classifySlashPacket(ctx, consumerId, data, func() math.Int {
+		return k.getSlashMeter(ctx, consumerId, cache)
+	})
+
+	// The slash packet validator address may be known only on the consumer chain,
+	// in this case, it must be mapped back to the consensus address on the provider chain
+	consumerConsAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
+	providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerConsAddr)
+
+	switch outcome {
+	case slashPacketInvalid:
 		k.Logger(ctx).Error("invalid slash packet",
 			"error", err.Error(),
 			"consumerId", consumerId,
@@ -317,18 +871,14 @@ func (k Keeper) OnRecvSlashPacket(
 			"infractionType", data.Infraction,
 		)
 		return nil, err
-	}
-
-	// The slash packet validator address may be known only on the consumer chain,
-	// in this case, it must be mapped back to the consensus address on the provider chain
-	consumerConsAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
-	providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerConsAddr)
 
-	if data.Infraction == stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN {
+	case slashPacketDoubleSign:
 		// getMappedInfractionHeight is already checked in ValidateSlashPacket
 		infractionHeight, _ := k.getMappedInfractionHeight(ctx, consumerId, data.ValsetUpdateId)
 
 		k.SetSlashLog(ctx, providerConsAddr)
+		// double-sign slash packets are not throttled, so they are counted separately from the slash meter
+		k.IncrementInfractionSlashCount(ctx, data.Infraction)
 		k.Logger(ctx).Info("SlashPacket received for double-signing",
 			"consumerId", consumerId,
 			"consumer cons addr", consumerConsAddr.String(),
@@ -340,10 +890,8 @@ func (k Keeper) OnRecvSlashPacket(
 		// return successful ack, as an error would result
 		// in the consumer closing the CCV channel
 		return ccv.V1Result, nil
-	}
 
-	// check that the chain is launched
-	if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+	case slashPacketConsumerNotLaunched:
 		k.Logger(ctx).Info("cannot jail validator on a chain that is not currently launched",
 			"consumerId", consumerId,
 			"phase", k.GetConsumerPhase(ctx, consumerId),
@@ -354,10 +902,46 @@ func (k Keeper) OnRecvSlashPacket(
 		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
 
 		return ccv.SlashPacketHandledResult, nil
-	}
 
-	// check that the validator belongs to the consumer chain valset
-	if !k.IsConsumerValidator(ctx, consumerId, providerConsAddr) {
+	case slashPacketConsumerPaused:
+		k.Logger(ctx).Info("cannot jail validator while the slash packets of the consumer chain are paused",
+			"consumerId", consumerId,
+			"provider cons addr", providerConsAddr.String(),
+		)
+
+		// drop packet but return a slash ack so that the consumer can send another slash packet
+		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+
+		return ccv.SlashPacketHandledResult, nil
+
+	case slashPacketUnknownValidator, slashPacketUnknownValidatorRejected:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				providertypes.EventTypeUnknownSlashValidator,
+				sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+				sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(ccv.AttributeValidatorAddress, providerConsAddr.String()),
+				sdk.NewAttribute(ccv.AttributeInfractionType, data.Infraction.String()),
+				sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
+			),
+		)
+		k.Logger(ctx).Error("SlashPacket received for a validator that does not exist on the provider chain",
+			"consumerId", consumerId,
+			"consumer cons addr", consumerConsAddr.String(),
+			"provider cons addr", providerConsAddr.String(),
+			"vscID", data.ValsetUpdateId,
+			"infractionType", data.Infraction,
+		)
+		if outcome == slashPacketUnknownValidatorRejected {
+			return nil, err
+		}
+
+		// drop packet but return a slash ack so that the consumer can send another slash packet
+		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+
+		return ccv.SlashPacketHandledResult, nil
+
+	case slashPacketNotConsumerValidator:
 		k.Logger(ctx).Error("cannot jail validator that does not belong on the consumer valset",
 			"consumerId", consumerId,
 			"provider cons addr", providerConsAddr.String(),
@@ -367,11 +951,28 @@ func (k Keeper) OnRecvSlashPacket(
 		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
 
 		return ccv.SlashPacketHandledResult, nil
-	}
 
-	meter := k.GetSlashMeter(ctx)
-	// Return bounce ack if meter is negative in value
-	if meter.IsNegative() {
+	case slashPacketRecordOnly:
+		k.recordSlashPacketOnly(ctx, consumerId, providerConsAddr, data)
+		cache.recorded = true
+
+		return ccv.SlashPacketHandledResult, nil
+
+	case slashPacketValidatorJailed:
+		k.Logger(ctx).Info("SlashPacket received for an already jailed validator",
+			"consumerId", consumerId,
+			"consumer cons addr", consumerConsAddr.String(),
+			"provider cons addr", providerConsAddr.String(),
+			"vscID", data.ValsetUpdateId,
+			"infractionType", data.Infraction,
+		)
+
+		// return a slash ack so that the consumer can send another slash packet for this validator
+		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+
+		return ccv.SlashPacketHandledResult, nil
+
+	case slashPacketBounced:
 		k.Logger(ctx).Info("SlashPacket received, but meter is negative. Packet will be bounced",
 			"consumerId", consumerId,
 			"consumer cons addr", consumerConsAddr.String(),
@@ -380,14 +981,34 @@ func (k Keeper) OnRecvSlashPacket(
 			"infractionType", data.Infraction,
 		)
 		return ccv.SlashPacketBouncedResult, nil
+
+	case slashPacketDeferred:
+		// defer the handling of the slash packet by the downtime slash grace period.
+		// Note that the slash ack is appended once the slash packet is handled or cancelled,
+		// so that the consumer does not send another slash packet for this validator meanwhile
+		gracePeriod := k.GetDowntimeSlashGracePeriod(ctx)
+		k.SetPendingDowntimeSlash(ctx, consumerId, providertypes.PendingDowntimeSlash{
+			JailTime:        ctx.BlockTime().Add(gracePeriod),
+			SlashPacketData: data,
+			Sequence:        packet.Sequence,
+		})
+		cache.deferred = true
+		k.Logger(ctx).Info("slash packet received and deferred by the downtime slash grace period",
+			"consumerId", consumerId,
+			"consumer cons addr", consumerConsAddr.String(),
+			"provider cons addr", providerConsAddr.String(),
+			"vscID", data.ValsetUpdateId,
+			"gracePeriod", gracePeriod,
+		)
+		return ccv.SlashPacketHandledResult, nil
 	}
 
 	// Subtract voting power that will be jailed/tombstoned from the slash meter,
 	// BEFORE handling slash packet.
-	meter = meter.Sub(k.GetEffectiveValPower(ctx, providerConsAddr))
-	k.SetSlashMeter(ctx, meter)
+	k.decreaseSlashMeter(ctx, consumerId, cache, k.GetEffectiveValPower(ctx, providerConsAddr))
+	k.IncrementInfractionSlashCount(ctx, data.Infraction)
 
-	k.HandleSlashPacket(ctx, consumerId, data)
+	k.handleSlashPacket(ctx, consumerId, data, cache)
 
 	k.Logger(ctx).Info("slash packet received and handled",
 		"consumerId", consumerId,
@@ -401,12 +1022,74 @@ func (k Keeper) OnRecvSlashPacket(
 	return ccv.SlashPacketHandledResult, nil
 }
 
+// recordSlashPacketOnly records the given downtime slash packet received from a consumer chain
+// in the record-only slash mode, i.e., without jailing the validator, and appends a slash ack
+// so that the consumer can send another slash packet for this validator
+func (k Keeper) recordSlashPacketOnly(
+	ctx sdk.Context,
+	consumerId string,
+	providerConsAddr providertypes.ProviderConsAddress,
+	data ccv.SlashPacketData,
+) {
+	consumerConsAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
+	k.SetSlashLog(ctx, providerConsAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			providertypes.EventTypeRecordConsumerSlash,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(ccv.AttributeValidatorAddress, providerConsAddr.String()),
+			sdk.NewAttribute(ccv.AttributeInfractionType, data.Infraction.String()),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
+		),
+	)
+	k.Logger(ctx).Info("slash packet recorded without jailing the validator",
+		"consumerId", consumerId,
+		"consumer cons addr", consumerConsAddr.String(),
+		"provider cons addr", providerConsAddr.String(),
+		"vscID", data.ValsetUpdateId,
+		"infractionType", data.Infraction,
+	)
+
+	k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+}
+
+// EstimateSlashPacketCost estimates, without mutating state, the outcome of handling at the current block
+// the given slash packet received from the consumer chain with the given `consumerId`.
+// It returns whether the packet would be handled, i.e., acknowledged with SlashPacketHandledResult,
+// and the value of the slash meter afterwards. Packets that would be rejected as invalid or bounced
+// because the slash meter is negative are reported as not handled.
+// Note that the estimate uses the same classification as the handling of received slash packets,
+// i.e., only the downtime slash packets for validators that are jailed upon receipt consume the slash meter,
+// which is the minimum of the slash meter of the consumer chain and of the global slash meter
+// if per consumer slash meters are enabled.
+func (k Keeper) EstimateSlashPacketCost(
+	ctx sdk.Context,
+	consumerId string,
+	data ccv.SlashPacketData,
+) (handled bool, meterAfter math.Int) {
+	meter := k.getSlashMeter(ctx, consumerId, newSlashPacketsCache(k.GetPerConsumerSlashMeters(ctx)))
+
+	outcome, _ := k.classifySlashPacket(ctx, consumerId, data, func() math.Int { return meter })
+	if outcome == slashPacketJail {
+		providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, providertypes.NewConsumerConsAddress(data.Validator.Address))
+		return true, meter.Sub(k.GetEffectiveValPower(ctx, providerConsAddr))
+	}
+	return outcome.handled(), meter
+}
+
 // ValidateSlashPacket validates a recv slash packet before it is
 // handled or persisted in store. An error is returned if the packet is invalid,
 // and an error ack should be relayed to the sender.
 func (k Keeper) ValidateSlashPacket(ctx sdk.Context, consumerId string,
 	packet channeltypes.Packet, data ccv.SlashPacketData,
 ) error {
+	return k.validateSlashPacketData(ctx, consumerId, data)
+}
+
+// validateSlashPacketData validates the data of a recv slash packet, see ValidateSlashPacket
+func (k Keeper) validateSlashPacketData(ctx sdk.Context, consumerId string, data ccv.SlashPacketData) error {
 	_, found := k.getMappedInfractionHeight(ctx, consumerId, data.ValsetUpdateId)
 	// return error if we cannot find infraction height matching the validator update id
 	if !found {
@@ -420,6 +1103,141 @@ func (k Keeper) ValidateSlashPacket(ctx sdk.Context, consumerId string,
 // HandleSlashPacket potentially jails a misbehaving validator for a downtime infraction.
 // This method should NEVER be called with a double-sign infraction.
 func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.SlashPacketData) {
+	k.handleSlashPacket(ctx, consumerId, data, &slashPacketsCache{
+		infractionParams: map[string]providertypes.InfractionParameters{},
+	})
+}
+
+// HandlePendingDowntimeSlashes handles the deferred downtime slash packets
+// whose downtime slash grace period elapsed
+func (k Keeper) HandlePendingDowntimeSlashes(ctx sdk.Context) {
+	type consumerSlash struct {
+		consumerId string
+		pending    providertypes.PendingDowntimeSlash
+	}
+	var toHandle []consumerSlash
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.PendingDowntimeSlashKeyPrefix()})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, _, err := providertypes.ParseStringIdAndConsAddrKey(providertypes.PendingDowntimeSlashKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetPendingDowntimeSlash.
+			panic(fmt.Errorf("failed to parse PendingDowntimeSlash key: %w", err))
+		}
+		var pending providertypes.PendingDowntimeSlash
+		if err := pending.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the PendingDowntimeSlash is assumed to be correctly serialized in SetPendingDowntimeSlash.
+			panic(fmt.Errorf("failed to unmarshal PendingDowntimeSlash: %w", err))
+		}
+		if ctx.BlockTime().Before(pending.JailTime) {
+			continue
+		}
+		toHandle = append(toHandle, consumerSlash{consumerId: consumerId, pending: pending})
+	}
+
+	cache := newSlashPacketsCache(k.GetPerConsumerSlashMeters(ctx))
+	for _, s := range toHandle {
+		k.handlePendingDowntimeSlash(ctx, s.consumerId, s.pending, cache)
+	}
+
+	if cache.meterUpdated {
+		k.SetSlashMeter(ctx, *cache.meter)
+	}
+	for _, consumerId := range cache.updatedConsumerMeters {
+		k.SetConsumerSlashMeter(ctx, consumerId, cache.consumerMeters[consumerId])
+	}
+}
+
+// handlePendingDowntimeSlash handles a deferred downtime slash packet whose downtime slash grace period elapsed.
+// As the state of the consumer chain may have changed during the grace period, the checks done upon receiving
+// the slash packet are done again, i.e., the slash packet is dropped if the consumer chain is no longer launched
+// or its slash packets are paused, and only recorded if the consumer chain is in the record-only slash mode.
+// Otherwise, the slash meter is consumed and the validator is jailed. If the slash meter is negative,
+// the slash packet stays pending until the slash meter is replenished, as it was already acknowledged.
+// Once the slash packet is no longer pending, its record is updated accordingly.
+func (k Keeper) handlePendingDowntimeSlash(
+	ctx sdk.Context,
+	consumerId string,
+	pending providertypes.PendingDowntimeSlash,
+	cache *slashPacketsCache,
+) {
+	data := pending.SlashPacketData
+	consumerConsAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
+	providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerConsAddr)
+
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase != providertypes.CONSUMER_PHASE_LAUNCHED || k.IsSlashPacketsPaused(ctx, consumerId) {
+		k.Logger(ctx).Info("deferred downtime slash packet dropped, as the consumer chain is not launched or its slash packets are paused",
+			"consumerId", consumerId,
+			"phase", phase,
+			"provider cons addr", providerConsAddr.String(),
+		)
+		k.DeletePendingDowntimeSlash(ctx, consumerId, consumerConsAddr)
+		// drop packet but return a slash ack so that the consumer can send another slash packet
+		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+		k.completePendingSlashPacketRecord(ctx, consumerId, pending.Sequence, providertypes.SLASH_PACKET_STATUS_HANDLED, false)
+		return
+	}
+
+	if k.GetConsumerSlashMode(ctx, consumerId) == providertypes.SLASH_MODE_RECORD_ONLY {
+		k.DeletePendingDowntimeSlash(ctx, consumerId, consumerConsAddr)
+		k.recordSlashPacketOnly(ctx, consumerId, providerConsAddr, data)
+		k.completePendingSlashPacketRecord(ctx, consumerId, pending.Sequence, providertypes.SLASH_PACKET_STATUS_RECORDED, false)
+		return
+	}
+
+	meter := k.getSlashMeter(ctx, consumerId, cache)
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr())
+	if meter.IsNegative() && !(err == nil && validator.IsJailed()) {
+		k.Logger(ctx).Info("deferred downtime slash packet kept pending, as the slash meter is negative",
+			"consumerId", consumerId,
+			"provider cons addr", providerConsAddr.String(),
+		)
+		return
+	}
+
+	k.DeletePendingDowntimeSlash(ctx, consumerId, consumerConsAddr)
+	// already jailed validators do not consume the slash meter, as when receiving slash packets
+	if err == nil && !validator.IsJailed() {
+		k.decreaseSlashMeter(ctx, consumerId, cache, k.GetEffectiveValPower(ctx, providerConsAddr))
+		k.IncrementInfractionSlashCount(ctx, data.Infraction)
+	}
+	cache.jailed = false
+	if acked := k.handleSlashPacket(ctx, consumerId, data, cache); !acked {
+		// the slash packet was dropped, e.g., because the validator unbonded during the grace period;
+		// still return a slash ack so that the consumer can send another slash packet for this validator
+		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+	}
+	k.completePendingSlashPacketRecord(ctx, consumerId, pending.Sequence, providertypes.SLASH_PACKET_STATUS_HANDLED, cache.jailed)
+}
+
+// CancelPendingDowntimeSlash cancels the deferred downtime slash packet received from the given
+// consumer chain for the validator with `consumerAddr`, e.g., once the validator recovered.
+// It returns false if there is no such deferred downtime slash packet.
+func (k Keeper) CancelPendingDowntimeSlash(ctx sdk.Context, consumerId string, consumerAddr providertypes.ConsumerConsAddress) bool {
+	pending, found := k.GetPendingDowntimeSlash(ctx, consumerId, consumerAddr)
+	if !found {
+		return false
+	}
+	k.DeletePendingDowntimeSlash(ctx, consumerId, consumerAddr)
+
+	// return a slash ack so that the consumer can send another slash packet for this validator
+	k.AppendSlashAck(ctx, consumerId, consumerAddr.String())
+	k.completePendingSlashPacketRecord(ctx, consumerId, pending.Sequence, providertypes.SLASH_PACKET_STATUS_HANDLED, false)
+
+	k.Logger(ctx).Info("deferred downtime slash packet cancelled",
+		"consumerId", consumerId,
+		"consumer cons addr", consumerAddr.String(),
+	)
+	return true
+}
+
+// handleSlashPacket handles a downtime slash packet using the given cache, see HandleSlashPacket.
+// It returns whether a slash ack was appended for the validator, i.e., false if the slash packet was dropped.
+func (k Keeper) handleSlashPacket(ctx sdk.Context, consumerId string, data ccv.SlashPacketData, cache *slashPacketsCache) (acked bool) {
 	consumerConsAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
 	// Obtain provider chain consensus address using the consumer chain consensus address
 	providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerConsAddr)
@@ -476,14 +1294,19 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 	// append the validator address to the slash ack for its consumer id
 	// TODO: consumer cons address should be accepted here
 	k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+	acked = true
 
-	infractionParams, err := k.GetInfractionParameters(ctx, consumerId)
+	infractionParams, err := k.getInfractionParameters(ctx, consumerId, cache)
 	if err != nil {
 		k.Logger(ctx).Error("failed to get infraction parameters", "err", err.Error())
 		return
 	}
 
 	if !validator.IsJailed() {
+		// the jailed power is the power of the validator on the provider chain,
+		// which has to be obtained before the validator is jailed
+		jailedPower := k.GetEffectiveValPower(ctx, providerConsAddr)
+
 		// slash validator
 		_, err = k.stakingKeeper.SlashWithInfractionReason(ctx, providerConsAddr.ToSdkConsAddr(), int64(infractionHeight),
 			data.Validator.Power, infractionParams.Downtime.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
@@ -500,12 +1323,35 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 		}
 		k.Logger(ctx).Info("HandleSlashPacket - validator jailed", "provider cons addr", providerConsAddr.String())
 
+		// record that the validator was jailed because of this consumer chain
+		k.SetJailingReason(ctx, providerConsAddr, providertypes.JailingReason{
+			ConsumerId: consumerId,
+			Infraction: data.Infraction,
+		})
+		cache.jailed = true
+		k.IncreaseConsumerJailedPower(ctx, consumerId, jailedPower.Int64())
+
 		jailEndTime := ctx.BlockTime().Add(infractionParams.Downtime.JailDuration)
 		err = k.slashingKeeper.JailUntil(ctx, providerConsAddr.ToSdkConsAddr(), jailEndTime)
 		if err != nil {
 			k.Logger(ctx).Error("failed to set jail duration", "err", err.Error())
 			return
 		}
+
+		if k.hooks != nil {
+			// run the hooks in a cached context, so that a failing hook neither halts
+			// the provider chain nor leaves partial state changes behind
+			cachedCtx, writeFn := ctx.CacheContext()
+			if err := k.hooks.AfterConsumerInitiatedSlash(cachedCtx, consumerId, providerConsAddr, data.Infraction); err != nil {
+				k.Logger(ctx).Error("AfterConsumerInitiatedSlash hook failed",
+					"consumerId", consumerId,
+					"provider cons addr", providerConsAddr.String(),
+					"error", err,
+				)
+			} else {
+				writeFn()
+			}
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -518,6 +1364,7 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
 		),
 	)
+	return acked
 }
 
 // getMappedInfractionHeight gets the infraction height mapped from val set ID for the given consumer id
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
+	gomath "math"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -10,11 +14,13 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -144,6 +150,127 @@ func TestQueueVSCPacketsDoesNotResetConsumerValidatorsHeights(t *testing.T) {
 	require.Equal(t, chainHeight, cv.JoinHeight, "the consumer validator's height was not correctly set")
 }
 
+// vscSentRecorder is a provider hook that records the VSC packets that were sent
+type vscSentRecorder struct {
+	providertypes.BaseProviderHooks
+	vscIds  []uint64
+	updates [][]abci.ValidatorUpdate
+}
+
+func (r *vscSentRecorder) AfterVSCPacketSent(_ context.Context, _ string, vscId uint64, updates []abci.ValidatorUpdate) error {
+	r.vscIds = append(r.vscIds, vscId)
+	r.updates = append(r.updates, updates)
+	return nil
+}
+
+// TestQueueVSCPacketsHooks tests that the AfterVSCPacketSent hooks are called once per queued VSC packet
+func TestQueueVSCPacketsHooks(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetValidatorSetUpdateId(ctx, 1)
+
+	recorderA, recorderB := &vscSentRecorder{}, &vscSentRecorder{}
+	providerKeeper.SetHooks(providertypes.NewMultiProviderHooks(recorderA, recorderB))
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	valAPubKey, _ := valA.CmtConsPublicKey()
+	valB := createStakingValidator(ctx, mocks, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	valBPubKey, _ := valB.CmtConsPublicKey()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 2, []stakingtypes.Validator{valA, valB}, -1)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	// validator A opts in and a VSC packet with valset update id 1 is queued
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valAConsAddr))
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	// no validator set changes and hence no VSC packet with valset update id 2 is queued
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	// validator B opts in and a VSC packet with valset update id 3 is queued
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valBConsAddr))
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), providerKeeper.GetValidatorSetUpdateId(ctx))
+
+	for _, recorder := range []*vscSentRecorder{recorderA, recorderB} {
+		require.Equal(t, []uint64{1, 3}, recorder.vscIds)
+		require.Equal(t, [][]abci.ValidatorUpdate{
+			{{PubKey: valAPubKey, Power: 1}},
+			{{PubKey: valBPubKey, Power: 2}},
+		}, recorder.updates)
+	}
+
+	// the hooks observe the same VSC packets that are queued
+	pending := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 2)
+	for i, packet := range pending {
+		require.Equal(t, recorderA.vscIds[i], packet.ValsetUpdateId)
+		require.Equal(t, recorderA.updates[i], packet.ValidatorUpdates)
+	}
+}
+
+// TestQueueVSCPacketsSendsOnlyChanges tests that, after the initial validator set,
+// the queued VSC packets only contain the validators that changed
+func TestQueueVSCPacketsSendsOnlyChanges(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetValidatorSetUpdateId(ctx, 1)
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	valAPubKey, _ := valA.CmtConsPublicKey()
+	valB := createStakingValidator(ctx, mocks, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	valBPubKey, _ := valB.CmtConsPublicKey()
+	valC := createStakingValidator(ctx, mocks, 3, 3)
+	valCConsAddr, _ := valC.GetConsAddr()
+	valCPubKey, _ := valC.CmtConsPublicKey()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 3, []stakingtypes.Validator{valA, valB, valC}, -1)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	// validators A and B opt in and the first VSC packet contains the full validator set
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valAConsAddr))
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valBConsAddr))
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	// validator A opts out and validator C opts in, while validator B remains unchanged
+	providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valAConsAddr))
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valCConsAddr))
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	pending := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 2)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: valAPubKey, Power: 1},
+		{PubKey: valBPubKey, Power: 2},
+	}, pending[0].ValidatorUpdates)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: valAPubKey, Power: 0},
+		{PubKey: valCPubKey, Power: 3},
+	}, pending[1].ValidatorUpdates)
+
+	// the full validator set is still available on the provider
+	consumerValSet, err := providerKeeper.GetConsumerValSet(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Len(t, consumerValSet, 2)
+}
+
 // TestOnRecvDowntimeSlashPacket tests the OnRecvSlashPacket method specifically for downtime slash packets.
 func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -173,6 +300,12 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// The validator is not jailed, so the packets are subject to throttling
+	providerAddr := providertypes.NewProviderConsAddress(packetData.Validator.Address)
+	valAddr := sdk.ValAddress(packetData.Validator.Address).String()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()).
+		Return(stakingtypes.Validator{OperatorAddress: valAddr}, nil).Times(2)
+
 	// Set slash meter to negative value and assert a bounce ack is returned
 	providerKeeper.SetSlashMeter(ctx, math.NewInt(-5))
 	ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, channelId0, 1, packetData)
@@ -204,9 +337,9 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	require.NoError(t, err)
 
 	// Mock call to GetEffectiveValPower, so that it returns 2.
-	providerAddr := providertypes.NewProviderConsAddress(packetData.Validator.Address)
-	valAddr := sdk.ValAddress(packetData.Validator.Address).String()
 	calls := []*gomock.Call{
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()).
+			Return(stakingtypes.Validator{OperatorAddress: valAddr}, nil).Times(1),
 		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()).
 			Return(stakingtypes.Validator{
 				// provided address must be valid so it can be processed correctly
@@ -233,6 +366,387 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	require.Equal(t, int64(3), providerKeeper.GetSlashMeter(ctx).Int64())
 }
 
+// TestOnRecvSlashPacketAlreadyJailedValidator tests that a downtime slash packet for a validator
+// that is already jailed is handled without consuming the slash meter.
+func TestOnRecvSlashPacketAlreadyJailedValidator(t *testing.T) {
+	// two downtime slash packets for the same validator
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 2)
+	require.Equal(t, datas[0].Validator.Address, datas[1].Validator.Address)
+	require.Equal(t, int64(101), providerKeeper.GetSlashMeter(ctx).Int64())
+
+	// the first packet jails the validator and consumes its power from the slash meter
+	ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[0], datas[0])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Len(t, jailed, 1)
+	require.Equal(t, int64(99), providerKeeper.GetSlashMeter(ctx).Int64())
+
+	// the second packet is acknowledged as handled without consuming the slash meter
+	ackResult, err = providerKeeper.OnRecvSlashPacket(ctx, packets[1], datas[1])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Equal(t, int64(99), providerKeeper.GetSlashMeter(ctx).Int64())
+	require.Len(t, providerKeeper.GetSlashAcks(ctx, "0"), 2)
+
+	// the second packet is also handled when the slash meter is negative
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(-5))
+	ackResult, err = providerKeeper.OnRecvSlashPacket(ctx, packets[1], datas[1])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Equal(t, int64(-5), providerKeeper.GetSlashMeter(ctx).Int64())
+}
+
+// setupDowntimeSlashGracePeriod returns a provider keeper with a downtime slash grace period of one hour,
+// for which downtime slash packets for two validators were received and deferred
+func setupDowntimeSlashGracePeriod(t *testing.T) (keeper.Keeper, sdk.Context, []channeltypes.Packet, []ccv.SlashPacketData, map[string]bool) {
+	t.Helper()
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 5)
+	params := providerKeeper.GetParams(ctx)
+	params.DowntimeSlashGracePeriod = time.Hour
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	ackResults, errs := providerKeeper.OnRecvSlashPackets(ctx, packets[:2], datas[:2])
+	for i := range ackResults {
+		require.NoError(t, errs[i])
+		require.Equal(t, ccv.SlashPacketHandledResult, ackResults[i])
+	}
+
+	// the validators are not jailed yet and no slash acks are sent
+	for _, data := range datas[:2] {
+		require.False(t, jailed[sdk.ConsAddress(data.Validator.Address).String()])
+		_, found := providerKeeper.GetPendingDowntimeSlash(ctx, "0", providertypes.NewConsumerConsAddress(data.Validator.Address))
+		require.True(t, found)
+	}
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, "0"))
+	// the slash meter is not consumed yet
+	require.Equal(t, int64(101), providerKeeper.GetSlashMeter(ctx).Int64())
+	// the slash packets are recorded as pending
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets[:2], providertypes.SLASH_PACKET_STATUS_PENDING, false)
+
+	return providerKeeper, ctx, packets[:2], datas[:2], jailed
+}
+
+// requireSlashPacketRecords checks the status of the records of the given slash packets
+// and whether their validators were jailed because of them
+func requireSlashPacketRecords(t *testing.T, ctx sdk.Context, k keeper.Keeper,
+	packets []channeltypes.Packet, status providertypes.SlashPacketStatus, jailed bool,
+) {
+	t.Helper()
+	for _, packet := range packets {
+		record, found := k.GetSlashPacketRecord(ctx, "0", packet.Sequence)
+		require.True(t, found)
+		require.Equal(t, status, record.Status)
+		require.Equal(t, jailed, record.Jailed)
+	}
+}
+
+// TestDowntimeSlashGracePeriodJail tests that the validators are jailed
+// once the downtime slash grace period elapses
+func TestDowntimeSlashGracePeriodJail(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupDowntimeSlashGracePeriod(t)
+
+	// the grace period did not elapse yet
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour - time.Second))
+	providerKeeper.HandlePendingDowntimeSlashes(ctx)
+	for _, data := range datas {
+		require.False(t, jailed[sdk.ConsAddress(data.Validator.Address).String()])
+	}
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets, providertypes.SLASH_PACKET_STATUS_PENDING, false)
+
+	// the grace period elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	providerKeeper.HandlePendingDowntimeSlashes(ctx)
+	for _, data := range datas {
+		consumerAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
+		require.True(t, jailed[sdk.ConsAddress(data.Validator.Address).String()])
+		_, found := providerKeeper.GetPendingDowntimeSlash(ctx, "0", consumerAddr)
+		require.False(t, found)
+		require.Contains(t, providerKeeper.GetSlashAcks(ctx, "0"), consumerAddr.String())
+	}
+	// the slash meter is consumed once the validators are jailed
+	require.Equal(t, int64(97), providerKeeper.GetSlashMeter(ctx).Int64())
+	// the records of the slash packets are updated once the validators are jailed
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets, providertypes.SLASH_PACKET_STATUS_HANDLED, true)
+	require.Equal(t, uint64(2), providerKeeper.GetInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOWNTIME))
+}
+
+// TestDowntimeSlashGracePeriodRecheck tests that the state of the consumer chain
+// is checked again once the downtime slash grace period elapses
+func TestDowntimeSlashGracePeriodRecheck(t *testing.T) {
+	testCases := []struct {
+		name string
+		// setup is called before the grace period elapses
+		setup func(sdk.Context, keeper.Keeper)
+		// whether the validators are jailed once the grace period elapses
+		expJailed bool
+		// whether the deferred slash packets are still pending once the grace period elapses
+		expPending bool
+		// the status of the records of the slash packets once the grace period elapses
+		expStatus providertypes.SlashPacketStatus
+	}{
+		{
+			"consumer chain stopped", func(ctx sdk.Context, k keeper.Keeper) {
+				k.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_STOPPED)
+			}, false, false, providertypes.SLASH_PACKET_STATUS_HANDLED,
+		},
+		{
+			"slash packets paused", func(ctx sdk.Context, k keeper.Keeper) {
+				k.SetSlashPacketsPaused(ctx, "0", true)
+			}, false, false, providertypes.SLASH_PACKET_STATUS_HANDLED,
+		},
+		{
+			"record-only slash mode", func(ctx sdk.Context, k keeper.Keeper) {
+				k.SetConsumerSlashMode(ctx, "0", providertypes.SLASH_MODE_RECORD_ONLY)
+			}, false, false, providertypes.SLASH_PACKET_STATUS_RECORDED,
+		},
+		{
+			"negative slash meter", func(ctx sdk.Context, k keeper.Keeper) {
+				k.SetSlashMeter(ctx, math.NewInt(-1))
+			}, false, true, providertypes.SLASH_PACKET_STATUS_PENDING,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, packets, datas, jailed := setupDowntimeSlashGracePeriod(t)
+			tc.setup(ctx, providerKeeper)
+			meter := providerKeeper.GetSlashMeter(ctx)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+			providerKeeper.HandlePendingDowntimeSlashes(ctx)
+			for _, data := range datas {
+				consumerAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
+				require.Equal(t, tc.expJailed, jailed[sdk.ConsAddress(data.Validator.Address).String()])
+				_, found := providerKeeper.GetPendingDowntimeSlash(ctx, "0", consumerAddr)
+				require.Equal(t, tc.expPending, found)
+				// a slash ack is only returned once the slash packet is no longer pending
+				require.Equal(t, !tc.expPending, slices.Contains(providerKeeper.GetSlashAcks(ctx, "0"), consumerAddr.String()))
+			}
+			// the slash meter is not consumed
+			require.Equal(t, meter, providerKeeper.GetSlashMeter(ctx))
+			requireSlashPacketRecords(t, ctx, providerKeeper, packets, tc.expStatus, false)
+		})
+	}
+}
+
+// TestDowntimeSlashGracePeriodDropped tests that a slash ack is returned for a deferred
+// downtime slash packet that is dropped once the grace period elapses
+func TestDowntimeSlashGracePeriodDropped(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupDowntimeSlashGracePeriod(t)
+
+	// the infraction height is no longer mapped, so the slash packets are dropped
+	providerKeeper.DeleteValsetUpdateBlockHeight(ctx, datas[0].ValsetUpdateId)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	providerKeeper.HandlePendingDowntimeSlashes(ctx)
+	for _, data := range datas {
+		consumerAddr := providertypes.NewConsumerConsAddress(data.Validator.Address)
+		require.False(t, jailed[sdk.ConsAddress(data.Validator.Address).String()])
+		_, found := providerKeeper.GetPendingDowntimeSlash(ctx, "0", consumerAddr)
+		require.False(t, found)
+		require.Contains(t, providerKeeper.GetSlashAcks(ctx, "0"), consumerAddr.String())
+	}
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets, providertypes.SLASH_PACKET_STATUS_HANDLED, false)
+}
+
+// TestDowntimeSlashGracePeriodCancel tests that the validators whose deferred
+// downtime slash packet is cancelled by governance during the grace period are not jailed
+func TestDowntimeSlashGracePeriodCancel(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupDowntimeSlashGracePeriod(t)
+	msgServer := keeper.NewMsgServerImpl(&providerKeeper)
+
+	// the first validator recovers during the grace period; as it did not assign
+	// a consumer key, its provider address is the same as its consumer address
+	recoveredAddr := providertypes.NewConsumerConsAddress(datas[0].Validator.Address)
+	msg := providertypes.MsgCancelPendingDowntimeSlash{
+		ConsumerId:   "0",
+		ProviderAddr: sdk.ConsAddress(datas[0].Validator.Address).String(),
+		Authority:    providerKeeper.GetAuthority(),
+	}
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+
+	// only governance or the owner of the consumer chain can cancel a deferred downtime slash packet
+	providerKeeper.SetConsumerOwnerAddress(ctx, "0", "owner")
+	_, err := msgServer.CancelPendingDowntimeSlash(ctx, &providertypes.MsgCancelPendingDowntimeSlash{
+		ConsumerId: msg.ConsumerId, ProviderAddr: msg.ProviderAddr, Authority: "notAuthority",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	_, err = msgServer.CancelPendingDowntimeSlash(ctx, &msg)
+	require.NoError(t, err)
+	_, err = msgServer.CancelPendingDowntimeSlash(ctx, &msg)
+	require.ErrorIs(t, err, providertypes.ErrNoPendingDowntimeSlash)
+	require.False(t, providerKeeper.CancelPendingDowntimeSlash(ctx, "0", recoveredAddr))
+	// the slash ack allows the consumer to send another slash packet for the validator
+	require.Equal(t, []string{recoveredAddr.String()}, providerKeeper.GetSlashAcks(ctx, "0"))
+	// the record of the cancelled slash packet is no longer pending
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets[:1], providertypes.SLASH_PACKET_STATUS_HANDLED, false)
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets[1:], providertypes.SLASH_PACKET_STATUS_PENDING, false)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	providerKeeper.HandlePendingDowntimeSlashes(ctx)
+	require.False(t, jailed[sdk.ConsAddress(datas[0].Validator.Address).String()])
+	require.True(t, jailed[sdk.ConsAddress(datas[1].Validator.Address).String()])
+	// the cancelled slash packet does not consume the slash meter
+	require.Equal(t, int64(99), providerKeeper.GetSlashMeter(ctx).Int64())
+}
+
+// TestDowntimeSlashGracePeriodCancelByOwner tests that the owner of the consumer chain
+// can cancel a deferred downtime slash packet without a governance proposal
+func TestDowntimeSlashGracePeriodCancelByOwner(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupDowntimeSlashGracePeriod(t)
+	msgServer := keeper.NewMsgServerImpl(&providerKeeper)
+	providerKeeper.SetConsumerOwnerAddress(ctx, "0", "owner")
+
+	_, err := msgServer.CancelPendingDowntimeSlash(ctx, &providertypes.MsgCancelPendingDowntimeSlash{
+		ConsumerId:   "0",
+		ProviderAddr: sdk.ConsAddress(datas[0].Validator.Address).String(),
+		Authority:    "owner",
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	providerKeeper.HandlePendingDowntimeSlashes(ctx)
+	require.False(t, jailed[sdk.ConsAddress(datas[0].Validator.Address).String()])
+	require.True(t, jailed[sdk.ConsAddress(datas[1].Validator.Address).String()])
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets[:1], providertypes.SLASH_PACKET_STATUS_HANDLED, false)
+	requireSlashPacketRecords(t, ctx, providerKeeper, packets[1:], providertypes.SLASH_PACKET_STATUS_HANDLED, true)
+}
+
+// TestOnRecvSlashPacketPausedConsumer tests that the slash packets received from a consumer chain
+// whose slash packets are paused are acknowledged without jailing, while the slash packets received
+// from other consumer chains are handled normally.
+func TestOnRecvSlashPacketPausedConsumer(t *testing.T) {
+	// slash packets received from consumer chain "0" for different validators
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 10)
+	require.NotEqual(t, datas[0].Validator.Address, datas[1].Validator.Address)
+
+	// launch a second consumer chain with the same validators, and pause its slash packets
+	pausedConsumerId := "1"
+	pausedChannelId := "channel-1"
+	providerKeeper.SetChannelToConsumerId(ctx, pausedChannelId, pausedConsumerId)
+	providerKeeper.SetConsumerPhase(ctx, pausedConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetInfractionParameters(ctx, pausedConsumerId, *getTestInfractionParameters()))
+	for _, data := range datas[:2] {
+		err := providerKeeper.SetConsumerValidator(ctx, pausedConsumerId, providertypes.ConsensusValidator{
+			ProviderConsAddr: data.Validator.Address,
+			Power:            2,
+		})
+		require.NoError(t, err)
+	}
+	providerKeeper.SetSlashPacketsPaused(ctx, pausedConsumerId, true)
+	meter := providerKeeper.GetSlashMeter(ctx)
+
+	// the slash packet received from the paused consumer chain does not jail the validator
+	ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, pausedChannelId, 1, datas[0])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Empty(t, jailed)
+	require.Equal(t, meter, providerKeeper.GetSlashMeter(ctx))
+	require.Len(t, providerKeeper.GetSlashAcks(ctx, pausedConsumerId), 1)
+
+	// the slash packet received from the other consumer chain jails the validator
+	ackResult, err = providerKeeper.OnRecvSlashPacket(ctx, packets[1], datas[1])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Len(t, jailed, 1)
+	require.True(t, jailed[sdk.ConsAddress(datas[1].Validator.Address).String()])
+
+	// once resumed, the slash packets received from the consumer chain jail validators again
+	providerKeeper.SetSlashPacketsPaused(ctx, pausedConsumerId, false)
+	ackResult, err = executeOnRecvSlashPacket(t, &providerKeeper, ctx, pausedChannelId, 2, datas[0])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Len(t, jailed, 2)
+}
+
+// TestOnRecvSlashPacketRecordOnlyConsumer tests that the slash packets received from a consumer chain
+// in the record-only slash mode are recorded in the slash log without jailing the validator.
+func TestOnRecvSlashPacketRecordOnlyConsumer(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 10)
+	providerKeeper.SetConsumerSlashMode(ctx, "0", providertypes.SLASH_MODE_RECORD_ONLY)
+	require.Equal(t, providertypes.SLASH_MODE_RECORD_ONLY, providerKeeper.GetConsumerSlashMode(ctx, "0"))
+	meter := providerKeeper.GetSlashMeter(ctx)
+
+	providerAddr := providertypes.NewProviderConsAddress(datas[0].Validator.Address)
+	require.False(t, providerKeeper.GetSlashLog(ctx, providerAddr))
+
+	ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[0], datas[0])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+
+	// the slash log is set, but the validator is neither jailed nor is its power reduced
+	require.True(t, providerKeeper.GetSlashLog(ctx, providerAddr))
+	require.Empty(t, jailed)
+	require.Equal(t, meter, providerKeeper.GetSlashMeter(ctx))
+	consumerAddr := providertypes.NewConsumerConsAddress(datas[0].Validator.Address)
+	require.Equal(t, []string{consumerAddr.String()}, providerKeeper.GetSlashAcks(ctx, "0"))
+
+	record, found := providerKeeper.GetSlashPacketRecord(ctx, "0", packets[0].Sequence)
+	require.True(t, found)
+	require.Equal(t, providertypes.SLASH_PACKET_STATUS_RECORDED, record.Status)
+	require.False(t, record.Jailed)
+
+	// once back in the jail slash mode, the slash packets received from the consumer chain jail validators again
+	providerKeeper.SetConsumerSlashMode(ctx, "0", providertypes.SLASH_MODE_JAIL)
+	ackResult, err = providerKeeper.OnRecvSlashPacket(ctx, packets[1], datas[1])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.True(t, jailed[sdk.ConsAddress(datas[1].Validator.Address).String()])
+}
+
+// TestOnRecvSlashPacketUnknownValidator tests that a slash packet for a validator that does not exist
+// on the provider chain is dropped without consuming the slash meter and that, depending on the
+// RejectUnknownSlashValidators param, it is either acknowledged as handled or as invalid
+func TestOnRecvSlashPacketUnknownValidator(t *testing.T) {
+	for _, reject := range []bool{false, true} {
+		providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 1)
+		params := providerKeeper.GetParams(ctx)
+		params.RejectUnknownSlashValidators = reject
+		providerKeeper.SetParams(ctx, params)
+		meter := providerKeeper.GetSlashMeter(ctx)
+
+		unknownAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(100).SDKValConsAddress()
+		datas[0].Validator.Address = unknownAddr
+		ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[0], datas[0])
+		if reject {
+			require.ErrorIs(t, err, providertypes.ErrUnknownSlashPacketValidator)
+			require.Nil(t, ackResult)
+			require.Empty(t, providerKeeper.GetSlashAcks(ctx, "0"))
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+			require.Len(t, providerKeeper.GetSlashAcks(ctx, "0"), 1)
+		}
+
+		// neither the slash meter nor the validators are affected
+		require.Equal(t, meter, providerKeeper.GetSlashMeter(ctx))
+		require.Empty(t, jailed)
+		record, found := providerKeeper.GetSlashPacketRecord(ctx, "0", packets[0].Sequence)
+		require.Equal(t, !reject, found)
+		require.False(t, record.Jailed)
+
+		handled, meterAfter := providerKeeper.EstimateSlashPacketCost(ctx, "0", datas[0])
+		require.Equal(t, !reject, handled)
+		require.Equal(t, meter, meterAfter)
+
+		var event *sdk.Event
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == providertypes.EventTypeUnknownSlashValidator {
+				event = &e
+			}
+		}
+		require.NotNil(t, event)
+		consumerIdAttr, found := event.GetAttribute(providertypes.AttributeConsumerId)
+		require.True(t, found)
+		require.Equal(t, "0", consumerIdAttr.Value)
+		addrAttr, found := event.GetAttribute(ccv.AttributeValidatorAddress)
+		require.True(t, found)
+		require.Equal(t, unknownAddr.String(), addrAttr.Value)
+	}
+}
+
 // TestOnRecvDoubleSignSlashPacket tests the OnRecvSlashPacket method specifically for double-sign slash packets.
 func TestOnRecvDoubleSignSlashPacket(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -278,6 +792,633 @@ func executeOnRecvSlashPacket(t *testing.T, providerKeeper *keeper.Keeper, ctx s
 	)
 }
 
+// setupSlashPackets returns a provider keeper with a launched consumer chain together with
+// numPackets downtime slash packets received from this consumer chain. The staking and slashing
+// keepers are mocked such that the returned map records the jailed validators.
+func setupSlashPackets(tb testing.TB, numPackets int) (
+	keeper.Keeper, sdk.Context, []channeltypes.Packet, []ccv.SlashPacketData, map[string]bool,
+) {
+	tb.Helper()
+	params := testkeeper.NewInMemKeeperParams(tb)
+	ctrl := gomock.NewController(tb)
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	providerKeeper := testkeeper.NewInMemProviderKeeper(params, mocks)
+	ctx := params.Ctx
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	channelId := "channel-0"
+	validVscId := uint64(1)
+	providerKeeper.SetChannelToConsumerId(ctx, channelId, consumerId)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, validVscId, 10)
+	require.NoError(tb, providerKeeper.SetInfractionParameters(ctx, consumerId, *getTestInfractionParameters()))
+
+	// every validator has a power of 2, so the meter allows jailing 51 validators
+	numValidators := numPackets/5 + 1
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(101))
+
+	operators := map[string]string{}
+	jailed := map[string]bool{}
+	cryptoIds := cryptotestutil.GenMultipleCryptoIds(numValidators, 0)
+	for _, cryptoId := range cryptoIds {
+		operators[cryptoId.SDKValConsAddress().String()] = cryptoId.SDKValOpAddressString()
+		err := providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+			ProviderConsAddr: cryptoId.SDKValConsAddress(),
+			Power:            2,
+		})
+		require.NoError(tb, err)
+	}
+
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+			if _, found := operators[consAddr.String()]; !found {
+				return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+			}
+			return stakingtypes.Validator{
+				OperatorAddress: operators[consAddr.String()],
+				Jailed:          jailed[consAddr.String()],
+			}, nil
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), gomock.Any()).Return(int64(2), nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(math.ZeroInt(), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().Jail(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) error {
+			jailed[consAddr.String()] = true
+			return nil
+		}).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().JailUntil(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	packets := make([]channeltypes.Packet, numPackets)
+	datas := make([]ccv.SlashPacketData, numPackets)
+	for i := 0; i < numPackets; i++ {
+		vscId := validVscId
+		if i%50 == 49 {
+			// invalid packets, i.e., without a block height mapped to the vscID
+			vscId = 999
+		}
+		datas[i] = *ccv.NewSlashPacketData(
+			abci.Validator{Address: cryptoIds[i%numValidators].SDKValConsAddress(), Power: 2},
+			vscId,
+			stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		)
+		dataBz, err := datas[i].Marshal()
+		require.NoError(tb, err)
+		packets[i] = channeltypes.NewPacket(dataBz, uint64(i+1), "srcPort", "srcChan", "provider-port", channelId, clienttypes.Height{}, 1)
+	}
+
+	return providerKeeper, ctx, packets, datas, jailed
+}
+
+// TestOnRecvSlashPackets tests that handling a batch of slash packets with OnRecvSlashPackets
+// has the same outcome as handling the packets one by one with OnRecvSlashPacket
+func TestOnRecvSlashPackets(t *testing.T) {
+	numPackets := 500
+
+	// handle the packets one by one
+	loopKeeper, loopCtx, packets, datas, loopJailed := setupSlashPackets(t, numPackets)
+	loopAckResults := make([]ccv.PacketAckResult, numPackets)
+	loopErrs := make([]error, numPackets)
+	for i := range packets {
+		loopAckResults[i], loopErrs[i] = loopKeeper.OnRecvSlashPacket(loopCtx, packets[i], datas[i])
+	}
+
+	// handle the packets as a batch
+	batchKeeper, batchCtx, packets, datas, batchJailed := setupSlashPackets(t, numPackets)
+	batchAckResults, batchErrs := batchKeeper.OnRecvSlashPackets(batchCtx, packets, datas)
+
+	require.Equal(t, loopAckResults, batchAckResults)
+	require.Len(t, batchErrs, numPackets)
+	for i := range loopErrs {
+		require.Equal(t, loopErrs[i] == nil, batchErrs[i] == nil)
+	}
+	require.Equal(t, loopJailed, batchJailed)
+	require.Len(t, batchJailed, 51)
+	require.Equal(t, loopKeeper.GetSlashMeter(loopCtx), batchKeeper.GetSlashMeter(batchCtx))
+	require.Equal(t, loopKeeper.GetSlashAcks(loopCtx, "0"), batchKeeper.GetSlashAcks(batchCtx, "0"))
+}
+
+// TestEstimateSlashPacketCost tests that the estimated cost of a slash packet matches
+// the outcome of actually handling the packet with OnRecvSlashPacket
+func TestEstimateSlashPacketCost(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 300)
+
+	for i := range packets {
+		meterBefore := providerKeeper.GetSlashMeter(ctx)
+		handled, meterAfter := providerKeeper.EstimateSlashPacketCost(ctx, "0", datas[i])
+		// the estimate does not mutate state
+		require.Equal(t, meterBefore, providerKeeper.GetSlashMeter(ctx))
+
+		ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[i], datas[i])
+		require.Equal(t, err == nil && bytes.Equal(ackResult, ccv.SlashPacketHandledResult), handled, "packet %d", i)
+		require.Equal(t, providerKeeper.GetSlashMeter(ctx), meterAfter, "packet %d", i)
+	}
+	// the packets jailed validators until the meter became negative
+	require.Len(t, jailed, 51)
+	require.True(t, providerKeeper.GetSlashMeter(ctx).IsNegative())
+}
+
+// TestEstimateSlashPacketCostConsumerStates tests that the estimated cost of a slash packet matches
+// the outcome of actually handling the packet for the different states of the consumer chain
+func TestEstimateSlashPacketCostConsumerStates(t *testing.T) {
+	testCases := []struct {
+		name  string
+		setup func(sdk.Context, keeper.Keeper)
+	}{
+		{"consumer chain stopped", func(ctx sdk.Context, k keeper.Keeper) {
+			k.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_STOPPED)
+		}},
+		{"slash packets paused", func(ctx sdk.Context, k keeper.Keeper) {
+			k.SetSlashPacketsPaused(ctx, "0", true)
+		}},
+		{"record-only slash mode", func(ctx sdk.Context, k keeper.Keeper) {
+			k.SetConsumerSlashMode(ctx, "0", providertypes.SLASH_MODE_RECORD_ONLY)
+		}},
+		{"downtime slash grace period", func(ctx sdk.Context, k keeper.Keeper) {
+			params := k.GetParams(ctx)
+			params.DowntimeSlashGracePeriod = time.Hour
+			k.SetParams(ctx, params)
+		}},
+		{"unknown validators rejected", func(ctx sdk.Context, k keeper.Keeper) {
+			params := k.GetParams(ctx)
+			params.RejectUnknownSlashValidators = true
+			k.SetParams(ctx, params)
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, packets, datas, _ := setupSlashPackets(t, 10)
+			tc.setup(ctx, providerKeeper)
+
+			for i := range packets {
+				handled, meterAfter := providerKeeper.EstimateSlashPacketCost(ctx, "0", datas[i])
+				ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[i], datas[i])
+				require.Equal(t, err == nil && bytes.Equal(ackResult, ccv.SlashPacketHandledResult), handled, "packet %d", i)
+				require.Equal(t, providerKeeper.GetSlashMeter(ctx), meterAfter, "packet %d", i)
+			}
+		})
+	}
+}
+
+// TestJailingReason tests that jailing a validator because of a consumer slash packet
+// records the consumer id and the infraction, which are returned by QueryJailingReason
+func TestJailingReason(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 1)
+	providerAddr := providertypes.NewProviderConsAddress(datas[0].Validator.Address)
+
+	_, found := providerKeeper.GetJailingReason(ctx, providerAddr)
+	require.False(t, found)
+	// a validator that is not jailed has no jailing reason
+	_, err := providerKeeper.QueryJailingReason(ctx, &providertypes.QueryJailingReasonRequest{
+		ProviderAddress: providerAddr.String(),
+	})
+	require.Error(t, err)
+
+	ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[0], datas[0])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.True(t, jailed[providerAddr.ToSdkConsAddr().String()])
+
+	reason, found := providerKeeper.GetJailingReason(ctx, providerAddr)
+	require.True(t, found)
+	require.Equal(t, providertypes.JailingReason{
+		ConsumerId: "0",
+		Infraction: stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	}, reason)
+
+	res, err := providerKeeper.QueryJailingReason(ctx, &providertypes.QueryJailingReasonRequest{
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.JailedBy)
+	require.Equal(t, stakingtypes.Infraction_INFRACTION_DOWNTIME, res.Infraction)
+
+	// a validator that was jailed on the provider chain has no stored reason
+	providerKeeper.DeleteJailingReason(ctx, providerAddr)
+	res, err = providerKeeper.QueryJailingReason(ctx, &providertypes.QueryJailingReasonRequest{
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.JailedByProvider, res.JailedBy)
+	require.Equal(t, stakingtypes.Infraction_INFRACTION_UNSPECIFIED, res.Infraction)
+
+	// the jailing reason is kept while the validator is jailed and cleared once it unjails
+	providerKeeper.SetJailingReason(ctx, providerAddr, reason)
+	providerKeeper.DeleteUnjailedValidatorsJailingReasons(ctx)
+	_, found = providerKeeper.GetJailingReason(ctx, providerAddr)
+	require.True(t, found)
+	jailed[providerAddr.ToSdkConsAddr().String()] = false
+	providerKeeper.DeleteUnjailedValidatorsJailingReasons(ctx)
+	_, found = providerKeeper.GetJailingReason(ctx, providerAddr)
+	require.False(t, found)
+}
+
+// TestConsumerJailedPower tests that the power of the validators jailed because of the slash packets
+// received from a consumer chain is accumulated and returned by QueryConsumerJailedPower
+func TestConsumerJailedPower(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 10)
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain1")
+
+	res, err := providerKeeper.QueryConsumerJailedPower(ctx, &providertypes.QueryConsumerJailedPowerRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.JailedPower)
+
+	// jail two validators via the consumer chain; the jailed power is the power of the validators
+	// on the provider chain (i.e., 2 each), not the power reported by the consumer chain
+	for i := 0; i < 2; i++ {
+		datas[i].Validator.Power = 1000
+		ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[i], datas[i])
+		require.NoError(t, err)
+		require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	}
+	require.Len(t, jailed, 2)
+	require.Equal(t, int64(4), providerKeeper.GetConsumerJailedPower(ctx, "0"))
+
+	// a slash packet for an already jailed validator does not jail any power
+	require.Equal(t, datas[0].Validator.Address, datas[3].Validator.Address)
+	ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[3], datas[3])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Len(t, jailed, 2)
+
+	res, err = providerKeeper.QueryConsumerJailedPower(ctx, &providertypes.QueryConsumerJailedPowerRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), res.JailedPower)
+
+	// the jailed power is tracked per consumer chain
+	require.Equal(t, int64(0), providerKeeper.GetConsumerJailedPower(ctx, "1"))
+	_, err = providerKeeper.QueryConsumerJailedPower(ctx, &providertypes.QueryConsumerJailedPowerRequest{ConsumerId: "1"})
+	require.Error(t, err)
+
+	// the jailed power ignores non-positive powers and does not overflow
+	providerKeeper.IncreaseConsumerJailedPower(ctx, "0", -1)
+	require.Equal(t, int64(4), providerKeeper.GetConsumerJailedPower(ctx, "0"))
+	providerKeeper.IncreaseConsumerJailedPower(ctx, "0", gomath.MaxInt64)
+	require.Equal(t, int64(gomath.MaxInt64), providerKeeper.GetConsumerJailedPower(ctx, "0"))
+
+	providerKeeper.DeleteConsumerJailedPower(ctx, "0")
+	require.Equal(t, int64(0), providerKeeper.GetConsumerJailedPower(ctx, "0"))
+}
+
+// TestSlashPacketBySeq tests that the processing status of the received slash packets
+// can be queried by their IBC sequence numbers until the unbonding period elapses
+func TestSlashPacketBySeq(t *testing.T) {
+	providerKeeper, ctx, packets, datas, _ := setupSlashPackets(t, 5)
+	consumerId := "0"
+
+	querySlashPacket := func(ctx sdk.Context, ibcSeq uint64) *providertypes.QuerySlashPacketBySeqResponse {
+		res, err := providerKeeper.QuerySlashPacketBySeq(ctx, &providertypes.QuerySlashPacketBySeqRequest{
+			ConsumerId: consumerId,
+			IbcSeq:     ibcSeq,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// the first packet jails its validator
+	ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[0], datas[0])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	require.Equal(t, &providertypes.QuerySlashPacketBySeqResponse{
+		Status:          providertypes.SLASH_PACKET_STATUS_HANDLED,
+		ProviderAddress: sdk.ConsAddress(datas[0].Validator.Address).String(),
+		Infraction:      stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		Jailed:          true,
+	}, querySlashPacket(ctx, packets[0].Sequence))
+
+	// the third packet is for the same validator, which is already jailed
+	ackResult, err = providerKeeper.OnRecvSlashPacket(ctx, packets[2], datas[2])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	res := querySlashPacket(ctx, packets[2].Sequence)
+	require.Equal(t, providertypes.SLASH_PACKET_STATUS_HANDLED, res.Status)
+	require.False(t, res.Jailed)
+
+	// the second packet is bounced as the slash meter is negative
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(-1))
+	ackResult, err = providerKeeper.OnRecvSlashPacket(ctx, packets[1], datas[1])
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketBouncedResult, ackResult)
+	res = querySlashPacket(ctx, packets[1].Sequence)
+	require.Equal(t, providertypes.SLASH_PACKET_STATUS_THROTTLED, res.Status)
+	require.Equal(t, sdk.ConsAddress(datas[1].Validator.Address).String(), res.ProviderAddress)
+	require.False(t, res.Jailed)
+
+	// a packet that was not received is unknown
+	require.Equal(t, providertypes.SLASH_PACKET_STATUS_UNKNOWN, querySlashPacket(ctx, packets[3].Sequence).Status)
+
+	// the records are pruned once the unbonding period elapses
+	unbondingPeriod := 21 * 24 * time.Hour
+	providerKeeper.PruneSlashPacketRecords(ctx.WithBlockTime(ctx.BlockTime().Add(unbondingPeriod-time.Second)), consumerId, unbondingPeriod)
+	require.Equal(t, providertypes.SLASH_PACKET_STATUS_HANDLED, querySlashPacket(ctx, packets[0].Sequence).Status)
+	providerKeeper.PruneSlashPacketRecords(ctx.WithBlockTime(ctx.BlockTime().Add(unbondingPeriod)), consumerId, unbondingPeriod)
+	for _, packet := range packets[:3] {
+		require.Equal(t, providertypes.SLASH_PACKET_STATUS_UNKNOWN, querySlashPacket(ctx, packet.Sequence).Status)
+	}
+
+	// an unknown consumer chain cannot be queried
+	_, err = providerKeeper.QuerySlashPacketBySeq(ctx, &providertypes.QuerySlashPacketBySeqRequest{ConsumerId: "1", IbcSeq: 1})
+	require.Error(t, err)
+}
+
+// TestConsumersWithThrottledSlashing tests that the consumer chains with throttled slash packets
+// are returned together with the number of their slash packets that are still to be retried
+func TestConsumersWithThrottledSlashing(t *testing.T) {
+	providerKeeper, ctx, packets, datas, _ := setupSlashPackets(t, 10)
+
+	// consumer "1" has the same validators as consumer "0"
+	channelId := "channel-1"
+	providerKeeper.SetChannelToConsumerId(ctx, channelId, "1")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetInfractionParameters(ctx, "1", *getTestInfractionParameters()))
+	for _, cryptoId := range cryptotestutil.GenMultipleCryptoIds(3, 0) {
+		err := providerKeeper.SetConsumerValidator(ctx, "1", providertypes.ConsensusValidator{
+			ProviderConsAddr: cryptoId.SDKValConsAddress(),
+			Power:            2,
+		})
+		require.NoError(t, err)
+	}
+
+	queryConsumers := func() []providertypes.ConsumerThrottledSlashPackets {
+		res, err := providerKeeper.QueryConsumersWithThrottledSlashing(ctx,
+			&providertypes.QueryConsumersWithThrottledSlashingRequest{})
+		require.NoError(t, err)
+		return res.Consumers
+	}
+	receivePacket := func(packet channeltypes.Packet, data ccv.SlashPacketData, expectedAckResult ccv.PacketAckResult) {
+		ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packet, data)
+		require.NoError(t, err)
+		require.Equal(t, expectedAckResult, ackResult)
+	}
+	require.Empty(t, queryConsumers())
+
+	// with a negative slash meter, all the slash packets are throttled
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(-1))
+	// consumer "0" sends slash packets for three validators and then retries the first one
+	for i := 0; i < 4; i++ {
+		receivePacket(packets[i], datas[i], ccv.SlashPacketBouncedResult)
+	}
+	// consumer "1" sends slash packets for two validators
+	for i := 0; i < 2; i++ {
+		packet := packets[i]
+		packet.DestinationChannel = channelId
+		receivePacket(packet, datas[i], ccv.SlashPacketBouncedResult)
+	}
+	require.Equal(t, []providertypes.ConsumerThrottledSlashPackets{
+		{ConsumerId: "0", Count: 3},
+		{ConsumerId: "1", Count: 2},
+	}, queryConsumers())
+
+	// once the slash meter is replenished, the retried slash packets are handled
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(101))
+	receivePacket(packets[4], datas[4], ccv.SlashPacketHandledResult)
+	for i := 0; i < 2; i++ {
+		packet := packets[i]
+		packet.Sequence = uint64(10 + i)
+		packet.DestinationChannel = channelId
+		receivePacket(packet, datas[i], ccv.SlashPacketHandledResult)
+	}
+	require.Equal(t, []providertypes.ConsumerThrottledSlashPackets{
+		{ConsumerId: "0", Count: 2},
+	}, queryConsumers())
+}
+
+// TestPerConsumerSlashMeters tests that, if per consumer slash meters are enabled,
+// exhausting the slash meter of a consumer chain does not throttle the slash packets of another consumer chain
+func TestPerConsumerSlashMeters(t *testing.T) {
+	providerKeeper, ctx, packets, datas, _ := setupSlashPackets(t, 10)
+
+	params := providerKeeper.GetParams(ctx)
+	params.PerConsumerSlashMeters = true
+	providerKeeper.SetParams(ctx, params)
+
+	// consumer "1" has the same validators as consumer "0"
+	channelId := "channel-1"
+	providerKeeper.SetChannelToConsumerId(ctx, channelId, "1")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetInfractionParameters(ctx, "1", *getTestInfractionParameters()))
+	for _, cryptoId := range cryptotestutil.GenMultipleCryptoIds(3, 0) {
+		err := providerKeeper.SetConsumerValidator(ctx, "1", providertypes.ConsensusValidator{
+			ProviderConsAddr: cryptoId.SDKValConsAddress(),
+			Power:            2,
+		})
+		require.NoError(t, err)
+	}
+
+	// the slash meter of consumer "0" is exhausted, while the global slash meter
+	// and the slash meter of consumer "1" are not
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(101))
+	providerKeeper.SetConsumerSlashMeter(ctx, "0", math.NewInt(-1))
+	providerKeeper.SetConsumerSlashMeter(ctx, "1", math.NewInt(5))
+
+	// the slash packets of consumer "0" are bounced
+	ackResults, errs := providerKeeper.OnRecvSlashPackets(ctx, packets[:2], datas[:2])
+	for i := range ackResults {
+		require.NoError(t, errs[i])
+		require.Equal(t, ccv.SlashPacketBouncedResult, ackResults[i])
+	}
+
+	// the estimates also use the slash meters of the consumer chains
+	handled, meterAfter := providerKeeper.EstimateSlashPacketCost(ctx, "0", datas[0])
+	require.False(t, handled)
+	require.Equal(t, math.NewInt(-1), meterAfter)
+	handled, meterAfter = providerKeeper.EstimateSlashPacketCost(ctx, "1", datas[0])
+	require.True(t, handled)
+	require.Equal(t, math.NewInt(3), meterAfter)
+
+	// the global slash meter remains an overall cap on the slash meters of the consumer chains
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(-1))
+	handled, meterAfter = providerKeeper.EstimateSlashPacketCost(ctx, "1", datas[0])
+	require.False(t, handled)
+	require.Equal(t, math.NewInt(-1), meterAfter)
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(101))
+
+	// the slash packets of consumer "1" are handled until its own slash meter is exhausted
+	consumerPackets := make([]channeltypes.Packet, 3)
+	for i := range consumerPackets {
+		consumerPackets[i] = packets[i]
+		consumerPackets[i].DestinationChannel = channelId
+	}
+	ackResults, errs = providerKeeper.OnRecvSlashPackets(ctx, consumerPackets, datas[:3])
+	for i := range ackResults {
+		require.NoError(t, errs[i])
+	}
+	require.Equal(t, []ccv.PacketAckResult{
+		ccv.SlashPacketHandledResult,
+		ccv.SlashPacketHandledResult,
+		ccv.SlashPacketHandledResult,
+	}, ackResults)
+
+	// every handled slash packet consumed the slash meter of consumer "1" and the global slash meter
+	meter, found := providerKeeper.GetConsumerSlashMeter(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(-1), meter)
+	meter, _ = providerKeeper.GetConsumerSlashMeter(ctx, "0")
+	require.Equal(t, math.NewInt(-1), meter)
+	require.Equal(t, math.NewInt(95), providerKeeper.GetSlashMeter(ctx))
+}
+
+// TestConsumerSlashPacketRate tests that the slash packet rate of a consumer chain
+// only counts the slash packets received in the last SlashPacketRateWindow blocks
+func TestConsumerSlashPacketRate(t *testing.T) {
+	providerKeeper, ctx, packets, datas, _ := setupSlashPackets(t, 10)
+	consumerId := "0"
+
+	receivePackets := func(ctx sdk.Context, from, to int) {
+		for i := from; i < to; i++ {
+			_, err := providerKeeper.OnRecvSlashPacket(ctx, packets[i], datas[i])
+			require.NoError(t, err)
+		}
+	}
+	queryRate := func(ctx sdk.Context) uint64 {
+		res, err := providerKeeper.QueryConsumerSlashPacketRate(ctx,
+			&providertypes.QueryConsumerSlashPacketRateRequest{ConsumerId: consumerId})
+		require.NoError(t, err)
+		require.Equal(t, uint64(providertypes.SlashPacketRateWindow), res.WindowBlocks)
+		return res.Count
+	}
+
+	// receive 3 packets at height 10 and 5 packets at height 50
+	ctx = ctx.WithBlockHeight(10)
+	receivePackets(ctx, 0, 3)
+	require.Equal(t, uint64(3), queryRate(ctx))
+	ctx = ctx.WithBlockHeight(50)
+	receivePackets(ctx, 3, 8)
+	require.Equal(t, uint64(8), queryRate(ctx))
+
+	// the packets received at height 10 are still in the window at height 109
+	ctx = ctx.WithBlockHeight(109)
+	providerKeeper.PruneSlashPacketCounts(ctx, consumerId)
+	require.Equal(t, uint64(8), queryRate(ctx))
+
+	// the packets received at height 10 leave the window at height 110
+	ctx = ctx.WithBlockHeight(110)
+	providerKeeper.PruneSlashPacketCounts(ctx, consumerId)
+	require.Equal(t, uint64(5), queryRate(ctx))
+	receivePackets(ctx, 8, 10)
+	require.Equal(t, uint64(7), queryRate(ctx))
+
+	// only the packets received at height 110 are still in the window at height 150
+	ctx = ctx.WithBlockHeight(150)
+	providerKeeper.PruneSlashPacketCounts(ctx, consumerId)
+	require.Equal(t, uint64(2), queryRate(ctx))
+
+	// the counts that left the window were pruned
+	ctx = ctx.WithBlockHeight(110)
+	require.Equal(t, uint64(2), providerKeeper.GetSlashPacketRate(ctx, consumerId))
+}
+
+// TestInfractionSlashCounts tests that downtime slash packets consuming the slash meter
+// and double-sign slash packets, which bypass the slash meter, are counted separately
+func TestInfractionSlashCounts(t *testing.T) {
+	providerKeeper, ctx, packets, datas, jailed := setupSlashPackets(t, 10)
+	meterBefore := providerKeeper.GetSlashMeter(ctx)
+
+	// the first 3 downtime slash packets jail 3 different validators
+	for i := 0; i < 3; i++ {
+		ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packets[i], datas[i])
+		require.NoError(t, err)
+		require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+	}
+	require.Len(t, jailed, 3)
+
+	// double-sign slash packets for the same validators are recorded, but not throttled
+	for i := 0; i < 2; i++ {
+		data := datas[i]
+		data.Infraction = stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
+		dataBz, err := data.Marshal()
+		require.NoError(t, err)
+		packet := packets[i]
+		packet.Data = dataBz
+		ackResult, err := providerKeeper.OnRecvSlashPacket(ctx, packet, data)
+		require.NoError(t, err)
+		require.Equal(t, ccv.V1Result, ackResult)
+	}
+
+	require.Equal(t, uint64(3), providerKeeper.GetInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOWNTIME))
+	require.Equal(t, uint64(2), providerKeeper.GetInfractionSlashCount(ctx, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN))
+	// only the downtime slash packets consumed the slash meter
+	require.Equal(t, meterBefore.SubRaw(3*2), providerKeeper.GetSlashMeter(ctx))
+}
+
+// TestOnRecvSlashPacketsTelemetry tests that handling a batch of slash packets increments
+// the telemetry counters of the handled and throttled slash packets
+func TestOnRecvSlashPacketsTelemetry(t *testing.T) {
+	// enable telemetry and record the metrics in an in-memory sink
+	_, err := telemetry.New(telemetry.Config{Enabled: true, MetricsSink: telemetry.MetricSinkInMem})
+	require.NoError(t, err)
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	metricsConf := metrics.DefaultConfig("")
+	metricsConf.EnableHostname = false
+	metricsConf.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(metricsConf, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = telemetry.New(telemetry.Config{Enabled: false})
+		_, _ = metrics.NewGlobal(metricsConf, &metrics.BlackholeSink{})
+	})
+
+	// the slash meter allows jailing only half of the validators, so some packets are throttled
+	providerKeeper, ctx, packets, datas, _ := setupSlashPackets(t, 500)
+	ackResults, errs := providerKeeper.OnRecvSlashPackets(ctx, packets, datas)
+
+	expectedHandled, expectedThrottled := 0, 0
+	for i := range ackResults {
+		switch {
+		case errs[i] != nil:
+		case bytes.Equal(ackResults[i], ccv.SlashPacketBouncedResult):
+			expectedThrottled++
+		default:
+			expectedHandled++
+		}
+	}
+	require.NotZero(t, expectedHandled)
+	require.NotZero(t, expectedThrottled)
+
+	counter := func(metricKey string) int {
+		count := 0
+		for _, interval := range sink.Data() {
+			for _, sample := range interval.Counters {
+				if sample.Name != strings.Join([]string{providertypes.ModuleName, metricKey}, ".") {
+					continue
+				}
+				require.Contains(t, sample.Labels, metrics.Label{Name: providertypes.MetricLabelConsumerId, Value: "0"})
+				require.Contains(t, sample.Labels, metrics.Label{
+					Name:  providertypes.MetricLabelInfraction,
+					Value: stakingtypes.Infraction_INFRACTION_DOWNTIME.String(),
+				})
+				count += sample.Count
+			}
+		}
+		return count
+	}
+	require.Equal(t, expectedHandled, counter(providertypes.MetricKeySlashPacketsHandled))
+	require.Equal(t, expectedThrottled, counter(providertypes.MetricKeySlashPacketsThrottled))
+}
+
+func BenchmarkOnRecvSlashPacketLoop(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		providerKeeper, ctx, packets, datas, _ := setupSlashPackets(b, 500)
+		b.StartTimer()
+		for i := range packets {
+			_, _ = providerKeeper.OnRecvSlashPacket(ctx, packets[i], datas[i])
+		}
+	}
+}
+
+func BenchmarkOnRecvSlashPackets(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		providerKeeper, ctx, packets, datas, _ := setupSlashPackets(b, 500)
+		b.StartTimer()
+		_, _ = providerKeeper.OnRecvSlashPackets(ctx, packets, datas)
+	}
+}
+
 // TestValidateSlashPacket tests ValidateSlashPacket.
 func TestValidateSlashPacket(t *testing.T) {
 	validVscID := uint64(98)
@@ -508,6 +1649,148 @@ func TestHandleSlashPacket(t *testing.T) {
 	}
 }
 
+// TestHandleSlashPacketInfractionParameters tests that the downtime slashing and jailing parameters
+// are applied when handling a slash packet, i.e., the double-sign parameters only apply to the
+// double-signing infractions handled through MsgSubmitConsumerDoubleVoting and MsgSubmitConsumerMisbehaviour
+func TestHandleSlashPacketInfractionParameters(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	valOperAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).SDKValOpAddressString()
+
+	// use different parameters for downtime and double-sign infractions
+	infractionParams := providertypes.InfractionParameters{
+		DoubleSign: &providertypes.SlashJailParameters{
+			JailDuration:  1200 * time.Second,
+			SlashFraction: math.LegacyNewDecWithPrec(5, 2), // 0.05
+			Tombstone:     true,
+		},
+		Downtime: &providertypes.SlashJailParameters{
+			JailDuration:  600 * time.Second,
+			SlashFraction: math.LegacyNewDecWithPrec(1, 2), // 0.01
+			Tombstone:     false,
+		},
+	}
+	err := providerKeeper.SetInfractionParameters(ctx, consumerId, infractionParams)
+	require.NoError(t, err)
+
+	providerKeeper.SetInitChainHeight(ctx, consumerId, 5)
+	err = providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{ProviderConsAddr: providerConsAddr.ToSdkConsAddr()})
+	require.NoError(t, err)
+
+	// a downtime slash packet is handled with the downtime parameters
+	power := int64(1000)
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr()).
+			Return(stakingtypes.Validator{OperatorAddress: valOperAddr}, nil),
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, providerConsAddr.ToSdkConsAddr()).Return(false),
+		// the power of the validator is obtained before it is jailed
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr()).
+			Return(stakingtypes.Validator{OperatorAddress: valOperAddr}, nil),
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(ctx, gomock.Any()).Return(power, nil),
+		mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(ctx, providerConsAddr.ToSdkConsAddr(), int64(5),
+			power, infractionParams.Downtime.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME).
+			Return(infractionParams.Downtime.SlashFraction.MulInt64(power).TruncateInt(), nil),
+		mocks.MockStakingKeeper.EXPECT().Jail(ctx, providerConsAddr.ToSdkConsAddr()).Return(nil),
+		mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, providerConsAddr.ToSdkConsAddr(),
+			ctx.BlockTime().Add(infractionParams.Downtime.JailDuration)).Return(nil),
+	)
+
+	providerKeeper.HandleSlashPacket(ctx, consumerId, *ccv.NewSlashPacketData(
+		abci.Validator{Address: providerConsAddr.ToSdkConsAddr(), Power: power},
+		0, // ValsetUpdateId = 0 uses init chain height
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	))
+}
+
+// consumerSlashRecorder is a provider hook that records the consumer-initiated slashes
+type consumerSlashRecorder struct {
+	providertypes.BaseProviderHooks
+	consumerIds   []string
+	providerAddrs []providertypes.ProviderConsAddress
+	infractions   []stakingtypes.Infraction
+}
+
+func (r *consumerSlashRecorder) AfterConsumerInitiatedSlash(_ context.Context, consumerId string, providerAddr providertypes.ProviderConsAddress, infraction stakingtypes.Infraction) error {
+	r.consumerIds = append(r.consumerIds, consumerId)
+	r.providerAddrs = append(r.providerAddrs, providerAddr)
+	r.infractions = append(r.infractions, infraction)
+	return nil
+}
+
+// TestHandleSlashPacketHooks tests that the AfterConsumerInitiatedSlash hooks are called once per jailing
+func TestHandleSlashPacketHooks(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	recorderA, recorderB := &consumerSlashRecorder{}, &consumerSlashRecorder{}
+	providerKeeper.SetHooks(providertypes.NewMultiProviderHooks(recorderA, recorderB))
+
+	consumerId := "0"
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	valOperAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).SDKValOpAddressString()
+
+	infractionParams := providertypes.InfractionParameters{
+		DoubleSign: &providertypes.SlashJailParameters{
+			JailDuration:  1200 * time.Second,
+			SlashFraction: math.LegacyNewDecWithPrec(5, 2), // 0.05
+			Tombstone:     true,
+		},
+		Downtime: &providertypes.SlashJailParameters{
+			JailDuration:  600 * time.Second,
+			SlashFraction: math.LegacyNewDecWithPrec(1, 2), // 0.01
+			Tombstone:     false,
+		},
+	}
+	err := providerKeeper.SetInfractionParameters(ctx, consumerId, infractionParams)
+	require.NoError(t, err)
+
+	providerKeeper.SetInitChainHeight(ctx, consumerId, 5)
+	err = providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{ProviderConsAddr: providerConsAddr.ToSdkConsAddr()})
+	require.NoError(t, err)
+
+	power := int64(1000)
+	data := *ccv.NewSlashPacketData(
+		abci.Validator{Address: providerConsAddr.ToSdkConsAddr(), Power: power},
+		0, // ValsetUpdateId = 0 uses init chain height
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	)
+
+	// the validator is slashed and jailed
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr()).
+			Return(stakingtypes.Validator{OperatorAddress: valOperAddr}, nil),
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, providerConsAddr.ToSdkConsAddr()).Return(false),
+		// the power of the validator is obtained before it is jailed
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr()).
+			Return(stakingtypes.Validator{OperatorAddress: valOperAddr}, nil),
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(ctx, gomock.Any()).Return(power, nil),
+		mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(ctx, providerConsAddr.ToSdkConsAddr(), int64(5),
+			power, infractionParams.Downtime.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME).
+			Return(infractionParams.Downtime.SlashFraction.MulInt64(power).TruncateInt(), nil),
+		mocks.MockStakingKeeper.EXPECT().Jail(ctx, providerConsAddr.ToSdkConsAddr()).Return(nil),
+		mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, providerConsAddr.ToSdkConsAddr(),
+			ctx.BlockTime().Add(infractionParams.Downtime.JailDuration)).Return(nil),
+	)
+	providerKeeper.HandleSlashPacket(ctx, consumerId, data)
+
+	// the validator is already jailed, so no jailing is applied
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr()).
+			Return(stakingtypes.Validator{OperatorAddress: valOperAddr, Jailed: true}, nil),
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, providerConsAddr.ToSdkConsAddr()).Return(false),
+	)
+	providerKeeper.HandleSlashPacket(ctx, consumerId, data)
+
+	for _, recorder := range []*consumerSlashRecorder{recorderA, recorderB} {
+		require.Equal(t, []string{consumerId}, recorder.consumerIds)
+		require.Equal(t, []providertypes.ProviderConsAddress{providerConsAddr}, recorder.providerAddrs)
+		require.Equal(t, []stakingtypes.Infraction{stakingtypes.Infraction_INFRACTION_DOWNTIME}, recorder.infractions)
+	}
+}
+
 // TestSendVSCPacketsToChainFailure tests the SendVSCPacketsToChain method failing
 func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	// Keeper setup
@@ -560,6 +1843,56 @@ func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
 }
 
+// TestVSCMaturationSchedule tests that the maturity time of every sent VSC
+// is the time the VSC packet was sent plus the unbonding period
+func TestVSCMaturationSchedule(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	unbondingTime := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "CCVChannelID").
+		Return(channeltypes.Channel{}, true).Times(3)
+	mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "CCVChannelID",
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil).Times(3)
+
+	// send three VSC packets at different times
+	sendTimes := []time.Time{
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(time.Hour),
+		ctx.BlockTime().Add(3 * time.Hour),
+	}
+	for i, sendTime := range sendTimes {
+		ctx = ctx.WithBlockTime(sendTime)
+		providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: uint64(i + 1)})
+		err := providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID")
+		require.NoError(t, err)
+		require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
+	}
+
+	expectedMaturities := []providertypes.VSCMaturity{
+		{VscId: 1, MaturityTime: sendTimes[0].Add(unbondingTime)},
+		{VscId: 2, MaturityTime: sendTimes[1].Add(unbondingTime)},
+		{VscId: 3, MaturityTime: sendTimes[2].Add(unbondingTime)},
+	}
+	require.Equal(t, expectedMaturities, providerKeeper.GetVSCMaturationSchedule(ctx, CONSUMER_ID, unbondingTime))
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	res, err := providerKeeper.QueryVSCMaturationSchedule(ctx, &providertypes.QueryVSCMaturationScheduleRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, expectedMaturities, res.Maturities)
+
+	// the first VSC matures and is pruned
+	ctx = ctx.WithBlockTime(expectedMaturities[0].MaturityTime)
+	providerKeeper.PruneMaturedVscSendTimestamps(ctx, CONSUMER_ID, unbondingTime)
+	require.Equal(t, expectedMaturities[1:], providerKeeper.GetVSCMaturationSchedule(ctx, CONSUMER_ID, unbondingTime))
+
+	// all the send timestamps are deleted
+	providerKeeper.DeleteVscSendTimestampsForConsumer(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetVSCMaturationSchedule(ctx, CONSUMER_ID, unbondingTime))
+}
+
 // TestOnTimeoutPacketWithNoChainFound tests the `OnTimeoutPacket` method fails when no chain is found
 func TestOnTimeoutPacketWithNoChainFound(t *testing.T) {
 	// Keeper setup
@@ -796,6 +2129,64 @@ func TestProviderValidatorUpdates(t *testing.T) {
 	require.ElementsMatch(t, expectedUpdates, updates, "The validator updates should match the expected updates")
 }
 
+// TestRecomputeProviderConsensusValSet tests that the provider consensus validator set
+// is recomputed from the top bonded validators without being stored
+func TestRecomputeProviderConsensusValSet(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// more bonded validators than the cap, in descending order of power
+	validators := []stakingtypes.Validator{
+		createStakingValidator(ctx, mocks, 50, 5),
+		createStakingValidator(ctx, mocks, 40, 4),
+		createStakingValidator(ctx, mocks, 30, 3),
+		createStakingValidator(ctx, mocks, 20, 2),
+		createStakingValidator(ctx, mocks, 10, 1),
+	}
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(ctx).Return(validators, nil).AnyTimes()
+
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	providerKeeper.SetParams(ctx, params)
+
+	// the last provider consensus validator set still contains validators 2 and 1
+	consensusVals := []providertypes.ConsensusValidator{}
+	for _, val := range validators[2:] {
+		consensusVal, err := providerKeeper.CreateProviderConsensusValidator(ctx, val)
+		require.NoError(t, err)
+		consensusVals = append(consensusVals, consensusVal)
+	}
+	err := providerKeeper.SetLastProviderConsensusValSet(ctx, consensusVals)
+	require.NoError(t, err)
+
+	// validators 5 and 4 are added, validator 3 is untouched,
+	// and validators 2 and 1 are removed as they are not in the top 3 anymore
+	expectedUpdates := []abci.ValidatorUpdate{
+		{PubKey: testkeeper.Must(validators[0].CmtConsPublicKey()), Power: 50},
+		{PubKey: testkeeper.Must(validators[1].CmtConsPublicKey()), Power: 40},
+		{PubKey: testkeeper.Must(validators[3].CmtConsPublicKey()), Power: 0},
+		{PubKey: testkeeper.Must(validators[4].CmtConsPublicKey()), Power: 0},
+	}
+	updates, err := providerKeeper.RecomputeProviderConsensusValSet(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, expectedUpdates, updates)
+
+	// the last provider consensus validator set is not modified
+	lastVals, err := providerKeeper.GetLastProviderConsensusValSet(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, consensusVals, lastVals)
+
+	// committing the recomputed validator set results in the same updates
+	updates, err = providerKeeper.ProviderValidatorUpdates(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, expectedUpdates, updates)
+
+	// once committed, there is nothing left to recompute
+	updates, err = providerKeeper.RecomputeProviderConsensusValSet(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
+
 // TestQueueVSCPacketsWithPowerCapping tests queueing validator set updates with power capping
 func TestQueueVSCPacketsWithPowerCapping(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -917,3 +2308,182 @@ func TestBlocksUntilNextEpoch(t *testing.T) {
 	ctx = ctx.WithBlockHeight(19)
 	require.Equal(t, int64(1), providerKeeper.BlocksUntilNextEpoch(ctx))
 }
+
+// TestQueueVSCPacketsWhileVSCSendingPaused tests that no VSC packets are queued or sent to a consumer chain
+// while the sending of VSC packets is paused, and that a single VSC packet with the net change
+// of the validator set is queued once the sending is resumed
+func TestQueueVSCPacketsWhileVSCSendingPaused(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valB := createStakingValidator(ctx, mocks, 1, 2)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 2, []stakingtypes.Validator{valA, valB}, -1)
+	valAPubKey, err := valA.CmtConsPublicKey()
+	require.NoError(t, err)
+	valBPubKey, err := valB.CmtConsPublicKey()
+	require.NoError(t, err)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	for _, val := range []stakingtypes.Validator{valA, valB} {
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil).AnyTimes()
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(consAddr))
+	}
+
+	// queueVSCPackets queues the VSC packets at the end of an epoch in which
+	// validators A and B have the given powers
+	epoch := int64(0)
+	queueVSCPackets := func(powerA, powerB int64) {
+		epoch++
+		epochCtx := ctx.WithBlockHeight(epoch)
+		for val, power := range map[string]int64{valA.OperatorAddress: powerA, valB.OperatorAddress: powerB} {
+			valAddr, err := sdk.ValAddressFromBech32(val)
+			require.NoError(t, err)
+			mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(epochCtx, valAddr).Return(power, nil).AnyTimes()
+		}
+		err := providerKeeper.QueueVSCPackets(epochCtx)
+		require.NoError(t, err)
+	}
+
+	// both validators start validating the consumer chain
+	queueVSCPackets(1, 1)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+	providerKeeper.DeletePendingVSCPackets(ctx, CONSUMER_ID)
+
+	// pause the sending of VSC packets and apply several power updates
+	providerKeeper.SetVSCSendingPaused(ctx, CONSUMER_ID, true)
+	queueVSCPackets(2, 1)
+	queueVSCPackets(3, 4)
+	queueVSCPackets(3, 5)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
+
+	// already queued VSC packets are not sent while paused
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channelID")
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.NewValidatorSetChangePacketData(
+		[]abci.ValidatorUpdate{{PubKey: valAPubKey, Power: 1}}, 1, nil))
+	err = providerKeeper.SendVSCPackets(ctx)
+	require.NoError(t, err)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+	providerKeeper.DeletePendingVSCPackets(ctx, CONSUMER_ID)
+
+	// resume the sending of VSC packets: a single VSC packet contains the net change
+	providerKeeper.SetVSCSendingPaused(ctx, CONSUMER_ID, false)
+	queueVSCPackets(3, 5)
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pendingPackets, 1)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: valAPubKey, Power: 3},
+		{PubKey: valBPubKey, Power: 5},
+	}, pendingPackets[0].ValidatorUpdates)
+}
+
+// TestSendVSCPacketsOnClosedChannel tests that no VSC packets are sent to a consumer chain
+// whose CCV channel is not open and that the packets remain queued
+func TestSendVSCPacketsOnClosedChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channelID")
+
+	_, _, key := ibctesting.GenerateKeys(t, 1)
+	tmPubKey, _ := cryptocodec.ToCmtProtoPublicKey(key)
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.NewValidatorSetChangePacketData(
+		[]abci.ValidatorUpdate{{PubKey: tmPubKey, Power: 1}}, 1, nil))
+
+	// the CCV channel is closed, hence no packet is sent (i.e., no `SendPacket` is expected)
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channelID").Return(
+		channeltypes.Channel{State: channeltypes.CLOSED}, true,
+	).Times(1)
+
+	err := providerKeeper.SendVSCPackets(ctx)
+	require.NoError(t, err)
+
+	// the VSC packet remains queued
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+
+	// an event is emitted
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeSkipVSCChannelNotOpen, events[0].Type)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: providertypes.AttributeConsumerId, Value: CONSUMER_ID, Index: false},
+		{Key: providertypes.AttributeChannelId, Value: "channelID", Index: false},
+		{Key: providertypes.AttributeChannelState, Value: channeltypes.CLOSED.String(), Index: false},
+	}, events[0].Attributes)
+}
+
+// TestVSCLatency tests that the round-trip latency of the VSC packets
+// is measured when the corresponding acknowledgements are received
+func TestVSCLatency(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", CONSUMER_ID)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	// acknowledge successfully acknowledges the VSC packet with the given vscId
+	acknowledge := func(ctx sdk.Context, vscId uint64) {
+		data := ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, vscId, nil)
+		packet := channeltypes.Packet{SourceChannel: "channel-0", Data: data.GetBytes()}
+		require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packet, ack))
+	}
+
+	// no VSC packet acknowledged yet
+	resp, err := providerKeeper.QueryConsumerVSCLatency(ctx, &providertypes.QueryConsumerVSCLatencyRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.QueryConsumerVSCLatencyResponse{}, *resp)
+
+	// send a VSC packet and acknowledge it after a delay
+	sendTime := ctx.BlockTime()
+	providerKeeper.SetVscSendTimestamp(ctx, CONSUMER_ID, 1, sendTime)
+	ctx = ctx.WithBlockTime(sendTime.Add(10 * time.Minute))
+	acknowledge(ctx, 1)
+
+	resp, err = providerKeeper.QueryConsumerVSCLatency(ctx, &providertypes.QueryConsumerVSCLatencyRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.NumVscPackets)
+	require.Equal(t, 10*time.Minute, resp.AverageLatency)
+	require.Equal(t, 10*time.Minute, resp.MaxLatency)
+
+	// a second VSC packet takes longer to be acknowledged
+	sendTime = ctx.BlockTime()
+	providerKeeper.SetVscSendTimestamp(ctx, CONSUMER_ID, 2, sendTime)
+	ctx = ctx.WithBlockTime(sendTime.Add(20 * time.Minute))
+	acknowledge(ctx, 2)
+
+	resp, err = providerKeeper.QueryConsumerVSCLatency(ctx, &providertypes.QueryConsumerVSCLatencyRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.NumVscPackets)
+	require.Equal(t, 15*time.Minute, resp.AverageLatency)
+	require.Equal(t, 20*time.Minute, resp.MaxLatency)
+
+	// acknowledgements of VSC packets without a send timestamp are ignored
+	acknowledge(ctx, 3)
+	require.Len(t, providerKeeper.GetAllVSCLatencies(ctx, CONSUMER_ID), 2)
+
+	// only the latencies of the most recently acknowledged VSC packets are kept
+	for i := 3; i < providertypes.VSCLatencyWindow+3; i++ {
+		providerKeeper.SetVscSendTimestamp(ctx, CONSUMER_ID, uint64(i), ctx.BlockTime().Add(-time.Minute))
+		acknowledge(ctx, uint64(i))
+	}
+	resp, err = providerKeeper.QueryConsumerVSCLatency(ctx, &providertypes.QueryConsumerVSCLatencyRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(providertypes.VSCLatencyWindow), resp.NumVscPackets)
+	require.Equal(t, time.Minute, resp.AverageLatency)
+	require.Equal(t, time.Minute, resp.MaxLatency)
+
+	providerKeeper.DeleteVSCLatenciesForConsumer(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetAllVSCLatencies(ctx, CONSUMER_ID))
+
+	// unknown consumer chain
+	_, err = providerKeeper.QueryConsumerVSCLatency(ctx, &providertypes.QueryConsumerVSCLatencyRequest{ConsumerId: "1"})
+	require.Error(t, err)
+}
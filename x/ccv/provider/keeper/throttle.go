@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
@@ -83,10 +86,7 @@ func (k Keeper) ReplenishSlashMeter(ctx sdktypes.Context) {
 	// before being replenished, it'll become more positive in value. However, if the meter
 	// was 0 or positive in value, it'll be replenished only up to it's allowance
 	// for the current block.
-	meter = meter.Add(allowance)
-	if meter.GT(allowance) {
-		meter = allowance
-	}
+	meter = replenishedSlashMeter(meter, allowance)
 
 	k.SetSlashMeter(ctx, meter)
 
@@ -96,9 +96,20 @@ func (k Keeper) ReplenishSlashMeter(ctx sdktypes.Context) {
 	)
 }
 
+// replenishedSlashMeter returns the value of the given slash meter after adding the given allowance,
+// capped at the allowance
+func replenishedSlashMeter(meter, allowance math.Int) math.Int {
+	meter = meter.Add(allowance)
+	if meter.GT(allowance) {
+		return allowance
+	}
+	return meter
+}
+
 // GetSlashMeterAllowance returns the amount of voting power units (int)
 // that would be added to the slash meter for a replenishment that would happen this block,
 // this allowance value also serves as the max value for the meter for this block.
+// The allowance is never lower than the min absolute allowance param.
 //
 // Note: allowance can change between blocks, since it is directly correlated to total voting power.
 // The slash meter must be less than or equal to the allowance for this block, before any slash
@@ -116,12 +127,17 @@ func (k Keeper) GetSlashMeterAllowance(ctx sdktypes.Context) math.Int {
 	totalPower, _ := k.stakingKeeper.GetLastTotalPower(ctx)
 
 	roundedInt := math.NewInt(decFrac.MulInt(totalPower).RoundInt64())
-	if roundedInt.IsZero() {
-		k.Logger(ctx).Info("slash meter replenish fraction is too small " +
-			"to add any allowance to the meter, considering bankers rounding")
 
-		// Return non-zero allowance to guarantee some slash packets are eventually handled
-		return math.NewInt(1)
+	// The min absolute allowance is validated to be positive, which
+	// guarantees that some slash packets are eventually handled
+	minAllowance := math.NewInt(k.GetSlashMeterMinAbsoluteAllowance(ctx))
+	if roundedInt.LT(minAllowance) {
+		k.Logger(ctx).Info("slash meter replenish fraction is too small "+
+			"to add more than the min absolute allowance to the meter, considering bankers rounding",
+			"allowance", roundedInt.Int64(),
+			"min absolute allowance", minAllowance.Int64(),
+		)
+		return minAllowance
 	}
 	return roundedInt
 }
@@ -152,6 +168,13 @@ func (k Keeper) GetSlashMeter(ctx sdktypes.Context) math.Int {
 //
 // Note: the value of this int should always be in the range of tendermint's [-MaxTotalVotingPower, MaxTotalVotingPower]
 func (k Keeper) SetSlashMeter(ctx sdktypes.Context, value math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.SlashMeterKey(), mustMarshalSlashMeter(value))
+}
+
+// mustMarshalSlashMeter returns the bytes of the given slash meter value,
+// panicking if the value is out of the range of valid slash meter values
+func mustMarshalSlashMeter(value math.Int) []byte {
 	// TODO: remove these invariant panics once https://github.com/cosmos/interchain-security/issues/534 is solved.
 
 	// The following panics are included since they are invariants for slash meter value.
@@ -166,13 +189,12 @@ func (k Keeper) SetSlashMeter(ctx sdktypes.Context, value math.Int) {
 	if value.LT(math.NewInt(-tmtypes.MaxTotalVotingPower)) {
 		panic("slash meter value cannot be less than negative tendermint's MaxTotalVotingPower")
 	}
-	store := ctx.KVStore(k.storeKey)
 	bz, err := value.Marshal()
 	if err != nil {
 		// A returned error for marshaling an int would indicate something is very wrong.
 		panic(fmt.Sprintf("failed to marshal slash meter: %v", err))
 	}
-	store.Set(providertypes.SlashMeterKey(), bz)
+	return bz
 }
 
 // GetSlashMeterReplenishTimeCandidate returns the next UTC time the slash meter could potentially be replenished.
@@ -202,7 +224,186 @@ func (k Keeper) GetSlashMeterReplenishTimeCandidate(ctx sdktypes.Context) time.T
 // Note: this value is the next time the slash meter will be replenished IFF the slash meter is NOT full.
 // Otherwise this value will be updated in every future block until the slash meter becomes NOT full.
 func (k Keeper) SetSlashMeterReplenishTimeCandidate(ctx sdktypes.Context) {
+	k.setSlashMeterReplenishTimeCandidate(ctx, ctx.BlockTime().UTC().Add(k.GetSlashMeterReplenishPeriod(ctx)))
+}
+
+// setSlashMeterReplenishTimeCandidate sets the next time the slash meter may be replenished to the given time
+func (k Keeper) setSlashMeterReplenishTimeCandidate(ctx sdktypes.Context, candidate time.Time) {
 	store := ctx.KVStore(k.storeKey)
-	timeToStore := ctx.BlockTime().UTC().Add(k.GetSlashMeterReplenishPeriod(ctx))
-	store.Set(providertypes.SlashMeterReplenishTimeCandidateKey(), sdktypes.FormatTimeBytes(timeToStore))
+	store.Set(providertypes.SlashMeterReplenishTimeCandidateKey(), sdktypes.FormatTimeBytes(candidate.UTC()))
+}
+
+// InitializeConsumerSlashMeter initializes the slash meter of the consumer chain with the given consumer id
+// to its allowance, and sets its replenish time candidate to one replenish period from current block time.
+//
+// Note: the slash meters of the consumer chains are only used if per consumer slash meters are enabled.
+// Every consumer chain has the same allowance as the global slash meter and its slash meter is replenished
+// independently of the global slash meter and of the other consumer chains. Note that the slash packets
+// consume both the slash meter of their consumer chain and the global slash meter.
+func (k Keeper) InitializeConsumerSlashMeter(ctx sdktypes.Context, consumerId string) {
+	k.SetConsumerSlashMeter(ctx, consumerId, k.GetSlashMeterAllowance(ctx))
+	k.SetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+}
+
+// CheckForConsumerSlashMeterReplenishment checks if the slash meter of the consumer chain with the given
+// consumer id should be replenished, and if so, replenishes it. A slash meter that is not set yet is initialized.
+func (k Keeper) CheckForConsumerSlashMeterReplenishment(ctx sdktypes.Context, consumerId string) {
+	candidate, found := k.GetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+	if !found {
+		k.InitializeConsumerSlashMeter(ctx, consumerId)
+		return
+	}
+
+	allowance := k.GetSlashMeterAllowance(ctx)
+	meter, _ := k.GetConsumerSlashMeter(ctx, consumerId)
+	if !ctx.BlockTime().UTC().Before(candidate) {
+		meter = replenishedSlashMeter(meter, allowance)
+		k.SetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+	}
+
+	// as for the global slash meter, ensure the slash meter is not greater than the allowance for this block
+	if meter.GTE(allowance) {
+		k.SetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+		meter = allowance
+	}
+	k.SetConsumerSlashMeter(ctx, consumerId, meter)
+}
+
+// GetConsumerSlashMeter returns the slash meter of the consumer chain with the given consumer id
+// and whether it is set
+func (k Keeper) GetConsumerSlashMeter(ctx sdktypes.Context, consumerId string) (math.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(providertypes.ConsumerSlashMeterKey(consumerId))
+	if bz == nil {
+		return math.ZeroInt(), false
+	}
+	value := math.ZeroInt()
+	if err := value.Unmarshal(bz); err != nil {
+		// We should have obtained value bytes that were serialized in SetConsumerSlashMeter,
+		// so an error here would indicate something is very wrong.
+		panic(fmt.Sprintf("failed to unmarshal slash meter of consumer %s: %v", consumerId, err))
+	}
+	return value, true
+}
+
+// SetConsumerSlashMeter sets the slash meter of the consumer chain with the given consumer id
+//
+// Note: the value of this int should always be in the range of tendermint's [-MaxTotalVotingPower, MaxTotalVotingPower]
+func (k Keeper) SetConsumerSlashMeter(ctx sdktypes.Context, consumerId string, value math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.ConsumerSlashMeterKey(consumerId), mustMarshalSlashMeter(value))
+}
+
+// GetConsumerSlashMeterReplenishTimeCandidate returns the next UTC time the slash meter of the consumer chain
+// with the given consumer id could potentially be replenished and whether it is set
+func (k Keeper) GetConsumerSlashMeterReplenishTimeCandidate(ctx sdktypes.Context, consumerId string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(providertypes.ConsumerSlashMeterReplenishTimeCandidateKey(consumerId))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	ts, err := sdktypes.ParseTimeBytes(bz)
+	if err != nil {
+		// We should have obtained value bytes that were serialized in SetConsumerSlashMeterReplenishTimeCandidate,
+		// so an error here would indicate something is very wrong.
+		panic(fmt.Sprintf("failed to parse slash meter replenish time candidate of consumer %s: %s", consumerId, err))
+	}
+	return ts.UTC(), true
+}
+
+// SetConsumerSlashMeterReplenishTimeCandidate sets the next time the slash meter of the consumer chain
+// with the given consumer id may be replenished to the current block time + the configured slash meter replenish period
+func (k Keeper) SetConsumerSlashMeterReplenishTimeCandidate(ctx sdktypes.Context, consumerId string) {
+	k.setConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId, ctx.BlockTime().UTC().Add(k.GetSlashMeterReplenishPeriod(ctx)))
+}
+
+// setConsumerSlashMeterReplenishTimeCandidate sets the next time the slash meter of the consumer chain
+// with the given consumer id may be replenished to the given time
+func (k Keeper) setConsumerSlashMeterReplenishTimeCandidate(ctx sdktypes.Context, consumerId string, candidate time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.ConsumerSlashMeterReplenishTimeCandidateKey(consumerId), sdktypes.FormatTimeBytes(candidate.UTC()))
+}
+
+// DeleteConsumerSlashMeter deletes the slash meter and its replenish time candidate
+// of the consumer chain with the given consumer id
+func (k Keeper) DeleteConsumerSlashMeter(ctx sdktypes.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(providertypes.ConsumerSlashMeterKey(consumerId))
+	store.Delete(providertypes.ConsumerSlashMeterReplenishTimeCandidateKey(consumerId))
+}
+
+// IncrementInfractionSlashCount increments the number of slash packets applied for the given
+// infraction type. Downtime slash packets are counted when they consume the slash meter,
+// while double-sign slash packets are counted when they are recorded, as they bypass the slash meter.
+func (k Keeper) IncrementInfractionSlashCount(ctx sdktypes.Context, infraction stakingtypes.Infraction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.InfractionSlashCountKey(infraction),
+		sdktypes.Uint64ToBigEndian(k.GetInfractionSlashCount(ctx, infraction)+1))
+}
+
+// GetInfractionSlashCount returns the number of slash packets applied for the given infraction type
+func (k Keeper) GetInfractionSlashCount(ctx sdktypes.Context, infraction stakingtypes.Infraction) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(providertypes.InfractionSlashCountKey(infraction))
+	if bz == nil {
+		return 0
+	}
+	return sdktypes.BigEndianToUint64(bz)
+}
+
+// RecordSlashMeterHistory records the current value of the slash meter for the current block height
+// and prunes the values recorded for the blocks that are no longer among the SlashMeterHistoryLength
+// most recent blocks. If SlashMeterHistoryLength is zero, nothing is recorded and all the recorded values
+// are pruned, so that disabling the recording also clears the history.
+func (k Keeper) RecordSlashMeterHistory(ctx sdktypes.Context) {
+	historyLength := k.GetSlashMeterHistoryLength(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	store := ctx.KVStore(k.storeKey)
+	// all the heights up to and including pruneHeight are pruned
+	pruneHeight := height
+	if historyLength > 0 {
+		store.Set(providertypes.SlashMeterHistoryKey(height), mustMarshalSlashMeter(k.GetSlashMeter(ctx)))
+		if height < uint64(historyLength) {
+			return
+		}
+		pruneHeight = height - uint64(historyLength)
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.SlashMeterHistoryKeyPrefix()})
+	defer iterator.Close()
+
+	// the heights are iterated in ascending order
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if binary.BigEndian.Uint64(iterator.Key()[1:]) > pruneHeight {
+			break
+		}
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// GetSlashMeterHistory returns the recorded values of the slash meter in ascending order of block height
+func (k Keeper) GetSlashMeterHistory(ctx sdktypes.Context) []providertypes.SlashMeterHistoryEntry {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.SlashMeterHistoryKeyPrefix()})
+	defer iterator.Close()
+
+	entries := []providertypes.SlashMeterHistoryEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		meter := math.ZeroInt()
+		if err := meter.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the slash meter values are serialized in RecordSlashMeterHistory.
+			panic(fmt.Sprintf("failed to unmarshal slash meter history: %v", err))
+		}
+		entries = append(entries, providertypes.SlashMeterHistoryEntry{
+			Height:     int64(binary.BigEndian.Uint64(iterator.Key()[1:])),
+			SlashMeter: meter.Int64(),
+		})
+	}
+	return entries
 }
@@ -409,7 +409,9 @@ func TestGetSlashMeterAllowance(t *testing.T) {
 	testCases := []struct {
 		replenishFraction string
 		totalPower        math.Int
-		// Replenish fraction * total power
+		// Min absolute allowance, the default is used if zero
+		minAllowance int64
+		// max(Replenish fraction * total power, min absolute allowance)
 		expectedAllowance math.Int
 	}{
 		{
@@ -442,6 +444,18 @@ func TestGetSlashMeterAllowance(t *testing.T) {
 			totalPower:        math.NewInt(10000000),
 			expectedAllowance: math.NewInt(3400000),
 		},
+		{
+			replenishFraction: "0.0001",
+			totalPower:        math.NewInt(10000000),
+			minAllowance:      5000,
+			expectedAllowance: math.NewInt(5000), // 0.0001 * 10000000 = 1000 < 5000, 5000 is returned
+		},
+		{
+			replenishFraction: "0.34",
+			totalPower:        math.NewInt(10000000),
+			minAllowance:      5000,
+			expectedAllowance: math.NewInt(3400000),
+		},
 	}
 	for _, tc := range testCases {
 
@@ -457,6 +471,9 @@ func TestGetSlashMeterAllowance(t *testing.T) {
 		// Set desired params
 		params := providertypes.DefaultParams()
 		params.SlashMeterReplenishFraction = tc.replenishFraction
+		if tc.minAllowance != 0 {
+			params.SlashMeterMinAbsoluteAllowance = tc.minAllowance
+		}
 		providerKeeper.SetParams(ctx, params)
 
 		// Confirm allowance is calculated correctly
@@ -536,3 +553,146 @@ func TestSlashMeterReplenishTimeCandidate(t *testing.T) {
 		require.Equal(t, tc.blockTime.Add(tc.replenishPeriod).UTC(), gotTime)
 	}
 }
+
+// TestConsumerSlashMeterReplenishment tests that the slash meters of the consumer chains
+// are initialized and replenished independently of each other and of the global slash meter
+func TestConsumerSlashMeterReplenishment(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+		t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	params := providertypes.DefaultParams()
+	params.SlashMeterReplenishPeriod = time.Hour
+	params.SlashMeterReplenishFraction = "0.1"
+	params.PerConsumerSlashMeters = true
+	providerKeeper.SetParams(ctx, params)
+
+	// the allowance is 0.1 * 1000 = 100
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+	allowance := math.NewInt(100)
+	providerKeeper.InitializeSlashMeter(ctx)
+
+	// the slash meters are not set before the first check
+	_, found := providerKeeper.GetConsumerSlashMeter(ctx, "0")
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerSlashMeterReplenishTimeCandidate(ctx, "0")
+	require.False(t, found)
+
+	// the first check initializes the slash meters
+	for _, consumerId := range []string{"0", "1"} {
+		providerKeeper.CheckForConsumerSlashMeterReplenishment(ctx, consumerId)
+		meter, found := providerKeeper.GetConsumerSlashMeter(ctx, consumerId)
+		require.True(t, found)
+		require.Equal(t, allowance, meter)
+		candidate, found := providerKeeper.GetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+		require.True(t, found)
+		require.Equal(t, now.Add(time.Hour), candidate)
+	}
+
+	// consumer "0" exhausts its slash meter
+	providerKeeper.SetConsumerSlashMeter(ctx, "0", math.NewInt(-50))
+
+	// the slash meter is not replenished before the replenish period elapsed
+	ctx = ctx.WithBlockTime(now.Add(30 * time.Minute))
+	providerKeeper.CheckForConsumerSlashMeterReplenishment(ctx, "0")
+	providerKeeper.CheckForConsumerSlashMeterReplenishment(ctx, "1")
+	meter, _ := providerKeeper.GetConsumerSlashMeter(ctx, "0")
+	require.Equal(t, math.NewInt(-50), meter)
+	meter, _ = providerKeeper.GetConsumerSlashMeter(ctx, "1")
+	require.Equal(t, allowance, meter)
+	// neither is the global slash meter consumed
+	require.Equal(t, allowance, providerKeeper.GetSlashMeter(ctx))
+
+	// the slash meter of consumer "0" is replenished once the replenish period elapsed
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	providerKeeper.CheckForConsumerSlashMeterReplenishment(ctx, "0")
+	meter, _ = providerKeeper.GetConsumerSlashMeter(ctx, "0")
+	require.Equal(t, math.NewInt(50), meter)
+	candidate, _ := providerKeeper.GetConsumerSlashMeterReplenishTimeCandidate(ctx, "0")
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), candidate)
+
+	// deleting the slash meter of consumer "0" does not affect consumer "1"
+	providerKeeper.DeleteConsumerSlashMeter(ctx, "0")
+	_, found = providerKeeper.GetConsumerSlashMeter(ctx, "0")
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerSlashMeterReplenishTimeCandidate(ctx, "0")
+	require.False(t, found)
+	meter, found = providerKeeper.GetConsumerSlashMeter(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, allowance, meter)
+}
+
+// TestSlashMeterHistory tests that the slash meter values recorded over several blocks
+// reflect the decline and the replenishment of the slash meter, and that only the
+// values of the SlashMeterHistoryLength most recent blocks are kept
+func TestSlashMeterHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+		t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(1)
+
+	params := providertypes.DefaultParams()
+	params.SlashMeterReplenishPeriod = time.Hour
+	params.SlashMeterReplenishFraction = "0.1"
+	params.SlashMeterHistoryLength = 4
+	providerKeeper.SetParams(ctx, params)
+
+	// the allowance is 0.1 * 1000 = 100
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+	providerKeeper.InitializeSlashMeter(ctx)
+
+	// nothing is recorded yet
+	require.Empty(t, providerKeeper.GetSlashMeterHistory(ctx))
+
+	// every block, the slash meter is checked for replenishment at BeginBlock,
+	// consumed by the given amount of jailed power and recorded at EndBlock
+	advanceBlock := func(blockTime time.Time, jailedPower int64) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime)
+		providerKeeper.BeginBlockCIS(ctx)
+		providerKeeper.SetSlashMeter(ctx, providerKeeper.GetSlashMeter(ctx).SubRaw(jailedPower))
+		providerKeeper.RecordSlashMeterHistory(ctx)
+	}
+	advanceBlock(now.Add(time.Minute), 40)
+	advanceBlock(now.Add(2*time.Minute), 40)
+	advanceBlock(now.Add(3*time.Minute), 40)
+	require.Equal(t, []providertypes.SlashMeterHistoryEntry{
+		{Height: 2, SlashMeter: 60},
+		{Height: 3, SlashMeter: 20},
+		{Height: 4, SlashMeter: -20},
+	}, providerKeeper.GetSlashMeterHistory(ctx))
+
+	// the slash meter is replenished once the replenish period elapsed and
+	// the oldest recorded values are pruned
+	advanceBlock(now.Add(2*time.Hour), 0)
+	advanceBlock(now.Add(2*time.Hour+time.Minute), 10)
+	require.Equal(t, []providertypes.SlashMeterHistoryEntry{
+		{Height: 3, SlashMeter: 20},
+		{Height: 4, SlashMeter: -20},
+		{Height: 5, SlashMeter: 80},
+		{Height: 6, SlashMeter: 70},
+	}, providerKeeper.GetSlashMeterHistory(ctx))
+
+	// the slash meter history is queryable
+	res, err := providerKeeper.QuerySlashMeterHistory(ctx, &providertypes.QuerySlashMeterHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, providerKeeper.GetSlashMeterHistory(ctx), res.Entries)
+
+	// reducing the history length prunes all the older values at the next block
+	params.SlashMeterHistoryLength = 1
+	providerKeeper.SetParams(ctx, params)
+	advanceBlock(now.Add(2*time.Hour+2*time.Minute), 0)
+	require.Equal(t, []providertypes.SlashMeterHistoryEntry{
+		{Height: 7, SlashMeter: 70},
+	}, providerKeeper.GetSlashMeterHistory(ctx))
+
+	// nothing is recorded and the history is cleared if the history length is zero
+	params.SlashMeterHistoryLength = 0
+	providerKeeper.SetParams(ctx, params)
+	advanceBlock(now.Add(2*time.Hour+3*time.Minute), 0)
+	require.Empty(t, providerKeeper.GetSlashMeterHistory(ctx))
+}
@@ -1,15 +1,18 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -35,6 +38,38 @@ func (k Keeper) SetConsumerValSet(ctx sdk.Context, consumerId string, nextValida
 	return k.setValSet(ctx, k.GetConsumerChainConsensusValidatorsKey(ctx, consumerId), nextValidators)
 }
 
+// SetConsumerValSetProviderPower sets the provider total power at the time
+// the current validator set of the consumer chain with `consumerId` was computed
+func (k Keeper) SetConsumerValSetProviderPower(ctx sdk.Context, consumerId string, power math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := power.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal provider power: %w", err))
+	}
+	store.Set(types.ConsumerValSetProviderPowerKey(consumerId), bz)
+}
+
+// GetConsumerValSetProviderPower returns the provider total power at the time
+// the current validator set of the consumer chain with `consumerId` was computed
+func (k Keeper) GetConsumerValSetProviderPower(ctx sdk.Context, consumerId string) (math.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerValSetProviderPowerKey(consumerId))
+	if bz == nil {
+		return math.ZeroInt(), false
+	}
+	var power math.Int
+	if err := power.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal provider power: %w", err))
+	}
+	return power, true
+}
+
+// DeleteConsumerValSetProviderPower deletes the recorded provider total power of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerValSetProviderPower(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerValSetProviderPowerKey(consumerId))
+}
+
 // DeleteConsumerValidator removes consumer validator with `providerAddr` address
 func (k Keeper) DeleteConsumerValidator(
 	ctx sdk.Context,
@@ -83,6 +118,32 @@ func (k Keeper) GetConsumerValSet(
 	return k.getValSet(ctx, k.GetConsumerChainConsensusValidatorsKey(ctx, consumerId))
 }
 
+// ConsumerValSetHash returns the CometBFT validator set hash of the given consumer validators,
+// i.e., the hash a consumer chain using these validators reports as its validator set hash.
+// The validators are sorted by power (descending) and then by the address of their consumer
+// public keys (ascending), and the hash is the Merkle root of their (consumer public key, power) pairs.
+// Thus, the hash does not depend on the order of the given validators.
+func ConsumerValSetHash(validators []types.ConsensusValidator) ([]byte, error) {
+	updates := make([]abci.ValidatorUpdate, 0, len(validators))
+	for _, val := range validators {
+		if val.PublicKey == nil {
+			return nil, fmt.Errorf("consumer validator with no public key: %s", sdk.ConsAddress(val.ProviderConsAddr).String())
+		}
+		updates = append(updates, abci.ValidatorUpdate{PubKey: *val.PublicKey, Power: val.Power})
+	}
+
+	tmValidators, err := tmtypes.PB2TM.ValidatorUpdates(updates)
+	if err != nil {
+		return nil, fmt.Errorf("converting consumer validators: %w", err)
+	}
+	valSet := &tmtypes.ValidatorSet{}
+	if err := valSet.UpdateWithChangeSet(tmValidators); err != nil {
+		return nil, fmt.Errorf("creating consumer validator set: %w", err)
+	}
+
+	return valSet.Hash(), nil
+}
+
 // DiffValidators compares the current and the next epoch's consumer validators and returns the `ValidatorUpdate` diff
 // needed by CometBFT to update the validator set on a chain.
 func DiffValidators(
@@ -141,8 +202,15 @@ func (k Keeper) CreateConsumerValidator(ctx sdk.Context, consumerId string, vali
 			validator, err)
 	}
 
-	consumerPublicKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, types.NewProviderConsAddress(consAddr))
-	if !found {
+	providerAddr := types.NewProviderConsAddress(consAddr)
+	consumerPublicKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+	if found {
+		// make sure that the assigned consumer key can be used by the consumer chain
+		if _, err := ccv.TMCryptoPublicKeyToConsAddr(consumerPublicKey); err != nil {
+			return types.ConsensusValidator{}, errorsmod.Wrapf(types.ErrMalformedConsumerKey,
+				"validator %s: %s", providerAddr.String(), err.Error())
+		}
+	} else {
 		consumerPublicKey, err = validator.CmtConsPublicKey()
 		if err != nil {
 			return types.ConsensusValidator{}, fmt.Errorf("could not retrieve validator's (%+v) public key: %w", validator, err)
@@ -165,7 +233,7 @@ func (k Keeper) CreateConsumerValidator(ctx sdk.Context, consumerId string, vali
 }
 
 // FilterValidators filters the provided `bondedValidators` according to `predicate` and returns
-// the filtered set.
+// the filtered set. Validators whose assigned consumer key is malformed are skipped.
 func (k Keeper) FilterValidators(
 	ctx sdk.Context,
 	consumerId string,
@@ -179,12 +247,30 @@ func (k Keeper) FilterValidators(
 			continue
 		}
 
-		ok, err := predicate(types.NewProviderConsAddress(consAddr))
+		providerAddr := types.NewProviderConsAddress(consAddr)
+		ok, err := predicate(providerAddr)
 		if err != nil {
 			return nextValidators, err
 		}
 		if ok {
 			nextValidator, err := k.CreateConsumerValidator(ctx, consumerId, val)
+			if errors.Is(err, types.ErrMalformedConsumerKey) {
+				// skip the validator instead of sending a consumer key
+				// that the consumer chain cannot use
+				k.Logger(ctx).Error("skipping validator with malformed consumer key",
+					"consumerId", consumerId,
+					"providerAddr", providerAddr.String(),
+					"error", err.Error(),
+				)
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeSkipMalformedConsumerKey,
+						sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+						sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+					),
+				)
+				continue
+			}
 			if err != nil {
 				return nextValidators, err
 			}
@@ -202,6 +288,7 @@ func (k Keeper) ComputeNextValidators(
 	bondedValidators []stakingtypes.Validator,
 	powerShapingParameters types.PowerShapingParameters,
 	minPowerToOptIn int64,
+	forcedConsumers ForcedConsumers,
 ) ([]types.ConsensusValidator, error) {
 	// sort the bonded validators by number of staked tokens in descending order
 	sort.Slice(bondedValidators, func(i, j int) bool {
@@ -220,7 +307,7 @@ func (k Keeper) ComputeNextValidators(
 
 	nextValidators, err := k.FilterValidators(ctx, consumerId, bondedValidators,
 		func(providerAddr types.ProviderConsAddress) (bool, error) {
-			canValidateChain, err := k.CanValidateChain(ctx, consumerId, providerAddr, powerShapingParameters.Top_N, minPowerToOptIn)
+			canValidateChain, err := k.CanValidateChain(ctx, consumerId, providerAddr, powerShapingParameters.Top_N, minPowerToOptIn, forcedConsumers)
 			if err != nil {
 				return false, err
 			}
@@ -263,7 +350,8 @@ func (k Keeper) GetLastProviderConsensusActiveValidators(ctx sdk.Context) ([]sta
 // ComputeConsumerNextValSet computes the consumer next validator set and returns
 // the validator updates to be sent to the consumer chain.
 // For TopN consumer chains, it automatically opts in all validators that
-// belong to the top N of the active validators.
+// belong to the top N of the active validators, unless they reached the max forced consumers
+// according to `forcedConsumers`.
 //
 // TODO add unit test for ComputeConsumerNextValSet
 func (k Keeper) ComputeConsumerNextValSet(
@@ -272,6 +360,7 @@ func (k Keeper) ComputeConsumerNextValSet(
 	activeValidators []stakingtypes.Validator,
 	consumerId string,
 	currentConsumerValSet []types.ConsensusValidator,
+	forcedConsumers ForcedConsumers,
 ) ([]abci.ValidatorUpdate, error) {
 	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
 	if err != nil {
@@ -292,7 +381,7 @@ func (k Keeper) ComputeConsumerNextValSet(
 
 		// in a Top-N chain, we automatically opt in all validators that belong to the top N
 		// of the active validators
-		err = k.OptInTopNValidators(ctx, consumerId, activeValidators, minPower)
+		err = k.OptInTopNValidators(ctx, consumerId, activeValidators, minPower, forcedConsumers)
 		if err != nil {
 			return []abci.ValidatorUpdate{},
 				fmt.Errorf("opting in topN validators, consumerId(%s), minPower(%d): %w", consumerId, minPower, err)
@@ -300,7 +389,7 @@ func (k Keeper) ComputeConsumerNextValSet(
 	}
 
 	// need to use the bondedValidators, not activeValidators, here since the chain might be opt-in and allow inactive vals
-	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
+	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower, forcedConsumers)
 	if err != nil {
 		return []abci.ValidatorUpdate{},
 			fmt.Errorf("computing next validators, consumerId(%s), minPower(%d): %w", consumerId, minPower, err)
@@ -312,8 +401,71 @@ func (k Keeper) ComputeConsumerNextValSet(
 			fmt.Errorf("setting consumer validator set, consumerId(%s): %w", consumerId, err)
 	}
 
+	// record the provider total power the consumer validator set is computed against
+	providerTotalPower, err := k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return []abci.ValidatorUpdate{},
+			fmt.Errorf("getting provider last total power, consumerId(%s): %w", consumerId, err)
+	}
+	k.SetConsumerValSetProviderPower(ctx, consumerId, providerTotalPower)
+
 	// get the initial updates with the latest set consumer public keys
 	valUpdates := DiffValidators(currentConsumerValSet, nextValidators)
 
 	return valUpdates, nil
 }
+
+// SimulateConsumerNextValSet returns the consumer validator set the chain with `consumerId` would have
+// if its power-shaping parameters were updated to `powerShapingParameters`, together with the
+// validator updates with respect to its current validator set. The computation is done on a cached
+// context that is never written, i.e., this method does not change the state.
+func (k Keeper) SimulateConsumerNextValSet(
+	ctx sdk.Context,
+	consumerId string,
+	powerShapingParameters types.PowerShapingParameters,
+) ([]types.ConsensusValidator, []abci.ValidatorUpdate, error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	oldPowerShapingParameters, err := k.GetConsumerPowerShapingParameters(cacheCtx, consumerId)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting power shaping parameters: %s", err.Error())
+	}
+	if err := k.SetConsumerPowerShapingParameters(cacheCtx, consumerId, powerShapingParameters); err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidPowerShapingParameters,
+			"cannot set power shaping parameters: %s", err.Error())
+	}
+	if err := k.UpdateMinimumPowerInTopN(cacheCtx, consumerId, oldPowerShapingParameters.Top_N, powerShapingParameters.Top_N); err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrCannotUpdateMinimumPowerInTopN,
+			"oldTopN: %d, newTopN: %d: %s", oldPowerShapingParameters.Top_N, powerShapingParameters.Top_N, err.Error())
+	}
+
+	bondedValidators, err := k.GetLastBondedValidators(cacheCtx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting last bonded validators: %w", err)
+	}
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(cacheCtx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting last provider active validators: %w", err)
+	}
+	currentValSet, err := k.GetConsumerValSet(cacheCtx, consumerId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting consumer validator set, consumerId(%s): %w", consumerId, err)
+	}
+
+	forcedConsumers, err := k.ComputeForcedConsumers(cacheCtx, bondedValidators)
+	if err != nil {
+		return nil, nil, fmt.Errorf("computing forced consumers: %w", err)
+	}
+
+	valUpdates, err := k.ComputeConsumerNextValSet(cacheCtx, bondedValidators, activeValidators, consumerId, currentValSet, forcedConsumers)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextValSet, err := k.GetConsumerValSet(cacheCtx, consumerId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting next consumer validator set, consumerId(%s): %w", consumerId, err)
+	}
+
+	return nextValSet, valUpdates, nil
+}
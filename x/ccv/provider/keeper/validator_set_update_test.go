@@ -13,7 +13,9 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
@@ -252,6 +254,45 @@ func TestDiffEdgeCases(t *testing.T) {
 	require.Equal(t, expectedUpdates, actualUpdates)
 }
 
+// TestConsumerValSetHash tests that the consumer validator set hash matches the CometBFT
+// validator set hash and does not depend on the order of the consumer validators
+func TestConsumerValSetHash(t *testing.T) {
+	valA, _ := createConsumerValidator(1, 1, 1)
+	valB, _ := createConsumerValidator(2, 2, 2)
+	valC, _ := createConsumerValidator(3, 2, 3)
+	valD, _ := createConsumerValidator(4, 5, 4)
+
+	var tmValidators []*tmtypes.Validator
+	for _, val := range []types.ConsensusValidator{valA, valB, valC, valD} {
+		pubKey, err := cryptoenc.PubKeyFromProto(*val.PublicKey)
+		require.NoError(t, err)
+		tmValidators = append(tmValidators, tmtypes.NewValidator(pubKey, val.Power))
+	}
+	expectedHash := tmtypes.NewValidatorSet(tmValidators).Hash()
+
+	for _, validators := range [][]types.ConsensusValidator{
+		{valA, valB, valC, valD},
+		{valD, valC, valB, valA},
+		{valC, valA, valD, valB},
+		{valB, valD, valA, valC},
+	} {
+		hash, err := keeper.ConsumerValSetHash(validators)
+		require.NoError(t, err)
+		require.Equal(t, expectedHash, hash)
+	}
+
+	// the hash changes if the power of a validator changes
+	valB.Power = 3
+	hash, err := keeper.ConsumerValSetHash([]types.ConsensusValidator{valA, valB, valC, valD})
+	require.NoError(t, err)
+	require.NotEqual(t, expectedHash, hash)
+
+	// the hash of an empty validator set is the hash of an empty CometBFT validator set
+	hash, err = keeper.ConsumerValSetHash(nil)
+	require.NoError(t, err)
+	require.Equal(t, tmtypes.NewValidatorSet(nil).Hash(), hash)
+}
+
 func TestSetConsumerValSet(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -382,6 +423,50 @@ func TestFilterValidatorsConsiderAll(t *testing.T) {
 	require.Equal(t, expectedValidators, actualValidators)
 }
 
+// TestFilterValidatorsMalformedConsumerKey checks that validators with a malformed
+// consumer key are skipped and that an event is emitted for them
+func TestFilterValidatorsMalformedConsumerKey(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerID := CONSUMER_ID
+	considerAll := func(providerAddr types.ProviderConsAddress) (bool, error) { return true, nil }
+
+	// create a staking validator A that has not set a consumer public key
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	valAPublicKey, _ := valA.CmtConsPublicKey()
+
+	// create a staking validator B with a corrupt consumer key, i.e., an ed25519 key of invalid size
+	valB := createStakingValidator(ctx, mocks, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	valBProviderAddr := types.NewProviderConsAddress(valBConsAddr)
+	malformedKey := crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte{1, 2, 3}}}
+	providerKeeper.SetValidatorConsumerPubKey(ctx, consumerID, valBProviderAddr, malformedKey)
+
+	_, err := providerKeeper.CreateConsumerValidator(ctx, consumerID, valB)
+	require.ErrorIs(t, err, types.ErrMalformedConsumerKey)
+
+	// validator B is skipped instead of failing the whole replication
+	actualValidators, err := providerKeeper.FilterValidators(ctx, consumerID, []stakingtypes.Validator{valA, valB}, considerAll)
+	require.NoError(t, err)
+	require.Equal(t, []types.ConsensusValidator{
+		{
+			ProviderConsAddr: types.NewProviderConsAddress(valAConsAddr).Address.Bytes(),
+			Power:            1,
+			PublicKey:        &valAPublicKey,
+		},
+	}, actualValidators)
+
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSkipMalformedConsumerKey,
+			sdk.NewAttribute(types.AttributeConsumerId, consumerID),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, valBProviderAddr.String()),
+		),
+	}, ctx.EventManager().Events())
+}
+
 func TestFilterValidatorsConsiderOnlyOptIn(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
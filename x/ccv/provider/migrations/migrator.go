@@ -11,6 +11,7 @@ import (
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	v7 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v7"
 	v8 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v8"
+	v9 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -96,3 +97,12 @@ func (m Migrator) Migrate7to8(ctx sdktypes.Context) error {
 
 	return nil
 }
+
+// Migrate8to9 migrates x/ccvprovider state from consensus version 8 to 9.
+// The migration consists of the following actions:
+// - set the default values of the new provider params for which the zero value is invalid
+// - schedule for pruning the consumer addresses left without a prune timestamp by the v8 migration
+func (m Migrator) Migrate8to9(ctx sdktypes.Context) error {
+	v9.MigrateParams(ctx, m.providerKeeper)
+	return v9.MigrateConsumerAddrsToPrune(ctx, m.providerKeeper)
+}
@@ -82,5 +82,15 @@ func GetParamsLegacy(ctx sdk.Context, paramspace ccvtypes.LegacyParamSubspace) t
 		getNumberOfEpochsToStartReceivingRewards(ctx, paramspace),
 		// this parameter is new so it doesn't need to be migrated, just initialized
 		types.DefaultMaxProviderConsensusValidators,
+		types.DefaultKeyAssignmentMinInterval,
+		types.DefaultMaxValsetUpdateBlockHeights,
+		types.DefaultDowntimeSlashGracePeriod,
+		types.DefaultMaxConsumerPhaseHistoryLength,
+		types.DefaultSlashMeterMinAbsoluteAllowance,
+		types.DefaultKeyPruneWarningWindow,
+		types.DefaultMaxForcedConsumersPerValidator,
+		types.DefaultPerConsumerSlashMeters,
+		types.DefaultSlashMeterHistoryLength,
+		types.DefaultRejectUnknownSlashValidators,
 	)
 }
@@ -0,0 +1,55 @@
+package v9
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MigrateParams sets the provider params that were added after consensus version 8
+// and for which the zero value is invalid or disables a feature to their default values
+func MigrateParams(ctx sdk.Context, pk providerkeeper.Keeper) {
+	params := pk.GetParams(ctx)
+	if params.SlashMeterMinAbsoluteAllowance == 0 {
+		params.SlashMeterMinAbsoluteAllowance = providertypes.DefaultSlashMeterMinAbsoluteAllowance
+	}
+	// a zero value disables the recording of the valset update block heights
+	if params.MaxValsetUpdateBlockHeights == 0 {
+		params.MaxValsetUpdateBlockHeights = providertypes.DefaultMaxValsetUpdateBlockHeights
+	}
+	// a zero value disables the recording of the phase histories of the consumer chains
+	if params.MaxConsumerPhaseHistoryLength == 0 {
+		params.MaxConsumerPhaseHistoryLength = providertypes.DefaultMaxConsumerPhaseHistoryLength
+	}
+	pk.SetParams(ctx, params)
+}
+
+// MigrateConsumerAddrsToPrune schedules for pruning the consumer addresses that are neither
+// assigned nor scheduled for pruning, i.e., the ones for which the v8 migration of the ConsumerAddrsToPrune
+// index found no VSC send timestamp. These consumer addresses are pruned once the key pruning period
+// of the consumer chain elapses after the migration, as otherwise they would never be pruned.
+func MigrateConsumerAddrsToPrune(ctx sdk.Context, pk providerkeeper.Keeper) error {
+	for _, consumerId := range pk.GetAllConsumerIds(ctx) {
+		holds, violations := pk.CheckPruningInvariant(ctx, consumerId)
+		if holds {
+			continue
+		}
+
+		keyPruningPeriod, err := pk.GetKeyPruningPeriod(ctx, consumerId)
+		if err != nil {
+			return err
+		}
+		pruneTs := ctx.BlockTime().Add(keyPruningPeriod).UTC()
+
+		for _, violation := range violations {
+			consAddr, err := sdk.ConsAddressFromBech32(violation)
+			if err != nil {
+				return err
+			}
+			pk.AppendConsumerAddrsToPrune(ctx, consumerId, pruneTs, providertypes.NewConsumerConsAddress(consAddr))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package v9
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// params stored before the slash meter min absolute allowance, the max valset
+	// update block heights and the max consumer phase history length were added
+	params := providertypes.DefaultParams()
+	params.SlashMeterMinAbsoluteAllowance = 0
+	params.MaxValsetUpdateBlockHeights = 0
+	params.MaxConsumerPhaseHistoryLength = 0
+	pk.SetParams(ctx, params)
+	require.Error(t, pk.GetParams(ctx).Validate())
+
+	MigrateParams(ctx, pk)
+	require.Equal(t, providertypes.DefaultSlashMeterMinAbsoluteAllowance, pk.GetSlashMeterMinAbsoluteAllowance(ctx))
+	require.Equal(t, providertypes.DefaultMaxValsetUpdateBlockHeights, pk.GetParams(ctx).MaxValsetUpdateBlockHeights)
+	require.Equal(t, providertypes.DefaultMaxConsumerPhaseHistoryLength, pk.GetMaxConsumerPhaseHistoryLength(ctx))
+	require.NoError(t, pk.GetParams(ctx).Validate())
+
+	// non-zero values are not overwritten
+	params.SlashMeterMinAbsoluteAllowance = 500
+	params.MaxValsetUpdateBlockHeights = 20
+	params.MaxConsumerPhaseHistoryLength = 3
+	pk.SetParams(ctx, params)
+	MigrateParams(ctx, pk)
+	require.Equal(t, int64(500), pk.GetSlashMeterMinAbsoluteAllowance(ctx))
+	require.Equal(t, int64(20), pk.GetParams(ctx).MaxValsetUpdateBlockHeights)
+	require.Equal(t, int64(3), pk.GetMaxConsumerPhaseHistoryLength(ctx))
+}
+
+func TestMigrateConsumerAddrsToPrune(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, mocks := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod, nil).AnyTimes()
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	consumerId := "0"
+	providerAddr := crypto.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+
+	// a consumer address that is currently assigned
+	assigned := crypto.NewCryptoIdentityFromIntSeed(1)
+	pk.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, assigned.TMProtoCryptoPublicKey())
+	pk.SetValidatorByConsumerAddr(ctx, consumerId, assigned.ConsumerConsAddress(), providerAddr)
+
+	// a consumer address that is already scheduled for pruning
+	scheduled := crypto.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress()
+	scheduledTs := ctx.BlockTime().Add(time.Hour).UTC()
+	pk.SetValidatorByConsumerAddr(ctx, consumerId, scheduled, providerAddr)
+	pk.AppendConsumerAddrsToPrune(ctx, consumerId, scheduledTs, scheduled)
+
+	// a consumer address left without a prune timestamp by the v8 migration
+	orphaned := crypto.NewCryptoIdentityFromIntSeed(3).ConsumerConsAddress()
+	pk.SetValidatorByConsumerAddr(ctx, consumerId, orphaned, providerAddr)
+
+	pk.FetchAndIncrementConsumerId(ctx)
+	holds, violations := pk.CheckPruningInvariant(ctx, consumerId)
+	require.False(t, holds)
+	require.Equal(t, []string{orphaned.String()}, violations)
+
+	require.NoError(t, MigrateConsumerAddrsToPrune(ctx, pk))
+
+	holds, _ = pk.CheckPruningInvariant(ctx, consumerId)
+	require.True(t, holds)
+	pruneTs := ctx.BlockTime().Add(unbondingPeriod).UTC()
+	require.Equal(t, [][]byte{orphaned.ToSdkConsAddr()}, pk.GetConsumerAddrsToPrune(ctx, consumerId, pruneTs).Addresses)
+	require.Equal(t, [][]byte{scheduled.ToSdkConsAddr()}, pk.GetConsumerAddrsToPrune(ctx, consumerId, scheduledTs).Addresses)
+}
@@ -4,16 +4,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // Simulation parameter constants
 const (
 	// only includes params that make sense even with a single
 	maxProviderConsensusValidators = "max_provider_consensus_validators"
+	// number of consumer chains that are generated with pre-populated key assignments
+	numKeyAssignmentConsumers = "num_key_assignment_consumers"
 )
 
 // genMaxProviderConsensusValidators returns randomized maxProviderConsensusValidators
@@ -21,14 +31,111 @@ func genMaxProviderConsensusValidators(r *rand.Rand) int64 {
 	return int64(r.Intn(250) + 1)
 }
 
+// genNumKeyAssignmentConsumers returns a randomized number of consumer chains with
+// pre-populated key assignments; zero means that no key assignments are generated
+func genNumKeyAssignmentConsumers(r *rand.Rand) int {
+	return r.Intn(4)
+}
+
+// genConsumerStates returns the states of numConsumers consumer chains with an IBC client and a CCV channel.
+// The consumer chains are in the registered phase, so that no validator set updates
+// are computed for them, as their power-shaping parameters are not part of the genesis state.
+func genConsumerStates(numConsumers int) []types.ConsumerState {
+	consumerStates := make([]types.ConsumerState, 0, numConsumers)
+	for i := 0; i < numConsumers; i++ {
+		consumerStates = append(consumerStates, types.ConsumerState{
+			ChainId:         fmt.Sprintf("%d", i),
+			ClientId:        fmt.Sprintf("07-tendermint-%d", i),
+			ChannelId:       fmt.Sprintf("channel-%d", i),
+			ConsumerGenesis: *ccvtypes.DefaultConsumerGenesisState(),
+			Phase:           types.CONSUMER_PHASE_REGISTERED,
+		})
+	}
+	return consumerStates
+}
+
+// genKeyAssignments returns randomized key assignments for the given consumer chains.
+// For every consumer chain, each bonded account assigns a consumer key with probability 1/2.
+// Additionally, with probability 1/4, a previously assigned consumer key is added to
+// the reverse index and scheduled for pruning once the unbonding period elapses,
+// i.e., the returned state satisfies the invariant described in AppendConsumerAddrsToPrune.
+func genKeyAssignments(
+	r *rand.Rand,
+	accounts []simtypes.Account,
+	consumerStates []types.ConsumerState,
+	genTime time.Time,
+	unbondTime time.Duration,
+) (
+	validatorConsumerPubKeys []types.ValidatorConsumerPubKey,
+	validatorsByConsumerAddr []types.ValidatorByConsumerAddr,
+	consumerAddrsToPrune []types.ConsumerAddrsToPruneV2,
+) {
+	pruneTs := genTime.Add(unbondTime)
+	for _, cs := range consumerStates {
+		consumerId := cs.ChainId
+		toPrune := types.AddressList{}
+		for _, acc := range accounts {
+			if r.Intn(2) == 0 {
+				continue
+			}
+			providerAddr := sdk.ConsAddress(acc.ConsKey.PubKey().Address())
+
+			consumerKey, consumerAddr := genConsumerKey(r)
+			validatorConsumerPubKeys = append(validatorConsumerPubKeys, types.ValidatorConsumerPubKey{
+				ChainId:      consumerId,
+				ProviderAddr: providerAddr,
+				ConsumerKey:  &consumerKey,
+			})
+			validatorsByConsumerAddr = append(validatorsByConsumerAddr, types.ValidatorByConsumerAddr{
+				ChainId:      consumerId,
+				ConsumerAddr: consumerAddr,
+				ProviderAddr: providerAddr,
+			})
+
+			if r.Intn(4) == 0 {
+				_, oldConsumerAddr := genConsumerKey(r)
+				validatorsByConsumerAddr = append(validatorsByConsumerAddr, types.ValidatorByConsumerAddr{
+					ChainId:      consumerId,
+					ConsumerAddr: oldConsumerAddr,
+					ProviderAddr: providerAddr,
+				})
+				toPrune.Addresses = append(toPrune.Addresses, oldConsumerAddr)
+			}
+		}
+		if len(toPrune.Addresses) > 0 {
+			consumerAddrsToPrune = append(consumerAddrsToPrune, types.ConsumerAddrsToPruneV2{
+				ChainId:       consumerId,
+				PruneTs:       pruneTs,
+				ConsumerAddrs: &toPrune,
+			})
+		}
+	}
+
+	return validatorConsumerPubKeys, validatorsByConsumerAddr, consumerAddrsToPrune
+}
+
+// genConsumerKey returns a randomized ed25519 consumer key and its consensus address
+func genConsumerKey(r *rand.Rand) (tmprotocrypto.PublicKey, sdk.ConsAddress) {
+	secret := make([]byte, 32)
+	r.Read(secret)
+	pubKey := ed25519.GenPrivKeyFromSecret(secret).PubKey()
+	consumerKey, err := cryptocodec.ToCmtProtoPublicKey(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return consumerKey, sdk.ConsAddress(pubKey.Address())
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	var (
-		maxProviderConsensusVals int64
+		maxProviderConsensusVals       int64
+		numKeyAssignmentConsumerChains int
 	)
 
 	simState.AppParams.GetOrGenerate(maxProviderConsensusValidators, &maxProviderConsensusVals, simState.Rand, func(r *rand.Rand) { maxProviderConsensusVals = genMaxProviderConsensusValidators(r) })
+	simState.AppParams.GetOrGenerate(numKeyAssignmentConsumers, &numKeyAssignmentConsumerChains, simState.Rand, func(r *rand.Rand) { numKeyAssignmentConsumerChains = genNumKeyAssignmentConsumers(r) })
 
 	providerParams := types.DefaultParams()
 	providerParams.MaxProviderConsensusValidators = maxProviderConsensusVals
@@ -36,6 +143,26 @@ func RandomizedGenState(simState *module.SimulationState) {
 	providerGenesis := types.DefaultGenesisState()
 	providerGenesis.Params = providerParams
 
+	// key assignments are only generated for existing consumer chains,
+	// so the consumer chains are generated first
+	providerGenesis.ConsumerStates = genConsumerStates(numKeyAssignmentConsumerChains)
+
+	// key assignments are only generated for the initially bonded accounts
+	bondedAccounts := simState.Accounts
+	if int64(len(bondedAccounts)) > simState.NumBonded {
+		bondedAccounts = bondedAccounts[:simState.NumBonded]
+	}
+	validatorConsumerPubKeys, validatorsByConsumerAddr, consumerAddrsToPrune := genKeyAssignments(
+		simState.Rand,
+		bondedAccounts,
+		providerGenesis.ConsumerStates,
+		simState.GenTimestamp,
+		simState.UnbondTime,
+	)
+	providerGenesis.ValidatorConsumerPubkeys = validatorConsumerPubKeys
+	providerGenesis.ValidatorsByConsumerAddr = validatorsByConsumerAddr
+	providerGenesis.ConsumerAddrsToPruneV2 = consumerAddrsToPrune
+
 	bz, err := json.MarshalIndent(&providerGenesis.Params, "", " ")
 	if err != nil {
 		panic(err)
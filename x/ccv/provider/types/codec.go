@@ -32,7 +32,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateConsumer{},
 		&MsgUpdateConsumer{},
 		&MsgRemoveConsumer{},
+		&MsgResumeConsumer{},
+		&MsgTransferConsumerOwnership{},
 		&MsgChangeRewardDenoms{},
+		&MsgSetSlashPacketsPaused{},
+		&MsgSetConsumerSlashMode{},
+		&MsgCancelPendingDowntimeSlash{},
+		&MsgSetVSCSendingPaused{},
+		&MsgRemoveConsumers{},
+		&MsgRemoveConsumerKeyAssignment{},
+		&MsgResendConsumerValidatorSet{},
 		&MsgUpdateParams{},
 	)
 	// keep so existing proposals can be correctly deserialized
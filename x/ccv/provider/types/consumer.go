@@ -1,6 +1,10 @@
 package types
 
 import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -25,3 +29,21 @@ func NewConsumerStates(
 		Phase:                phase,
 	}
 }
+
+// ValidConsumerPhases returns the names of the phases a consumer chain can be in
+func ValidConsumerPhases() []string {
+	return []string{"REGISTERED", "INITIALIZED", "LAUNCHED", "STOPPED", "DELETED"}
+}
+
+// ParseConsumerPhase returns the consumer phase with the given name. The name is
+// case-insensitive and can be given with or without the `CONSUMER_PHASE_` prefix,
+// e.g., both `launched` and `CONSUMER_PHASE_LAUNCHED` correspond to CONSUMER_PHASE_LAUNCHED.
+func ParseConsumerPhase(phase string) (ConsumerPhase, error) {
+	name := strings.ToUpper(strings.TrimSpace(phase))
+	name = strings.TrimPrefix(name, "CONSUMER_PHASE_")
+	if value, found := ConsumerPhase_value["CONSUMER_PHASE_"+name]; found && ConsumerPhase(value) != CONSUMER_PHASE_UNSPECIFIED {
+		return ConsumerPhase(value), nil
+	}
+	return CONSUMER_PHASE_UNSPECIFIED, errorsmod.Wrapf(ErrUnknownConsumerPhase,
+		"%s, valid phases are: %s", phase, strings.Join(ValidConsumerPhases(), ", "))
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestParseConsumerPhase(t *testing.T) {
+	testCases := []struct {
+		name          string
+		phase         string
+		expectedPhase types.ConsumerPhase
+		expPass       bool
+	}{
+		{"full name", "CONSUMER_PHASE_LAUNCHED", types.CONSUMER_PHASE_LAUNCHED, true},
+		{"name without prefix", "REGISTERED", types.CONSUMER_PHASE_REGISTERED, true},
+		{"lower case name", "stopped", types.CONSUMER_PHASE_STOPPED, true},
+		{"name with spaces", " initialized ", types.CONSUMER_PHASE_INITIALIZED, true},
+		{"deleted", "DELETED", types.CONSUMER_PHASE_DELETED, true},
+		{"unspecified", "UNSPECIFIED", types.CONSUMER_PHASE_UNSPECIFIED, false},
+		{"unknown phase", "RUNNING", types.CONSUMER_PHASE_UNSPECIFIED, false},
+		{"empty phase", "", types.CONSUMER_PHASE_UNSPECIFIED, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			phase, err := types.ParseConsumerPhase(tc.phase)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrUnknownConsumerPhase)
+				// the error lists the valid phases
+				require.ErrorContains(t, err, "valid phases are: REGISTERED, INITIALIZED, LAUNCHED, STOPPED, DELETED")
+			}
+			require.Equal(t, tc.expectedPhase, phase)
+		})
+	}
+}
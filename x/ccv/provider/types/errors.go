@@ -41,4 +41,21 @@ var (
 	ErrInvalidMsgChangeRewardDenoms            = errorsmod.Register(ModuleName, 52, "invalid change reward denoms message")
 	ErrInvalidAllowlistedRewardDenoms          = errorsmod.Register(ModuleName, 53, "invalid allowlisted reward denoms")
 	ErrInvalidConsumerInfractionParameters     = errorsmod.Register(ModuleName, 54, "invalid consumer infraction parameters")
+	ErrUnknownConsumerPhase                    = errorsmod.Register(ModuleName, 55, "unknown consumer phase")
+	ErrInvalidMsgSetSlashPacketsPaused         = errorsmod.Register(ModuleName, 56, "invalid set slash packets paused message")
+	ErrKeyAssignmentTooFrequent                = errorsmod.Register(ModuleName, 57, "key assignment is too frequent")
+	ErrInvalidMsgRemoveConsumerKeyAssignment   = errorsmod.Register(ModuleName, 58, "invalid remove consumer key assignment message")
+	ErrInvalidMsgResendConsumerValidatorSet    = errorsmod.Register(ModuleName, 59, "invalid resend consumer validator set message")
+	ErrMalformedConsumerKey                    = errorsmod.Register(ModuleName, 60, "malformed consumer key")
+	ErrInvalidMsgResumeConsumer                = errorsmod.Register(ModuleName, 61, "invalid resume consumer message")
+	ErrConsumerKeyIsProviderKey                = errorsmod.Register(ModuleName, 62, "consumer key is already in use as a provider consensus key")
+	ErrInvalidMsgSetVSCSendingPaused           = errorsmod.Register(ModuleName, 63, "invalid set VSC sending paused message")
+	ErrStaleKeyAssignmentNonce                 = errorsmod.Register(ModuleName, 64, "stale key assignment nonce")
+	ErrInvalidMsgRemoveConsumers               = errorsmod.Register(ModuleName, 65, "invalid remove consumers message")
+	ErrInvalidConsumerInitialHeight            = errorsmod.Register(ModuleName, 66, "invalid consumer initial height")
+	ErrInvalidMsgTransferConsumerOwnership     = errorsmod.Register(ModuleName, 67, "invalid transfer consumer ownership message")
+	ErrInvalidMsgSetConsumerSlashMode          = errorsmod.Register(ModuleName, 68, "invalid set consumer slash mode message")
+	ErrUnknownSlashPacketValidator             = errorsmod.Register(ModuleName, 69, "slash packet for a validator that does not exist")
+	ErrInvalidMsgCancelPendingDowntimeSlash    = errorsmod.Register(ModuleName, 70, "invalid cancel pending downtime slash message")
+	ErrNoPendingDowntimeSlash                  = errorsmod.Register(ModuleName, 71, "no pending downtime slash")
 )
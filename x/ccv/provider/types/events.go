@@ -12,8 +12,23 @@ const (
 	EventTypeCreateConsumer            = "create_consumer"
 	EventTypeUpdateConsumer            = "update_consumer"
 	EventTypeRemoveConsumer            = "remove_consumer"
+	EventTypeResumeConsumer            = "resume_consumer"
+	EventTypeSetSlashPacketsPaused     = "set_slash_packets_paused"
+	EventTypeSetVSCSendingPaused       = "set_vsc_sending_paused"
+	EventTypeUnassignConsumerKey       = "unassign_consumer_key"
+	EventTypeResendConsumerValSet      = "resend_consumer_validator_set"
 	EventTypeReceivedRewards           = "received_ics_rewards"
 	EventTypeDistributedRewards        = "distributed_ics_rewards"
+	EventTypeUpcomingKeyPrune          = "upcoming_consumer_key_prune"
+	EventTypeSkipMalformedConsumerKey  = "skip_malformed_consumer_key"
+	EventTypeSkipVSCChannelNotOpen     = "skip_vsc_packets_channel_not_open"
+	EventTypeConsumerLaunched          = "consumer_launched"
+	EventTypeConsumerLaunchRejected    = "consumer_launch_rejected"
+	EventTypeTransferConsumerOwnership = "transfer_consumer_ownership"
+	EventTypeSetConsumerSlashMode      = "set_consumer_slash_mode"
+	EventTypeRecordConsumerSlash       = "record_consumer_slash"
+	EventTypeUnknownSlashValidator     = "unknown_slash_packet_validator"
+	EventTypeCancelDowntimeSlash       = "cancel_pending_downtime_slash"
 
 	AttributeInfractionHeight          = "infraction_height"
 	AttributeInitialHeight             = "initial_height"
@@ -33,10 +48,18 @@ const (
 	AttributeConsumerSpawnTime         = "consumer_spawn_time"
 	AttributeConsumerPhase             = "consumer_phase"
 	AttributeConsumerTopN              = "consumer_topn"
+	AttributeSlashPacketsPaused        = "slash_packets_paused"
+	AttributeVSCSendingPaused          = "vsc_sending_paused"
+	AttributeChannelId                 = "channel_id"
+	AttributeChannelState              = "channel_state"
 	AttributeRewardDenom               = "reward_denom"
 	AttributeRewardAmount              = "reward_amount"
 	AttributeRewardDistribution        = "reward_distribution"
 	AttributeRewardTotal               = "total_rewards"
 	AttributeRewardDistributed         = "distributed_rewards"
 	AttributeRewardCommunityPool       = "community_pool_rewards"
+	AttributePruneTime                 = "prune_time"
+	AttributeConsumerAddresses         = "consumer_addresses"
+	AttributeRejectionReason           = "rejection_reason"
+	AttributeSlashMode                 = "slash_mode"
 )
@@ -3,12 +3,17 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -59,6 +64,10 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := validateChannelMappings(gs.ConsumerStates); err != nil {
+		return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+	}
+
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
@@ -70,6 +79,75 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if gs.SlashMeterState != nil {
+		if err := gs.SlashMeterState.Validate(); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	for _, h := range gs.KeyAssignmentHeights {
+		if err := h.Validate(); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	for _, n := range gs.KeyAssignmentNonces {
+		if err := n.Validate(); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	for _, r := range gs.JailingReasons {
+		if err := r.Validate(); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a key assignment height validation returning an error upon any failure.
+// It ensures that the consumer id is not blank, the provider address is valid
+// and the block height is not negative.
+func (h KeyAssignmentHeight) Validate() error {
+	if strings.TrimSpace(h.ConsumerId) == "" {
+		return errors.New("consumer id must not be blank")
+	}
+	if err := sdk.VerifyAddressFormat(h.ProviderAddr); err != nil {
+		return fmt.Errorf("invalid provider address: %s", h.ProviderAddr)
+	}
+	if h.Height < 0 {
+		return fmt.Errorf("key assignment height %d is negative", h.Height)
+	}
+	return nil
+}
+
+// Validate performs a key assignment nonce validation returning an error upon any failure.
+// It ensures that the validator address is valid and the nonce is positive.
+func (n KeyAssignmentNonce) Validate() error {
+	if err := sdk.VerifyAddressFormat(n.ValidatorAddr); err != nil {
+		return fmt.Errorf("invalid validator address: %s", n.ValidatorAddr)
+	}
+	if n.Nonce == 0 {
+		return errors.New("key assignment nonce must be positive")
+	}
+	return nil
+}
+
+// Validate performs a slash meter state validation returning an error upon any failure.
+// It ensures that the slash meter is within the range of valid slash meter values
+// and that the replenish time candidate is set. Note that whether the slash meter is
+// above its allowance can only be checked against the total voting power in InitGenesis.
+func (sms SlashMeterState) Validate() error {
+	if sms.Meter.IsNil() {
+		return errors.New("slash meter cannot be empty")
+	}
+	if sms.Meter.Abs().GT(math.NewInt(cmttypes.MaxTotalVotingPower)) {
+		return fmt.Errorf("slash meter %s is out of range [-%d, %d]", sms.Meter, cmttypes.MaxTotalVotingPower, cmttypes.MaxTotalVotingPower)
+	}
+	if sms.ReplenishTimeCandidate.IsZero() {
+		return errors.New("slash meter replenish time candidate cannot be empty")
+	}
 	return nil
 }
 
@@ -102,6 +180,60 @@ func (cs ConsumerState) Validate() error {
 		}
 	}
 
+	for _, pending := range cs.PendingDowntimeSlashes {
+		if err := pending.SlashPacketData.Validate(); err != nil {
+			return fmt.Errorf("invalid pending downtime slash: %w", err)
+		}
+		if pending.SlashPacketData.Infraction != stakingtypes.Infraction_INFRACTION_DOWNTIME {
+			return fmt.Errorf("invalid pending downtime slash: infraction type is %s", pending.SlashPacketData.Infraction)
+		}
+	}
+
+	if _, ok := ConsumerSlashMode_name[int32(cs.SlashMode)]; !ok {
+		return fmt.Errorf("invalid slash mode: %d", cs.SlashMode)
+	}
+
+	if cs.CcvTimeoutPeriod < 0 {
+		return fmt.Errorf("invalid ccv timeout period: %s cannot be negative", cs.CcvTimeoutPeriod)
+	}
+
+	if cs.SlashMeterState != nil {
+		if err := cs.SlashMeterState.Validate(); err != nil {
+			return fmt.Errorf("invalid consumer slash meter state: %w", err)
+		}
+	}
+
+	// the most recent phase transition leads to the current phase of the consumer chain
+	if n := len(cs.PhaseHistory); n > 0 && cs.PhaseHistory[n-1].NewPhase != cs.Phase {
+		return fmt.Errorf("invalid phase history: last phase transition is to phase %s, but the phase is %s",
+			cs.PhaseHistory[n-1].NewPhase, cs.Phase)
+	}
+
+	return nil
+}
+
+// validateChannelMappings checks that the mappings from consumer ids to channel ids
+// that InitGenesis derives from the consumer states are bidirectional, i.e., that every
+// consumer id is mapped to a single channel id and every channel id to a single consumer id.
+// Otherwise, one of the mappings would be overwritten and left without a matching reverse mapping.
+func validateChannelMappings(consumerStates []ConsumerState) error {
+	consumerIdToChannelId := map[string]string{}
+	channelIdToConsumerId := map[string]string{}
+	for _, cs := range consumerStates {
+		if cs.ChannelId == "" {
+			continue
+		}
+		if channelId, found := consumerIdToChannelId[cs.ChainId]; found && channelId != cs.ChannelId {
+			return fmt.Errorf("consumer id %s is mapped to channels %s and %s: channel %s has no matching reverse mapping",
+				cs.ChainId, channelId, cs.ChannelId, channelId)
+		}
+		if consumerId, found := channelIdToConsumerId[cs.ChannelId]; found && consumerId != cs.ChainId {
+			return fmt.Errorf("channel %s is mapped to consumer ids %s and %s: consumer id %s has no matching reverse mapping",
+				cs.ChannelId, consumerId, cs.ChainId, consumerId)
+		}
+		consumerIdToChannelId[cs.ChainId] = cs.ChannelId
+		channelIdToConsumerId[cs.ChannelId] = cs.ChainId
+	}
 	return nil
 }
 
@@ -113,3 +245,15 @@ func validateSlashAcksAddress(acks []string) error {
 	}
 	return nil
 }
+
+// Validate performs a jailing reason validation returning an error upon any failure.
+// It ensures that the provider address is valid and the consumer id is not blank.
+func (r ValidatorJailingReason) Validate() error {
+	if err := sdk.VerifyAddressFormat(r.ProviderAddr); err != nil {
+		return fmt.Errorf("invalid provider address: %s", r.ProviderAddr)
+	}
+	if strings.TrimSpace(r.Reason.ConsumerId) == "" {
+		return errors.New("consumer id must not be blank")
+	}
+	return nil
+}
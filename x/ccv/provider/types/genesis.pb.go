@@ -4,19 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +46,15 @@ type GenesisState struct {
 	ValidatorsByConsumerAddr []ValidatorByConsumerAddr `protobuf:"bytes,10,rep,name=validators_by_consumer_addr,json=validatorsByConsumerAddr,proto3" json:"validators_by_consumer_addr"`
 	// empty for a new chain
 	ConsumerAddrsToPruneV2 []ConsumerAddrsToPruneV2 `protobuf:"bytes,14,rep,name=consumer_addrs_to_prune_v2,json=consumerAddrsToPruneV2,proto3" json:"consumer_addrs_to_prune_v2"`
+	// empty for a new chain, in which case the slash meter is initialized
+	// to its allowance
+	SlashMeterState *SlashMeterState `protobuf:"bytes,15,opt,name=slash_meter_state,json=slashMeterState,proto3" json:"slash_meter_state,omitempty"`
+	// empty for a new chain
+	KeyAssignmentHeights []KeyAssignmentHeight `protobuf:"bytes,16,rep,name=key_assignment_heights,json=keyAssignmentHeights,proto3" json:"key_assignment_heights"`
+	// empty for a new chain
+	KeyAssignmentNonces []KeyAssignmentNonce `protobuf:"bytes,17,rep,name=key_assignment_nonces,json=keyAssignmentNonces,proto3" json:"key_assignment_nonces"`
+	// empty for a new chain
+	JailingReasons []ValidatorJailingReason `protobuf:"bytes,18,rep,name=jailing_reasons,json=jailingReasons,proto3" json:"jailing_reasons"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +139,34 @@ func (m *GenesisState) GetConsumerAddrsToPruneV2() []ConsumerAddrsToPruneV2 {
 	return nil
 }
 
+func (m *GenesisState) GetSlashMeterState() *SlashMeterState {
+	if m != nil {
+		return m.SlashMeterState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyAssignmentHeights() []KeyAssignmentHeight {
+	if m != nil {
+		return m.KeyAssignmentHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyAssignmentNonces() []KeyAssignmentNonce {
+	if m != nil {
+		return m.KeyAssignmentNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJailingReasons() []ValidatorJailingReason {
+	if m != nil {
+		return m.JailingReasons
+	}
+	return nil
+}
+
 // The provider CCV module's knowledge of consumer state.
 //
 // Note this type is only used internally to the provider CCV module.
@@ -143,6 +187,23 @@ type ConsumerState struct {
 	SlashDowntimeAck     []string                             `protobuf:"bytes,7,rep,name=slash_downtime_ack,json=slashDowntimeAck,proto3" json:"slash_downtime_ack,omitempty"`
 	// the phase of the consumer chain
 	Phase ConsumerPhase `protobuf:"varint,9,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	// the downtime slash packets whose handling is deferred
+	// by the downtime slash grace period
+	PendingDowntimeSlashes []PendingDowntimeSlash `protobuf:"bytes,10,rep,name=pending_downtime_slashes,json=pendingDowntimeSlashes,proto3" json:"pending_downtime_slashes"`
+	// whether the sending of VSC packets to the consumer chain is paused
+	VscSendingPaused bool `protobuf:"varint,11,opt,name=vsc_sending_paused,json=vscSendingPaused,proto3" json:"vsc_sending_paused,omitempty"`
+	// whether the handling of the slash packets received from the consumer chain is paused
+	SlashPacketsPaused bool `protobuf:"varint,12,opt,name=slash_packets_paused,json=slashPacketsPaused,proto3" json:"slash_packets_paused,omitempty"`
+	// the most recent phase transitions of the consumer chain, ordered from the oldest to the newest
+	PhaseHistory []ConsumerPhaseTransition `protobuf:"bytes,13,rep,name=phase_history,json=phaseHistory,proto3" json:"phase_history"`
+	// the timeout period of the CCV packets sent to the consumer chain overriding
+	// the `ccv_timeout_period` provider param, zero if it is not overridden
+	CcvTimeoutPeriod time.Duration `protobuf:"bytes,14,opt,name=ccv_timeout_period,json=ccvTimeoutPeriod,proto3,stdduration" json:"ccv_timeout_period"`
+	// the slash meter of the consumer chain, used if per consumer slash meters
+	// are enabled; empty if the slash meter of the consumer chain is not set
+	SlashMeterState *SlashMeterState `protobuf:"bytes,15,opt,name=slash_meter_state,json=slashMeterState,proto3" json:"slash_meter_state,omitempty"`
+	// how the downtime slash packets received from the consumer chain are handled
+	SlashMode ConsumerSlashMode `protobuf:"varint,16,opt,name=slash_mode,json=slashMode,proto3,enum=interchain_security.ccv.provider.v1.ConsumerSlashMode" json:"slash_mode,omitempty"`
 }
 
 func (m *ConsumerState) Reset()         { *m = ConsumerState{} }
@@ -234,6 +295,55 @@ func (m *ConsumerState) GetPhase() ConsumerPhase {
 	return CONSUMER_PHASE_UNSPECIFIED
 }
 
+func (m *ConsumerState) GetPendingDowntimeSlashes() []PendingDowntimeSlash {
+	if m != nil {
+		return m.PendingDowntimeSlashes
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetVscSendingPaused() bool {
+	if m != nil {
+		return m.VscSendingPaused
+	}
+	return false
+}
+
+func (m *ConsumerState) GetSlashPacketsPaused() bool {
+	if m != nil {
+		return m.SlashPacketsPaused
+	}
+	return false
+}
+
+func (m *ConsumerState) GetPhaseHistory() []ConsumerPhaseTransition {
+	if m != nil {
+		return m.PhaseHistory
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetCcvTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.CcvTimeoutPeriod
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetSlashMeterState() *SlashMeterState {
+	if m != nil {
+		return m.SlashMeterState
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetSlashMode() ConsumerSlashMode {
+	if m != nil {
+		return m.SlashMode
+	}
+	return SLASH_MODE_JAIL
+}
+
 // ValsetUpdateIdToHeight defines the genesis information for the mapping
 // of each valset update id to a block height
 type ValsetUpdateIdToHeight struct {
@@ -288,10 +398,233 @@ func (m *ValsetUpdateIdToHeight) GetHeight() uint64 {
 	return 0
 }
 
+// SlashMeterState defines the genesis information for the state
+// of the slash meter used to throttle the jailing of validators
+type SlashMeterState struct {
+	// the value of the slash meter, which can be negative
+	Meter cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=meter,proto3,customtype=cosmossdk.io/math.Int" json:"meter"`
+	// the next time the slash meter may be replenished
+	ReplenishTimeCandidate time.Time `protobuf:"bytes,2,opt,name=replenish_time_candidate,json=replenishTimeCandidate,proto3,stdtime" json:"replenish_time_candidate"`
+}
+
+func (m *SlashMeterState) Reset()         { *m = SlashMeterState{} }
+func (m *SlashMeterState) String() string { return proto.CompactTextString(m) }
+func (*SlashMeterState) ProtoMessage()    {}
+func (*SlashMeterState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{3}
+}
+func (m *SlashMeterState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashMeterState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashMeterState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashMeterState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashMeterState.Merge(m, src)
+}
+func (m *SlashMeterState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashMeterState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashMeterState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashMeterState proto.InternalMessageInfo
+
+func (m *SlashMeterState) GetReplenishTimeCandidate() time.Time {
+	if m != nil {
+		return m.ReplenishTimeCandidate
+	}
+	return time.Time{}
+}
+
+// KeyAssignmentHeight defines the genesis information for the block height
+// of the last key assignment of a validator on a consumer chain
+type KeyAssignmentHeight struct {
+	ConsumerId   string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ProviderAddr []byte `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Height       int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *KeyAssignmentHeight) Reset()         { *m = KeyAssignmentHeight{} }
+func (m *KeyAssignmentHeight) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentHeight) ProtoMessage()    {}
+func (*KeyAssignmentHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{4}
+}
+func (m *KeyAssignmentHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyAssignmentHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyAssignmentHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyAssignmentHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentHeight.Merge(m, src)
+}
+func (m *KeyAssignmentHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyAssignmentHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyAssignmentHeight proto.InternalMessageInfo
+
+func (m *KeyAssignmentHeight) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *KeyAssignmentHeight) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
+	}
+	return nil
+}
+
+func (m *KeyAssignmentHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// KeyAssignmentNonce defines the genesis information for the last nonce
+// of the key assignments processed for a validator
+type KeyAssignmentNonce struct {
+	ValidatorAddr []byte `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Nonce         uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *KeyAssignmentNonce) Reset()         { *m = KeyAssignmentNonce{} }
+func (m *KeyAssignmentNonce) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentNonce) ProtoMessage()    {}
+func (*KeyAssignmentNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{5}
+}
+func (m *KeyAssignmentNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyAssignmentNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyAssignmentNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyAssignmentNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentNonce.Merge(m, src)
+}
+func (m *KeyAssignmentNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyAssignmentNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyAssignmentNonce proto.InternalMessageInfo
+
+func (m *KeyAssignmentNonce) GetValidatorAddr() []byte {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return nil
+}
+
+func (m *KeyAssignmentNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// ValidatorJailingReason defines the genesis information for the reason
+// for which a validator was jailed because of a consumer slash packet
+type ValidatorJailingReason struct {
+	ProviderAddr []byte        `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Reason       JailingReason `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+}
+
+func (m *ValidatorJailingReason) Reset()         { *m = ValidatorJailingReason{} }
+func (m *ValidatorJailingReason) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJailingReason) ProtoMessage()    {}
+func (*ValidatorJailingReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{6}
+}
+func (m *ValidatorJailingReason) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorJailingReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorJailingReason.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorJailingReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJailingReason.Merge(m, src)
+}
+func (m *ValidatorJailingReason) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorJailingReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJailingReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorJailingReason proto.InternalMessageInfo
+
+func (m *ValidatorJailingReason) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
+	}
+	return nil
+}
+
+func (m *ValidatorJailingReason) GetReason() JailingReason {
+	if m != nil {
+		return m.Reason
+	}
+	return JailingReason{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "interchain_security.ccv.provider.v1.GenesisState")
 	proto.RegisterType((*ConsumerState)(nil), "interchain_security.ccv.provider.v1.ConsumerState")
 	proto.RegisterType((*ValsetUpdateIdToHeight)(nil), "interchain_security.ccv.provider.v1.ValsetUpdateIdToHeight")
+	proto.RegisterType((*SlashMeterState)(nil), "interchain_security.ccv.provider.v1.SlashMeterState")
+	proto.RegisterType((*KeyAssignmentHeight)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentHeight")
+	proto.RegisterType((*KeyAssignmentNonce)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentNonce")
+	proto.RegisterType((*ValidatorJailingReason)(nil), "interchain_security.ccv.provider.v1.ValidatorJailingReason")
 }
 
 func init() {
@@ -299,54 +632,87 @@ func init() {
 }
 
 var fileDescriptor_48411d9c7900d48e = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
-	0x14, 0xc6, 0xc1, 0x80, 0x99, 0x04, 0xae, 0x65, 0x45, 0xc8, 0x37, 0xd1, 0x25, 0x88, 0xab, 0x48,
-	0x48, 0xf7, 0x5e, 0x1c, 0xb8, 0x8b, 0x56, 0xfd, 0x59, 0x84, 0x44, 0x6a, 0x70, 0x37, 0x88, 0xa4,
-	0xa9, 0x94, 0x8d, 0x35, 0xcc, 0x8c, 0xf0, 0x08, 0xb0, 0x2d, 0xcf, 0xe0, 0x14, 0x55, 0x95, 0xda,
-	0x37, 0xe8, 0x9b, 0xf4, 0x35, 0xb2, 0xcc, 0xb2, 0xab, 0xa8, 0x4a, 0xfa, 0x04, 0x7d, 0x82, 0xca,
-	0xe3, 0x81, 0x40, 0x4a, 0x22, 0xd2, 0x9d, 0x7d, 0xbe, 0xf9, 0xce, 0xf9, 0xce, 0x2f, 0x68, 0x50,
-	0x8f, 0x93, 0x10, 0xb9, 0x90, 0x7a, 0x0e, 0x23, 0x68, 0x1c, 0x52, 0x3e, 0xb1, 0x10, 0x8a, 0xac,
-	0x20, 0xf4, 0x23, 0x8a, 0x49, 0x68, 0x45, 0x0d, 0xab, 0x4f, 0x3c, 0xc2, 0x28, 0xab, 0x07, 0xa1,
-	0xcf, 0x7d, 0xe3, 0xef, 0x25, 0x94, 0x3a, 0x42, 0x51, 0x7d, 0x4a, 0xa9, 0x47, 0x8d, 0xad, 0xcd,
-	0xbe, 0xdf, 0xf7, 0xc5, 0x7b, 0x2b, 0xfe, 0x4a, 0xa8, 0x5b, 0x7b, 0xf7, 0x45, 0x8b, 0x1a, 0x16,
-	0x73, 0x61, 0x48, 0xb0, 0x83, 0x7c, 0x8f, 0x8d, 0x47, 0x24, 0x94, 0x8c, 0xdd, 0x07, 0x18, 0xe7,
-	0x34, 0x24, 0xf2, 0x59, 0x73, 0x95, 0x34, 0x66, 0xfa, 0x04, 0xa7, 0xfa, 0x25, 0x0b, 0x36, 0x5e,
-	0x25, 0x99, 0x1d, 0x73, 0xc8, 0x89, 0x51, 0x03, 0x7a, 0x04, 0x87, 0x8c, 0x70, 0x67, 0x1c, 0x60,
-	0xc8, 0x89, 0x43, 0xb1, 0xa9, 0x54, 0x94, 0x9a, 0xda, 0x2d, 0x26, 0xf6, 0x37, 0xc2, 0xdc, 0xc6,
-	0xc6, 0x7b, 0xf0, 0xc7, 0x54, 0xa7, 0xc3, 0x62, 0x2e, 0x33, 0xd7, 0x2a, 0xe9, 0xda, 0x7a, 0xb3,
-	0x59, 0x5f, 0xa1, 0x38, 0xf5, 0x03, 0xc9, 0x15, 0x61, 0x5b, 0xe5, 0x8b, 0xab, 0x9d, 0xd4, 0x8f,
-	0xab, 0x9d, 0xd2, 0x04, 0x8e, 0x86, 0xcf, 0xaa, 0x77, 0x1c, 0x57, 0xbb, 0x45, 0x34, 0xff, 0x9c,
-	0x19, 0x1f, 0xc0, 0xd6, 0x5d, 0x99, 0x0e, 0xf7, 0x1d, 0x97, 0xd0, 0xbe, 0xcb, 0xcd, 0x8c, 0xd0,
-	0xf1, 0x7c, 0x25, 0x1d, 0xa7, 0x0b, 0x59, 0x9d, 0xf8, 0x47, 0xc2, 0x45, 0x4b, 0x8d, 0x05, 0x75,
-	0x4b, 0xd1, 0x52, 0xd4, 0x68, 0x83, 0x6c, 0x00, 0x43, 0x38, 0x62, 0xa6, 0x56, 0x51, 0x6a, 0xeb,
-	0xcd, 0x7f, 0x56, 0x0a, 0xd5, 0x11, 0x14, 0xe9, 0x5a, 0x3a, 0x30, 0x3e, 0x2a, 0x22, 0x15, 0x8a,
-	0x21, 0xf7, 0xc3, 0x59, 0xe7, 0x9d, 0x60, 0xdc, 0x1b, 0x90, 0x09, 0x33, 0xf3, 0x22, 0x95, 0x17,
-	0xab, 0xa6, 0x92, 0xb8, 0x99, 0xd6, 0xb6, 0x33, 0xee, 0xbd, 0x26, 0x13, 0x19, 0xd0, 0x8c, 0x96,
-	0xc0, 0x71, 0x0c, 0xe3, 0x93, 0x02, 0xb6, 0x67, 0x20, 0x73, 0x7a, 0x93, 0x5b, 0x19, 0x10, 0xe3,
-	0xd0, 0x04, 0xbf, 0xa3, 0xa1, 0x35, 0x99, 0x86, 0xd9, 0xc7, 0x38, 0xfc, 0x45, 0x03, 0x5b, 0xc4,
-	0xe3, 0x86, 0x2e, 0x04, 0x65, 0x71, 0x3b, 0x83, 0x70, 0xec, 0x11, 0x27, 0x6a, 0x9a, 0xc5, 0x47,
-	0x34, 0x74, 0xde, 0x2d, 0x3b, 0xf1, 0x3b, 0xb1, 0x8f, 0xd3, 0xe6, 0xb4, 0xa1, 0x68, 0x29, 0x6a,
-	0xab, 0x5a, 0x5a, 0x57, 0x6d, 0x55, 0x53, 0xf5, 0x8c, 0xad, 0x6a, 0x59, 0x3d, 0x67, 0xab, 0x5a,
-	0x4e, 0xd7, 0x6c, 0x55, 0x5b, 0xd7, 0x37, 0x6c, 0x55, 0xdb, 0xd0, 0x0b, 0xb6, 0xaa, 0x15, 0xf4,
-	0x62, 0xf5, 0x7b, 0x1a, 0x14, 0x16, 0x66, 0xd7, 0xf8, 0x13, 0x68, 0x89, 0x24, 0xb9, 0x2a, 0xf9,
-	0x6e, 0x4e, 0xfc, 0xb7, 0xb1, 0xf1, 0x17, 0x00, 0xc8, 0x85, 0x9e, 0x47, 0x86, 0x31, 0xb8, 0x26,
-	0xc0, 0xbc, 0xb4, 0xb4, 0xb1, 0xb1, 0x0d, 0xf2, 0x68, 0x48, 0x89, 0xc7, 0x63, 0x34, 0x2d, 0x50,
-	0x2d, 0x31, 0xb4, 0xb1, 0xb1, 0x0b, 0x8a, 0xd4, 0xa3, 0x9c, 0xc2, 0xe1, 0x74, 0xac, 0x55, 0xb1,
-	0x87, 0x05, 0x69, 0x95, 0xa3, 0x08, 0x81, 0x3e, 0x2b, 0x9c, 0xbc, 0x51, 0x66, 0x46, 0x0c, 0xe5,
-	0xde, 0xbd, 0xe5, 0x9a, 0xab, 0xd2, 0xfc, 0xf2, 0xcb, 0x1a, 0xcd, 0xd6, 0x5a, 0x62, 0x06, 0x07,
-	0xa5, 0x80, 0x78, 0x98, 0x7a, 0x7d, 0x47, 0x2e, 0x5d, 0x9c, 0x42, 0x9f, 0x30, 0x33, 0x2b, 0xfa,
-	0xf2, 0xf4, 0xa1, 0x40, 0xb3, 0x81, 0x38, 0x26, 0xfc, 0x40, 0xd0, 0x3a, 0x10, 0x0d, 0x08, 0x3f,
-	0x84, 0x1c, 0xca, 0x80, 0x9b, 0xd2, 0x7b, 0xb2, 0x8a, 0xc9, 0x23, 0x66, 0xfc, 0x0b, 0x0c, 0x36,
-	0x84, 0xcc, 0x75, 0xb0, 0x7f, 0xee, 0x71, 0x3a, 0x22, 0x0e, 0x44, 0x03, 0x33, 0x57, 0x49, 0xd7,
-	0xf2, 0x5d, 0x5d, 0x20, 0x87, 0x12, 0xd8, 0x47, 0x03, 0xe3, 0x08, 0x64, 0x02, 0x17, 0x32, 0x62,
-	0xe6, 0x2b, 0x4a, 0xad, 0xf8, 0xc8, 0x1b, 0xd4, 0x89, 0x99, 0xdd, 0xc4, 0x81, 0xad, 0x6a, 0x9a,
-	0x9e, 0xaf, 0x9e, 0x81, 0xd2, 0xf2, 0xcb, 0xf0, 0x88, 0x0b, 0x59, 0x02, 0x59, 0xd9, 0xb9, 0x35,
-	0x81, 0xcb, 0xbf, 0xd6, 0xdb, 0x8b, 0xeb, 0xb2, 0x72, 0x79, 0x5d, 0x56, 0xbe, 0x5d, 0x97, 0x95,
-	0xcf, 0x37, 0xe5, 0xd4, 0xe5, 0x4d, 0x39, 0xf5, 0xf5, 0xa6, 0x9c, 0x3a, 0x7b, 0xd9, 0xa7, 0xdc,
-	0x1d, 0xf7, 0xea, 0xc8, 0x1f, 0x59, 0xc8, 0x67, 0x23, 0x9f, 0x59, 0xb7, 0x79, 0xfc, 0x37, 0x3b,
-	0xea, 0xd1, 0x13, 0xeb, 0xdd, 0xe2, 0x65, 0xe7, 0x93, 0x80, 0xb0, 0x5e, 0x56, 0x1c, 0xf5, 0xff,
-	0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xd9, 0x94, 0x40, 0xd1, 0x06, 0x00, 0x00,
+	// 1278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x9b, 0x64, 0x3d, 0xb1, 0x9d, 0xed, 0x34, 0x8d, 0xb6, 0xa9, 0x70, 0x22, 0x57,
+	0x95, 0x2c, 0x95, 0xda, 0x8d, 0x41, 0x94, 0xdf, 0x8b, 0xa4, 0x95, 0xa8, 0x5d, 0x81, 0xdc, 0x4d,
+	0x5a, 0xa4, 0x5e, 0xb0, 0x4c, 0x66, 0x07, 0x7b, 0x6a, 0x7b, 0x67, 0xd9, 0x19, 0x6f, 0xb1, 0x10,
+	0x12, 0x3c, 0x01, 0xbd, 0xe4, 0x41, 0xfa, 0x10, 0xbd, 0xac, 0xb8, 0x42, 0x5c, 0x14, 0xd4, 0x48,
+	0x3c, 0x00, 0x4f, 0x80, 0xe6, 0x67, 0x37, 0x71, 0xec, 0x82, 0xcd, 0x05, 0x77, 0x9e, 0x73, 0xe6,
+	0x7c, 0xe7, 0x77, 0xbf, 0x39, 0x06, 0x7b, 0x34, 0x12, 0x24, 0xc1, 0x3d, 0x44, 0xa3, 0x80, 0x13,
+	0x3c, 0x4a, 0xa8, 0x18, 0x37, 0x30, 0x4e, 0x1b, 0x71, 0xc2, 0x52, 0x1a, 0x92, 0xa4, 0x91, 0xee,
+	0x35, 0xba, 0x24, 0x22, 0x9c, 0xf2, 0x7a, 0x9c, 0x30, 0xc1, 0xe0, 0xb5, 0x19, 0x26, 0x75, 0x8c,
+	0xd3, 0x7a, 0x66, 0x52, 0x4f, 0xf7, 0xb6, 0xaf, 0x60, 0xc6, 0x87, 0x8c, 0x07, 0xca, 0xa4, 0xa1,
+	0x0f, 0xda, 0x7e, 0x7b, 0xb3, 0xcb, 0xba, 0x4c, 0xcb, 0xe5, 0x2f, 0x23, 0xdd, 0xe9, 0x32, 0xd6,
+	0x1d, 0x90, 0x86, 0x3a, 0x1d, 0x8f, 0xbe, 0x6e, 0x08, 0x3a, 0x24, 0x5c, 0xa0, 0x61, 0x6c, 0x2e,
+	0xdc, 0x7a, 0x53, 0xa4, 0xe9, 0x5e, 0x83, 0xf7, 0x50, 0x42, 0xc2, 0x00, 0xb3, 0x88, 0x8f, 0x86,
+	0x24, 0x31, 0x16, 0xd7, 0xff, 0xc1, 0xe2, 0x29, 0x4d, 0x88, 0xb9, 0xd6, 0x9c, 0xa7, 0x04, 0x79,
+	0x6e, 0xda, 0xa6, 0x72, 0x3e, 0xda, 0x70, 0x94, 0x20, 0x41, 0x59, 0xa4, 0xf5, 0xd5, 0x3f, 0x0b,
+	0xa0, 0xf8, 0xa9, 0xae, 0xda, 0xa1, 0x40, 0x82, 0xc0, 0x1a, 0x70, 0x53, 0x34, 0xe0, 0x44, 0x04,
+	0xa3, 0x38, 0x44, 0x82, 0x04, 0x34, 0xf4, 0xac, 0x5d, 0xab, 0x66, 0xfb, 0x65, 0x2d, 0x7f, 0xa8,
+	0xc4, 0xad, 0x10, 0x7e, 0x07, 0x36, 0xb2, 0x3c, 0x02, 0x2e, 0x6d, 0xb9, 0x77, 0x61, 0x77, 0xb9,
+	0xb6, 0xde, 0x6c, 0xd6, 0xe7, 0x28, 0x7c, 0xfd, 0x8e, 0xb1, 0x55, 0x6e, 0x0f, 0x2a, 0x2f, 0x5e,
+	0xed, 0x2c, 0xfd, 0xf5, 0x6a, 0x67, 0x6b, 0x8c, 0x86, 0x83, 0x0f, 0xab, 0xe7, 0x80, 0xab, 0x7e,
+	0x19, 0x9f, 0xbd, 0xce, 0xe1, 0xf7, 0x60, 0xfb, 0x7c, 0x98, 0x81, 0x60, 0x41, 0x8f, 0xd0, 0x6e,
+	0x4f, 0x78, 0x2b, 0x2a, 0x8e, 0x8f, 0xe6, 0x8a, 0xe3, 0xd1, 0x44, 0x56, 0x47, 0xec, 0x9e, 0x82,
+	0x38, 0xb0, 0x65, 0x40, 0xfe, 0x56, 0x3a, 0x53, 0x0b, 0x5b, 0x60, 0x35, 0x46, 0x09, 0x1a, 0x72,
+	0xcf, 0xd9, 0xb5, 0x6a, 0xeb, 0xcd, 0x1b, 0x73, 0xb9, 0xea, 0x28, 0x13, 0x03, 0x6d, 0x00, 0xe0,
+	0x0f, 0x96, 0x4a, 0x85, 0x86, 0x48, 0xb0, 0x24, 0x9f, 0x8c, 0x20, 0x1e, 0x1d, 0xf7, 0xc9, 0x98,
+	0x7b, 0x05, 0x95, 0xca, 0xc7, 0xf3, 0xa6, 0xa2, 0x61, 0xb2, 0xda, 0x76, 0x46, 0xc7, 0xf7, 0xc9,
+	0xd8, 0x38, 0xf4, 0xd2, 0x19, 0x6a, 0xe9, 0x03, 0xfe, 0x68, 0x81, 0xab, 0xb9, 0x92, 0x07, 0xc7,
+	0xe3, 0xd3, 0x30, 0x50, 0x18, 0x26, 0x1e, 0xf8, 0x2f, 0x31, 0x1c, 0x8c, 0x33, 0x37, 0xfb, 0x61,
+	0x98, 0x4c, 0xc5, 0xc0, 0x27, 0xf5, 0xb2, 0xa1, 0x13, 0x4e, 0xb9, 0x6c, 0x67, 0x9c, 0x8c, 0x22,
+	0x12, 0xa4, 0x4d, 0xaf, 0xbc, 0x40, 0x43, 0xcf, 0xc2, 0xf2, 0x23, 0xd6, 0x91, 0x18, 0x8f, 0x9a,
+	0x59, 0x43, 0xf1, 0x4c, 0x2d, 0xfc, 0x0a, 0x5c, 0xe4, 0x03, 0xc4, 0x7b, 0xc1, 0x90, 0x88, 0x6c,
+	0xec, 0xbc, 0x0d, 0xd5, 0xdb, 0x77, 0xe7, 0xf2, 0x7a, 0x28, 0xad, 0x3f, 0x23, 0xc2, 0x4c, 0xa8,
+	0xbf, 0xc1, 0x27, 0x05, 0x50, 0x80, 0xad, 0x3e, 0x19, 0x07, 0x88, 0x73, 0xda, 0x8d, 0x86, 0x24,
+	0x12, 0x66, 0x58, 0xb9, 0xe7, 0xaa, 0xe4, 0xde, 0x9f, 0xcb, 0xcd, 0x7d, 0x32, 0xde, 0xcf, 0x11,
+	0x26, 0x46, 0x75, 0xb3, 0x3f, 0xad, 0xe2, 0xf0, 0x1b, 0x70, 0xf9, 0x9c, 0xd7, 0x88, 0x45, 0x98,
+	0x70, 0xef, 0xa2, 0x72, 0x7a, 0x7b, 0x71, 0xa7, 0x9f, 0x4b, 0x7b, 0xe3, 0xf3, 0x52, 0x7f, 0x4a,
+	0xc3, 0xe1, 0x13, 0xb0, 0xf1, 0x04, 0xd1, 0x01, 0x8d, 0xba, 0x41, 0x42, 0x10, 0x67, 0x11, 0xf7,
+	0xe0, 0x62, 0xdf, 0xa3, 0x9e, 0x90, 0xb6, 0x06, 0xf1, 0x15, 0x86, 0x71, 0x58, 0x7e, 0x72, 0x56,
+	0xc8, 0xdb, 0xb6, 0xb3, 0xec, 0xda, 0x6d, 0xdb, 0xb1, 0xdd, 0x95, 0xb6, 0xed, 0xac, 0xba, 0x6b,
+	0x6d, 0xdb, 0x59, 0x73, 0x9d, 0xb6, 0xed, 0xac, 0xbb, 0xc5, 0xb6, 0xed, 0x14, 0xdd, 0x52, 0xdb,
+	0x76, 0x4a, 0x6e, 0xb9, 0xfa, 0xdc, 0x01, 0xa5, 0x09, 0xca, 0x81, 0x57, 0x80, 0xa3, 0x43, 0x31,
+	0x0c, 0x57, 0xf0, 0xd7, 0xd4, 0xb9, 0x15, 0xc2, 0xb7, 0x00, 0xc0, 0x3d, 0x14, 0x45, 0x64, 0x20,
+	0x95, 0x17, 0x94, 0xb2, 0x60, 0x24, 0xad, 0x10, 0x5e, 0x05, 0x05, 0x3c, 0xa0, 0xb2, 0x98, 0x34,
+	0xf4, 0x96, 0x95, 0xd6, 0xd1, 0x82, 0x56, 0x08, 0xaf, 0x83, 0x32, 0x8d, 0xa8, 0xa0, 0x68, 0x90,
+	0xb1, 0x91, 0xad, 0xe8, 0xb3, 0x64, 0xa4, 0x86, 0x41, 0x10, 0x70, 0xf3, 0x79, 0x37, 0xcf, 0x96,
+	0xb7, 0xa2, 0xe6, 0xed, 0xd6, 0x1b, 0xcb, 0x74, 0x66, 0xb8, 0xcf, 0x72, 0xb6, 0xa9, 0xcd, 0x06,
+	0x9e, 0xd4, 0xc9, 0x89, 0x8b, 0x49, 0x14, 0xca, 0x46, 0x18, 0xae, 0x94, 0x29, 0x74, 0x09, 0xf7,
+	0x56, 0xff, 0x65, 0xe2, 0xce, 0xb6, 0xe1, 0x90, 0x88, 0x3b, 0xca, 0xac, 0x83, 0x70, 0x9f, 0x88,
+	0xbb, 0x48, 0xa0, 0x6c, 0xe2, 0x0c, 0xba, 0x66, 0x50, 0x7d, 0x89, 0xc3, 0xb7, 0x01, 0xd4, 0x5f,
+	0x52, 0xc8, 0x9e, 0x46, 0xf2, 0x6d, 0x0c, 0x10, 0xee, 0x7b, 0x6b, 0xbb, 0xcb, 0xb5, 0x82, 0xef,
+	0x2a, 0xcd, 0x5d, 0xa3, 0xd8, 0xc7, 0x7d, 0x78, 0x0f, 0xac, 0xc4, 0x3d, 0xc4, 0x89, 0x57, 0xd8,
+	0xb5, 0x6a, 0xe5, 0x05, 0x9f, 0x8e, 0x8e, 0xb4, 0xf4, 0x35, 0x00, 0x1c, 0x03, 0x2f, 0xcb, 0x36,
+	0xf7, 0xac, 0xdc, 0x11, 0x6e, 0x08, 0xec, 0x83, 0xf9, 0x48, 0x5a, 0x83, 0x64, 0x41, 0xaa, 0xef,
+	0x3a, 0x23, 0x8f, 0x78, 0x86, 0x4e, 0xa7, 0x9c, 0x72, 0x1c, 0x70, 0xe3, 0x3e, 0x46, 0x23, 0x4e,
+	0x42, 0x6f, 0x7d, 0xd7, 0xaa, 0x39, 0xbe, 0x9b, 0x72, 0x7c, 0xa8, 0x15, 0x1d, 0x25, 0x87, 0xb7,
+	0xc0, 0xa6, 0x2e, 0x50, 0xac, 0x0a, 0xca, 0xb3, 0xfb, 0x45, 0x75, 0x5f, 0x17, 0x4f, 0xd7, 0x9a,
+	0x1b, 0x8b, 0x2e, 0x28, 0xa9, 0x1c, 0x83, 0x1e, 0xe5, 0x82, 0x25, 0x63, 0xaf, 0xb4, 0x00, 0x21,
+	0x4f, 0x14, 0xeb, 0x28, 0x41, 0x11, 0xa7, 0x82, 0xe6, 0x1f, 0x54, 0x51, 0x01, 0xdf, 0xd3, 0xb8,
+	0xf0, 0x01, 0x80, 0x18, 0xa7, 0x81, 0xcc, 0x8d, 0x8d, 0x44, 0x10, 0x93, 0x84, 0xb2, 0xd0, 0x2b,
+	0xab, 0xb1, 0xbc, 0x52, 0xd7, 0xab, 0x44, 0x3d, 0x5b, 0x25, 0xea, 0x77, 0xcd, 0x2a, 0x71, 0xe0,
+	0x48, 0xa8, 0x9f, 0x7f, 0xdf, 0xb1, 0x7c, 0x17, 0xe3, 0xf4, 0x48, 0x5b, 0x77, 0x94, 0xf1, 0xff,
+	0x40, 0xac, 0x0f, 0x01, 0x30, 0x1e, 0x58, 0x48, 0x3c, 0x57, 0xcd, 0xd1, 0x7b, 0x8b, 0xad, 0x20,
+	0x0a, 0x91, 0x85, 0xc4, 0x2f, 0xf0, 0xec, 0x67, 0xdb, 0x76, 0x1c, 0xb7, 0x50, 0x7d, 0x0c, 0xb6,
+	0x66, 0x2f, 0x08, 0x0b, 0x2c, 0x4a, 0x5b, 0x60, 0xd5, 0x30, 0xc1, 0x05, 0xa5, 0x37, 0xa7, 0xea,
+	0x73, 0x0b, 0x6c, 0x9c, 0xcb, 0x0e, 0xee, 0x83, 0x15, 0x55, 0x28, 0xcd, 0x48, 0x07, 0x37, 0x64,
+	0x65, 0x7f, 0x7b, 0xb5, 0x73, 0x59, 0x2f, 0xa6, 0x3c, 0xec, 0xd7, 0x29, 0x6b, 0x0c, 0x91, 0xe8,
+	0xd5, 0x5b, 0x91, 0xf8, 0xe5, 0xf9, 0x4d, 0xa0, 0x15, 0xf2, 0xe4, 0x6b, 0x4b, 0xf8, 0x25, 0xf0,
+	0x12, 0x12, 0x0f, 0x48, 0x44, 0x79, 0x4f, 0xb5, 0x32, 0xc0, 0x28, 0x0a, 0xe5, 0xc7, 0x4c, 0x54,
+	0x00, 0xeb, 0xcd, 0xed, 0xa9, 0x56, 0x1e, 0x65, 0x3b, 0xac, 0xee, 0xe5, 0x33, 0xd9, 0xcb, 0xad,
+	0x1c, 0x45, 0x6a, 0xef, 0x64, 0x18, 0x55, 0x0e, 0x2e, 0xcd, 0x78, 0x85, 0xe0, 0x0e, 0x58, 0xcf,
+	0x09, 0x2d, 0x67, 0x54, 0x90, 0x89, 0x5a, 0x21, 0xbc, 0x06, 0x4a, 0x59, 0xf1, 0xf5, 0x5a, 0x21,
+	0x83, 0x29, 0xfa, 0xc5, 0x4c, 0xa8, 0xd6, 0x80, 0xd3, 0x5a, 0x49, 0x5e, 0x5d, 0xce, 0x6b, 0xf5,
+	0x00, 0xc0, 0xe9, 0x57, 0x48, 0x72, 0xed, 0xe9, 0xea, 0xa4, 0x30, 0x2d, 0x85, 0x59, 0xca, 0xa5,
+	0x0a, 0x74, 0x13, 0xac, 0xa8, 0x57, 0xcf, 0xd4, 0x5f, 0x1f, 0xaa, 0x3f, 0x59, 0xaa, 0xb7, 0x33,
+	0x1e, 0x9b, 0xe9, 0x50, 0xad, 0x19, 0xa1, 0x76, 0xc0, 0xaa, 0x7e, 0xdf, 0x4c, 0x55, 0xe7, 0xe3,
+	0xae, 0x59, 0xaf, 0x9a, 0xc1, 0x39, 0xf8, 0xe2, 0xc5, 0xeb, 0x8a, 0xf5, 0xf2, 0x75, 0xc5, 0xfa,
+	0xe3, 0x75, 0xc5, 0x7a, 0x76, 0x52, 0x59, 0x7a, 0x79, 0x52, 0x59, 0xfa, 0xf5, 0xa4, 0xb2, 0xf4,
+	0xf8, 0x93, 0x2e, 0x15, 0xbd, 0xd1, 0x71, 0x1d, 0xb3, 0xa1, 0xf9, 0x8f, 0xd2, 0x38, 0x75, 0x76,
+	0x33, 0xff, 0x33, 0x90, 0xde, 0x6e, 0x7c, 0x3b, 0xf9, 0x8f, 0x40, 0x8c, 0x63, 0xc2, 0x8f, 0x57,
+	0x55, 0xa3, 0xdf, 0xf9, 0x7b, 0x00, 0xa8, 0xfb, 0x03, 0x70, 0x45, 0x0d, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +735,66 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.JailingReasons) > 0 {
+		for iNdEx := len(m.JailingReasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailingReasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.KeyAssignmentNonces) > 0 {
+		for iNdEx := len(m.KeyAssignmentNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAssignmentNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.KeyAssignmentHeights) > 0 {
+		for iNdEx := len(m.KeyAssignmentHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAssignmentHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.SlashMeterState != nil {
+		{
+			size, err := m.SlashMeterState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.ConsumerAddrsToPruneV2) > 0 {
 		for iNdEx := len(m.ConsumerAddrsToPruneV2) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -477,6 +903,81 @@ func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SlashMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SlashMeterState != nil {
+		{
+			size, err := m.SlashMeterState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x72
+	if len(m.PhaseHistory) > 0 {
+		for iNdEx := len(m.PhaseHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PhaseHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.SlashPacketsPaused {
+		i--
+		if m.SlashPacketsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.VscSendingPaused {
+		i--
+		if m.VscSendingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PendingDowntimeSlashes) > 0 {
+		for iNdEx := len(m.PendingDowntimeSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDowntimeSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.Phase != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Phase))
 		i--
@@ -577,7 +1078,165 @@ func (m *ValsetUpdateIdToHeight) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+func (m *SlashMeterState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashMeterState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashMeterState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReplenishTimeCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReplenishTimeCandidate):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Meter.Size()
+		i -= size
+		if _, err := m.Meter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorJailingReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorJailingReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorJailingReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reason.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
 	for v >= 1<<7 {
@@ -629,6 +1288,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.SlashMeterState != nil {
+		l = m.SlashMeterState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.KeyAssignmentHeights) > 0 {
+		for _, e := range m.KeyAssignmentHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyAssignmentNonces) > 0 {
+		for _, e := range m.KeyAssignmentNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JailingReasons) > 0 {
+		for _, e := range m.JailingReasons {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -670,6 +1351,33 @@ func (m *ConsumerState) Size() (n int) {
 	if m.Phase != 0 {
 		n += 1 + sovGenesis(uint64(m.Phase))
 	}
+	if len(m.PendingDowntimeSlashes) > 0 {
+		for _, e := range m.PendingDowntimeSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.VscSendingPaused {
+		n += 2
+	}
+	if m.SlashPacketsPaused {
+		n += 2
+	}
+	if len(m.PhaseHistory) > 0 {
+		for _, e := range m.PhaseHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.SlashMeterState != nil {
+		l = m.SlashMeterState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SlashMode != 0 {
+		n += 2 + sovGenesis(uint64(m.SlashMode))
+	}
 	return n
 }
 
@@ -688,6 +1396,70 @@ func (m *ValsetUpdateIdToHeight) Size() (n int) {
 	return n
 }
 
+func (m *SlashMeterState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Meter.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReplenishTimeCandidate)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *KeyAssignmentHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *KeyAssignmentNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ValidatorJailingReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Reason.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -945,61 +1717,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1009,24 +1731,212 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if m.SlashMeterState == nil {
+				m.SlashMeterState = &SlashMeterState{}
+			}
+			if err := m.SlashMeterState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAssignmentHeights = append(m.KeyAssignmentHeights, KeyAssignmentHeight{})
+			if err := m.KeyAssignmentHeights[len(m.KeyAssignmentHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAssignmentNonces = append(m.KeyAssignmentNonces, KeyAssignmentNonce{})
+			if err := m.KeyAssignmentNonces[len(m.KeyAssignmentNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailingReasons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailingReasons = append(m.JailingReasons, ValidatorJailingReason{})
+			if err := m.JailingReasons[len(m.JailingReasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
@@ -1228,61 +2138,45 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDowntimeSlashes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingDowntimeSlashes = append(m.PendingDowntimeSlashes, PendingDowntimeSlash{})
+			if err := m.PendingDowntimeSlashes[len(m.PendingDowntimeSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValsetUpdateIdToHeight: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValsetUpdateIdToHeight: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VscSendingPaused", wireType)
 			}
-			m.ValsetUpdateId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1292,16 +2186,17 @@ func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.VscSendingPaused = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPacketsPaused", wireType)
 			}
-			m.Height = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1311,11 +2206,694 @@ func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SlashPacketsPaused = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhaseHistory = append(m.PhaseHistory, ConsumerPhaseTransition{})
+			if err := m.PhaseHistory[len(m.PhaseHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashMeterState == nil {
+				m.SlashMeterState = &SlashMeterState{}
+			}
+			if err := m.SlashMeterState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMode", wireType)
+			}
+			m.SlashMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMode |= ConsumerSlashMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValsetUpdateIdToHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValsetUpdateIdToHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashMeterState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashMeterState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashMeterState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Meter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplenishTimeCandidate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReplenishTimeCandidate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAssignmentHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAssignmentNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorJailingReason) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorJailingReason: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorJailingReason: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
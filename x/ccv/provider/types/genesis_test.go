@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -66,7 +67,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false),
 				nil,
 				nil,
 				nil,
@@ -110,7 +111,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false),
 				nil,
 				nil,
 				nil,
@@ -128,7 +129,7 @@ func TestValidateGenesisState(t *testing.T) {
 					0, // 0 ccv timeout here
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false),
 				nil,
 				nil,
 				nil,
@@ -146,7 +147,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					0, // 0 slash meter replenish period here
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false),
 				nil,
 				nil,
 				nil,
@@ -164,7 +165,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false),
 				nil,
 				nil,
 				nil,
@@ -272,7 +273,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false),
 				nil,
 				nil,
 				nil,
@@ -286,7 +287,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false),
 				nil,
 				nil,
 				nil,
@@ -308,6 +309,236 @@ func TestValidateGenesisState(t *testing.T) {
 	}
 }
 
+// TestValidateGenesisChannelMappings tests that the validation of a provider genesis
+// fails if the consumer states yield channel mappings that are not bidirectional
+func TestValidateGenesisChannelMappings(t *testing.T) {
+	consumerState := func(consumerId, channelId string) types.ConsumerState {
+		return types.ConsumerState{
+			ChainId:         consumerId,
+			ChannelId:       channelId,
+			ClientId:        "client-id",
+			ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-"+consumerId, false),
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		consumerStates []types.ConsumerState
+		expErr         string
+	}{
+		{
+			"consistent channel mappings",
+			[]types.ConsumerState{consumerState("0", "channel-0"), consumerState("1", "channel-1")},
+			"",
+		},
+		{
+			"consumer id mapped to two channels, i.e., missing channel to consumer id mapping",
+			[]types.ConsumerState{consumerState("0", "channel-0"), consumerState("0", "channel-1")},
+			"consumer id 0 is mapped to channels channel-0 and channel-1: channel channel-0 has no matching reverse mapping",
+		},
+		{
+			"channel mapped to two consumer ids, i.e., missing consumer id to channel mapping",
+			[]types.ConsumerState{consumerState("0", "channel-0"), consumerState("1", "channel-0")},
+			"channel channel-0 is mapped to consumer ids 0 and 1: consumer id 0 has no matching reverse mapping",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.NewGenesisState(types.DefaultValsetUpdateID, nil, tc.consumerStates, types.DefaultParams(), nil, nil, nil)
+			err := genState.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ccv.ErrInvalidGenesis)
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestValidateGenesisSlashMeterState tests the validation of the slash meter state within a provider genesis state
+func TestValidateGenesisSlashMeterState(t *testing.T) {
+	now := time.Now().UTC()
+	testCases := []struct {
+		name            string
+		slashMeterState *types.SlashMeterState
+		expPass         bool
+	}{
+		{"no slash meter state, i.e., new chain", nil, true},
+		{"negative slash meter", &types.SlashMeterState{Meter: math.NewInt(-10), ReplenishTimeCandidate: now}, true},
+		{"empty slash meter", &types.SlashMeterState{ReplenishTimeCandidate: now}, false},
+		{"slash meter above max total voting power", &types.SlashMeterState{Meter: math.NewInt(tmtypes.MaxTotalVotingPower + 1), ReplenishTimeCandidate: now}, false},
+		{"slash meter below negative max total voting power", &types.SlashMeterState{Meter: math.NewInt(-tmtypes.MaxTotalVotingPower - 1), ReplenishTimeCandidate: now}, false},
+		{"empty replenish time candidate", &types.SlashMeterState{Meter: math.NewInt(10)}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.SlashMeterState = tc.slashMeterState
+			err := genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ccv.ErrInvalidGenesis)
+			}
+		})
+	}
+}
+
+// TestValidateGenesisKeyAssignmentHeights tests the validation of the key assignment heights within a provider genesis state
+func TestValidateGenesisKeyAssignmentHeights(t *testing.T) {
+	providerAddr := crypto.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress()
+	testCases := []struct {
+		name    string
+		height  types.KeyAssignmentHeight
+		expPass bool
+	}{
+		{"valid key assignment height", types.KeyAssignmentHeight{ConsumerId: "0", ProviderAddr: providerAddr, Height: 5}, true},
+		{"blank consumer id", types.KeyAssignmentHeight{ConsumerId: " ", ProviderAddr: providerAddr, Height: 5}, false},
+		{"invalid provider address", types.KeyAssignmentHeight{ConsumerId: "0", ProviderAddr: nil, Height: 5}, false},
+		{"negative height", types.KeyAssignmentHeight{ConsumerId: "0", ProviderAddr: providerAddr, Height: -1}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.KeyAssignmentHeights = []types.KeyAssignmentHeight{tc.height}
+			err := genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ccv.ErrInvalidGenesis)
+			}
+		})
+	}
+}
+
+// TestValidateGenesisKeyAssignmentNonces tests the validation of the key assignment nonces within a provider genesis state
+func TestValidateGenesisKeyAssignmentNonces(t *testing.T) {
+	valAddr := crypto.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress()
+	testCases := []struct {
+		name    string
+		nonce   types.KeyAssignmentNonce
+		expPass bool
+	}{
+		{"valid key assignment nonce", types.KeyAssignmentNonce{ValidatorAddr: valAddr, Nonce: 5}, true},
+		{"invalid validator address", types.KeyAssignmentNonce{ValidatorAddr: nil, Nonce: 5}, false},
+		{"zero nonce", types.KeyAssignmentNonce{ValidatorAddr: valAddr, Nonce: 0}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.KeyAssignmentNonces = []types.KeyAssignmentNonce{tc.nonce}
+			err := genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ccv.ErrInvalidGenesis)
+			}
+		})
+	}
+}
+
+// TestValidateGenesisJailingReasons tests the validation of the jailing reasons within a provider genesis state
+func TestValidateGenesisJailingReasons(t *testing.T) {
+	consAddr := crypto.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress()
+	reason := types.JailingReason{ConsumerId: "0", Infraction: stakingtypes.Infraction_INFRACTION_DOWNTIME}
+	testCases := []struct {
+		name          string
+		jailingReason types.ValidatorJailingReason
+		expPass       bool
+	}{
+		{"valid jailing reason", types.ValidatorJailingReason{ProviderAddr: consAddr, Reason: reason}, true},
+		{"invalid provider address", types.ValidatorJailingReason{ProviderAddr: nil, Reason: reason}, false},
+		{"blank consumer id", types.ValidatorJailingReason{ProviderAddr: consAddr, Reason: types.JailingReason{ConsumerId: " "}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.JailingReasons = []types.ValidatorJailingReason{tc.jailingReason}
+			err := genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ccv.ErrInvalidGenesis)
+			}
+		})
+	}
+}
+
+// TestValidateGenesisPendingDowntimeSlashes tests the validation of the deferred downtime slash packets
+// within the consumer states of a provider genesis state
+func TestValidateGenesisPendingDowntimeSlashes(t *testing.T) {
+	validator := abci.Validator{Address: crypto.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress(), Power: 1}
+	testCases := []struct {
+		name    string
+		data    ccv.SlashPacketData
+		expPass bool
+	}{
+		{"valid downtime slash packet", *ccv.NewSlashPacketData(validator, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME), true},
+		{"invalid validator address", *ccv.NewSlashPacketData(abci.Validator{Power: 1}, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME), false},
+		{"double-sign slash packet", *ccv.NewSlashPacketData(validator, 1, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := types.ConsumerState{
+				ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id",
+				ConsumerGenesis:        getInitialConsumerGenesis(t, "chainid-1", false),
+				PendingDowntimeSlashes: []types.PendingDowntimeSlash{{JailTime: time.Now().UTC(), SlashPacketData: tc.data}},
+			}
+			err := types.NewGenesisState(types.DefaultValsetUpdateID, nil, []types.ConsumerState{cs},
+				types.DefaultParams(), nil, nil, nil).Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestValidateGenesisPhaseHistory tests the validation of the phase histories
+// within the consumer states of a provider genesis state
+func TestValidateGenesisPhaseHistory(t *testing.T) {
+	testCases := []struct {
+		name    string
+		history []types.ConsumerPhaseTransition
+		expPass bool
+	}{
+		{"empty phase history", nil, true},
+		{"phase history leading to the current phase", []types.ConsumerPhaseTransition{
+			{Height: 1, OldPhase: types.CONSUMER_PHASE_UNSPECIFIED, NewPhase: types.CONSUMER_PHASE_INITIALIZED},
+			{Height: 2, OldPhase: types.CONSUMER_PHASE_INITIALIZED, NewPhase: types.CONSUMER_PHASE_LAUNCHED},
+		}, true},
+		{"phase history leading to another phase", []types.ConsumerPhaseTransition{
+			{Height: 1, OldPhase: types.CONSUMER_PHASE_UNSPECIFIED, NewPhase: types.CONSUMER_PHASE_INITIALIZED},
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := types.ConsumerState{
+				ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id",
+				ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false),
+				Phase:           types.CONSUMER_PHASE_LAUNCHED,
+				PhaseHistory:    tc.history,
+			}
+			err := types.NewGenesisState(types.DefaultValsetUpdateID, nil, []types.ConsumerState{cs},
+				types.DefaultParams(), nil, nil, nil).Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func getInitialConsumerGenesis(t *testing.T, chainID string, preCCV bool) ccv.ConsumerGenesisState {
 	t.Helper()
 	// generate validator public key
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ProviderHooks event hooks for the provider module
+type ProviderHooks interface {
+	// AfterVSCPacketSent is called after a VSC packet with the given valset update id
+	// and validator updates is queued to be sent to the consumer chain with `consumerId`
+	AfterVSCPacketSent(ctx context.Context, consumerId string, vscId uint64, updates []abci.ValidatorUpdate) error
+	// AfterConsumerInitiatedSlash is called after the validator with `providerAddr` is slashed and jailed
+	// because of a slash packet for the given infraction received from the consumer chain with `consumerId`
+	AfterConsumerInitiatedSlash(ctx context.Context, consumerId string, providerAddr ProviderConsAddress, infraction stakingtypes.Infraction) error
+}
+
+var (
+	_ ProviderHooks = BaseProviderHooks{}
+	_ ProviderHooks = MultiProviderHooks{}
+)
+
+// BaseProviderHooks implements all the provider hooks as no-ops. It can be embedded
+// by hook implementations that only need a subset of the hooks, so that they keep
+// implementing ProviderHooks when new hooks are added.
+type BaseProviderHooks struct{}
+
+// AfterVSCPacketSent is a no-op
+func (BaseProviderHooks) AfterVSCPacketSent(context.Context, string, uint64, []abci.ValidatorUpdate) error {
+	return nil
+}
+
+// AfterConsumerInitiatedSlash is a no-op
+func (BaseProviderHooks) AfterConsumerInitiatedSlash(context.Context, string, ProviderConsAddress, stakingtypes.Infraction) error {
+	return nil
+}
+
+// MultiProviderHooks combines multiple provider hooks, all hook functions are run in array sequence
+type MultiProviderHooks []ProviderHooks
+
+// NewMultiProviderHooks creates a new MultiProviderHooks instance
+func NewMultiProviderHooks(hooks ...ProviderHooks) MultiProviderHooks {
+	return hooks
+}
+
+// AfterVSCPacketSent calls AfterVSCPacketSent on all the hooks, in order,
+// and returns the first error encountered
+func (h MultiProviderHooks) AfterVSCPacketSent(ctx context.Context, consumerId string, vscId uint64, updates []abci.ValidatorUpdate) error {
+	for i := range h {
+		if err := h[i].AfterVSCPacketSent(ctx, consumerId, vscId, updates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterConsumerInitiatedSlash calls AfterConsumerInitiatedSlash on all the hooks, in order,
+// and returns the first error encountered
+func (h MultiProviderHooks) AfterConsumerInitiatedSlash(ctx context.Context, consumerId string, providerAddr ProviderConsAddress, infraction stakingtypes.Infraction) error {
+	for i := range h {
+		if err := h[i].AfterConsumerInitiatedSlash(ctx, consumerId, providerAddr, infraction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -37,6 +38,37 @@ const (
 	// a consumer chain can allowlist
 	MaxAllowlistedRewardDenomsPerChain = 3
 
+	// JailedByProvider is returned by the jailing reason query for validators
+	// that were not jailed because of a slash packet sent by a consumer chain
+	JailedByProvider = "provider"
+
+	// SlashPacketRateWindow is the number of most recent blocks over which
+	// the slash packets received from a consumer chain are counted.
+	// It is a constant rather than a param as the rate is only returned by a query,
+	// i.e., no state transition depends on it, the window is returned together with the rate,
+	// and it bounds the number of slash packet counts stored per consumer chain.
+	SlashPacketRateWindow = 100
+
+	// MaxConsumerIdsPerValidatorSetsQuery is the maximum number of consumer ids
+	// that can be queried at once through the consumer validator sets query
+	MaxConsumerIdsPerValidatorSetsQuery = 20
+
+	// MaxProviderAddrsPerConsumerObligationsQuery is the maximum number of validators
+	// that can be queried at once through the validators consumer obligations query
+	MaxProviderAddrsPerConsumerObligationsQuery = 20
+
+	// SpawnTimePastTolerance is how far in the past, relative to the current block time,
+	// the spawn time of a consumer chain can be when it is set. The tolerance only absorbs
+	// the delay between signing a message and its inclusion in a block, as a spawn time in
+	// the past launches the consumer chain in the next block regardless of how far in the past
+	// it is. Hence, it is a constant rather than a param: a larger tolerance would not change
+	// the launch time, but would only accept spawn times that are clearly stale.
+	SpawnTimePastTolerance = 10 * time.Minute
+
+	// VSCLatencyWindow is the number of most recently acknowledged VSC packets
+	// over which the VSC round-trip latency of a consumer chain is computed
+	VSCLatencyWindow = 100
+
 	// Names for the store keys.
 	// Used for storing the byte prefixes in the constant map.
 	// See getKeyPrefixes().
@@ -162,6 +194,56 @@ const (
 	ConsumerIdToQueuedInfractionParametersKeyName = "ConsumerIdToQueuedInfractionParametersKeyName"
 
 	InfractionScheduledTimeToConsumerIdsKeyName = "InfractionScheduledTimeToConsumerIdsKeyName"
+
+	VscSendTimestampKeyName = "VscSendTimestampKey"
+
+	ConsumerIdToInheritedConsumerIdKeyName = "ConsumerIdToInheritedConsumerIdKey"
+
+	SlashPacketsPausedKeyName = "SlashPacketsPausedKey"
+
+	KeyAssignmentHeightKeyName = "KeyAssignmentHeightKey"
+
+	PendingDowntimeSlashKeyName = "PendingDowntimeSlashKey"
+
+	ConsumerIdToPhaseHistoryKeyName = "ConsumerIdToPhaseHistoryKey"
+
+	JailingReasonKeyName = "JailingReasonKey"
+
+	SlashPacketCountKeyName = "SlashPacketCountKey"
+
+	InfractionSlashCountKeyName = "InfractionSlashCountKey"
+
+	ConsumerLaunchFailureKeyName = "ConsumerLaunchFailureKey"
+
+	SlashPacketRecordKeyName = "SlashPacketRecordKey"
+
+	LastKeyPruneWarningTsKeyName = "LastKeyPruneWarningTsKey"
+
+	ConsumerGenesisValsetHashKeyName = "ConsumerGenesisValsetHashKey"
+
+	ConsumerJailedPowerKeyName = "ConsumerJailedPowerKey"
+
+	VSCSendingPausedKeyName = "VSCSendingPausedKey"
+
+	KeyAssignmentNonceKeyName = "KeyAssignmentNonceKey"
+
+	ConsumerSlashMeterKeyName = "ConsumerSlashMeterKey"
+
+	ConsumerSlashMeterReplenishTimeCandidateKeyName = "ConsumerSlashMeterReplenishTimeCandidateKey"
+
+	SlashMeterHistoryKeyName = "SlashMeterHistoryKey"
+
+	VSCLatencyKeyName = "VSCLatencyKey"
+
+	ConsumerSlashModeKeyName = "ConsumerSlashModeKey"
+
+	RecentValsetUpdateBlockHeightKeyName = "RecentValsetUpdateBlockHeightKey"
+
+	ConsumerValSetProviderPowerKeyName = "ConsumerValSetProviderPowerKey"
+
+	ConsumerAddrReplacementTimeKeyName = "ConsumerAddrReplacementTimeKey"
+
+	ConsumerCCVTimeoutPeriodKeyName = "ConsumerCCVTimeoutPeriodKey"
 )
 
 // getKeyPrefixes returns a constant map of all the byte prefixes for existing keys
@@ -412,6 +494,114 @@ func getKeyPrefixes() map[string]byte {
 		// InfractionScheduledTimeToConsumerIdsKeyName is the key for storing time when the infraction parameters will be updated for the specific consumer
 		InfractionScheduledTimeToConsumerIdsKeyName: 59,
 
+		// VscSendTimestampKey is the key for storing the mapping from VSC ids
+		// to the timestamps when the VSC packets were sent to a consumer chain.
+		// NOTE: Unlike the deprecated VscSendTimestampKey (18), which was indexed by chain id and
+		// was used to mature unbonding operations, this index is indexed by consumer id and is not
+		// used for any state transition of the protocol. The send time of a VSC packet is not stored
+		// anywhere else (neither the pruning timestamps of the key assignments nor the valset update
+		// block heights record it per consumer chain), and it is needed for the VSC maturation schedule,
+		// the key rotations since a VSC packet, and the VSC latencies. The entries are pruned once
+		// the VSC packets mature, and hence the index holds at most an unbonding period of VSC packets.
+		VscSendTimestampKeyName: 60,
+
+		// ConsumerIdToInheritedConsumerIdKeyName is the key for storing the id of the stopped consumer chain
+		// from which a consumer chain inherits its key assignments and validator set at launch
+		ConsumerIdToInheritedConsumerIdKeyName: 61,
+
+		// SlashPacketsPausedKeyName is the key for storing whether the handling of the slash packets
+		// received from a consumer chain is paused
+		SlashPacketsPausedKeyName: 62,
+
+		// KeyAssignmentHeightKeyName is the key for storing the block height of the last
+		// key assignment of a validator on a consumer chain
+		KeyAssignmentHeightKeyName: 63,
+
+		// PendingDowntimeSlashKeyName is the key for storing the downtime slash packets
+		// whose handling is deferred by the downtime slash grace period
+		PendingDowntimeSlashKeyName: 64,
+
+		// ConsumerIdToPhaseHistoryKeyName is the key for storing the most recent
+		// phase transitions of a consumer chain
+		ConsumerIdToPhaseHistoryKeyName: 65,
+
+		// JailingReasonKeyName is the key for storing the consumer chain and the infraction
+		// for which a validator was jailed on the provider
+		JailingReasonKeyName: 66,
+
+		// SlashPacketCountKeyName is the key for storing the number of slash packets
+		// received from a consumer chain at a given block height
+		SlashPacketCountKeyName: 67,
+
+		// InfractionSlashCountKeyName is the key for storing the number of slash packets
+		// applied per infraction type, i.e., the downtime slash packets that consumed the
+		// slash meter and the double-sign slash packets that bypassed it
+		InfractionSlashCountKeyName: 68,
+
+		// ConsumerLaunchFailureKeyName is the key for storing the number of failed
+		// attempts to launch a consumer chain and the error of the last attempt
+		ConsumerLaunchFailureKeyName: 69,
+
+		// SlashPacketRecordKeyName is the key for storing how the slash packets
+		// received from a consumer chain were processed, indexed by IBC sequence number
+		SlashPacketRecordKeyName: 70,
+
+		// LastKeyPruneWarningTsKeyName is the key for storing the latest prune timestamp
+		// of the consumer addresses for which a warning event was emitted
+		LastKeyPruneWarningTsKeyName: 71,
+
+		// ConsumerGenesisValsetHashKeyName is the key for storing the hash of the
+		// initial validator set of a consumer chain, computed when the chain launches
+		ConsumerGenesisValsetHashKeyName: 72,
+
+		// ConsumerJailedPowerKeyName is the key for storing the cumulative power of the validators
+		// jailed because of the slash packets received from a consumer chain
+		ConsumerJailedPowerKeyName: 73,
+
+		// VSCSendingPausedKeyName is the key for storing whether the sending of VSC packets
+		// to a consumer chain is paused
+		VSCSendingPausedKeyName: 74,
+
+		// KeyAssignmentNonceKeyName is the key for storing the last nonce of the key assignments
+		// processed for a validator
+		KeyAssignmentNonceKeyName: 75,
+
+		// ConsumerSlashMeterKeyName is the key for storing the slash meters of the consumer chains,
+		// used if per consumer slash meters are enabled
+		ConsumerSlashMeterKeyName: 76,
+
+		// ConsumerSlashMeterReplenishTimeCandidateKeyName is the key for storing the replenish time
+		// candidates of the slash meters of the consumer chains
+		ConsumerSlashMeterReplenishTimeCandidateKeyName: 77,
+
+		// SlashMeterHistoryKeyName is the key for storing the values of the slash meter
+		// at the end of the most recent blocks
+		SlashMeterHistoryKeyName: 78,
+
+		// VSCLatencyKeyName is the key for storing the round-trip latencies
+		// of the most recently acknowledged VSC packets of the consumer chains
+		VSCLatencyKeyName: 79,
+
+		// ConsumerSlashModeKeyName is the key for storing the slash modes of the consumer chains
+		// that are not in the default (jail) slash mode
+		ConsumerSlashModeKeyName: 80,
+
+		// RecentValsetUpdateBlockHeightKeyName is the key for storing the block heights
+		// of the most recent vscIDs, which are only used for queries
+		RecentValsetUpdateBlockHeightKeyName: 81,
+
+		// ConsumerValSetProviderPowerKeyName is the key for storing the provider total power
+		// at the time the current validator sets of the consumer chains were computed
+		ConsumerValSetProviderPowerKeyName: 82,
+
+		// ConsumerAddrReplacementTimeKeyName is the key for storing the time when the consumer addresses
+		// scheduled for pruning were replaced, i.e., the time of the key rotations
+		ConsumerAddrReplacementTimeKeyName: 83,
+
+		// ConsumerCCVTimeoutPeriodKeyName is the key for storing the consumer-specific timeout period
+		// of the CCV packets sent to a consumer chain
+		ConsumerCCVTimeoutPeriodKeyName: 84,
+
 		// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO TestPreserveBytePrefix() IN keys_test.go
 	}
 }
@@ -850,6 +1040,165 @@ func InfractionScheduledTimeToConsumerIdsKey(updateTime time.Time) []byte {
 	)
 }
 
+// VscSendTimestampKeyPrefix returns the key prefix for storing the VSC send timestamps
+func VscSendTimestampKeyPrefix() byte {
+	return mustGetKeyPrefix(VscSendTimestampKeyName)
+}
+
+// VscSendTimestampKey returns the key under which the send timestamp of the VSC packet
+// with the given vscId is stored for the given consumer chain
+func VscSendTimestampKey(consumerId string, vscId uint64) []byte {
+	return StringIdAndUintIdKey(VscSendTimestampKeyPrefix(), consumerId, vscId)
+}
+
+// ConsumerIdToInheritedConsumerIdKeyPrefix returns the key prefix for storing the id of
+// the consumer chain from which a consumer chain inherits at launch
+func ConsumerIdToInheritedConsumerIdKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToInheritedConsumerIdKeyName)
+}
+
+// ConsumerIdToInheritedConsumerIdKey returns the key used to store the id of the consumer chain
+// from which the consumer chain with `consumerId` inherits at launch
+func ConsumerIdToInheritedConsumerIdKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToInheritedConsumerIdKeyPrefix(), consumerId)
+}
+
+// SlashPacketsPausedKeyPrefix returns the key prefix for storing whether the handling
+// of the slash packets received from a consumer chain is paused
+func SlashPacketsPausedKeyPrefix() byte {
+	return mustGetKeyPrefix(SlashPacketsPausedKeyName)
+}
+
+// SlashPacketsPausedKey returns the key used to store whether the handling of the slash packets
+// received from the consumer chain with `consumerId` is paused
+func SlashPacketsPausedKey(consumerId string) []byte {
+	return StringIdWithLenKey(SlashPacketsPausedKeyPrefix(), consumerId)
+}
+
+// KeyAssignmentHeightKeyPrefix returns the key prefix for storing the block height
+// of the last key assignment of a validator on a consumer chain
+func KeyAssignmentHeightKeyPrefix() byte {
+	return mustGetKeyPrefix(KeyAssignmentHeightKeyName)
+}
+
+// KeyAssignmentHeightKey returns the key used to store the block height of the last
+// key assignment of the validator with `providerAddr` on the consumer chain with `consumerId`
+func KeyAssignmentHeightKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(KeyAssignmentHeightKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// PendingDowntimeSlashKeyPrefix returns the key prefix for storing the downtime slash packets
+// whose handling is deferred by the downtime slash grace period
+func PendingDowntimeSlashKeyPrefix() byte {
+	return mustGetKeyPrefix(PendingDowntimeSlashKeyName)
+}
+
+// PendingDowntimeSlashKey returns the key used to store the deferred downtime slash packet
+// received from the consumer chain with `consumerId` for the validator with `consumerAddr`
+func PendingDowntimeSlashKey(consumerId string, consumerAddr ConsumerConsAddress) []byte {
+	return StringIdAndConsAddrKey(PendingDowntimeSlashKeyPrefix(), consumerId, consumerAddr.ToSdkConsAddr())
+}
+
+// ConsumerIdToPhaseHistoryKeyPrefix returns the key prefix for storing the phase histories of consumer chains
+func ConsumerIdToPhaseHistoryKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToPhaseHistoryKeyName)
+}
+
+// ConsumerIdToPhaseHistoryKey returns the key used to store the most recent phase transitions
+// of the consumer chain with `consumerId`
+func ConsumerIdToPhaseHistoryKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToPhaseHistoryKeyPrefix(), consumerId)
+}
+
+// JailingReasonKeyPrefix returns the key prefix for storing the jailing reasons of validators
+func JailingReasonKeyPrefix() byte {
+	return mustGetKeyPrefix(JailingReasonKeyName)
+}
+
+// JailingReasonKey returns the key used to store the reason for which
+// the validator with `providerAddr` was jailed by a consumer chain
+func JailingReasonKey(providerAddr ProviderConsAddress) []byte {
+	return append([]byte{JailingReasonKeyPrefix()}, providerAddr.ToSdkConsAddr().Bytes()...)
+}
+
+// SlashPacketCountKeyPrefix returns the key prefix for storing the number of slash packets
+// received from consumer chains per block height
+func SlashPacketCountKeyPrefix() byte {
+	return mustGetKeyPrefix(SlashPacketCountKeyName)
+}
+
+// SlashPacketCountKey returns the key under which the number of slash packets
+// received from the given consumer chain at the given block height is stored
+func SlashPacketCountKey(consumerId string, height uint64) []byte {
+	return StringIdAndUintIdKey(SlashPacketCountKeyPrefix(), consumerId, height)
+}
+
+// InfractionSlashCountKeyPrefix returns the key prefix for storing
+// the number of slash packets applied per infraction type
+func InfractionSlashCountKeyPrefix() byte {
+	return mustGetKeyPrefix(InfractionSlashCountKeyName)
+}
+
+// InfractionSlashCountKey returns the key under which the number of
+// slash packets applied for the given infraction type is stored
+func InfractionSlashCountKey(infraction stakingtypes.Infraction) []byte {
+	return append([]byte{InfractionSlashCountKeyPrefix()}, sdk.Uint64ToBigEndian(uint64(infraction))...)
+}
+
+// ConsumerLaunchFailureKeyPrefix returns the key prefix for storing the failed launch attempts of consumer chains
+func ConsumerLaunchFailureKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerLaunchFailureKeyName)
+}
+
+// ConsumerLaunchFailureKey returns the key used to store the failed launch attempts of the consumer chain with `consumerId`
+func ConsumerLaunchFailureKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerLaunchFailureKeyPrefix(), consumerId)
+}
+
+// SlashPacketRecordKeyPrefix returns the key prefix for storing the records of the received slash packets
+func SlashPacketRecordKeyPrefix() byte {
+	return mustGetKeyPrefix(SlashPacketRecordKeyName)
+}
+
+// SlashPacketRecordKey returns the key under which the record of the slash packet
+// received from the given consumer chain with the given IBC sequence number is stored
+func SlashPacketRecordKey(consumerId string, ibcSeq uint64) []byte {
+	return StringIdAndUintIdKey(SlashPacketRecordKeyPrefix(), consumerId, ibcSeq)
+}
+
+// LastKeyPruneWarningTsKeyPrefix returns the key prefix for storing the latest prune timestamps for which a warning was emitted
+func LastKeyPruneWarningTsKeyPrefix() byte {
+	return mustGetKeyPrefix(LastKeyPruneWarningTsKeyName)
+}
+
+// LastKeyPruneWarningTsKey returns the key used to store the latest prune timestamp
+// for which a warning was emitted on the consumer chain with `consumerId`
+func LastKeyPruneWarningTsKey(consumerId string) []byte {
+	return StringIdWithLenKey(LastKeyPruneWarningTsKeyPrefix(), consumerId)
+}
+
+// ConsumerGenesisValsetHashKeyPrefix returns the key prefix for storing the hashes of the initial validator sets of consumer chains
+func ConsumerGenesisValsetHashKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerGenesisValsetHashKeyName)
+}
+
+// ConsumerGenesisValsetHashKey returns the key used to store the hash of the initial validator set
+// of the consumer chain with `consumerId`
+func ConsumerGenesisValsetHashKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerGenesisValsetHashKeyPrefix(), consumerId)
+}
+
+// ConsumerJailedPowerKeyPrefix returns the key prefix for storing the cumulative jailed power of consumer chains
+func ConsumerJailedPowerKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerJailedPowerKeyName)
+}
+
+// ConsumerJailedPowerKey returns the key used to store the cumulative power of the validators
+// jailed because of the slash packets received from the consumer chain with `consumerId`
+func ConsumerJailedPowerKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerJailedPowerKeyPrefix(), consumerId)
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
@@ -957,3 +1306,132 @@ func ParseStringIdAndConsAddrKey(prefix byte, bz []byte) (string, sdk.ConsAddres
 //
 // End of generic helpers section
 //
+
+// VSCSendingPausedKeyPrefix returns the key prefix for storing whether the sending
+// of VSC packets to a consumer chain is paused
+func VSCSendingPausedKeyPrefix() byte {
+	return mustGetKeyPrefix(VSCSendingPausedKeyName)
+}
+
+// VSCSendingPausedKey returns the key used to store whether the sending of VSC packets
+// to the consumer chain with the given consumer id is paused
+func VSCSendingPausedKey(consumerId string) []byte {
+	return StringIdWithLenKey(VSCSendingPausedKeyPrefix(), consumerId)
+}
+
+// KeyAssignmentNonceKeyPrefix returns the key prefix for storing the last nonces of the key assignments
+func KeyAssignmentNonceKeyPrefix() byte {
+	return mustGetKeyPrefix(KeyAssignmentNonceKeyName)
+}
+
+// KeyAssignmentNonceKey returns the key used to store the last nonce of the key assignments
+// processed for the validator with the given operator address
+func KeyAssignmentNonceKey(valAddr sdk.ValAddress) []byte {
+	return append([]byte{KeyAssignmentNonceKeyPrefix()}, valAddr.Bytes()...)
+}
+
+// ConsumerSlashMeterKeyPrefix returns the key prefix for storing the slash meters of the consumer chains
+func ConsumerSlashMeterKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerSlashMeterKeyName)
+}
+
+// ConsumerSlashMeterKey returns the key used to store the slash meter
+// of the consumer chain with the given consumer id
+func ConsumerSlashMeterKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerSlashMeterKeyPrefix(), consumerId)
+}
+
+// ConsumerSlashMeterReplenishTimeCandidateKeyPrefix returns the key prefix for storing
+// the replenish time candidates of the slash meters of the consumer chains
+func ConsumerSlashMeterReplenishTimeCandidateKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerSlashMeterReplenishTimeCandidateKeyName)
+}
+
+// ConsumerSlashMeterReplenishTimeCandidateKey returns the key used to store the replenish time
+// candidate of the slash meter of the consumer chain with the given consumer id
+func ConsumerSlashMeterReplenishTimeCandidateKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerSlashMeterReplenishTimeCandidateKeyPrefix(), consumerId)
+}
+
+// SlashMeterHistoryKeyPrefix returns the key prefix for storing the values of the slash meter
+// at the end of the most recent blocks
+func SlashMeterHistoryKeyPrefix() byte {
+	return mustGetKeyPrefix(SlashMeterHistoryKeyName)
+}
+
+// SlashMeterHistoryKey returns the key used to store the value of the slash meter
+// at the end of the block with the given height
+func SlashMeterHistoryKey(height uint64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	return append([]byte{SlashMeterHistoryKeyPrefix()}, heightBytes...)
+}
+
+// VSCLatencyKeyPrefix returns the key prefix for storing the round-trip latencies of the acknowledged VSC packets
+func VSCLatencyKeyPrefix() byte {
+	return mustGetKeyPrefix(VSCLatencyKeyName)
+}
+
+// VSCLatencyKey returns the key under which the round-trip latency
+// of the VSC packet with the given vscId sent to the given consumer chain is stored
+func VSCLatencyKey(consumerId string, vscId uint64) []byte {
+	return StringIdAndUintIdKey(VSCLatencyKeyPrefix(), consumerId, vscId)
+}
+
+// ConsumerSlashModeKeyPrefix returns the key prefix for storing the slash modes of the consumer chains
+func ConsumerSlashModeKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerSlashModeKeyName)
+}
+
+// ConsumerSlashModeKey returns the key used to store the slash mode of the consumer chain with the given consumer id
+func ConsumerSlashModeKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerSlashModeKeyPrefix(), consumerId)
+}
+
+// RecentValsetUpdateBlockHeightKeyPrefix returns the key prefix for storing the block heights of the most recent vscIDs
+func RecentValsetUpdateBlockHeightKeyPrefix() byte {
+	return mustGetKeyPrefix(RecentValsetUpdateBlockHeightKeyName)
+}
+
+// RecentValsetUpdateBlockHeightKey returns the key used to store the block height of a recent vscID
+func RecentValsetUpdateBlockHeightKey(valsetUpdateId uint64) []byte {
+	vuidBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(vuidBytes, valsetUpdateId)
+	return append([]byte{RecentValsetUpdateBlockHeightKeyPrefix()}, vuidBytes...)
+}
+
+// ConsumerValSetProviderPowerKeyPrefix returns the key prefix for storing the provider total power
+// at the time the current validator sets of the consumer chains were computed
+func ConsumerValSetProviderPowerKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerValSetProviderPowerKeyName)
+}
+
+// ConsumerValSetProviderPowerKey returns the key used to store the provider total power at the time
+// the current validator set of the consumer chain with `consumerId` was computed
+func ConsumerValSetProviderPowerKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerValSetProviderPowerKeyPrefix(), consumerId)
+}
+
+// ConsumerAddrReplacementTimeKeyPrefix returns the key prefix for storing the time when
+// the consumer addresses scheduled for pruning were replaced
+func ConsumerAddrReplacementTimeKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerAddrReplacementTimeKeyName)
+}
+
+// ConsumerAddrReplacementTimeKey returns the key used to store the time when the given
+// consumer address was replaced on the consumer chain with the given consumer id
+func ConsumerAddrReplacementTimeKey(consumerId string, addr ConsumerConsAddress) []byte {
+	return StringIdAndConsAddrKey(ConsumerAddrReplacementTimeKeyPrefix(), consumerId, addr.ToSdkConsAddr())
+}
+
+// ConsumerCCVTimeoutPeriodKeyPrefix returns the key prefix for storing the consumer-specific
+// timeout periods of the CCV packets
+func ConsumerCCVTimeoutPeriodKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerCCVTimeoutPeriodKeyName)
+}
+
+// ConsumerCCVTimeoutPeriodKey returns the key used to store the timeout period of the CCV packets
+// sent to the consumer chain with the given consumer id
+func ConsumerCCVTimeoutPeriodKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerCCVTimeoutPeriodKeyPrefix(), consumerId)
+}
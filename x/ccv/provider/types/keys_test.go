@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	cryptoutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
@@ -155,6 +156,56 @@ func TestPreserveBytePrefix(t *testing.T) {
 	i++
 	require.Equal(t, byte(59), providertypes.InfractionScheduledTimeToConsumerIdsKeyPrefix())
 	i++
+	require.Equal(t, byte(60), providertypes.VscSendTimestampKeyPrefix())
+	i++
+	require.Equal(t, byte(61), providertypes.ConsumerIdToInheritedConsumerIdKeyPrefix())
+	i++
+	require.Equal(t, byte(62), providertypes.SlashPacketsPausedKeyPrefix())
+	i++
+	require.Equal(t, byte(63), providertypes.KeyAssignmentHeightKeyPrefix())
+	i++
+	require.Equal(t, byte(64), providertypes.PendingDowntimeSlashKeyPrefix())
+	i++
+	require.Equal(t, byte(65), providertypes.ConsumerIdToPhaseHistoryKeyPrefix())
+	i++
+	require.Equal(t, byte(66), providertypes.JailingReasonKeyPrefix())
+	i++
+	require.Equal(t, byte(67), providertypes.SlashPacketCountKeyPrefix())
+	i++
+	require.Equal(t, byte(68), providertypes.InfractionSlashCountKeyPrefix())
+	i++
+	require.Equal(t, byte(69), providertypes.ConsumerLaunchFailureKeyPrefix())
+	i++
+	require.Equal(t, byte(70), providertypes.SlashPacketRecordKeyPrefix())
+	i++
+	require.Equal(t, byte(71), providertypes.LastKeyPruneWarningTsKeyPrefix())
+	i++
+	require.Equal(t, byte(72), providertypes.ConsumerGenesisValsetHashKeyPrefix())
+	i++
+	require.Equal(t, byte(73), providertypes.ConsumerJailedPowerKeyPrefix())
+	i++
+	require.Equal(t, byte(74), providertypes.VSCSendingPausedKeyPrefix())
+	i++
+	require.Equal(t, byte(75), providertypes.KeyAssignmentNonceKeyPrefix())
+	i++
+	require.Equal(t, byte(76), providertypes.ConsumerSlashMeterKeyPrefix())
+	i++
+	require.Equal(t, byte(77), providertypes.ConsumerSlashMeterReplenishTimeCandidateKeyPrefix())
+	i++
+	require.Equal(t, byte(78), providertypes.SlashMeterHistoryKeyPrefix())
+	i++
+	require.Equal(t, byte(79), providertypes.VSCLatencyKeyPrefix())
+	i++
+	require.Equal(t, byte(80), providertypes.ConsumerSlashModeKeyPrefix())
+	i++
+	require.Equal(t, byte(81), providertypes.RecentValsetUpdateBlockHeightKeyPrefix())
+	i++
+	require.Equal(t, byte(82), providertypes.ConsumerValSetProviderPowerKeyPrefix())
+	i++
+	require.Equal(t, byte(83), providertypes.ConsumerAddrReplacementTimeKeyPrefix())
+	i++
+	require.Equal(t, byte(84), providertypes.ConsumerCCVTimeoutPeriodKeyPrefix())
+	i++
 
 	prefixes := providertypes.GetAllKeyPrefixes()
 	require.Equal(t, len(prefixes), i)
@@ -228,6 +279,31 @@ func getAllFullyDefinedKeys() [][]byte {
 		providertypes.ConsumerIdToInfractionParametersKey("13"),
 		providertypes.ConsumerIdToQueuedInfractionParametersKey("13"),
 		providertypes.InfractionScheduledTimeToConsumerIdsKey(time.Time{}),
+		providertypes.VscSendTimestampKey("13", 1),
+		providertypes.ConsumerIdToInheritedConsumerIdKey("13"),
+		providertypes.SlashPacketsPausedKey("13"),
+		providertypes.KeyAssignmentHeightKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.PendingDowntimeSlashKey("13", providertypes.NewConsumerConsAddress([]byte{0x05})),
+		providertypes.ConsumerIdToPhaseHistoryKey("13"),
+		providertypes.JailingReasonKey(providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.SlashPacketCountKey("13", 42),
+		providertypes.InfractionSlashCountKey(stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN),
+		providertypes.ConsumerLaunchFailureKey("13"),
+		providertypes.SlashPacketRecordKey("13", 42),
+		providertypes.LastKeyPruneWarningTsKey("13"),
+		providertypes.ConsumerGenesisValsetHashKey("13"),
+		providertypes.ConsumerJailedPowerKey("13"),
+		providertypes.VSCSendingPausedKey("13"),
+		providertypes.KeyAssignmentNonceKey(sdk.ValAddress([]byte{0x05})),
+		providertypes.ConsumerSlashMeterKey("13"),
+		providertypes.ConsumerSlashMeterReplenishTimeCandidateKey("13"),
+		providertypes.SlashMeterHistoryKey(42),
+		providertypes.VSCLatencyKey("13", 42),
+		providertypes.ConsumerSlashModeKey("13"),
+		providertypes.RecentValsetUpdateBlockHeightKey(7),
+		providertypes.ConsumerValSetProviderPowerKey("13"),
+		providertypes.ConsumerAddrReplacementTimeKey("13", providertypes.NewConsumerConsAddress([]byte{0x05})),
+		providertypes.ConsumerCCVTimeoutPeriodKey("13"),
 	}
 }
 
@@ -0,0 +1,21 @@
+package types
+
+// Telemetry metric keys and labels of the provider module
+const (
+	// MetricKeyVSCPacketsSent counts the VSC packets sent to consumer chains
+	MetricKeyVSCPacketsSent = "vsc_packets_sent"
+	// MetricKeySlashPacketsHandled counts the slash packets that are handled, i.e., not bounced
+	MetricKeySlashPacketsHandled = "slash_packets_handled"
+	// MetricKeySlashPacketsThrottled counts the slash packets that are bounced due to throttling
+	MetricKeySlashPacketsThrottled = "slash_packets_throttled"
+	// MetricKeyVSCLatencyAverage is the average round-trip latency (in milliseconds) of the
+	// most recently acknowledged VSC packets, i.e., the average time between sending a VSC packet
+	// and receiving its acknowledgement
+	MetricKeyVSCLatencyAverage = "vsc_latency_average_ms"
+	// MetricKeyVSCLatencyMax is the maximum round-trip latency (in milliseconds)
+	// of the most recently acknowledged VSC packets
+	MetricKeyVSCLatencyMax = "vsc_latency_max_ms"
+
+	MetricLabelConsumerId = "consumer_id"
+	MetricLabelInfraction = "infraction"
+)
@@ -36,22 +36,40 @@ const (
 var (
 	_ sdk.Msg = (*MsgAssignConsumerKey)(nil)
 	_ sdk.Msg = (*MsgChangeRewardDenoms)(nil)
+	_ sdk.Msg = (*MsgSetSlashPacketsPaused)(nil)
+	_ sdk.Msg = (*MsgSetConsumerSlashMode)(nil)
+	_ sdk.Msg = (*MsgCancelPendingDowntimeSlash)(nil)
+	_ sdk.Msg = (*MsgSetVSCSendingPaused)(nil)
+	_ sdk.Msg = (*MsgRemoveConsumers)(nil)
+	_ sdk.Msg = (*MsgRemoveConsumerKeyAssignment)(nil)
+	_ sdk.Msg = (*MsgResendConsumerValidatorSet)(nil)
 	_ sdk.Msg = (*MsgSubmitConsumerMisbehaviour)(nil)
 	_ sdk.Msg = (*MsgSubmitConsumerDoubleVoting)(nil)
 	_ sdk.Msg = (*MsgCreateConsumer)(nil)
 	_ sdk.Msg = (*MsgUpdateConsumer)(nil)
 	_ sdk.Msg = (*MsgRemoveConsumer)(nil)
+	_ sdk.Msg = (*MsgResumeConsumer)(nil)
+	_ sdk.Msg = (*MsgTransferConsumerOwnership)(nil)
 	_ sdk.Msg = (*MsgOptIn)(nil)
 	_ sdk.Msg = (*MsgOptOut)(nil)
 	_ sdk.Msg = (*MsgSetConsumerCommissionRate)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgAssignConsumerKey)(nil)
 	_ sdk.HasValidateBasic = (*MsgChangeRewardDenoms)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetSlashPacketsPaused)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetConsumerSlashMode)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelPendingDowntimeSlash)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetVSCSendingPaused)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveConsumers)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveConsumerKeyAssignment)(nil)
+	_ sdk.HasValidateBasic = (*MsgResendConsumerValidatorSet)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitConsumerMisbehaviour)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitConsumerDoubleVoting)(nil)
 	_ sdk.HasValidateBasic = (*MsgCreateConsumer)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateConsumer)(nil)
 	_ sdk.HasValidateBasic = (*MsgRemoveConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgResumeConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferConsumerOwnership)(nil)
 	_ sdk.HasValidateBasic = (*MsgOptIn)(nil)
 	_ sdk.HasValidateBasic = (*MsgOptOut)(nil)
 	_ sdk.HasValidateBasic = (*MsgSetConsumerCommissionRate)(nil)
@@ -126,6 +144,92 @@ func (msg *MsgChangeRewardDenoms) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgSetSlashPacketsPaused) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetSlashPacketsPaused, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgSetConsumerSlashMode) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerSlashMode, "ConsumerId: %s", err.Error())
+	}
+
+	if _, ok := ConsumerSlashMode_name[int32(msg.SlashMode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerSlashMode, "unknown slash mode: %d", msg.SlashMode)
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgCancelPendingDowntimeSlash) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgCancelPendingDowntimeSlash, "ConsumerId: %s", err.Error())
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(msg.ProviderAddr); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgCancelPendingDowntimeSlash, "ProviderAddr: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgSetVSCSendingPaused) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetVSCSendingPaused, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgRemoveConsumers) ValidateBasic() error {
+	if len(msg.ConsumerIds) == 0 {
+		return errorsmod.Wrapf(ErrInvalidMsgRemoveConsumers, "ConsumerIds cannot be empty")
+	}
+
+	seen := map[string]bool{}
+	for _, consumerId := range msg.ConsumerIds {
+		if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgRemoveConsumers, "ConsumerIds: %s", err.Error())
+		}
+		if seen[consumerId] {
+			return errorsmod.Wrapf(ErrInvalidMsgRemoveConsumers, "ConsumerIds: duplicate consumer id %s", consumerId)
+		}
+		seen[consumerId] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgRemoveConsumerKeyAssignment) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRemoveConsumerKeyAssignment, "ConsumerId: %s", err.Error())
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(msg.ProviderAddr); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRemoveConsumerKeyAssignment, "ProviderAddr: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgResendConsumerValidatorSet) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgResendConsumerValidatorSet, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
 func NewMsgSubmitConsumerMisbehaviour(
 	consumerId string,
 	submitter sdk.AccAddress,
@@ -358,6 +462,12 @@ func (msg MsgCreateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if msg.InheritFromConsumerId != "" {
+		if err := ccvtypes.ValidateConsumerId(msg.InheritFromConsumerId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "InheritFromConsumerId: %s", err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -411,6 +521,10 @@ func (msg MsgUpdateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if msg.CcvTimeoutPeriod != nil && *msg.CcvTimeoutPeriod < 0 {
+		return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "CcvTimeoutPeriod cannot be negative: %s", *msg.CcvTimeoutPeriod)
+	}
+
 	if msg.AllowlistedRewardDenoms != nil {
 		if err := ValidateAllowlistedRewardDenoms(*msg.AllowlistedRewardDenoms); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "AllowlistedRewardDenoms: %s", err.Error())
@@ -436,6 +550,47 @@ func (msg MsgRemoveConsumer) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgResumeConsumer creates a new MsgResumeConsumer instance
+func NewMsgResumeConsumer(owner, consumerId string) (*MsgResumeConsumer, error) {
+	return &MsgResumeConsumer{
+		Owner:      owner,
+		ConsumerId: consumerId,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgResumeConsumer) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgResumeConsumer, "ConsumerId: %s", err.Error())
+	}
+	return nil
+}
+
+// NewMsgTransferConsumerOwnership creates a new MsgTransferConsumerOwnership instance
+func NewMsgTransferConsumerOwnership(owner, consumerId, newOwner string) (*MsgTransferConsumerOwnership, error) {
+	return &MsgTransferConsumerOwnership{
+		Owner:      owner,
+		ConsumerId: consumerId,
+		NewOwner:   newOwner,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgTransferConsumerOwnership) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgTransferConsumerOwnership, "ConsumerId: %s", err.Error())
+	}
+
+	// Note that NewOwner is validated as an account address when handling the message in TransferConsumerOwnership
+	if strings.TrimSpace(msg.NewOwner) == "" {
+		return errorsmod.Wrapf(ErrInvalidMsgTransferConsumerOwnership, "NewOwner cannot be empty")
+	}
+	if msg.NewOwner == msg.Owner {
+		return errorsmod.Wrapf(ErrInvalidMsgTransferConsumerOwnership, "NewOwner cannot be the current owner")
+	}
+	return nil
+}
+
 //
 // Validation methods
 //
@@ -545,7 +700,8 @@ func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameter
 	// Top N corresponds to the top N% of validators that have to validate the consumer chain and can only be 0 (for an
 	// Opt In chain) or in the range [50, 100] (for a Top N chain).
 	if powerShapingParameters.Top_N != 0 && (powerShapingParameters.Top_N < 50 || powerShapingParameters.Top_N > 100) {
-		return errorsmod.Wrap(ErrInvalidPowerShapingParameters, "Top N can either be 0 or in the range [50, 100]")
+		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters,
+			"Top N can either be 0 (for an Opt In chain) or in the range [50, 100] (for a Top N chain), got %d", powerShapingParameters.Top_N)
 	}
 
 	if powerShapingParameters.ValidatorsPowerCap > 100 {
@@ -562,9 +718,49 @@ func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameter
 		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "Prioritylist: %s", err.Error())
 	}
 
+	// a prioritized validator cannot be denylisted
+	denylist := consAddressSet(powerShapingParameters.Denylist)
+	for _, address := range powerShapingParameters.Prioritylist {
+		consAddr, _ := sdk.ConsAddressFromBech32(address)
+		if denylist[string(consAddr)] {
+			return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "Prioritylist: %s is denylisted", address)
+		}
+	}
+
 	return nil
 }
 
+// GetPrioritizedValidatorsNotAllowlisted returns the validators on the priority list that are not
+// on the allowlist, if an allowlist is declared. These validators are accepted, but cannot validate
+// the consumer chain, as only allowlisted validators can, and hence the priority has no effect.
+// The power-shaping parameters are assumed to be validated with ValidatePowerShapingParameters.
+func GetPrioritizedValidatorsNotAllowlisted(powerShapingParameters PowerShapingParameters) []string {
+	if len(powerShapingParameters.Allowlist) == 0 {
+		return nil
+	}
+
+	allowlist := consAddressSet(powerShapingParameters.Allowlist)
+	var notAllowlisted []string
+	for _, address := range powerShapingParameters.Prioritylist {
+		consAddr, _ := sdk.ConsAddressFromBech32(address)
+		if !allowlist[string(consAddr)] {
+			notAllowlisted = append(notAllowlisted, address)
+		}
+	}
+	return notAllowlisted
+}
+
+// consAddressSet returns the set of the consensus addresses in the given list,
+// which is assumed to be validated with ValidateConsAddressList
+func consAddressSet(list []string) map[string]bool {
+	set := make(map[string]bool, len(list))
+	for _, address := range list {
+		consAddr, _ := sdk.ConsAddressFromBech32(address)
+		set[string(consAddr)] = true
+	}
+	return set
+}
+
 // ValidateAllowlistedRewardDenoms validates the provided allowlisted reward denoms
 func ValidateAllowlistedRewardDenoms(allowlistedRewardDenoms AllowlistedRewardDenoms) error {
 	if len(allowlistedRewardDenoms.Denoms) > MaxAllowlistedRewardDenomsPerChain {
@@ -625,6 +821,20 @@ func ValidateInitializationParameters(initializationParameters ConsumerInitializ
 		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "ConnectionId: %s", err.Error())
 	}
 
+	// a zero KeyPruningPeriod means that the unbonding period of the provider is used;
+	// otherwise, the consumer addresses must be kept for longer than the unbonding period of the consumer chain,
+	// as they can be referenced in slash requests for infractions up to an unbonding period old,
+	// plus the CCV timeout period of the consumer chain, as the slash requests it sends can be in flight for up to that period
+	if initializationParameters.KeyPruningPeriod < 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerInitializationParameters, "KeyPruningPeriod cannot be negative")
+	}
+	minKeyPruningPeriod := initializationParameters.UnbondingPeriod + initializationParameters.CcvTimeoutPeriod
+	if initializationParameters.KeyPruningPeriod > 0 && initializationParameters.KeyPruningPeriod <= minKeyPruningPeriod {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters,
+			"KeyPruningPeriod (%s) must be greater than UnbondingPeriod plus CcvTimeoutPeriod (%s)",
+			initializationParameters.KeyPruningPeriod, minKeyPruningPeriod)
+	}
+
 	return nil
 }
 
@@ -353,6 +353,101 @@ func TestValidateInitializationParameters(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "invalid - KeyPruningPeriod equal to UnbondingPeriod",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				KeyPruningPeriod:                  time.Duration(100000000000),
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - KeyPruningPeriod equal to UnbondingPeriod plus CcvTimeoutPeriod",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				KeyPruningPeriod:                  time.Duration(200000000000),
+			},
+			valid: false,
+		},
+		{
+			name: "valid - KeyPruningPeriod larger than UnbondingPeriod plus CcvTimeoutPeriod",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				KeyPruningPeriod:                  time.Duration(250000000000),
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - KeyPruningPeriod smaller than UnbondingPeriod",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				KeyPruningPeriod:                  time.Duration(50000000000),
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - negative KeyPruningPeriod",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				KeyPruningPeriod:                  -time.Duration(100000000000),
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -418,6 +513,80 @@ func TestValidateConsAddressList(t *testing.T) {
 	}
 }
 
+func TestValidatePowerShapingParametersTopN(t *testing.T) {
+	testCases := []struct {
+		name  string
+		topN  uint32
+		valid bool
+	}{
+		{
+			name:  "valid - opt-in chain",
+			topN:  0,
+			valid: true,
+		},
+		{
+			name:  "invalid - Top N of 1",
+			topN:  1,
+			valid: false,
+		},
+		{
+			name:  "invalid - Top N of 49",
+			topN:  49,
+			valid: false,
+		},
+		{
+			name:  "valid - Top N of 50",
+			topN:  50,
+			valid: true,
+		},
+		{
+			name:  "valid - Top N of 100",
+			topN:  100,
+			valid: true,
+		},
+		{
+			name:  "invalid - Top N of 101",
+			topN:  101,
+			valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidatePowerShapingParameters(types.PowerShapingParameters{Top_N: tc.topN})
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidPowerShapingParameters, tc.name)
+		}
+	}
+}
+
+func TestGetPrioritizedValidatorsNotAllowlisted(t *testing.T) {
+	consAddr1 := "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"
+	consAddr2 := "cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39"
+	consAddr3 := "cosmosvalcons1muys5jyqk4xd27e208nym85kn0t4zjcfeu63fe"
+
+	testCases := []struct {
+		name                   string
+		allowlist              []string
+		prioritylist           []string
+		expectedNotAllowlisted []string
+	}{
+		{"no allowlist", nil, []string{consAddr1, consAddr2}, nil},
+		{"all prioritized validators are allowlisted", []string{consAddr1, consAddr2}, []string{consAddr2}, nil},
+		{"prioritized validator is not allowlisted", []string{consAddr1}, []string{consAddr1, consAddr3}, []string{consAddr3}},
+		{"no prioritized validators", []string{consAddr1}, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		notAllowlisted := types.GetPrioritizedValidatorsNotAllowlisted(types.PowerShapingParameters{
+			Allowlist:    tc.allowlist,
+			Prioritylist: tc.prioritylist,
+		})
+		require.Equal(t, tc.expectedNotAllowlisted, notAllowlisted, tc.name)
+	}
+}
+
 func TestValidateByteSlice(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -640,6 +809,50 @@ func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
 			"validchainid-0",
 			true,
 		},
+		{
+			"prioritized validator is denylisted",
+			types.PowerShapingParameters{
+				Top_N:        0,
+				Allowlist:    nil,
+				Denylist:     []string{consAddr2},
+				Prioritylist: []string{consAddr1, consAddr2},
+			},
+			"validchainid-0",
+			false,
+		},
+		{
+			"prioritized validator is allowlisted and denylisted",
+			types.PowerShapingParameters{
+				Top_N:        0,
+				Allowlist:    []string{consAddr1, consAddr2},
+				Denylist:     []string{consAddr2},
+				Prioritylist: []string{consAddr2},
+			},
+			"validchainid-0",
+			false,
+		},
+		{
+			"prioritized validator is not allowlisted",
+			types.PowerShapingParameters{
+				Top_N:        0,
+				Allowlist:    []string{consAddr1},
+				Denylist:     nil,
+				Prioritylist: []string{consAddr1, consAddr3},
+			},
+			"validchainid-0",
+			true,
+		},
+		{
+			"prioritized validators without allowlist",
+			types.PowerShapingParameters{
+				Top_N:        0,
+				Allowlist:    nil,
+				Denylist:     []string{consAddr2},
+				Prioritylist: []string{consAddr1, consAddr3},
+			},
+			"validchainid-0",
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -52,6 +52,46 @@ const (
 	// DefaultMaxProviderConsensusValidators is the default maximum number of validators that will
 	// be passed on from the staking module to the consensus engine on the provider.
 	DefaultMaxProviderConsensusValidators = 180
+
+	// DefaultKeyAssignmentMinInterval is the default minimum number of blocks between two consecutive
+	// key assignments of a validator on the same consumer chain. By default, there is no limit.
+	DefaultKeyAssignmentMinInterval = int64(0)
+
+	// DefaultMaxValsetUpdateBlockHeights is the default maximal number of most recent valset update ids
+	// for which the provider block height is recorded to be queried.
+	DefaultMaxValsetUpdateBlockHeights = int64(100)
+
+	// DefaultDowntimeSlashGracePeriod is the default period by which the jailing of a validator
+	// for a downtime infraction is deferred. By default, validators are jailed immediately.
+	DefaultDowntimeSlashGracePeriod = time.Duration(0)
+
+	// DefaultMaxConsumerPhaseHistoryLength is the default maximal number of most recent phase transitions
+	// that are recorded for every consumer chain
+	DefaultMaxConsumerPhaseHistoryLength = int64(10)
+
+	// DefaultSlashMeterMinAbsoluteAllowance is the default minimal allowance of the slash meter
+	// in units of voting power. By default, a non-zero allowance is guaranteed.
+	DefaultSlashMeterMinAbsoluteAllowance = int64(1)
+
+	// DefaultKeyPruneWarningWindow is the default period before a consumer address is pruned
+	// during which a warning event is emitted. By default, no warning is emitted.
+	DefaultKeyPruneWarningWindow = time.Duration(0)
+
+	// DefaultMaxForcedConsumersPerValidator is the default maximum number of Top N consumer chains
+	// a validator can be forced to validate. By default, there is no limit.
+	DefaultMaxForcedConsumersPerValidator = int64(0)
+
+	// DefaultPerConsumerSlashMeters is the default value of the per consumer slash meters param.
+	// By default, the slash packets of all consumer chains are throttled by the global slash meter.
+	DefaultPerConsumerSlashMeters = false
+
+	// DefaultSlashMeterHistoryLength is the default number of most recent blocks for which
+	// the slash meter value is recorded. By default, the slash meter values are not recorded.
+	DefaultSlashMeterHistoryLength = int64(0)
+
+	// DefaultRejectUnknownSlashValidators is the default value of the reject unknown slash validators param.
+	// By default, slash packets for unknown validators are dropped and acknowledged as handled.
+	DefaultRejectUnknownSlashValidators = false
 )
 
 // Reflection based keys for params subspace
@@ -85,6 +125,16 @@ func NewParams(
 	blocksPerEpoch int64,
 	numberOfEpochsToStartReceivingRewards int64,
 	maxProviderConsensusValidators int64,
+	keyAssignmentMinInterval int64,
+	maxValsetUpdateBlockHeights int64,
+	downtimeSlashGracePeriod time.Duration,
+	maxConsumerPhaseHistoryLength int64,
+	slashMeterMinAbsoluteAllowance int64,
+	keyPruneWarningWindow time.Duration,
+	maxForcedConsumersPerValidator int64,
+	perConsumerSlashMeters bool,
+	slashMeterHistoryLength int64,
+	rejectUnknownSlashValidators bool,
 ) Params {
 	return Params{
 		TemplateClient:                        cs,
@@ -96,6 +146,16 @@ func NewParams(
 		BlocksPerEpoch:                        blocksPerEpoch,
 		NumberOfEpochsToStartReceivingRewards: numberOfEpochsToStartReceivingRewards,
 		MaxProviderConsensusValidators:        maxProviderConsensusValidators,
+		KeyAssignmentMinInterval:              keyAssignmentMinInterval,
+		MaxValsetUpdateBlockHeights:           maxValsetUpdateBlockHeights,
+		DowntimeSlashGracePeriod:              downtimeSlashGracePeriod,
+		MaxConsumerPhaseHistoryLength:         maxConsumerPhaseHistoryLength,
+		SlashMeterMinAbsoluteAllowance:        slashMeterMinAbsoluteAllowance,
+		KeyPruneWarningWindow:                 keyPruneWarningWindow,
+		MaxForcedConsumersPerValidator:        maxForcedConsumersPerValidator,
+		PerConsumerSlashMeters:                perConsumerSlashMeters,
+		SlashMeterHistoryLength:               slashMeterHistoryLength,
+		RejectUnknownSlashValidators:          rejectUnknownSlashValidators,
 	}
 }
 
@@ -132,6 +192,16 @@ func DefaultParams() Params {
 		DefaultBlocksPerEpoch,
 		DefaultNumberOfEpochsToStartReceivingRewards,
 		DefaultMaxProviderConsensusValidators,
+		DefaultKeyAssignmentMinInterval,
+		DefaultMaxValsetUpdateBlockHeights,
+		DefaultDowntimeSlashGracePeriod,
+		DefaultMaxConsumerPhaseHistoryLength,
+		DefaultSlashMeterMinAbsoluteAllowance,
+		DefaultKeyPruneWarningWindow,
+		DefaultMaxForcedConsumersPerValidator,
+		DefaultPerConsumerSlashMeters,
+		DefaultSlashMeterHistoryLength,
+		DefaultRejectUnknownSlashValidators,
 	)
 }
 
@@ -168,6 +238,30 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidatePositiveInt64(p.MaxProviderConsensusValidators); err != nil {
 		return fmt.Errorf("max provider consensus validators is invalid: %s", err)
 	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.KeyAssignmentMinInterval); err != nil {
+		return fmt.Errorf("key assignment min interval is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.MaxValsetUpdateBlockHeights); err != nil {
+		return fmt.Errorf("max valset update block heights is invalid: %s", err)
+	}
+	if p.DowntimeSlashGracePeriod < 0 {
+		return fmt.Errorf("downtime slash grace period is invalid: %s is negative", p.DowntimeSlashGracePeriod)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.MaxConsumerPhaseHistoryLength); err != nil {
+		return fmt.Errorf("max consumer phase history length is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidatePositiveInt64(p.SlashMeterMinAbsoluteAllowance); err != nil {
+		return fmt.Errorf("slash meter min absolute allowance is invalid: %s", err)
+	}
+	if p.KeyPruneWarningWindow < 0 {
+		return fmt.Errorf("key prune warning window is invalid: %s is negative", p.KeyPruneWarningWindow)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.MaxForcedConsumersPerValidator); err != nil {
+		return fmt.Errorf("max forced consumers per validator is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.SlashMeterHistoryLength); err != nil {
+		return fmt.Errorf("slash meter history length is invalid: %s", err)
+	}
 	return nil
 }
 
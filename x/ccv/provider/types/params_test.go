@@ -26,36 +26,72 @@ func TestValidateParams(t *testing.T) {
 		{"custom valid params", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), true},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), true},
 		{"custom invalid params", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				0, clienttypes.Height{}, nil, []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"blank client", types.NewParams(&ibctmtypes.ClientState{},
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
-		{"nil client", types.NewParams(nil, "0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
+		{"nil client", types.NewParams(nil, "0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"0 trusting period fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.00", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.00", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"0 ccv timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", 0, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", 0, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"0 slash meter replenish period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 0, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 0, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"slash meter replenish fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "1.5", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "1.5", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"invalid consumer reward denom registration fee denom", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"invalid consumer reward denom registration fee amount", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
 		{"invalid number of epochs to start receiving rewards", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 0, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 0, 180, 1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
+		{"negative key assignment min interval", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, -1, 0, 0, 10, 1, 0, 0, false, 0, false), false},
+		{"0 key assignment min interval", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 0, 0, 10, 1, 0, 0, false, 0, false), true},
+		{"negative max valset update block heights", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, -1, 0, 10, 1, 0, 0, false, 0, false), false},
+		{"negative downtime slash grace period", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, -time.Minute, 10, 1, 0, 0, false, 0, false), false},
+		{"negative max consumer phase history length", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, -1, 1, 0, 0, false, 0, false), false},
+		{"0 slash meter min absolute allowance", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 0, 0, 0, false, 0, false), false},
+		{"negative key prune warning window", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, -time.Minute, 0, false, 0, false), false},
+		{"negative max forced consumers per validator", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, -1, false, 0, false), false},
+		{"negative slash meter history length", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1, 0, 0, 10, 1, 0, 0, false, -1, false), false},
 	}
 
 	for _, tc := range testCases {
@@ -12,6 +12,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types4 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -85,6 +86,78 @@ func (ConsumerPhase) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{0}
 }
 
+// SlashPacketStatus indicates how a slash packet received from a consumer chain was processed
+type SlashPacketStatus int32
+
+const (
+	// UNKNOWN defines a slash packet that was not received, that was invalid,
+	// or whose record was pruned after the unbonding period.
+	SLASH_PACKET_STATUS_UNKNOWN SlashPacketStatus = 0
+	// HANDLED defines a slash packet that was acknowledged as handled.
+	SLASH_PACKET_STATUS_HANDLED SlashPacketStatus = 1
+	// THROTTLED defines a slash packet that was bounced because the slash meter was negative.
+	SLASH_PACKET_STATUS_THROTTLED SlashPacketStatus = 2
+	// RECORDED defines a slash packet that was acknowledged as handled and only recorded,
+	// without jailing the validator, as the consumer chain is in the record-only slash mode.
+	SLASH_PACKET_STATUS_RECORDED SlashPacketStatus = 3
+	// PENDING defines a slash packet that was acknowledged as handled, but whose handling
+	// is deferred until the downtime slash grace period elapses.
+	SLASH_PACKET_STATUS_PENDING SlashPacketStatus = 4
+)
+
+var SlashPacketStatus_name = map[int32]string{
+	0: "SLASH_PACKET_STATUS_UNKNOWN",
+	1: "SLASH_PACKET_STATUS_HANDLED",
+	2: "SLASH_PACKET_STATUS_THROTTLED",
+	3: "SLASH_PACKET_STATUS_RECORDED",
+	4: "SLASH_PACKET_STATUS_PENDING",
+}
+
+var SlashPacketStatus_value = map[string]int32{
+	"SLASH_PACKET_STATUS_UNKNOWN":   0,
+	"SLASH_PACKET_STATUS_HANDLED":   1,
+	"SLASH_PACKET_STATUS_THROTTLED": 2,
+	"SLASH_PACKET_STATUS_RECORDED":  3,
+	"SLASH_PACKET_STATUS_PENDING":   4,
+}
+
+func (x SlashPacketStatus) String() string {
+	return proto.EnumName(SlashPacketStatus_name, int32(x))
+}
+
+func (SlashPacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{1}
+}
+
+// ConsumerSlashMode defines how the downtime slash packets received from a consumer chain are handled
+type ConsumerSlashMode int32
+
+const (
+	// JAIL defines that the validators reported by the slash packets are jailed (and slashed).
+	SLASH_MODE_JAIL ConsumerSlashMode = 0
+	// RECORD_ONLY defines that the slash packets are only recorded and that the reported validators
+	// are neither jailed nor slashed, e.g., for low-stakes consumer chains.
+	SLASH_MODE_RECORD_ONLY ConsumerSlashMode = 1
+)
+
+var ConsumerSlashMode_name = map[int32]string{
+	0: "SLASH_MODE_JAIL",
+	1: "SLASH_MODE_RECORD_ONLY",
+}
+
+var ConsumerSlashMode_value = map[string]int32{
+	"SLASH_MODE_JAIL":        0,
+	"SLASH_MODE_RECORD_ONLY": 1,
+}
+
+func (x ConsumerSlashMode) String() string {
+	return proto.EnumName(ConsumerSlashMode_name, int32(x))
+}
+
+func (ConsumerSlashMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{2}
+}
+
 // WARNING: This message is deprecated in favor of `MsgCreateConsumer`.
 // ConsumerAdditionProposal is a governance proposal on the provider chain to
 // spawn a new consumer chain. If it passes, then all validators on the provider
@@ -673,6 +746,43 @@ type Params struct {
 	// The maximal number of validators that will be passed
 	// to the consensus engine on the provider.
 	MaxProviderConsensusValidators int64 `protobuf:"varint,12,opt,name=max_provider_consensus_validators,json=maxProviderConsensusValidators,proto3" json:"max_provider_consensus_validators,omitempty"`
+	// The minimum number of blocks between two consecutive key assignments
+	// of a validator on the same consumer chain. Zero disables the limit.
+	KeyAssignmentMinInterval int64 `protobuf:"varint,13,opt,name=key_assignment_min_interval,json=keyAssignmentMinInterval,proto3" json:"key_assignment_min_interval,omitempty"`
+	// The maximal number of most recent valset update ids for which the
+	// provider block height is recorded to be queried. Zero disables the recording.
+	MaxValsetUpdateBlockHeights int64 `protobuf:"varint,14,opt,name=max_valset_update_block_heights,json=maxValsetUpdateBlockHeights,proto3" json:"max_valset_update_block_heights,omitempty"`
+	// The period by which the jailing of a validator for a downtime infraction
+	// on a consumer chain is deferred. Zero disables the grace period.
+	DowntimeSlashGracePeriod time.Duration `protobuf:"bytes,15,opt,name=downtime_slash_grace_period,json=downtimeSlashGracePeriod,proto3,stdduration" json:"downtime_slash_grace_period"`
+	// The maximal number of most recent phase transitions that are recorded
+	// for every consumer chain. Zero disables the recording.
+	MaxConsumerPhaseHistoryLength int64 `protobuf:"varint,16,opt,name=max_consumer_phase_history_length,json=maxConsumerPhaseHistoryLength,proto3" json:"max_consumer_phase_history_length,omitempty"`
+	// The minimal allowance of the slash meter in units of voting power, i.e.,
+	// the allowance is the maximum between this value and the slash meter
+	// replenish fraction of the total voting power.
+	SlashMeterMinAbsoluteAllowance int64 `protobuf:"varint,17,opt,name=slash_meter_min_absolute_allowance,json=slashMeterMinAbsoluteAllowance,proto3" json:"slash_meter_min_absolute_allowance,omitempty"`
+	// The period before a consumer address is pruned during which an event
+	// is emitted to warn about the upcoming pruning. Zero disables the warning.
+	KeyPruneWarningWindow time.Duration `protobuf:"bytes,18,opt,name=key_prune_warning_window,json=keyPruneWarningWindow,proto3,stdduration" json:"key_prune_warning_window"`
+	// The maximum number of Top N consumer chains a validator can be forced
+	// to validate. Top N consumer chains with lower consumer ids take precedence.
+	// Zero means there is no limit.
+	MaxForcedConsumersPerValidator int64 `protobuf:"varint,19,opt,name=max_forced_consumers_per_validator,json=maxForcedConsumersPerValidator,proto3" json:"max_forced_consumers_per_validator,omitempty"`
+	// Whether every consumer chain has its own slash meter, replenished
+	// independently of the global slash meter. If true, the slash packets of
+	// a consumer chain are throttled by both its own slash meter and the global
+	// slash meter, which remains an overall cap. If false, the slash packets of
+	// all consumer chains are throttled by the global slash meter only.
+	PerConsumerSlashMeters bool `protobuf:"varint,20,opt,name=per_consumer_slash_meters,json=perConsumerSlashMeters,proto3" json:"per_consumer_slash_meters,omitempty"`
+	// The number of most recent blocks for which the value of the slash meter
+	// at the end of the block is recorded. Zero disables the recording and
+	// clears the recorded values.
+	SlashMeterHistoryLength int64 `protobuf:"varint,21,opt,name=slash_meter_history_length,json=slashMeterHistoryLength,proto3" json:"slash_meter_history_length,omitempty"`
+	// Whether slash packets for validators that do not exist on the provider
+	// chain are rejected with an error acknowledgement. If false, such slash
+	// packets are dropped and a slash packet handled acknowledgement is returned.
+	RejectUnknownSlashValidators bool `protobuf:"varint,22,opt,name=reject_unknown_slash_validators,json=rejectUnknownSlashValidators,proto3" json:"reject_unknown_slash_validators,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -771,6 +881,141 @@ func (m *Params) GetMaxProviderConsensusValidators() int64 {
 	return 0
 }
 
+func (m *Params) GetKeyAssignmentMinInterval() int64 {
+	if m != nil {
+		return m.KeyAssignmentMinInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValsetUpdateBlockHeights() int64 {
+	if m != nil {
+		return m.MaxValsetUpdateBlockHeights
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeSlashGracePeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeSlashGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConsumerPhaseHistoryLength() int64 {
+	if m != nil {
+		return m.MaxConsumerPhaseHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetSlashMeterMinAbsoluteAllowance() int64 {
+	if m != nil {
+		return m.SlashMeterMinAbsoluteAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetKeyPruneWarningWindow() time.Duration {
+	if m != nil {
+		return m.KeyPruneWarningWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxForcedConsumersPerValidator() int64 {
+	if m != nil {
+		return m.MaxForcedConsumersPerValidator
+	}
+	return 0
+}
+
+func (m *Params) GetPerConsumerSlashMeters() bool {
+	if m != nil {
+		return m.PerConsumerSlashMeters
+	}
+	return false
+}
+
+func (m *Params) GetSlashMeterHistoryLength() int64 {
+	if m != nil {
+		return m.SlashMeterHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetRejectUnknownSlashValidators() bool {
+	if m != nil {
+		return m.RejectUnknownSlashValidators
+	}
+	return false
+}
+
+// PendingDowntimeSlash is a downtime slash packet whose handling is deferred
+// by the downtime slash grace period
+type PendingDowntimeSlash struct {
+	// the time after which the slash packet is handled
+	JailTime time.Time `protobuf:"bytes,1,opt,name=jail_time,json=jailTime,proto3,stdtime" json:"jail_time"`
+	// the data of the slash packet
+	SlashPacketData types3.SlashPacketData `protobuf:"bytes,2,opt,name=slash_packet_data,json=slashPacketData,proto3" json:"slash_packet_data"`
+	// the IBC sequence of the slash packet, used to update its record once it is handled
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *PendingDowntimeSlash) Reset()         { *m = PendingDowntimeSlash{} }
+func (m *PendingDowntimeSlash) String() string { return proto.CompactTextString(m) }
+func (*PendingDowntimeSlash) ProtoMessage()    {}
+func (*PendingDowntimeSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{7}
+}
+func (m *PendingDowntimeSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDowntimeSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDowntimeSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingDowntimeSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDowntimeSlash.Merge(m, src)
+}
+func (m *PendingDowntimeSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDowntimeSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDowntimeSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDowntimeSlash proto.InternalMessageInfo
+
+func (m *PendingDowntimeSlash) GetJailTime() time.Time {
+	if m != nil {
+		return m.JailTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingDowntimeSlash) GetSlashPacketData() types3.SlashPacketData {
+	if m != nil {
+		return m.SlashPacketData
+	}
+	return types3.SlashPacketData{}
+}
+
+func (m *PendingDowntimeSlash) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // SlashAcks contains cons addresses of consumer chain validators
 // successfully slashed on the provider chain.
 type SlashAcks struct {
@@ -781,7 +1026,7 @@ func (m *SlashAcks) Reset()         { *m = SlashAcks{} }
 func (m *SlashAcks) String() string { return proto.CompactTextString(m) }
 func (*SlashAcks) ProtoMessage()    {}
 func (*SlashAcks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{7}
+	return fileDescriptor_f22ec409a72b7b72, []int{8}
 }
 func (m *SlashAcks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +1073,7 @@ func (m *ConsumerAdditionProposals) Reset()         { *m = ConsumerAdditionPropo
 func (m *ConsumerAdditionProposals) String() string { return proto.CompactTextString(m) }
 func (*ConsumerAdditionProposals) ProtoMessage()    {}
 func (*ConsumerAdditionProposals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{8}
+	return fileDescriptor_f22ec409a72b7b72, []int{9}
 }
 func (m *ConsumerAdditionProposals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1120,7 @@ func (m *ConsumerRemovalProposals) Reset()         { *m = ConsumerRemovalProposa
 func (m *ConsumerRemovalProposals) String() string { return proto.CompactTextString(m) }
 func (*ConsumerRemovalProposals) ProtoMessage()    {}
 func (*ConsumerRemovalProposals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{9}
+	return fileDescriptor_f22ec409a72b7b72, []int{10}
 }
 func (m *ConsumerRemovalProposals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -920,7 +1165,7 @@ func (m *AddressList) Reset()         { *m = AddressList{} }
 func (m *AddressList) String() string { return proto.CompactTextString(m) }
 func (*AddressList) ProtoMessage()    {}
 func (*AddressList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{10}
+	return fileDescriptor_f22ec409a72b7b72, []int{11}
 }
 func (m *AddressList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1214,7 @@ func (m *ChannelToChain) Reset()         { *m = ChannelToChain{} }
 func (m *ChannelToChain) String() string { return proto.CompactTextString(m) }
 func (*ChannelToChain) ProtoMessage()    {}
 func (*ChannelToChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{11}
+	return fileDescriptor_f22ec409a72b7b72, []int{12}
 }
 func (m *ChannelToChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1021,7 +1266,7 @@ func (m *ValidatorSetChangePackets) Reset()         { *m = ValidatorSetChangePac
 func (m *ValidatorSetChangePackets) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetChangePackets) ProtoMessage()    {}
 func (*ValidatorSetChangePackets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{12}
+	return fileDescriptor_f22ec409a72b7b72, []int{13}
 }
 func (m *ValidatorSetChangePackets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1312,7 @@ func (m *KeyAssignmentReplacement) Reset()         { *m = KeyAssignmentReplaceme
 func (m *KeyAssignmentReplacement) String() string { return proto.CompactTextString(m) }
 func (*KeyAssignmentReplacement) ProtoMessage()    {}
 func (*KeyAssignmentReplacement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{13}
+	return fileDescriptor_f22ec409a72b7b72, []int{14}
 }
 func (m *KeyAssignmentReplacement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,7 +1375,7 @@ func (m *ValidatorConsumerPubKey) Reset()         { *m = ValidatorConsumerPubKey
 func (m *ValidatorConsumerPubKey) String() string { return proto.CompactTextString(m) }
 func (*ValidatorConsumerPubKey) ProtoMessage()    {}
 func (*ValidatorConsumerPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{14}
+	return fileDescriptor_f22ec409a72b7b72, []int{15}
 }
 func (m *ValidatorConsumerPubKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1193,7 +1438,7 @@ func (m *ValidatorByConsumerAddr) Reset()         { *m = ValidatorByConsumerAddr
 func (m *ValidatorByConsumerAddr) String() string { return proto.CompactTextString(m) }
 func (*ValidatorByConsumerAddr) ProtoMessage()    {}
 func (*ValidatorByConsumerAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{15}
+	return fileDescriptor_f22ec409a72b7b72, []int{16}
 }
 func (m *ValidatorByConsumerAddr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1500,7 @@ func (m *ConsumerAddrsToPruneV2) Reset()         { *m = ConsumerAddrsToPruneV2{}
 func (m *ConsumerAddrsToPruneV2) String() string { return proto.CompactTextString(m) }
 func (*ConsumerAddrsToPruneV2) ProtoMessage()    {}
 func (*ConsumerAddrsToPruneV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{16}
+	return fileDescriptor_f22ec409a72b7b72, []int{17}
 }
 func (m *ConsumerAddrsToPruneV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1573,7 @@ func (m *ConsensusValidator) Reset()         { *m = ConsensusValidator{} }
 func (m *ConsensusValidator) String() string { return proto.CompactTextString(m) }
 func (*ConsensusValidator) ProtoMessage()    {}
 func (*ConsensusValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{17}
+	return fileDescriptor_f22ec409a72b7b72, []int{18}
 }
 func (m *ConsensusValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1641,7 @@ func (m *ConsumerRewardsAllocation) Reset()         { *m = ConsumerRewardsAlloca
 func (m *ConsumerRewardsAllocation) String() string { return proto.CompactTextString(m) }
 func (*ConsumerRewardsAllocation) ProtoMessage()    {}
 func (*ConsumerRewardsAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{18}
+	return fileDescriptor_f22ec409a72b7b72, []int{19}
 }
 func (m *ConsumerRewardsAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1446,7 +1691,7 @@ func (m *ConsumerMetadata) Reset()         { *m = ConsumerMetadata{} }
 func (m *ConsumerMetadata) String() string { return proto.CompactTextString(m) }
 func (*ConsumerMetadata) ProtoMessage()    {}
 func (*ConsumerMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{19}
+	return fileDescriptor_f22ec409a72b7b72, []int{20}
 }
 func (m *ConsumerMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,13 +1792,18 @@ type ConsumerInitializationParameters struct {
 	// Note that a standalone chain can transition to a consumer chain while
 	// maintaining existing IBC channels to other chains by providing a valid connection_id.
 	ConnectionId string `protobuf:"bytes,12,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The period after which consumer addresses that are no longer in use
+	// (e.g., after a key re-assignment) are pruned from the provider state.
+	// If zero, the unbonding period of the provider is used instead.
+	// Otherwise, it must be greater than the unbonding period plus the CCV timeout period.
+	KeyPruningPeriod time.Duration `protobuf:"bytes,13,opt,name=key_pruning_period,json=keyPruningPeriod,proto3,stdduration" json:"key_pruning_period"`
 }
 
 func (m *ConsumerInitializationParameters) Reset()         { *m = ConsumerInitializationParameters{} }
 func (m *ConsumerInitializationParameters) String() string { return proto.CompactTextString(m) }
 func (*ConsumerInitializationParameters) ProtoMessage()    {}
 func (*ConsumerInitializationParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{20}
+	return fileDescriptor_f22ec409a72b7b72, []int{21}
 }
 func (m *ConsumerInitializationParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1666,6 +1916,13 @@ func (m *ConsumerInitializationParameters) GetConnectionId() string {
 	return ""
 }
 
+func (m *ConsumerInitializationParameters) GetKeyPruningPeriod() time.Duration {
+	if m != nil {
+		return m.KeyPruningPeriod
+	}
+	return 0
+}
+
 // PowerShapingParameters contains parameters that shape the validator set that we send to the consumer chain
 type PowerShapingParameters struct {
 	// Corresponds to the percentage of validators that have to validate the chain under the Top N case.
@@ -1724,7 +1981,7 @@ func (m *PowerShapingParameters) Reset()         { *m = PowerShapingParameters{}
 func (m *PowerShapingParameters) String() string { return proto.CompactTextString(m) }
 func (*PowerShapingParameters) ProtoMessage()    {}
 func (*PowerShapingParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{21}
+	return fileDescriptor_f22ec409a72b7b72, []int{22}
 }
 func (m *PowerShapingParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1819,7 +2076,7 @@ func (m *ConsumerIds) Reset()         { *m = ConsumerIds{} }
 func (m *ConsumerIds) String() string { return proto.CompactTextString(m) }
 func (*ConsumerIds) ProtoMessage()    {}
 func (*ConsumerIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{22}
+	return fileDescriptor_f22ec409a72b7b72, []int{23}
 }
 func (m *ConsumerIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1855,6 +2112,117 @@ func (m *ConsumerIds) GetIds() []string {
 	return nil
 }
 
+// ConsumerPhaseTransition records the transition of a consumer chain
+// from one phase to another
+type ConsumerPhaseTransition struct {
+	// the provider block height at which the transition took place
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the phase of the consumer chain before the transition
+	OldPhase ConsumerPhase `protobuf:"varint,2,opt,name=old_phase,json=oldPhase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"old_phase,omitempty"`
+	// the phase of the consumer chain after the transition
+	NewPhase ConsumerPhase `protobuf:"varint,3,opt,name=new_phase,json=newPhase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"new_phase,omitempty"`
+}
+
+func (m *ConsumerPhaseTransition) Reset()         { *m = ConsumerPhaseTransition{} }
+func (m *ConsumerPhaseTransition) String() string { return proto.CompactTextString(m) }
+func (*ConsumerPhaseTransition) ProtoMessage()    {}
+func (*ConsumerPhaseTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{24}
+}
+func (m *ConsumerPhaseTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerPhaseTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerPhaseTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerPhaseTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerPhaseTransition.Merge(m, src)
+}
+func (m *ConsumerPhaseTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerPhaseTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerPhaseTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerPhaseTransition proto.InternalMessageInfo
+
+func (m *ConsumerPhaseTransition) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsumerPhaseTransition) GetOldPhase() ConsumerPhase {
+	if m != nil {
+		return m.OldPhase
+	}
+	return CONSUMER_PHASE_UNSPECIFIED
+}
+
+func (m *ConsumerPhaseTransition) GetNewPhase() ConsumerPhase {
+	if m != nil {
+		return m.NewPhase
+	}
+	return CONSUMER_PHASE_UNSPECIFIED
+}
+
+// ConsumerPhaseHistory contains the most recent phase transitions of a consumer chain,
+// ordered from the oldest to the newest
+type ConsumerPhaseHistory struct {
+	Transitions []ConsumerPhaseTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions"`
+}
+
+func (m *ConsumerPhaseHistory) Reset()         { *m = ConsumerPhaseHistory{} }
+func (m *ConsumerPhaseHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsumerPhaseHistory) ProtoMessage()    {}
+func (*ConsumerPhaseHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{25}
+}
+func (m *ConsumerPhaseHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerPhaseHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerPhaseHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerPhaseHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerPhaseHistory.Merge(m, src)
+}
+func (m *ConsumerPhaseHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerPhaseHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerPhaseHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerPhaseHistory proto.InternalMessageInfo
+
+func (m *ConsumerPhaseHistory) GetTransitions() []ConsumerPhaseTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
 // AllowlistedRewardDenoms corresponds to the denoms allowlisted by a specific consumer id
 type AllowlistedRewardDenoms struct {
 	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
@@ -1864,7 +2232,7 @@ func (m *AllowlistedRewardDenoms) Reset()         { *m = AllowlistedRewardDenoms
 func (m *AllowlistedRewardDenoms) String() string { return proto.CompactTextString(m) }
 func (*AllowlistedRewardDenoms) ProtoMessage()    {}
 func (*AllowlistedRewardDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{23}
+	return fileDescriptor_f22ec409a72b7b72, []int{26}
 }
 func (m *AllowlistedRewardDenoms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1909,7 +2277,7 @@ func (m *InfractionParameters) Reset()         { *m = InfractionParameters{} }
 func (m *InfractionParameters) String() string { return proto.CompactTextString(m) }
 func (*InfractionParameters) ProtoMessage()    {}
 func (*InfractionParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{24}
+	return fileDescriptor_f22ec409a72b7b72, []int{27}
 }
 func (m *InfractionParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1964,7 +2332,7 @@ func (m *SlashJailParameters) Reset()         { *m = SlashJailParameters{} }
 func (m *SlashJailParameters) String() string { return proto.CompactTextString(m) }
 func (*SlashJailParameters) ProtoMessage()    {}
 func (*SlashJailParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{25}
+	return fileDescriptor_f22ec409a72b7b72, []int{28}
 }
 func (m *SlashJailParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2007,205 +2375,452 @@ func (m *SlashJailParameters) GetTombstone() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerPhase", ConsumerPhase_name, ConsumerPhase_value)
-	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
-	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
-	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
-	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
-	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
-	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
-	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
-	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
-	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
-	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
-	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
-	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
-	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
-	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
-	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
-	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
-	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
-	proto.RegisterType((*ConsensusValidator)(nil), "interchain_security.ccv.provider.v1.ConsensusValidator")
-	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
-	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
-	proto.RegisterType((*ConsumerInitializationParameters)(nil), "interchain_security.ccv.provider.v1.ConsumerInitializationParameters")
-	proto.RegisterType((*PowerShapingParameters)(nil), "interchain_security.ccv.provider.v1.PowerShapingParameters")
-	proto.RegisterType((*ConsumerIds)(nil), "interchain_security.ccv.provider.v1.ConsumerIds")
-	proto.RegisterType((*AllowlistedRewardDenoms)(nil), "interchain_security.ccv.provider.v1.AllowlistedRewardDenoms")
-	proto.RegisterType((*InfractionParameters)(nil), "interchain_security.ccv.provider.v1.InfractionParameters")
-	proto.RegisterType((*SlashJailParameters)(nil), "interchain_security.ccv.provider.v1.SlashJailParameters")
+// JailingReason records the consumer chain and the infraction for which
+// a validator was jailed on the provider chain
+type JailingReason struct {
+	// the consumer chain that sent the slash packet
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the infraction reported in the slash packet
+	Infraction types4.Infraction `protobuf:"varint,2,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+func (m *JailingReason) Reset()         { *m = JailingReason{} }
+func (m *JailingReason) String() string { return proto.CompactTextString(m) }
+func (*JailingReason) ProtoMessage()    {}
+func (*JailingReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{29}
 }
-
-var fileDescriptor_f22ec409a72b7b72 = []byte{
-	// 2443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x4b, 0x6f, 0x1b, 0xc7,
-	0x59, 0x2b, 0x52, 0x12, 0x39, 0xd4, 0x83, 0x1a, 0x29, 0x32, 0x25, 0x2b, 0x24, 0xbd, 0x69, 0x02,
-	0x35, 0xae, 0xc9, 0x48, 0x01, 0x5a, 0xc3, 0x6d, 0x10, 0x50, 0x24, 0x63, 0xd1, 0x0f, 0x99, 0x5d,
-	0xd2, 0x0a, 0xea, 0xa2, 0x58, 0x0c, 0x77, 0x47, 0xe4, 0x44, 0xbb, 0x3b, 0xeb, 0x9d, 0x21, 0x65,
-	0xf6, 0xd0, 0x73, 0x2e, 0x05, 0xd2, 0x5b, 0xd0, 0x4b, 0x03, 0xf4, 0x52, 0xf4, 0xd2, 0x1e, 0x82,
-	0xfc, 0x80, 0x5e, 0x9a, 0x16, 0x28, 0x90, 0xf6, 0x54, 0x14, 0x85, 0x53, 0xd8, 0x87, 0x1e, 0x0a,
-	0xb4, 0xe7, 0xde, 0x8a, 0x99, 0x7d, 0x70, 0xa9, 0x97, 0x69, 0xd8, 0xee, 0x45, 0xda, 0xf9, 0x5e,
-	0xf3, 0x7d, 0x33, 0xdf, 0x73, 0x08, 0x76, 0x88, 0xc3, 0xb1, 0x67, 0xf4, 0x10, 0x71, 0x74, 0x86,
-	0x8d, 0xbe, 0x47, 0xf8, 0xb0, 0x6c, 0x18, 0x83, 0xb2, 0xeb, 0xd1, 0x01, 0x31, 0xb1, 0x57, 0x1e,
-	0x6c, 0x47, 0xdf, 0x25, 0xd7, 0xa3, 0x9c, 0xc2, 0x37, 0xce, 0xe0, 0x29, 0x19, 0xc6, 0xa0, 0x14,
-	0xd1, 0x0d, 0xb6, 0x37, 0x96, 0x91, 0x4d, 0x1c, 0x5a, 0x96, 0x7f, 0x7d, 0xbe, 0x8d, 0xbc, 0x41,
-	0x99, 0x4d, 0x59, 0xb9, 0x83, 0x18, 0x2e, 0x0f, 0xb6, 0x3b, 0x98, 0xa3, 0xed, 0xb2, 0x41, 0x89,
-	0x13, 0xe0, 0xdf, 0x0a, 0xf0, 0x58, 0x08, 0x71, 0x8c, 0x11, 0x4d, 0x08, 0x08, 0xe8, 0xd6, 0x7d,
-	0x3a, 0x5d, 0xae, 0xca, 0xfe, 0x22, 0x40, 0xad, 0x76, 0x69, 0x97, 0xfa, 0x70, 0xf1, 0x15, 0x6e,
-	0xdc, 0xa5, 0xb4, 0x6b, 0xe1, 0xb2, 0x5c, 0x75, 0xfa, 0x87, 0x65, 0xb3, 0xef, 0x21, 0x4e, 0x68,
-	0xb8, 0x71, 0xe1, 0x24, 0x9e, 0x13, 0x1b, 0x33, 0x8e, 0x6c, 0x37, 0x24, 0x20, 0x1d, 0xa3, 0x6c,
-	0x50, 0x0f, 0x97, 0x0d, 0x8b, 0x60, 0x87, 0x8b, 0x43, 0xf1, 0xbf, 0x02, 0x82, 0xb2, 0x20, 0xb0,
-	0x48, 0xb7, 0xc7, 0x7d, 0x30, 0x2b, 0x73, 0xec, 0x98, 0xd8, 0xb3, 0x89, 0x4f, 0x3c, 0x5a, 0x05,
-	0x0c, 0x6f, 0x9e, 0x77, 0xee, 0x83, 0xed, 0xf2, 0x31, 0xf1, 0x42, 0x53, 0x37, 0x63, 0x62, 0x0c,
-	0x6f, 0xe8, 0x72, 0x5a, 0x3e, 0xc2, 0xc3, 0xc0, 0x5a, 0xf5, 0xbf, 0x29, 0x90, 0xab, 0x52, 0x87,
-	0xf5, 0x6d, 0xec, 0x55, 0x4c, 0x93, 0x08, 0x93, 0x9a, 0x1e, 0x75, 0x29, 0x43, 0x16, 0x5c, 0x05,
-	0x33, 0x9c, 0x70, 0x0b, 0xe7, 0x94, 0xa2, 0xb2, 0x95, 0xd6, 0xfc, 0x05, 0x2c, 0x82, 0x8c, 0x89,
-	0x99, 0xe1, 0x11, 0x57, 0x10, 0xe7, 0xa6, 0x25, 0x2e, 0x0e, 0x82, 0xeb, 0x20, 0xe5, 0xab, 0x45,
-	0xcc, 0x5c, 0x42, 0xa2, 0xe7, 0xe4, 0xba, 0x61, 0xc2, 0x9b, 0x60, 0x91, 0x38, 0x84, 0x13, 0x64,
-	0xe9, 0x3d, 0x2c, 0x8c, 0xcd, 0x25, 0x8b, 0xca, 0x56, 0x66, 0x67, 0xa3, 0x44, 0x3a, 0x46, 0x49,
-	0x9c, 0x4f, 0x29, 0x38, 0x95, 0xc1, 0x76, 0x69, 0x4f, 0x52, 0xec, 0x26, 0xbf, 0x7c, 0x5c, 0x98,
-	0xd2, 0x16, 0x02, 0x3e, 0x1f, 0x08, 0xaf, 0x80, 0xf9, 0x2e, 0x76, 0x30, 0x23, 0x4c, 0xef, 0x21,
-	0xd6, 0xcb, 0xcd, 0x14, 0x95, 0xad, 0x79, 0x2d, 0x13, 0xc0, 0xf6, 0x10, 0xeb, 0xc1, 0x02, 0xc8,
-	0x74, 0x88, 0x83, 0xbc, 0xa1, 0x4f, 0x31, 0x2b, 0x29, 0x80, 0x0f, 0x92, 0x04, 0x55, 0x00, 0x98,
-	0x8b, 0x8e, 0x1d, 0x5d, 0x5c, 0x56, 0x6e, 0x2e, 0x50, 0xc4, 0xbf, 0xc9, 0x52, 0x78, 0x93, 0xa5,
-	0x76, 0x78, 0x93, 0xbb, 0x29, 0xa1, 0xc8, 0x27, 0x5f, 0x17, 0x14, 0x2d, 0x2d, 0xf9, 0x04, 0x06,
-	0xee, 0x83, 0x6c, 0xdf, 0xe9, 0x50, 0xc7, 0x24, 0x4e, 0x57, 0x77, 0xb1, 0x47, 0xa8, 0x99, 0x4b,
-	0x49, 0x51, 0xeb, 0xa7, 0x44, 0xd5, 0x02, 0xa7, 0xf1, 0x25, 0x7d, 0x2a, 0x24, 0x2d, 0x45, 0xcc,
-	0x4d, 0xc9, 0x0b, 0xbf, 0x0f, 0xa0, 0x61, 0x0c, 0xa4, 0x4a, 0xb4, 0xcf, 0x43, 0x89, 0xe9, 0xc9,
-	0x25, 0x66, 0x0d, 0x63, 0xd0, 0xf6, 0xb9, 0x03, 0x91, 0x3f, 0x04, 0x97, 0xb8, 0x87, 0x1c, 0x76,
-	0x88, 0xbd, 0x93, 0x72, 0xc1, 0xe4, 0x72, 0x5f, 0x0b, 0x65, 0x8c, 0x0b, 0xdf, 0x03, 0x45, 0x23,
-	0x70, 0x20, 0xdd, 0xc3, 0x26, 0x61, 0xdc, 0x23, 0x9d, 0xbe, 0xe0, 0xd5, 0x0f, 0x3d, 0x64, 0x48,
-	0x1f, 0xc9, 0x48, 0x27, 0xc8, 0x87, 0x74, 0xda, 0x18, 0xd9, 0x07, 0x01, 0x15, 0xbc, 0x07, 0xbe,
-	0xd1, 0xb1, 0xa8, 0x71, 0xc4, 0x84, 0x72, 0xfa, 0x98, 0x24, 0xb9, 0xb5, 0x4d, 0x18, 0x13, 0xd2,
-	0xe6, 0x8b, 0xca, 0x56, 0x42, 0xbb, 0xe2, 0xd3, 0x36, 0xb1, 0x57, 0x8b, 0x51, 0xb6, 0x63, 0x84,
-	0xf0, 0x1a, 0x80, 0x3d, 0xc2, 0x38, 0xf5, 0x88, 0x81, 0x2c, 0x1d, 0x3b, 0xdc, 0x23, 0x98, 0xe5,
-	0x16, 0x24, 0xfb, 0xf2, 0x08, 0x53, 0xf7, 0x11, 0xf0, 0x16, 0xb8, 0x72, 0xee, 0xa6, 0xba, 0xd1,
-	0x43, 0x8e, 0x83, 0xad, 0xdc, 0xa2, 0x34, 0xa5, 0x60, 0x9e, 0xb3, 0x67, 0xd5, 0x27, 0x83, 0x2b,
-	0x60, 0x86, 0x53, 0x57, 0xdf, 0xcf, 0x2d, 0x15, 0x95, 0xad, 0x05, 0x2d, 0xc9, 0xa9, 0xbb, 0x0f,
-	0xdf, 0x01, 0xab, 0x03, 0x64, 0x11, 0x13, 0x71, 0xea, 0x31, 0xdd, 0xa5, 0xc7, 0xd8, 0xd3, 0x0d,
-	0xe4, 0xe6, 0xb2, 0x92, 0x06, 0x8e, 0x70, 0x4d, 0x81, 0xaa, 0x22, 0x17, 0xbe, 0x0d, 0x96, 0x23,
-	0xa8, 0xce, 0x30, 0x97, 0xe4, 0xcb, 0x92, 0x7c, 0x29, 0x42, 0xb4, 0x30, 0x17, 0xb4, 0x9b, 0x20,
-	0x8d, 0x2c, 0x8b, 0x1e, 0x5b, 0x84, 0xf1, 0x1c, 0x2c, 0x26, 0xb6, 0xd2, 0xda, 0x08, 0x00, 0x37,
-	0x40, 0xca, 0xc4, 0xce, 0x50, 0x22, 0x57, 0x24, 0x32, 0x5a, 0xc3, 0xcb, 0x20, 0x6d, 0x8b, 0x24,
-	0xc2, 0xd1, 0x11, 0xce, 0xad, 0x16, 0x95, 0xad, 0xa4, 0x96, 0xb2, 0x89, 0xd3, 0x12, 0x6b, 0x58,
-	0x02, 0x2b, 0x52, 0x8a, 0x4e, 0x1c, 0x71, 0x4f, 0x03, 0xac, 0x0f, 0x90, 0xc5, 0x72, 0xaf, 0x15,
-	0x95, 0xad, 0x94, 0xb6, 0x2c, 0x51, 0x8d, 0x00, 0x73, 0x80, 0x2c, 0x76, 0x63, 0xeb, 0xe3, 0xcf,
-	0x0a, 0x53, 0x9f, 0x7e, 0x56, 0x98, 0xfa, 0xe3, 0xe7, 0xd7, 0x36, 0x82, 0xcc, 0xda, 0xa5, 0x83,
-	0x52, 0x90, 0x89, 0x4b, 0x55, 0xea, 0x70, 0xec, 0xf0, 0x9c, 0xa2, 0xfe, 0x59, 0x01, 0x97, 0xaa,
-	0x91, 0x4b, 0xd8, 0x74, 0x80, 0xac, 0x57, 0x99, 0x7a, 0x2a, 0x20, 0xcd, 0xc4, 0x9d, 0xc8, 0x60,
-	0x4f, 0x3e, 0x47, 0xb0, 0xa7, 0x04, 0x9b, 0x40, 0xdc, 0x28, 0x3e, 0xd3, 0xa6, 0xff, 0x4c, 0x83,
-	0xcd, 0xd0, 0xa6, 0xbb, 0xd4, 0x24, 0x87, 0xc4, 0x40, 0xaf, 0x3a, 0xa7, 0x46, 0xbe, 0x96, 0x9c,
-	0xc0, 0xd7, 0x66, 0x9e, 0xcf, 0xd7, 0x66, 0x27, 0xf0, 0xb5, 0xb9, 0x8b, 0x7c, 0x2d, 0x75, 0x91,
-	0xaf, 0xa5, 0x27, 0xf3, 0x35, 0x70, 0x9e, 0xaf, 0x4d, 0xe7, 0x14, 0xf5, 0x17, 0x0a, 0x58, 0xad,
-	0x3f, 0xec, 0x93, 0x01, 0x7d, 0x49, 0x27, 0x7d, 0x1b, 0x2c, 0xe0, 0x98, 0x3c, 0x96, 0x4b, 0x14,
-	0x13, 0x5b, 0x99, 0x9d, 0x37, 0x4b, 0xc1, 0xc5, 0x47, 0xad, 0x44, 0x78, 0xfb, 0xf1, 0xdd, 0xb5,
-	0x71, 0x5e, 0xa9, 0xe1, 0xef, 0x14, 0xb0, 0x21, 0xf2, 0x42, 0x17, 0x6b, 0xf8, 0x18, 0x79, 0x66,
-	0x0d, 0x3b, 0xd4, 0x66, 0x2f, 0xac, 0xa7, 0x0a, 0x16, 0x4c, 0x29, 0x49, 0xe7, 0x54, 0x47, 0xa6,
-	0x29, 0xf5, 0x94, 0x34, 0x02, 0xd8, 0xa6, 0x15, 0xd3, 0x84, 0x5b, 0x20, 0x3b, 0xa2, 0xf1, 0x44,
-	0x8c, 0x09, 0xd7, 0x17, 0x64, 0x8b, 0x21, 0x99, 0x8c, 0x3c, 0x7c, 0x23, 0x7f, 0xb1, 0x6b, 0xab,
-	0xff, 0x52, 0x40, 0xf6, 0xa6, 0x45, 0x3b, 0xc8, 0x6a, 0x59, 0x88, 0xf5, 0x44, 0xce, 0x1c, 0x8a,
-	0x90, 0xf2, 0x70, 0x50, 0xac, 0xa4, 0xfa, 0x13, 0x87, 0x94, 0x60, 0x93, 0xe5, 0xf3, 0x7d, 0xb0,
-	0x1c, 0x95, 0x8f, 0xc8, 0xc1, 0xa5, 0xb5, 0xbb, 0x2b, 0x4f, 0x1e, 0x17, 0x96, 0xc2, 0x60, 0xaa,
-	0x4a, 0x67, 0xaf, 0x69, 0x4b, 0xc6, 0x18, 0xc0, 0x84, 0x79, 0x90, 0x21, 0x1d, 0x43, 0x67, 0xf8,
-	0xa1, 0xee, 0xf4, 0x6d, 0x19, 0x1b, 0x49, 0x2d, 0x4d, 0x3a, 0x46, 0x0b, 0x3f, 0xdc, 0xef, 0xdb,
-	0xf0, 0x5d, 0xb0, 0x16, 0x36, 0x95, 0xc2, 0x9b, 0x74, 0xc1, 0x2f, 0x8e, 0xcb, 0x93, 0xe1, 0x32,
-	0xaf, 0xad, 0x84, 0xd8, 0x03, 0x64, 0x89, 0xcd, 0x2a, 0xa6, 0xe9, 0xa9, 0xff, 0x9e, 0x01, 0xb3,
-	0x4d, 0xe4, 0x21, 0x9b, 0xc1, 0x36, 0x58, 0xe2, 0xd8, 0x76, 0x2d, 0xc4, 0xb1, 0xee, 0xb7, 0x26,
-	0x81, 0xa5, 0x57, 0x65, 0xcb, 0x12, 0xef, 0xd8, 0x4a, 0xb1, 0x1e, 0x6d, 0xb0, 0x5d, 0xaa, 0x4a,
-	0x68, 0x8b, 0x23, 0x8e, 0xb5, 0xc5, 0x50, 0x86, 0x0f, 0x84, 0xd7, 0x41, 0x8e, 0x7b, 0x7d, 0xc6,
-	0x47, 0x4d, 0xc3, 0xa8, 0x5a, 0xfa, 0x77, 0xbd, 0x16, 0xe2, 0xfd, 0x3a, 0x1b, 0x55, 0xc9, 0xb3,
-	0xfb, 0x83, 0xc4, 0x8b, 0xf4, 0x07, 0x26, 0xd8, 0x64, 0xe2, 0x52, 0x75, 0x1b, 0x73, 0x59, 0xc5,
-	0x5d, 0x0b, 0x3b, 0x84, 0xf5, 0x42, 0xe1, 0xb3, 0x93, 0x0b, 0x5f, 0x97, 0x82, 0xee, 0x0a, 0x39,
-	0x5a, 0x28, 0x26, 0xd8, 0xa5, 0x0a, 0xf2, 0x67, 0xef, 0x12, 0x19, 0x3e, 0x27, 0x0d, 0xbf, 0x7c,
-	0x86, 0x88, 0xc8, 0x7a, 0x06, 0xde, 0x8a, 0x75, 0x1b, 0x22, 0x9a, 0x74, 0xe9, 0xc8, 0xba, 0x87,
-	0xbb, 0xa2, 0x24, 0x23, 0xbf, 0xf1, 0xc0, 0x38, 0xea, 0x98, 0x02, 0x9f, 0x16, 0x13, 0x43, 0xcc,
-	0xa9, 0x89, 0x13, 0xb4, 0x95, 0xea, 0xa8, 0x29, 0x89, 0x62, 0x53, 0x8b, 0xc9, 0xfa, 0x00, 0x63,
-	0x11, 0x45, 0xb1, 0xc6, 0x04, 0xbb, 0xd4, 0xe8, 0xc9, 0x9c, 0x94, 0xd0, 0x16, 0xa3, 0x26, 0xa4,
-	0x2e, 0xa0, 0xf0, 0x01, 0xb8, 0xea, 0xf4, 0xed, 0x0e, 0xf6, 0x74, 0x7a, 0xe8, 0x13, 0xca, 0xc8,
-	0x63, 0x1c, 0x79, 0x5c, 0xf7, 0xb0, 0x81, 0xc9, 0x40, 0xdc, 0xb8, 0xaf, 0x39, 0x93, 0x7d, 0x51,
-	0x42, 0x7b, 0xd3, 0x67, 0xb9, 0x77, 0x28, 0x65, 0xb0, 0x36, 0x6d, 0x09, 0x72, 0x2d, 0xa4, 0xf6,
-	0x15, 0x63, 0xb0, 0x01, 0xae, 0xd8, 0xe8, 0x91, 0x1e, 0x39, 0xb3, 0x50, 0x1c, 0x3b, 0xac, 0xcf,
-	0xf4, 0x51, 0x32, 0x0f, 0x7a, 0xa3, 0xbc, 0x8d, 0x1e, 0x35, 0x03, 0xba, 0x6a, 0x48, 0x76, 0x10,
-	0x51, 0xdd, 0x4a, 0xa6, 0x92, 0xd9, 0x99, 0x5b, 0xc9, 0xd4, 0x4c, 0x76, 0xf6, 0x56, 0x32, 0x95,
-	0xca, 0xa6, 0xd5, 0x6f, 0x82, 0xb4, 0x8c, 0xeb, 0x8a, 0x71, 0xc4, 0x64, 0x76, 0x37, 0x4d, 0x0f,
-	0x33, 0x86, 0x59, 0x4e, 0x09, 0xb2, 0x7b, 0x08, 0x50, 0x39, 0x58, 0x3f, 0x6f, 0x62, 0x60, 0xf0,
-	0x43, 0x30, 0xe7, 0x62, 0xd9, 0xce, 0x4a, 0xc6, 0xcc, 0xce, 0x7b, 0xa5, 0x09, 0x46, 0xbd, 0xd2,
-	0x79, 0x02, 0xb5, 0x50, 0x9a, 0xea, 0x8d, 0xe6, 0x94, 0x13, 0xbd, 0x02, 0x83, 0x07, 0x27, 0x37,
-	0xfd, 0xde, 0x73, 0x6d, 0x7a, 0x42, 0xde, 0x68, 0xcf, 0xab, 0x20, 0x53, 0xf1, 0xcd, 0xbe, 0x23,
-	0x4a, 0xd7, 0xa9, 0x63, 0x99, 0x8f, 0x1f, 0xcb, 0x3e, 0x58, 0x0c, 0x9a, 0xbf, 0x36, 0x95, 0xb9,
-	0x09, 0xbe, 0x0e, 0x40, 0xd0, 0x35, 0x8a, 0x9c, 0xe6, 0x67, 0xf7, 0x74, 0x00, 0x69, 0x98, 0x63,
-	0x15, 0x7d, 0x7a, 0xac, 0xa2, 0xcb, 0xaa, 0x41, 0xc1, 0xfa, 0x41, 0xbc, 0xea, 0xca, 0x02, 0xd2,
-	0x44, 0xc6, 0x11, 0xe6, 0x0c, 0x6a, 0x20, 0x29, 0xab, 0xab, 0x6f, 0xee, 0xf5, 0x73, 0xcd, 0x1d,
-	0x6c, 0x97, 0xce, 0x13, 0x52, 0x43, 0x1c, 0x05, 0x31, 0x20, 0x65, 0xa9, 0x3f, 0x53, 0x40, 0xee,
-	0x36, 0x1e, 0x56, 0x18, 0x23, 0x5d, 0xc7, 0xc6, 0x0e, 0x17, 0xd1, 0x87, 0x0c, 0x2c, 0x3e, 0xe1,
-	0x1b, 0x60, 0x21, 0x72, 0x3c, 0x99, 0x3c, 0x15, 0x99, 0x3c, 0xe7, 0x43, 0xa0, 0x38, 0x27, 0x78,
-	0x03, 0x00, 0xd7, 0xc3, 0x03, 0xdd, 0xd0, 0x8f, 0xf0, 0x50, 0xda, 0x94, 0xd9, 0xd9, 0x8c, 0x27,
-	0x45, 0x7f, 0xfe, 0x2c, 0x35, 0xfb, 0x1d, 0x8b, 0x18, 0xb7, 0xf1, 0x50, 0x4b, 0x09, 0xfa, 0xea,
-	0x6d, 0x3c, 0x14, 0x55, 0x50, 0x36, 0x29, 0x32, 0x93, 0x25, 0x34, 0x7f, 0xa1, 0xfe, 0x5c, 0x01,
-	0x97, 0x22, 0x03, 0xc2, 0xfb, 0x6a, 0xf6, 0x3b, 0x82, 0x23, 0x7e, 0x7e, 0xca, 0x78, 0x47, 0x74,
-	0x4a, 0xdb, 0xe9, 0x33, 0xb4, 0x7d, 0x1f, 0xcc, 0x47, 0xa9, 0x44, 0xe8, 0x9b, 0x98, 0x40, 0xdf,
-	0x4c, 0xc8, 0x71, 0x1b, 0x0f, 0xd5, 0x9f, 0xc4, 0x74, 0xdb, 0x1d, 0xc6, 0x5c, 0xd8, 0x7b, 0x86,
-	0x6e, 0xd1, 0xb6, 0x71, 0xdd, 0x8c, 0x38, 0xff, 0x29, 0x03, 0x12, 0xa7, 0x0d, 0x50, 0xff, 0xa4,
-	0x80, 0xb5, 0xf8, 0xae, 0xac, 0x4d, 0x9b, 0x5e, 0xdf, 0xc1, 0x07, 0x3b, 0x17, 0xed, 0xff, 0x3e,
-	0x48, 0xb9, 0x82, 0x4a, 0xe7, 0x2c, 0xb8, 0xa2, 0xc9, 0x4a, 0xf6, 0x9c, 0xe4, 0x6a, 0x8b, 0x10,
-	0x5f, 0x1c, 0x33, 0x80, 0x05, 0x27, 0xf7, 0xce, 0x44, 0x41, 0x17, 0x0b, 0x28, 0x6d, 0x21, 0x6e,
-	0x33, 0x53, 0xbf, 0x50, 0x00, 0x3c, 0x9d, 0xad, 0xe0, 0xb7, 0x00, 0x1c, 0xcb, 0x79, 0x71, 0xff,
-	0xcb, 0xba, 0xb1, 0x2c, 0x27, 0x4f, 0x2e, 0xf2, 0xa3, 0xe9, 0x98, 0x1f, 0xc1, 0xef, 0x02, 0xe0,
-	0xca, 0x4b, 0x9c, 0xf8, 0xa6, 0xd3, 0x6e, 0xf8, 0x09, 0x0b, 0x20, 0xf3, 0x11, 0x25, 0x4e, 0xfc,
-	0xc1, 0x22, 0xa1, 0x01, 0x01, 0xf2, 0xdf, 0x22, 0xd4, 0x9f, 0x2a, 0xa3, 0x94, 0x18, 0x64, 0xeb,
-	0x8a, 0x65, 0x05, 0x3d, 0x20, 0x74, 0xc1, 0x5c, 0x98, 0xef, 0xfd, 0x70, 0xdd, 0x3c, 0xb3, 0x26,
-	0xd5, 0xb0, 0x21, 0xcb, 0xd2, 0x75, 0x71, 0xe2, 0xbf, 0xfe, 0xba, 0x70, 0xb5, 0x4b, 0x78, 0xaf,
-	0xdf, 0x29, 0x19, 0xd4, 0x0e, 0x1e, 0xa8, 0x82, 0x7f, 0xd7, 0x98, 0x79, 0x54, 0xe6, 0x43, 0x17,
-	0xb3, 0x90, 0x87, 0xfd, 0xea, 0x9f, 0xbf, 0x7d, 0x5b, 0xd1, 0xc2, 0x6d, 0x54, 0x13, 0x64, 0xa3,
-	0x19, 0x04, 0x73, 0x64, 0x22, 0x8e, 0x20, 0x04, 0x49, 0x07, 0xd9, 0x61, 0x93, 0x29, 0xbf, 0x27,
-	0xe8, 0x31, 0x37, 0x40, 0xca, 0x0e, 0x24, 0x04, 0x53, 0x47, 0xb4, 0x56, 0x7f, 0x33, 0x0b, 0x8a,
-	0xe1, 0x36, 0x0d, 0xff, 0x6d, 0x86, 0xfc, 0xd8, 0x6f, 0xc1, 0x45, 0xe7, 0x24, 0xea, 0x37, 0x3b,
-	0xe3, 0xbd, 0x47, 0x79, 0x39, 0xef, 0x3d, 0xd3, 0xcf, 0x7c, 0xef, 0x49, 0x3c, 0xe3, 0xbd, 0x27,
-	0xf9, 0xf2, 0xde, 0x7b, 0x66, 0x5e, 0xfa, 0x7b, 0xcf, 0xec, 0x2b, 0x7a, 0xef, 0x99, 0xfb, 0xbf,
-	0xbc, 0xf7, 0xa4, 0x5e, 0xea, 0x7b, 0x4f, 0xfa, 0xc5, 0xde, 0x7b, 0xc0, 0x0b, 0xbd, 0xf7, 0x64,
-	0x26, 0x7b, 0xef, 0xf1, 0xb3, 0xba, 0x83, 0xa5, 0x65, 0x22, 0xeb, 0xce, 0x4b, 0xbe, 0xf9, 0x11,
-	0xb0, 0x61, 0xaa, 0x5f, 0x4c, 0x83, 0x35, 0x39, 0x6e, 0xb7, 0x7a, 0xc8, 0x15, 0x1e, 0x30, 0x8a,
-	0x93, 0x68, 0x86, 0x57, 0x26, 0x98, 0xe1, 0xa7, 0x9f, 0x6f, 0x86, 0x4f, 0x4c, 0x30, 0xc3, 0x27,
-	0x2f, 0x9a, 0xe1, 0x67, 0x2e, 0x9a, 0xe1, 0x67, 0x27, 0x9b, 0xe1, 0xe7, 0xce, 0x99, 0xe1, 0xa1,
-	0x0a, 0xe6, 0x5d, 0x8f, 0x50, 0x51, 0x2c, 0x62, 0x0f, 0x06, 0x63, 0x30, 0xb5, 0x00, 0x32, 0x51,
-	0xa6, 0x31, 0x19, 0xcc, 0x82, 0x04, 0x31, 0xc3, 0xce, 0x54, 0x7c, 0xaa, 0xdb, 0xe0, 0x52, 0x25,
-	0x54, 0x1d, 0x9b, 0xf1, 0x31, 0x1b, 0xae, 0x81, 0x59, 0x7f, 0xd4, 0x0d, 0xe8, 0x83, 0x95, 0xfa,
-	0x7b, 0x05, 0xac, 0x36, 0x9c, 0xd0, 0x65, 0x63, 0x57, 0xf1, 0x03, 0x90, 0x31, 0x69, 0xbf, 0x63,
-	0x61, 0x5d, 0x34, 0x42, 0x41, 0xbe, 0xba, 0x3e, 0x51, 0x71, 0x93, 0x2d, 0xf4, 0x2d, 0x44, 0xac,
-	0x91, 0x38, 0x0d, 0xf8, 0xc2, 0x5a, 0xa4, 0xeb, 0xc0, 0x36, 0x48, 0x99, 0xf4, 0xd8, 0x91, 0xe9,
-	0x67, 0xfa, 0x05, 0xe5, 0x46, 0x92, 0xd4, 0xbf, 0x2b, 0x60, 0xe5, 0x0c, 0x0a, 0xf8, 0x23, 0xb0,
-	0xe8, 0x0f, 0x5c, 0x51, 0x5c, 0xca, 0xa2, 0xb9, 0xfb, 0x6d, 0x11, 0xe2, 0x7f, 0x7b, 0x5c, 0xb8,
-	0xec, 0xd7, 0x13, 0x66, 0x1e, 0x95, 0x08, 0x2d, 0xdb, 0x88, 0xf7, 0x4a, 0x77, 0x70, 0x17, 0x19,
-	0xc3, 0x1a, 0x36, 0xfe, 0xf2, 0xf9, 0x35, 0x10, 0x54, 0xa9, 0x1a, 0x36, 0xfc, 0xfa, 0xb2, 0x20,
-	0xa5, 0x45, 0xe1, 0xbb, 0x07, 0x16, 0x3e, 0x42, 0xc4, 0xd2, 0xc3, 0x5f, 0x42, 0x02, 0x8b, 0x26,
-	0xca, 0x2d, 0xf3, 0x82, 0x33, 0x84, 0x0b, 0x4f, 0xe4, 0xd4, 0xee, 0x30, 0x4e, 0x1d, 0x2c, 0xbd,
-	0x35, 0xa5, 0x8d, 0x00, 0x6f, 0xff, 0x41, 0x01, 0x0b, 0x51, 0xeb, 0xd7, 0x43, 0x0c, 0xc3, 0x3c,
-	0xd8, 0xa8, 0xde, 0xdb, 0x6f, 0xdd, 0xbf, 0x5b, 0xd7, 0xf4, 0xe6, 0x5e, 0xa5, 0x55, 0xd7, 0xef,
-	0xef, 0xb7, 0x9a, 0xf5, 0x6a, 0xe3, 0x83, 0x46, 0xbd, 0x96, 0x9d, 0x82, 0xaf, 0x83, 0xf5, 0x13,
-	0x78, 0xad, 0x7e, 0xb3, 0xd1, 0x6a, 0xd7, 0xb5, 0x7a, 0x2d, 0xab, 0x9c, 0xc1, 0xde, 0xd8, 0x6f,
-	0xb4, 0x1b, 0x95, 0x3b, 0x8d, 0x07, 0xf5, 0x5a, 0x76, 0x1a, 0x5e, 0x06, 0x97, 0x4e, 0xe0, 0xef,
-	0x54, 0xee, 0xef, 0x57, 0xf7, 0xea, 0xb5, 0x6c, 0x02, 0x6e, 0x80, 0xb5, 0x13, 0xc8, 0x56, 0xfb,
-	0x5e, 0xb3, 0x59, 0xaf, 0x65, 0x93, 0x67, 0xe0, 0x6a, 0xf5, 0x3b, 0xf5, 0x76, 0xbd, 0x96, 0x9d,
-	0xd9, 0x48, 0x7e, 0xfc, 0xcb, 0xfc, 0xd4, 0xee, 0x87, 0x5f, 0x3e, 0xc9, 0x2b, 0x5f, 0x3d, 0xc9,
-	0x2b, 0xff, 0x78, 0x92, 0x57, 0x3e, 0x79, 0x9a, 0x9f, 0xfa, 0xea, 0x69, 0x7e, 0xea, 0xaf, 0x4f,
-	0xf3, 0x53, 0x0f, 0xde, 0x3b, 0x5d, 0xee, 0x47, 0x9e, 0x71, 0x2d, 0xfa, 0x7d, 0x67, 0xf0, 0x9d,
-	0xf2, 0xa3, 0xf1, 0x1f, 0xd7, 0x64, 0x27, 0xd0, 0x99, 0x95, 0xa7, 0xfd, 0xee, 0xff, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xa2, 0x6c, 0x24, 0x24, 0x8d, 0x1b, 0x00, 0x00,
+func (m *JailingReason) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *JailingReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailingReason.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailingReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailingReason.Merge(m, src)
+}
+func (m *JailingReason) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailingReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailingReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailingReason proto.InternalMessageInfo
+
+func (m *JailingReason) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *JailingReason) GetInfraction() types4.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types4.Infraction_INFRACTION_UNSPECIFIED
+}
+
+// ConsumerLaunchFailure records the failed attempts to launch a consumer chain
+type ConsumerLaunchFailure struct {
+	// the number of failed attempts to launch the consumer chain
+	Attempts uint32 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// the error of the last failed attempt
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ConsumerLaunchFailure) Reset()         { *m = ConsumerLaunchFailure{} }
+func (m *ConsumerLaunchFailure) String() string { return proto.CompactTextString(m) }
+func (*ConsumerLaunchFailure) ProtoMessage()    {}
+func (*ConsumerLaunchFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{30}
+}
+func (m *ConsumerLaunchFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerLaunchFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerLaunchFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerLaunchFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerLaunchFailure.Merge(m, src)
+}
+func (m *ConsumerLaunchFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerLaunchFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerLaunchFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerLaunchFailure proto.InternalMessageInfo
+
+func (m *ConsumerLaunchFailure) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ConsumerLaunchFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SlashPacketRecord records how a slash packet received from a consumer chain was processed
+type SlashPacketRecord struct {
+	Status SlashPacketStatus `protobuf:"varint,1,opt,name=status,proto3,enum=interchain_security.ccv.provider.v1.SlashPacketStatus" json:"status,omitempty"`
+	// the consensus address on the provider of the validator in the slash packet
+	ProviderAddr []byte `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	// the infraction reported in the slash packet
+	Infraction types4.Infraction `protobuf:"varint,3,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+	// true if the validator was jailed because of the slash packet
+	Jailed bool `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// the block time at which the slash packet was received
+	ReceivedTime time.Time `protobuf:"bytes,5,opt,name=received_time,json=receivedTime,proto3,stdtime" json:"received_time"`
+}
+
+func (m *SlashPacketRecord) Reset()         { *m = SlashPacketRecord{} }
+func (m *SlashPacketRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashPacketRecord) ProtoMessage()    {}
+func (*SlashPacketRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{31}
+}
+func (m *SlashPacketRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashPacketRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashPacketRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashPacketRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashPacketRecord.Merge(m, src)
+}
+func (m *SlashPacketRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashPacketRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashPacketRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashPacketRecord proto.InternalMessageInfo
+
+func (m *SlashPacketRecord) GetStatus() SlashPacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SLASH_PACKET_STATUS_UNKNOWN
+}
+
+func (m *SlashPacketRecord) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
+	}
+	return nil
+}
+
+func (m *SlashPacketRecord) GetInfraction() types4.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types4.Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *SlashPacketRecord) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *SlashPacketRecord) GetReceivedTime() time.Time {
+	if m != nil {
+		return m.ReceivedTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerPhase", ConsumerPhase_name, ConsumerPhase_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.SlashPacketStatus", SlashPacketStatus_name, SlashPacketStatus_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerSlashMode", ConsumerSlashMode_name, ConsumerSlashMode_value)
+	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
+	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
+	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
+	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
+	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
+	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
+	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
+	proto.RegisterType((*PendingDowntimeSlash)(nil), "interchain_security.ccv.provider.v1.PendingDowntimeSlash")
+	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
+	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
+	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
+	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
+	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
+	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
+	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
+	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
+	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
+	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
+	proto.RegisterType((*ConsensusValidator)(nil), "interchain_security.ccv.provider.v1.ConsensusValidator")
+	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
+	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
+	proto.RegisterType((*ConsumerInitializationParameters)(nil), "interchain_security.ccv.provider.v1.ConsumerInitializationParameters")
+	proto.RegisterType((*PowerShapingParameters)(nil), "interchain_security.ccv.provider.v1.PowerShapingParameters")
+	proto.RegisterType((*ConsumerIds)(nil), "interchain_security.ccv.provider.v1.ConsumerIds")
+	proto.RegisterType((*ConsumerPhaseTransition)(nil), "interchain_security.ccv.provider.v1.ConsumerPhaseTransition")
+	proto.RegisterType((*ConsumerPhaseHistory)(nil), "interchain_security.ccv.provider.v1.ConsumerPhaseHistory")
+	proto.RegisterType((*AllowlistedRewardDenoms)(nil), "interchain_security.ccv.provider.v1.AllowlistedRewardDenoms")
+	proto.RegisterType((*InfractionParameters)(nil), "interchain_security.ccv.provider.v1.InfractionParameters")
+	proto.RegisterType((*SlashJailParameters)(nil), "interchain_security.ccv.provider.v1.SlashJailParameters")
+	proto.RegisterType((*JailingReason)(nil), "interchain_security.ccv.provider.v1.JailingReason")
+	proto.RegisterType((*ConsumerLaunchFailure)(nil), "interchain_security.ccv.provider.v1.ConsumerLaunchFailure")
+	proto.RegisterType((*SlashPacketRecord)(nil), "interchain_security.ccv.provider.v1.SlashPacketRecord")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+}
+
+var fileDescriptor_f22ec409a72b7b72 = []byte{
+	// 3192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x6c, 0x1b, 0xc9,
+	0x95, 0x56, 0x8b, 0x94, 0x44, 0x95, 0xfe, 0xa8, 0x92, 0x2c, 0xb7, 0x7e, 0x4c, 0xc9, 0x3d, 0x3f,
+	0xd0, 0xda, 0x6b, 0x72, 0xa4, 0x01, 0x66, 0xbd, 0x9e, 0x1d, 0x0c, 0x28, 0x92, 0xb6, 0x28, 0xcb,
+	0x14, 0xb7, 0x49, 0xdb, 0x98, 0xd9, 0x1d, 0x34, 0x8a, 0xdd, 0x25, 0xb2, 0x46, 0xcd, 0xee, 0x76,
+	0x57, 0x91, 0x32, 0x17, 0x8b, 0x3d, 0xec, 0x69, 0x2e, 0x01, 0x26, 0xb7, 0x41, 0x2e, 0x19, 0x20,
+	0x97, 0x20, 0x97, 0xe4, 0x30, 0x98, 0x7b, 0x72, 0xc9, 0x24, 0x40, 0x80, 0xc9, 0x0f, 0x82, 0x20,
+	0x08, 0x66, 0x02, 0xcf, 0x21, 0x87, 0x1c, 0x72, 0xce, 0x2d, 0xa8, 0xaa, 0xee, 0x66, 0x53, 0x3f,
+	0x36, 0x65, 0x7b, 0x72, 0xb1, 0xbb, 0xaa, 0xde, 0x7b, 0x55, 0xef, 0xd5, 0x57, 0xaf, 0xbe, 0x7a,
+	0x22, 0xd8, 0x26, 0x0e, 0xc3, 0xbe, 0xd9, 0x42, 0xc4, 0x31, 0x28, 0x36, 0x3b, 0x3e, 0x61, 0xbd,
+	0x9c, 0x69, 0x76, 0x73, 0x9e, 0xef, 0x76, 0x89, 0x85, 0xfd, 0x5c, 0x77, 0x2b, 0xfa, 0xce, 0x7a,
+	0xbe, 0xcb, 0x5c, 0xf8, 0xca, 0x19, 0x3a, 0x59, 0xd3, 0xec, 0x66, 0x23, 0xb9, 0xee, 0xd6, 0xca,
+	0x3c, 0x6a, 0x13, 0xc7, 0xcd, 0x89, 0x7f, 0xa5, 0xde, 0x4a, 0xc6, 0x74, 0x69, 0xdb, 0xa5, 0xb9,
+	0x06, 0xa2, 0x38, 0xd7, 0xdd, 0x6a, 0x60, 0x86, 0xb6, 0x72, 0xa6, 0x4b, 0x9c, 0x60, 0xfc, 0xf5,
+	0x60, 0x1c, 0x73, 0x23, 0x8e, 0xd9, 0x97, 0x09, 0x3b, 0x02, 0xb9, 0x57, 0x03, 0x39, 0xca, 0xd0,
+	0x11, 0x71, 0x9a, 0x91, 0x58, 0xd0, 0x0e, 0xa4, 0x96, 0xa5, 0x94, 0x21, 0x5a, 0x39, 0xd9, 0x08,
+	0x86, 0x16, 0x9b, 0x6e, 0xd3, 0x95, 0xfd, 0xfc, 0x2b, 0x5c, 0x5e, 0xd3, 0x75, 0x9b, 0x36, 0xce,
+	0x89, 0x56, 0xa3, 0x73, 0x98, 0xb3, 0x3a, 0x3e, 0x62, 0xc4, 0x0d, 0x97, 0xb7, 0x7e, 0x72, 0x9c,
+	0x91, 0x36, 0xa6, 0x0c, 0xb5, 0xbd, 0x50, 0x80, 0x34, 0xcc, 0x9c, 0xe9, 0xfa, 0x38, 0x67, 0xda,
+	0x04, 0x3b, 0x8c, 0x87, 0x4e, 0x7e, 0x05, 0x02, 0x39, 0x2e, 0x60, 0x93, 0x66, 0x8b, 0xc9, 0x6e,
+	0x9a, 0x63, 0xd8, 0xb1, 0xb0, 0xdf, 0x26, 0x52, 0xb8, 0xdf, 0x0a, 0x14, 0x5e, 0x3b, 0x6f, 0x77,
+	0xba, 0x5b, 0xb9, 0x63, 0xe2, 0x87, 0x01, 0x59, 0x8b, 0x99, 0x31, 0xfd, 0x9e, 0xc7, 0xdc, 0xdc,
+	0x11, 0xee, 0x05, 0xde, 0x6a, 0x7f, 0x4f, 0x01, 0xb5, 0xe0, 0x3a, 0xb4, 0xd3, 0xc6, 0x7e, 0xde,
+	0xb2, 0x08, 0x77, 0xa9, 0xea, 0xbb, 0x9e, 0x4b, 0x91, 0x0d, 0x17, 0xc1, 0x18, 0x23, 0xcc, 0xc6,
+	0xaa, 0xb2, 0xa1, 0x6c, 0x4e, 0xea, 0xb2, 0x01, 0x37, 0xc0, 0x94, 0x85, 0xa9, 0xe9, 0x13, 0x8f,
+	0x0b, 0xab, 0xa3, 0x62, 0x2c, 0xde, 0x05, 0x97, 0x41, 0x4a, 0x2e, 0x8b, 0x58, 0x6a, 0x42, 0x0c,
+	0x4f, 0x88, 0x76, 0xd9, 0x82, 0x77, 0xc0, 0x2c, 0x71, 0x08, 0x23, 0xc8, 0x36, 0x5a, 0x98, 0x3b,
+	0xab, 0x26, 0x37, 0x94, 0xcd, 0xa9, 0xed, 0x95, 0x2c, 0x69, 0x98, 0x59, 0x1e, 0x9f, 0x6c, 0x10,
+	0x95, 0xee, 0x56, 0x76, 0x57, 0x48, 0xec, 0x24, 0xbf, 0xf8, 0x6a, 0x7d, 0x44, 0x9f, 0x09, 0xf4,
+	0x64, 0x27, 0xbc, 0x0a, 0xa6, 0x9b, 0xd8, 0xc1, 0x94, 0x50, 0xa3, 0x85, 0x68, 0x4b, 0x1d, 0xdb,
+	0x50, 0x36, 0xa7, 0xf5, 0xa9, 0xa0, 0x6f, 0x17, 0xd1, 0x16, 0x5c, 0x07, 0x53, 0x0d, 0xe2, 0x20,
+	0xbf, 0x27, 0x25, 0xc6, 0x85, 0x04, 0x90, 0x5d, 0x42, 0xa0, 0x00, 0x00, 0xf5, 0xd0, 0xb1, 0x63,
+	0xf0, 0xcd, 0x52, 0x27, 0x82, 0x85, 0xc8, 0x9d, 0xcc, 0x86, 0x3b, 0x99, 0xad, 0x87, 0x3b, 0xb9,
+	0x93, 0xe2, 0x0b, 0xf9, 0xf8, 0xeb, 0x75, 0x45, 0x9f, 0x14, 0x7a, 0x7c, 0x04, 0x56, 0x40, 0xba,
+	0xe3, 0x34, 0x5c, 0xc7, 0x22, 0x4e, 0xd3, 0xf0, 0xb0, 0x4f, 0x5c, 0x4b, 0x4d, 0x09, 0x53, 0xcb,
+	0xa7, 0x4c, 0x15, 0x03, 0xd0, 0x48, 0x4b, 0x9f, 0x70, 0x4b, 0x73, 0x91, 0x72, 0x55, 0xe8, 0xc2,
+	0xff, 0x04, 0xd0, 0x34, 0xbb, 0x62, 0x49, 0x6e, 0x87, 0x85, 0x16, 0x27, 0x87, 0xb7, 0x98, 0x36,
+	0xcd, 0x6e, 0x5d, 0x6a, 0x07, 0x26, 0xff, 0x0b, 0x5c, 0x66, 0x3e, 0x72, 0xe8, 0x21, 0xf6, 0x4f,
+	0xda, 0x05, 0xc3, 0xdb, 0xbd, 0x14, 0xda, 0x18, 0x34, 0xbe, 0x0b, 0x36, 0xcc, 0x00, 0x40, 0x86,
+	0x8f, 0x2d, 0x42, 0x99, 0x4f, 0x1a, 0x1d, 0xae, 0x6b, 0x1c, 0xfa, 0xc8, 0xe4, 0x1f, 0xea, 0x94,
+	0x00, 0x41, 0x26, 0x94, 0xd3, 0x07, 0xc4, 0x6e, 0x07, 0x52, 0xf0, 0x00, 0xbc, 0xda, 0xb0, 0x5d,
+	0xf3, 0x88, 0xf2, 0xc5, 0x19, 0x03, 0x96, 0xc4, 0xd4, 0x6d, 0x42, 0x29, 0xb7, 0x36, 0xbd, 0xa1,
+	0x6c, 0x26, 0xf4, 0xab, 0x52, 0xb6, 0x8a, 0xfd, 0x62, 0x4c, 0xb2, 0x1e, 0x13, 0x84, 0x37, 0x00,
+	0x6c, 0x11, 0xca, 0x5c, 0x9f, 0x98, 0xc8, 0x36, 0xb0, 0xc3, 0x7c, 0x82, 0xa9, 0x3a, 0x23, 0xd4,
+	0xe7, 0xfb, 0x23, 0x25, 0x39, 0x00, 0xf7, 0xc0, 0xd5, 0x73, 0x27, 0x35, 0xcc, 0x16, 0x72, 0x1c,
+	0x6c, 0xab, 0xb3, 0xc2, 0x95, 0x75, 0xeb, 0x9c, 0x39, 0x0b, 0x52, 0x0c, 0x2e, 0x80, 0x31, 0xe6,
+	0x7a, 0x46, 0x45, 0x9d, 0xdb, 0x50, 0x36, 0x67, 0xf4, 0x24, 0x73, 0xbd, 0x0a, 0x7c, 0x03, 0x2c,
+	0x76, 0x91, 0x4d, 0x2c, 0xc4, 0x5c, 0x9f, 0x1a, 0x9e, 0x7b, 0x8c, 0x7d, 0xc3, 0x44, 0x9e, 0x9a,
+	0x16, 0x32, 0xb0, 0x3f, 0x56, 0xe5, 0x43, 0x05, 0xe4, 0xc1, 0x6b, 0x60, 0x3e, 0xea, 0x35, 0x28,
+	0x66, 0x42, 0x7c, 0x5e, 0x88, 0xcf, 0x45, 0x03, 0x35, 0xcc, 0xb8, 0xec, 0x1a, 0x98, 0x44, 0xb6,
+	0xed, 0x1e, 0xdb, 0x84, 0x32, 0x15, 0x6e, 0x24, 0x36, 0x27, 0xf5, 0x7e, 0x07, 0x5c, 0x01, 0x29,
+	0x0b, 0x3b, 0x3d, 0x31, 0xb8, 0x20, 0x06, 0xa3, 0x36, 0x5c, 0x05, 0x93, 0x6d, 0x9e, 0x44, 0x18,
+	0x3a, 0xc2, 0xea, 0xe2, 0x86, 0xb2, 0x99, 0xd4, 0x53, 0x6d, 0xe2, 0xd4, 0x78, 0x1b, 0x66, 0xc1,
+	0x82, 0xb0, 0x62, 0x10, 0x87, 0xef, 0x53, 0x17, 0x1b, 0x5d, 0x64, 0x53, 0xf5, 0xd2, 0x86, 0xb2,
+	0x99, 0xd2, 0xe7, 0xc5, 0x50, 0x39, 0x18, 0x79, 0x80, 0x6c, 0x7a, 0x6b, 0xf3, 0xa3, 0x4f, 0xd7,
+	0x47, 0x3e, 0xf9, 0x74, 0x7d, 0xe4, 0x97, 0x9f, 0xdd, 0x58, 0x09, 0x32, 0x6b, 0xd3, 0xed, 0x66,
+	0x83, 0x44, 0x9c, 0x2d, 0xb8, 0x0e, 0xc3, 0x0e, 0x53, 0x15, 0xed, 0xd7, 0x0a, 0xb8, 0x5c, 0x88,
+	0x20, 0xd1, 0x76, 0xbb, 0xc8, 0xfe, 0x36, 0x53, 0x4f, 0x1e, 0x4c, 0x52, 0xbe, 0x27, 0xe2, 0xb0,
+	0x27, 0x2f, 0x70, 0xd8, 0x53, 0x5c, 0x8d, 0x0f, 0xdc, 0xda, 0x78, 0xa6, 0x4f, 0x7f, 0x1b, 0x05,
+	0x6b, 0xa1, 0x4f, 0xf7, 0x5c, 0x8b, 0x1c, 0x12, 0x13, 0x7d, 0xdb, 0x39, 0x35, 0xc2, 0x5a, 0x72,
+	0x08, 0xac, 0x8d, 0x5d, 0x0c, 0x6b, 0xe3, 0x43, 0x60, 0x6d, 0xe2, 0x69, 0x58, 0x4b, 0x3d, 0x0d,
+	0x6b, 0x93, 0xc3, 0x61, 0x0d, 0x9c, 0x87, 0xb5, 0x51, 0x55, 0xd1, 0xbe, 0xaf, 0x80, 0xc5, 0xd2,
+	0xa3, 0x0e, 0xe9, 0xba, 0x2f, 0x29, 0xd2, 0x77, 0xc1, 0x0c, 0x8e, 0xd9, 0xa3, 0x6a, 0x62, 0x23,
+	0xb1, 0x39, 0xb5, 0xfd, 0x5a, 0x36, 0xd8, 0xf8, 0x88, 0x70, 0x84, 0xbb, 0x1f, 0x9f, 0x5d, 0x1f,
+	0xd4, 0x15, 0x2b, 0xfc, 0x99, 0x02, 0x56, 0x78, 0x5e, 0x68, 0x62, 0x1d, 0x1f, 0x23, 0xdf, 0x2a,
+	0x62, 0xc7, 0x6d, 0xd3, 0x17, 0x5e, 0xa7, 0x06, 0x66, 0x2c, 0x61, 0xc9, 0x60, 0xae, 0x81, 0x2c,
+	0x4b, 0xac, 0x53, 0xc8, 0xf0, 0xce, 0xba, 0x9b, 0xb7, 0x2c, 0xb8, 0x09, 0xd2, 0x7d, 0x19, 0x9f,
+	0x9f, 0x31, 0x0e, 0x7d, 0x2e, 0x36, 0x1b, 0x8a, 0x89, 0x93, 0x87, 0x6f, 0x65, 0x9e, 0x0e, 0x6d,
+	0xed, 0xaf, 0x0a, 0x48, 0xdf, 0xb1, 0xdd, 0x06, 0xb2, 0x6b, 0x36, 0xa2, 0x2d, 0x9e, 0x33, 0x7b,
+	0xfc, 0x48, 0xf9, 0x38, 0xb8, 0xac, 0x54, 0xe5, 0x22, 0x47, 0x8a, 0xab, 0xf1, 0x01, 0xf8, 0x2e,
+	0x98, 0x8f, 0xae, 0x8f, 0x08, 0xe0, 0xc2, 0xdb, 0x9d, 0x85, 0x27, 0x5f, 0xad, 0xcf, 0x85, 0x87,
+	0xa9, 0x20, 0xc0, 0x5e, 0xd4, 0xe7, 0xcc, 0x81, 0x0e, 0x0b, 0x66, 0xc0, 0x14, 0x69, 0x98, 0x06,
+	0xc5, 0x8f, 0x0c, 0xa7, 0xd3, 0x16, 0x67, 0x23, 0xa9, 0x4f, 0x92, 0x86, 0x59, 0xc3, 0x8f, 0x2a,
+	0x9d, 0x36, 0x7c, 0x13, 0x2c, 0x85, 0xd4, 0x93, 0xa3, 0xc9, 0xe0, 0xfa, 0x3c, 0x5c, 0xbe, 0x38,
+	0x2e, 0xd3, 0xfa, 0x42, 0x38, 0xfa, 0x00, 0xd9, 0x7c, 0xb2, 0xbc, 0x65, 0xf9, 0xda, 0xef, 0xa7,
+	0xc0, 0x78, 0x15, 0xf9, 0xa8, 0x4d, 0x61, 0x1d, 0xcc, 0x31, 0xdc, 0xf6, 0x6c, 0xc4, 0xb0, 0x21,
+	0xa9, 0x49, 0xe0, 0xe9, 0x75, 0x41, 0x59, 0xe2, 0x8c, 0x2d, 0x1b, 0xe3, 0x68, 0xdd, 0xad, 0x6c,
+	0x41, 0xf4, 0xd6, 0x18, 0x62, 0x58, 0x9f, 0x0d, 0x6d, 0xc8, 0x4e, 0x78, 0x13, 0xa8, 0xcc, 0xef,
+	0x50, 0xd6, 0x27, 0x0d, 0xfd, 0xdb, 0x52, 0xee, 0xf5, 0x52, 0x38, 0x2e, 0xef, 0xd9, 0xe8, 0x96,
+	0x3c, 0x9b, 0x1f, 0x24, 0x5e, 0x84, 0x1f, 0x58, 0x60, 0x8d, 0xf2, 0x4d, 0x35, 0xda, 0x98, 0x89,
+	0x5b, 0xdc, 0xb3, 0xb1, 0x43, 0x68, 0x2b, 0x34, 0x3e, 0x3e, 0xbc, 0xf1, 0x65, 0x61, 0xe8, 0x1e,
+	0xb7, 0xa3, 0x87, 0x66, 0x82, 0x59, 0x0a, 0x20, 0x73, 0xf6, 0x2c, 0x91, 0xe3, 0x13, 0xc2, 0xf1,
+	0xd5, 0x33, 0x4c, 0x44, 0xde, 0x53, 0xf0, 0x7a, 0x8c, 0x6d, 0xf0, 0xd3, 0x64, 0x08, 0x20, 0x1b,
+	0x3e, 0x6e, 0x12, 0xca, 0xe4, 0x7a, 0x8c, 0x43, 0x8c, 0x23, 0xc6, 0x14, 0x60, 0x9a, 0xbf, 0x2b,
+	0x62, 0xa0, 0x26, 0x4e, 0x40, 0x2b, 0xb5, 0x3e, 0x29, 0x89, 0xce, 0xa6, 0x1e, 0xb3, 0x75, 0x1b,
+	0x63, 0x7e, 0x8a, 0x62, 0xc4, 0x04, 0x7b, 0xae, 0xd9, 0x12, 0x39, 0x29, 0xa1, 0xcf, 0x46, 0x24,
+	0xa4, 0xc4, 0x7b, 0xe1, 0xfb, 0xe0, 0xba, 0xd3, 0x69, 0x37, 0xb0, 0x6f, 0xb8, 0x87, 0x52, 0x50,
+	0x9c, 0x3c, 0xca, 0x90, 0xcf, 0x0c, 0x1f, 0x9b, 0x98, 0x74, 0xf9, 0x8e, 0xcb, 0x95, 0x53, 0xc1,
+	0x8b, 0x12, 0xfa, 0x6b, 0x52, 0xe5, 0xe0, 0x50, 0xd8, 0xa0, 0x75, 0xb7, 0xc6, 0xc5, 0xf5, 0x50,
+	0x5a, 0x2e, 0x8c, 0xc2, 0x32, 0xb8, 0xda, 0x46, 0x8f, 0x8d, 0x08, 0xcc, 0x7c, 0xe1, 0xd8, 0xa1,
+	0x1d, 0x6a, 0xf4, 0x93, 0x79, 0xc0, 0x8d, 0x32, 0x6d, 0xf4, 0xb8, 0x1a, 0xc8, 0x15, 0x42, 0xb1,
+	0x07, 0x91, 0x14, 0x7c, 0x07, 0xac, 0x1e, 0xe1, 0x9e, 0x81, 0x28, 0x25, 0x4d, 0xa7, 0x8d, 0x1d,
+	0x66, 0xf0, 0x9c, 0x2c, 0xde, 0x13, 0x5d, 0x64, 0x07, 0x0c, 0x49, 0x3d, 0xc2, 0xbd, 0x7c, 0x24,
+	0x71, 0x8f, 0x38, 0xe5, 0x60, 0x1c, 0x16, 0xc1, 0x3a, 0x5f, 0x09, 0xcf, 0xcd, 0x98, 0x19, 0x1d,
+	0xcf, 0xe2, 0x67, 0x43, 0x44, 0x22, 0x20, 0xf5, 0x54, 0xd0, 0xa4, 0x84, 0xbe, 0xda, 0x46, 0x8f,
+	0x1f, 0x08, 0xa9, 0xfb, 0x42, 0x68, 0x87, 0xcb, 0x48, 0x02, 0x4f, 0x61, 0x03, 0xac, 0x5a, 0xee,
+	0xb1, 0xc3, 0x81, 0x6c, 0x48, 0x60, 0x34, 0x7d, 0x64, 0xe2, 0x10, 0x74, 0x73, 0xc3, 0x83, 0x4e,
+	0x0d, 0xed, 0x88, 0xd4, 0x74, 0x87, 0x5b, 0x89, 0xc8, 0xa9, 0x88, 0x59, 0x04, 0x19, 0xaf, 0x85,
+	0x28, 0x36, 0x24, 0xf5, 0xeb, 0x19, 0x36, 0x76, 0x9a, 0xac, 0x25, 0xe8, 0x57, 0x42, 0xbf, 0xd2,
+	0x46, 0x8f, 0xc3, 0x64, 0x53, 0xe5, 0x62, 0xbb, 0x52, 0x6a, 0x5f, 0x08, 0xc1, 0x3d, 0xa0, 0xc5,
+	0xd1, 0xcb, 0xe3, 0x85, 0x1a, 0xd4, 0xb5, 0x3b, 0x0c, 0x1b, 0xe2, 0x62, 0x42, 0x8e, 0x89, 0x05,
+	0x35, 0x4b, 0xe8, 0x99, 0x3e, 0x82, 0xef, 0x11, 0x27, 0x1f, 0x88, 0xe5, 0x43, 0x29, 0xf8, 0xdf,
+	0x80, 0xc7, 0xd6, 0xf0, 0xfc, 0x8e, 0x83, 0x8d, 0x63, 0xe4, 0x3b, 0x1c, 0x13, 0xc7, 0xc4, 0xb1,
+	0xdc, 0x63, 0x15, 0x5e, 0x80, 0x90, 0x1f, 0xe1, 0x5e, 0x95, 0xdb, 0x78, 0x28, 0x4d, 0x3c, 0x14,
+	0x16, 0xf8, 0x4a, 0xb9, 0xcf, 0x87, 0xae, 0x6f, 0x62, 0x2b, 0x72, 0x5d, 0x62, 0x37, 0x42, 0x8a,
+	0xba, 0x10, 0x01, 0xe5, 0xb6, 0x10, 0x0c, 0x5d, 0xe7, 0x58, 0x8e, 0x90, 0x02, 0xff, 0x1d, 0x2c,
+	0x7b, 0x01, 0xd4, 0x44, 0xfc, 0x62, 0x21, 0xa0, 0x82, 0x29, 0xa6, 0xf4, 0x25, 0x4f, 0x62, 0x8c,
+	0x8f, 0xd7, 0x22, 0xbf, 0x29, 0x7c, 0x1b, 0xac, 0xc4, 0x03, 0x76, 0x22, 0xe6, 0x97, 0xc4, 0xf4,
+	0x97, 0xfb, 0x81, 0x1a, 0x8c, 0x76, 0x09, 0xac, 0xfb, 0xf8, 0x43, 0x6c, 0x32, 0xa3, 0xe3, 0x1c,
+	0x39, 0xee, 0xb1, 0x13, 0xcc, 0x1c, 0x43, 0xfa, 0x92, 0x98, 0x7d, 0x4d, 0x8a, 0xdd, 0x97, 0x52,
+	0x62, 0xfe, 0x3e, 0xce, 0xf7, 0x92, 0xa9, 0x64, 0x7a, 0x6c, 0x2f, 0x99, 0x1a, 0x4b, 0x8f, 0xef,
+	0x25, 0x53, 0xa9, 0xf4, 0xa4, 0xf6, 0x3b, 0x05, 0x2c, 0x56, 0xb1, 0x78, 0x6f, 0x15, 0xe3, 0xa0,
+	0xe1, 0x57, 0xd9, 0x87, 0x88, 0xd8, 0xcf, 0x71, 0x95, 0x71, 0x35, 0x3e, 0x00, 0x3f, 0x00, 0xf3,
+	0x72, 0x95, 0x1e, 0x32, 0x8f, 0x30, 0x33, 0x2c, 0xc4, 0x90, 0x3a, 0x1a, 0xde, 0x15, 0xe7, 0x94,
+	0x45, 0xba, 0x5b, 0x59, 0xb1, 0x80, 0xaa, 0xd0, 0x29, 0x22, 0x86, 0x82, 0xc4, 0x34, 0x47, 0x07,
+	0xbb, 0x39, 0xab, 0xa2, 0xf8, 0x51, 0x87, 0x53, 0x8f, 0xe0, 0x96, 0x8b, 0xda, 0xda, 0xbf, 0x80,
+	0x49, 0x61, 0x25, 0x6f, 0x1e, 0x51, 0x41, 0xce, 0x2c, 0xcb, 0xc7, 0x94, 0x62, 0xaa, 0x2a, 0x01,
+	0x39, 0x0b, 0x3b, 0x34, 0x06, 0x96, 0xcf, 0x7b, 0xf0, 0x53, 0xf8, 0x10, 0x4c, 0x78, 0x32, 0x3a,
+	0x42, 0x71, 0x6a, 0xfb, 0x9d, 0xec, 0x10, 0xf5, 0x9c, 0xec, 0x79, 0x06, 0xf5, 0xd0, 0x9a, 0xe6,
+	0xf7, 0xcb, 0x0c, 0x27, 0xa8, 0x3e, 0x85, 0x0f, 0x4e, 0x4e, 0xfa, 0x1f, 0x17, 0x9a, 0xf4, 0x84,
+	0xbd, 0xfe, 0x9c, 0xd7, 0xc1, 0x54, 0x5e, 0xba, 0xbd, 0xcf, 0x99, 0xe7, 0xa9, 0xb0, 0x4c, 0xc7,
+	0xc3, 0x52, 0x01, 0xb3, 0xc1, 0xdb, 0xad, 0xee, 0x0a, 0x6a, 0x01, 0xaf, 0x00, 0x10, 0x3c, 0xfa,
+	0x38, 0x25, 0x91, 0xe4, 0x6c, 0x32, 0xe8, 0x29, 0x5b, 0x03, 0x84, 0x7c, 0x74, 0x80, 0x90, 0x0b,
+	0xd2, 0xe7, 0x82, 0xe5, 0x07, 0x71, 0xd2, 0x2c, 0xf8, 0x9f, 0xdc, 0x4d, 0x0a, 0x75, 0x90, 0x14,
+	0xe4, 0x58, 0xba, 0x7b, 0xf3, 0x69, 0xe0, 0x38, 0xcf, 0x48, 0x0c, 0x29, 0xc2, 0x96, 0xf6, 0x5d,
+	0x05, 0xa8, 0x77, 0xe3, 0x19, 0x9b, 0x5f, 0x9e, 0xc8, 0xc4, 0xfc, 0x13, 0xbe, 0x02, 0x66, 0xa2,
+	0x7b, 0x43, 0x70, 0x1f, 0x45, 0x70, 0x9f, 0xe9, 0xb0, 0x93, 0xc7, 0x09, 0xde, 0x02, 0xc0, 0xf3,
+	0x71, 0xd7, 0x30, 0x8d, 0x23, 0xdc, 0x0b, 0x80, 0xbb, 0x16, 0xe7, 0x34, 0xb2, 0x7c, 0x94, 0xad,
+	0x76, 0x1a, 0x36, 0x31, 0xef, 0xe2, 0x9e, 0x9e, 0xe2, 0xf2, 0x85, 0xbb, 0xb8, 0xc7, 0x49, 0xac,
+	0x78, 0x63, 0x08, 0x64, 0x26, 0x74, 0xd9, 0xd0, 0xbe, 0xa7, 0x80, 0xcb, 0x91, 0x03, 0x51, 0x72,
+	0xed, 0x34, 0xb8, 0x46, 0x3c, 0x7e, 0xca, 0xe0, 0x83, 0xe6, 0xd4, 0x6a, 0x47, 0xcf, 0x58, 0xed,
+	0xbb, 0x60, 0x3a, 0x4a, 0x4b, 0x7c, 0xbd, 0x89, 0x21, 0xd6, 0x3b, 0x15, 0x6a, 0xdc, 0xc5, 0x3d,
+	0xed, 0xff, 0x62, 0x6b, 0xdb, 0xe9, 0xc5, 0x20, 0xec, 0x3f, 0x63, 0x6d, 0xd1, 0xb4, 0xf1, 0xb5,
+	0x99, 0x71, 0xfd, 0x53, 0x0e, 0x24, 0x4e, 0x3b, 0xa0, 0xfd, 0x4a, 0x01, 0x4b, 0xf1, 0x59, 0x69,
+	0xdd, 0x15, 0xd9, 0xfc, 0xc1, 0xf6, 0xd3, 0xe6, 0x7f, 0x17, 0xa4, 0xe4, 0xbd, 0xc1, 0xa8, 0x3a,
+	0x7a, 0x81, 0x34, 0x35, 0x21, 0xb4, 0xea, 0xfc, 0x88, 0xcf, 0x0e, 0x38, 0x40, 0x83, 0xc8, 0xbd,
+	0x31, 0xd4, 0xa1, 0x8b, 0x1d, 0x28, 0x7d, 0x26, 0xee, 0x33, 0xd5, 0x3e, 0x57, 0x00, 0x3c, 0x4d,
+	0x36, 0xe0, 0xbf, 0x02, 0x38, 0x40, 0x59, 0xe2, 0xf8, 0x4b, 0x7b, 0x31, 0x92, 0x22, 0x22, 0x17,
+	0xe1, 0x68, 0x34, 0x86, 0x23, 0xf8, 0x36, 0x00, 0x9e, 0xd8, 0xc4, 0xa1, 0x77, 0x7a, 0xd2, 0x0b,
+	0x3f, 0x79, 0x19, 0xf0, 0x43, 0x97, 0x38, 0xf1, 0x7a, 0x63, 0x42, 0x07, 0xbc, 0x4b, 0x32, 0x11,
+	0xed, 0x3b, 0x4a, 0x3f, 0x25, 0x06, 0x64, 0x8b, 0xdf, 0xd5, 0xf2, 0x09, 0x07, 0x3d, 0x30, 0x11,
+	0xd2, 0x35, 0x79, 0x5c, 0xd7, 0xce, 0xa4, 0x94, 0x45, 0x6c, 0x0a, 0x56, 0x79, 0x93, 0x47, 0xfc,
+	0x47, 0x5f, 0xaf, 0x5f, 0x6f, 0x12, 0xd6, 0xea, 0x34, 0xb2, 0xa6, 0xdb, 0x0e, 0xea, 0xcb, 0xc1,
+	0x7f, 0x37, 0xa8, 0x75, 0x94, 0x63, 0x3d, 0x0f, 0xd3, 0x50, 0x87, 0xfe, 0xf0, 0x2f, 0x3f, 0xb9,
+	0xa6, 0xe8, 0xe1, 0x34, 0x9a, 0x05, 0xd2, 0x51, 0x09, 0x01, 0x33, 0xc4, 0xaf, 0x11, 0x08, 0x41,
+	0xd2, 0x41, 0xed, 0xf0, 0x8d, 0x28, 0xbe, 0x87, 0x78, 0x22, 0xae, 0x80, 0x54, 0x3b, 0xb0, 0x10,
+	0x14, 0x0d, 0xa2, 0xb6, 0xf6, 0xff, 0x13, 0x60, 0x23, 0x9c, 0xa6, 0x2c, 0x4b, 0xab, 0xe4, 0x7f,
+	0xe4, 0x0b, 0x9a, 0x3f, 0x7c, 0xe4, 0x25, 0x7e, 0xba, 0x5c, 0xab, 0xbc, 0x9c, 0x72, 0xed, 0xe8,
+	0x33, 0xcb, 0xb5, 0x89, 0x67, 0x94, 0x6b, 0x93, 0x2f, 0xaf, 0x5c, 0x3b, 0xf6, 0xd2, 0xcb, 0xb5,
+	0xe3, 0xdf, 0x52, 0xb9, 0x76, 0xe2, 0x9f, 0x52, 0xae, 0x4d, 0xbd, 0xd4, 0x72, 0xed, 0xe4, 0x8b,
+	0x95, 0x6b, 0xc1, 0x0b, 0x95, 0x6b, 0xa7, 0x86, 0x2b, 0xd7, 0xca, 0xac, 0xee, 0x60, 0xe1, 0x19,
+	0xcf, 0xba, 0xd3, 0x42, 0x6f, 0xba, 0xdf, 0x59, 0x16, 0x5b, 0x1d, 0xd2, 0xf6, 0x18, 0x78, 0x66,
+	0x2e, 0xb0, 0xd5, 0x01, 0x61, 0x8f, 0xd0, 0xa3, 0x7d, 0x3e, 0x0a, 0x96, 0x44, 0x01, 0xae, 0xd6,
+	0x42, 0x1e, 0xef, 0xee, 0x1f, 0xbd, 0xa8, 0xaa, 0xa7, 0x0c, 0x51, 0xd5, 0x1b, 0xbd, 0x58, 0x55,
+	0x2f, 0x31, 0x44, 0x55, 0x2f, 0xf9, 0xb4, 0xaa, 0xde, 0xd8, 0xd3, 0xaa, 0x7a, 0xe3, 0xc3, 0x55,
+	0xf5, 0x26, 0xce, 0xa9, 0xea, 0x41, 0x0d, 0x4c, 0x7b, 0x3e, 0x71, 0xf9, 0xfd, 0x13, 0x2b, 0x21,
+	0x0e, 0xf4, 0x69, 0xeb, 0x60, 0x2a, 0x4a, 0x5e, 0x16, 0x85, 0x69, 0x90, 0x20, 0x56, 0x48, 0x76,
+	0xf9, 0xa7, 0xf6, 0xdb, 0x58, 0x71, 0x59, 0x3c, 0xe7, 0xc4, 0xb6, 0x0b, 0x76, 0x0a, 0x97, 0xc0,
+	0x78, 0x2c, 0x9b, 0x25, 0xf4, 0xa0, 0x05, 0x0f, 0xc0, 0xa4, 0x6b, 0x5b, 0xf2, 0x91, 0x28, 0x42,
+	0x3a, 0xbb, 0xbd, 0x7d, 0x21, 0x2a, 0x2a, 0x26, 0xd2, 0x53, 0xae, 0x6d, 0x89, 0x2f, 0x6e, 0xd0,
+	0xc1, 0xc7, 0x81, 0xc1, 0xc4, 0xf3, 0x1b, 0x74, 0xf0, 0xb1, 0xf8, 0xd2, 0xfe, 0x17, 0x2c, 0x9e,
+	0xf5, 0x46, 0x85, 0x16, 0x98, 0x62, 0x91, 0x7f, 0xf4, 0xb9, 0x68, 0xf4, 0x89, 0x20, 0x05, 0x69,
+	0x3c, 0x6e, 0x56, 0xdb, 0x02, 0x97, 0xf3, 0x21, 0x1c, 0xb0, 0x15, 0x2f, 0x66, 0xf2, 0x90, 0xca,
+	0x82, 0x62, 0xb0, 0x07, 0x41, 0x4b, 0xfb, 0xb9, 0x02, 0x16, 0xcb, 0x4e, 0x98, 0x59, 0x62, 0xf0,
+	0x7e, 0x0f, 0x4c, 0x59, 0x6e, 0xa7, 0x61, 0x63, 0x83, 0xf3, 0xd5, 0xe0, 0x5a, 0xb9, 0x39, 0xd4,
+	0x8a, 0xc5, 0x4b, 0x67, 0x0f, 0x11, 0xbb, 0x6f, 0x4e, 0x07, 0xd2, 0x58, 0x8d, 0x34, 0x1d, 0x58,
+	0x07, 0xa9, 0xb0, 0x20, 0xa0, 0x8e, 0xbe, 0xa0, 0xdd, 0xc8, 0x92, 0xf6, 0x27, 0x05, 0x2c, 0x9c,
+	0x21, 0x01, 0x3f, 0x00, 0xb3, 0xf2, 0xd5, 0x17, 0xa5, 0x4f, 0xc1, 0x6d, 0x76, 0xde, 0xe2, 0xf1,
+	0xfb, 0xe3, 0x57, 0xeb, 0xab, 0xf2, 0xda, 0xa7, 0xd6, 0x51, 0x96, 0xb8, 0xb9, 0x36, 0x62, 0xad,
+	0xec, 0x3e, 0x6e, 0x22, 0xb3, 0x57, 0xc4, 0xe6, 0x6f, 0x3e, 0xbb, 0x01, 0xe4, 0x30, 0xe7, 0x02,
+	0x92, 0x06, 0xcc, 0x08, 0x6b, 0x51, 0x96, 0xdd, 0x05, 0x33, 0xe2, 0x5d, 0x1a, 0xfe, 0xbd, 0x59,
+	0x1d, 0x1d, 0x3e, 0xdf, 0x4c, 0x73, 0xcd, 0xb0, 0x9f, 0x9f, 0x6e, 0xe6, 0xb6, 0x1b, 0x94, 0xb9,
+	0x8e, 0x04, 0x63, 0x4a, 0xef, 0x77, 0x68, 0x0c, 0xcc, 0x70, 0xc7, 0x44, 0xbd, 0x09, 0x51, 0xd7,
+	0xe1, 0xd7, 0x71, 0x74, 0x51, 0x44, 0x34, 0x14, 0x98, 0xd1, 0xa1, 0x83, 0x3b, 0x00, 0x10, 0x67,
+	0xa0, 0x68, 0x39, 0xbb, 0xad, 0x85, 0xdc, 0x28, 0xfc, 0x73, 0x7b, 0x48, 0x8f, 0xfa, 0x18, 0xd0,
+	0x63, 0x5a, 0x5a, 0x19, 0x5c, 0x0a, 0xf1, 0xb7, 0x8f, 0x3a, 0x8e, 0xd9, 0xba, 0x8d, 0x88, 0xdd,
+	0xf1, 0x31, 0x4f, 0x36, 0x88, 0xf1, 0x9a, 0x29, 0xa3, 0x41, 0x02, 0x8c, 0xda, 0x9c, 0x23, 0x62,
+	0xdf, 0x77, 0xfd, 0x80, 0xf1, 0xc8, 0x86, 0xf6, 0xe3, 0x51, 0x30, 0x1f, 0x7b, 0x49, 0xeb, 0xd8,
+	0x74, 0x7d, 0x0b, 0x56, 0xc0, 0x38, 0x65, 0x88, 0x75, 0xa4, 0x95, 0xd9, 0xed, 0xb7, 0x86, 0x47,
+	0x82, 0xb4, 0x53, 0x13, 0xda, 0x7a, 0x60, 0x65, 0xb8, 0xa7, 0xc9, 0x60, 0x64, 0x12, 0xcf, 0x13,
+	0x19, 0x7e, 0xa0, 0xf8, 0xee, 0x61, 0x4b, 0x10, 0x9d, 0x94, 0x1e, 0xb4, 0x60, 0x19, 0xcc, 0xc8,
+	0x3a, 0x22, 0xb6, 0x24, 0x0f, 0x1a, 0xbb, 0x00, 0x0f, 0x9a, 0x0e, 0x55, 0xf9, 0xe0, 0xb5, 0x5f,
+	0x28, 0x60, 0x66, 0xe0, 0xf4, 0xc3, 0x0c, 0x58, 0x29, 0x1c, 0x54, 0x6a, 0xf7, 0xef, 0x95, 0x74,
+	0xa3, 0xba, 0x9b, 0xaf, 0x95, 0x8c, 0xfb, 0x95, 0x5a, 0xb5, 0x54, 0x28, 0xdf, 0x2e, 0x97, 0x8a,
+	0xe9, 0x11, 0x78, 0x05, 0x2c, 0x9f, 0x18, 0xd7, 0x4b, 0x77, 0xca, 0xb5, 0x7a, 0x49, 0x2f, 0x15,
+	0xd3, 0xca, 0x19, 0xea, 0xe5, 0x4a, 0xb9, 0x5e, 0xce, 0xef, 0x97, 0xdf, 0x2f, 0x15, 0xd3, 0xa3,
+	0x70, 0x15, 0x5c, 0x3e, 0x31, 0xbe, 0x9f, 0xbf, 0x5f, 0x29, 0xec, 0x96, 0x8a, 0xe9, 0x04, 0x5c,
+	0x01, 0x4b, 0x27, 0x06, 0x6b, 0xf5, 0x83, 0x6a, 0xb5, 0x54, 0x4c, 0x27, 0xcf, 0x18, 0x2b, 0x96,
+	0xf6, 0x4b, 0xf5, 0x52, 0x31, 0x3d, 0xb6, 0x92, 0xfc, 0xe8, 0x07, 0x99, 0x91, 0x6b, 0x3f, 0x55,
+	0xc0, 0xfc, 0xa9, 0x5d, 0x83, 0xeb, 0x60, 0xb5, 0xb6, 0x9f, 0xaf, 0xed, 0x1a, 0xd5, 0x7c, 0xe1,
+	0x6e, 0xa9, 0x6e, 0xd4, 0xea, 0xf9, 0xfa, 0xfd, 0x9a, 0x71, 0xbf, 0x72, 0xb7, 0x72, 0xf0, 0xb0,
+	0x92, 0x1e, 0x39, 0x4f, 0x60, 0x37, 0x5f, 0x29, 0xee, 0x0b, 0x97, 0xae, 0x82, 0x2b, 0x67, 0x09,
+	0xd4, 0x77, 0xf5, 0x83, 0x7a, 0x7d, 0x5f, 0x78, 0xb5, 0x01, 0xd6, 0xce, 0x12, 0xd1, 0x4b, 0x85,
+	0x03, 0xbd, 0x28, 0x5c, 0x3b, 0x67, 0x96, 0x6a, 0xa9, 0x52, 0x2c, 0x57, 0xee, 0xa4, 0x93, 0x81,
+	0x0f, 0x7b, 0x60, 0x7e, 0xb0, 0x90, 0xe6, 0x5a, 0x18, 0x2e, 0x80, 0x39, 0xa9, 0x7b, 0xef, 0xa0,
+	0x58, 0x32, 0xf6, 0xf2, 0xe5, 0xfd, 0xf4, 0x08, 0x8f, 0x47, 0xac, 0x53, 0xce, 0x64, 0x1c, 0x54,
+	0xf6, 0xdf, 0x4b, 0x2b, 0xd2, 0xd6, 0xce, 0xc3, 0x2f, 0x9e, 0x64, 0x94, 0x2f, 0x9f, 0x64, 0x94,
+	0x3f, 0x3f, 0xc9, 0x28, 0x1f, 0x7f, 0x93, 0x19, 0xf9, 0xf2, 0x9b, 0xcc, 0xc8, 0x1f, 0xbe, 0xc9,
+	0x8c, 0xbc, 0xff, 0xce, 0xe9, 0x87, 0x49, 0xff, 0x48, 0xdc, 0x88, 0x7e, 0x48, 0xd2, 0xfd, 0xb7,
+	0xdc, 0xe3, 0xc1, 0xdf, 0xfa, 0x88, 0x37, 0x4b, 0x63, 0x5c, 0x00, 0xec, 0xcd, 0x7f, 0x0c, 0x00,
+	0x95, 0xe6, 0xed, 0x7f, 0x1c, 0x24, 0x00, 0x00,
+}
+
+func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
@@ -2702,6 +3317,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RejectUnknownSlashValidators {
+		i--
+		if m.RejectUnknownSlashValidators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SlashMeterHistoryLength != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SlashMeterHistoryLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.PerConsumerSlashMeters {
+		i--
+		if m.PerConsumerSlashMeters {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxForcedConsumersPerValidator != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxForcedConsumersPerValidator))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.KeyPruneWarningWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.KeyPruneWarningWindow):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintProvider(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.SlashMeterMinAbsoluteAllowance != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SlashMeterMinAbsoluteAllowance))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxConsumerPhaseHistoryLength != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxConsumerPhaseHistoryLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeSlashGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeSlashGracePeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintProvider(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x7a
+	if m.MaxValsetUpdateBlockHeights != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxValsetUpdateBlockHeights))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.KeyAssignmentMinInterval != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.KeyAssignmentMinInterval))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.MaxProviderConsensusValidators != 0 {
 		i = encodeVarintProvider(dAtA, i, uint64(m.MaxProviderConsensusValidators))
 		i--
@@ -2734,20 +3429,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintProvider(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintProvider(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x32
-	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintProvider(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintProvider(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.TrustingPeriodFraction) > 0 {
@@ -2772,6 +3467,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingDowntimeSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDowntimeSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDowntimeSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.SlashPacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintProvider(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3156,12 +3897,12 @@ func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
-	if err15 != nil {
-		return 0, err15
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n15
-	i = encodeVarintProvider(dAtA, i, uint64(n15))
+	i -= n19
+	i = encodeVarintProvider(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ChainId) > 0 {
@@ -3327,6 +4068,14 @@ func (m *ConsumerInitializationParameters) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.KeyPruningPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.KeyPruningPeriod):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintProvider(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x6a
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -3358,36 +4107,36 @@ func (m *ConsumerInitializationParameters) MarshalToSizedBuffer(dAtA []byte) (in
 		i--
 		dAtA[i] = 0x42
 	}
-	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err17 != nil {
-		return 0, err17
+	n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err22 != nil {
+		return 0, err22
 	}
-	i -= n17
-	i = encodeVarintProvider(dAtA, i, uint64(n17))
+	i -= n22
+	i = encodeVarintProvider(dAtA, i, uint64(n22))
 	i--
 	dAtA[i] = 0x3a
-	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err18 != nil {
-		return 0, err18
+	n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err23 != nil {
+		return 0, err23
 	}
-	i -= n18
-	i = encodeVarintProvider(dAtA, i, uint64(n18))
+	i -= n23
+	i = encodeVarintProvider(dAtA, i, uint64(n23))
 	i--
 	dAtA[i] = 0x32
-	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err19 != nil {
-		return 0, err19
+	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err24 != nil {
+		return 0, err24
 	}
-	i -= n19
-	i = encodeVarintProvider(dAtA, i, uint64(n19))
+	i -= n24
+	i = encodeVarintProvider(dAtA, i, uint64(n24))
 	i--
 	dAtA[i] = 0x2a
-	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
-	if err20 != nil {
-		return 0, err20
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
+	if err25 != nil {
+		return 0, err25
 	}
-	i -= n20
-	i = encodeVarintProvider(dAtA, i, uint64(n20))
+	i -= n25
+	i = encodeVarintProvider(dAtA, i, uint64(n25))
 	i--
 	dAtA[i] = 0x22
 	if len(m.BinaryHash) > 0 {
@@ -3529,6 +4278,81 @@ func (m *ConsumerIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsumerPhaseTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerPhaseTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPhaseTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPhase != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.NewPhase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OldPhase != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.OldPhase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPhaseHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerPhaseHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPhaseHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transitions) > 0 {
+		for iNdEx := len(m.Transitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AllowlistedRewardDenoms) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3638,12 +4462,12 @@ func (m *SlashJailParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
-	if err24 != nil {
-		return 0, err24
+	n29, err29 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err29 != nil {
+		return 0, err29
 	}
-	i -= n24
-	i = encodeVarintProvider(dAtA, i, uint64(n24))
+	i -= n29
+	i = encodeVarintProvider(dAtA, i, uint64(n29))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -3659,6 +4483,134 @@ func (m *SlashJailParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JailingReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailingReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailingReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerLaunchFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerLaunchFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerLaunchFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashPacketRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashPacketRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashPacketRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReceivedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintProvider(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x2a
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProvider(v)
 	base := offset
@@ -3928,6 +4880,50 @@ func (m *Params) Size() (n int) {
 	if m.MaxProviderConsensusValidators != 0 {
 		n += 1 + sovProvider(uint64(m.MaxProviderConsensusValidators))
 	}
+	if m.KeyAssignmentMinInterval != 0 {
+		n += 1 + sovProvider(uint64(m.KeyAssignmentMinInterval))
+	}
+	if m.MaxValsetUpdateBlockHeights != 0 {
+		n += 1 + sovProvider(uint64(m.MaxValsetUpdateBlockHeights))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeSlashGracePeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.MaxConsumerPhaseHistoryLength != 0 {
+		n += 2 + sovProvider(uint64(m.MaxConsumerPhaseHistoryLength))
+	}
+	if m.SlashMeterMinAbsoluteAllowance != 0 {
+		n += 2 + sovProvider(uint64(m.SlashMeterMinAbsoluteAllowance))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.KeyPruneWarningWindow)
+	n += 2 + l + sovProvider(uint64(l))
+	if m.MaxForcedConsumersPerValidator != 0 {
+		n += 2 + sovProvider(uint64(m.MaxForcedConsumersPerValidator))
+	}
+	if m.PerConsumerSlashMeters {
+		n += 3
+	}
+	if m.SlashMeterHistoryLength != 0 {
+		n += 2 + sovProvider(uint64(m.SlashMeterHistoryLength))
+	}
+	if m.RejectUnknownSlashValidators {
+		n += 3
+	}
+	return n
+}
+
+func (m *PendingDowntimeSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = m.SlashPacketData.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovProvider(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -4205,6 +5201,8 @@ func (m *ConsumerInitializationParameters) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProvider(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.KeyPruningPeriod)
+	n += 1 + l + sovProvider(uint64(l))
 	return n
 }
 
@@ -4265,6 +5263,39 @@ func (m *ConsumerIds) Size() (n int) {
 	return n
 }
 
+func (m *ConsumerPhaseTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	if m.OldPhase != 0 {
+		n += 1 + sovProvider(uint64(m.OldPhase))
+	}
+	if m.NewPhase != 0 {
+		n += 1 + sovProvider(uint64(m.NewPhase))
+	}
+	return n
+}
+
+func (m *ConsumerPhaseHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transitions) > 0 {
+		for _, e := range m.Transitions {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *AllowlistedRewardDenoms) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4313,6 +5344,62 @@ func (m *SlashJailParameters) Size() (n int) {
 	return n
 }
 
+func (m *JailingReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovProvider(uint64(m.Infraction))
+	}
+	return n
+}
+
+func (m *ConsumerLaunchFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		n += 1 + sovProvider(uint64(m.Attempts))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashPacketRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovProvider(uint64(m.Status))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovProvider(uint64(m.Infraction))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
 func sovProvider(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6224,12 +7311,813 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentMinInterval", wireType)
+			}
+			m.KeyAssignmentMinInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyAssignmentMinInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValsetUpdateBlockHeights", wireType)
+			}
+			m.MaxValsetUpdateBlockHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValsetUpdateBlockHeights |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSlashGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeSlashGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsumerPhaseHistoryLength", wireType)
+			}
+			m.MaxConsumerPhaseHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsumerPhaseHistoryLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterMinAbsoluteAllowance", wireType)
+			}
+			m.SlashMeterMinAbsoluteAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeterMinAbsoluteAllowance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPruneWarningWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.KeyPruneWarningWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForcedConsumersPerValidator", wireType)
+			}
+			m.MaxForcedConsumersPerValidator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForcedConsumersPerValidator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerConsumerSlashMeters", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PerConsumerSlashMeters = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterHistoryLength", wireType)
+			}
+			m.SlashMeterHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeterHistoryLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectUnknownSlashValidators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectUnknownSlashValidators = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingDowntimeSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingDowntimeSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingDowntimeSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashPacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
@@ -6245,7 +8133,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6268,17 +8156,17 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6288,23 +8176,25 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6327,7 +8217,146 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCKey == nil {
+				m.PrevCKey = &crypto.PublicKey{}
+			}
+			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6337,28 +8366,94 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowProvider
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6385,8 +8480,10 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6411,7 +8508,7 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6434,17 +8531,17 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6454,79 +8551,61 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddressList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ConsumerAddr = append(m.ConsumerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsumerAddr == nil {
+				m.ConsumerAddr = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6553,8 +8632,10 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6577,7 +8658,7 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6600,15 +8681,15 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6636,13 +8717,13 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneTs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6652,23 +8733,60 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTs, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerAddrs == nil {
+				m.ConsumerAddrs = &AddressList{}
+			}
+			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6691,7 +8809,7 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
+func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6710,19 +8828,72 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderConsAddr = append(m.ProviderConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderConsAddr == nil {
+				m.ProviderConsAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6749,11 +8920,32 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
-			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PublicKey == nil {
+				m.PublicKey = &crypto.PublicKey{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinHeight", wireType)
+			}
+			m.JoinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -6775,7 +8967,7 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6798,49 +8990,15 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6867,32 +9025,11 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCKey == nil {
-				m.PrevCKey = &crypto.PublicKey{}
-			}
-			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types2.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -6914,7 +9051,7 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
+func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6937,15 +9074,15 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6973,13 +9110,13 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6989,31 +9126,29 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7023,27 +9158,23 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7066,7 +9197,7 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
+func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7089,17 +9220,17 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerInitializationParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerInitializationParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7109,27 +9240,28 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7156,14 +9288,14 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddr = append(m.ConsumerAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConsumerAddr == nil {
-				m.ConsumerAddr = []byte{}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7190,66 +9322,16 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7259,27 +9341,28 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PruneTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7306,13 +9389,13 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTs, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7339,68 +9422,15 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerAddrs == nil {
-				m.ConsumerAddrs = &AddressList{}
-			}
-			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7410,50 +9440,30 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderConsAddr = append(m.ProviderConsAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderConsAddr == nil {
-				m.ProviderConsAddr = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7463,33 +9473,29 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PublicKey == nil {
-				m.PublicKey = &crypto.PublicKey{}
-			}
-			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
 			}
-			m.JoinHeight = 0
+			m.BlocksPerDistributionTransmission = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7499,66 +9505,16 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JoinHeight |= int64(b&0x7F) << shift
+				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRewardsAllocation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			var msglen int
+			m.HistoricalEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7568,79 +9524,14 @@ func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HistoricalEntries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rewards = append(m.Rewards, types2.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7668,11 +9559,11 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7700,13 +9591,13 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPruningPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7716,23 +9607,24 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.KeyPruningPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7755,7 +9647,7 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
+func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7778,17 +9670,17 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerInitializationParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: PowerShapingParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerInitializationParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PowerShapingParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
 			}
-			var msglen int
+			m.Top_N = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7798,30 +9690,16 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Top_N |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
 			}
-			var byteLen int
+			m.ValidatorsPowerCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7831,31 +9709,16 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
 			}
-			var byteLen int
+			m.ValidatorSetCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7865,31 +9728,16 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BinaryHash == nil {
-				m.BinaryHash = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7899,30 +9747,29 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7932,30 +9779,29 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
 			}
-			var msglen int
+			m.MinStake = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7965,30 +9811,16 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinStake |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7998,28 +9830,15 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AllowInactiveVals = bool(v != 0)
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8047,81 +9866,61 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
-			}
-			m.BlocksPerDistributionTransmission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
-			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerIds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerIds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8149,7 +9948,7 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8172,7 +9971,7 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
+func (m *ConsumerPhaseTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8195,17 +9994,17 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PowerShapingParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerPhaseTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerShapingParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerPhaseTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Top_N = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8215,16 +10014,16 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPhase", wireType)
 			}
-			m.ValidatorsPowerCap = 0
+			m.OldPhase = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8234,16 +10033,16 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				m.OldPhase |= ConsumerPhase(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPhase", wireType)
 			}
-			m.ValidatorSetCap = 0
+			m.NewPhase = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8253,16 +10052,66 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				m.NewPhase |= ConsumerPhase(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerPhaseHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerPhaseHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerPhaseHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8272,27 +10121,79 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.Transitions = append(m.Transitions, ConsumerPhaseTransition{})
+			if err := m.Transitions[len(m.Transitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowlistedRewardDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowlistedRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8320,32 +10221,63 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MinStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfractionParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfractionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSign", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8355,17 +10287,33 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowInactiveVals = bool(v != 0)
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DoubleSign == nil {
+				m.DoubleSign = &SlashJailParameters{}
+			}
+			if err := m.DoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8375,23 +10323,27 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
+			if m.Downtime == nil {
+				m.Downtime = &SlashJailParameters{}
+			}
+			if err := m.Downtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8414,7 +10366,7 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
+func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8437,17 +10389,17 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashJailParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashJailParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8457,24 +10409,78 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -8496,7 +10502,7 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
+func (m *JailingReason) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8519,15 +10525,15 @@ func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowlistedRewardDenoms: wiretype end group for non-group")
+			return fmt.Errorf("proto: JailingReason: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowlistedRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JailingReason: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8555,8 +10561,27 @@ func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types4.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -8578,7 +10603,7 @@ func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
+func (m *ConsumerLaunchFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8601,17 +10626,17 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfractionParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerLaunchFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfractionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerLaunchFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSign", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var msglen int
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8621,33 +10646,16 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DoubleSign == nil {
-				m.DoubleSign = &SlashJailParameters{}
-			}
-			if err := m.DoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8657,27 +10665,23 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downtime == nil {
-				m.Downtime = &SlashJailParameters{}
-			}
-			if err := m.Downtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8700,7 +10704,7 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
+func (m *SlashPacketRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8723,15 +10727,34 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashJailParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashPacketRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashJailParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashPacketRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashPacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8758,15 +10781,16 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
 			}
-			var msglen int
+			m.Infraction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8776,28 +10800,14 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Infraction |= types4.Infraction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -8814,7 +10824,40 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Tombstone = bool(v != 0)
+			m.Jailed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReceivedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
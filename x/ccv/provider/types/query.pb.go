@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types2 "github.com/cometbft/cometbft/abci/types"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -20,6 +21,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -39,6 +41,48 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HasToValidateReason indicates why a validator has to validate a consumer chain
+type HasToValidateReason int32
+
+const (
+	// UNSPECIFIED indicates that the validator does not have to validate the consumer chain.
+	HAS_TO_VALIDATE_REASON_UNSPECIFIED HasToValidateReason = 0
+	// TOP_N indicates that the validator belongs to the Top N validators of a Top N consumer chain.
+	HAS_TO_VALIDATE_REASON_TOP_N HasToValidateReason = 1
+	// OPTED_IN indicates that the validator opted in to the consumer chain.
+	HAS_TO_VALIDATE_REASON_OPTED_IN HasToValidateReason = 2
+	// ALLOWLISTED indicates that the validator is on the allowlist of the consumer chain
+	// and belongs to its current validator set.
+	HAS_TO_VALIDATE_REASON_ALLOWLISTED HasToValidateReason = 3
+	// CONSUMER_VALIDATOR indicates that the validator belongs to the current validator set
+	// of the consumer chain.
+	HAS_TO_VALIDATE_REASON_CONSUMER_VALIDATOR HasToValidateReason = 4
+)
+
+var HasToValidateReason_name = map[int32]string{
+	0: "HAS_TO_VALIDATE_REASON_UNSPECIFIED",
+	1: "HAS_TO_VALIDATE_REASON_TOP_N",
+	2: "HAS_TO_VALIDATE_REASON_OPTED_IN",
+	3: "HAS_TO_VALIDATE_REASON_ALLOWLISTED",
+	4: "HAS_TO_VALIDATE_REASON_CONSUMER_VALIDATOR",
+}
+
+var HasToValidateReason_value = map[string]int32{
+	"HAS_TO_VALIDATE_REASON_UNSPECIFIED":        0,
+	"HAS_TO_VALIDATE_REASON_TOP_N":              1,
+	"HAS_TO_VALIDATE_REASON_OPTED_IN":           2,
+	"HAS_TO_VALIDATE_REASON_ALLOWLISTED":        3,
+	"HAS_TO_VALIDATE_REASON_CONSUMER_VALIDATOR": 4,
+}
+
+func (x HasToValidateReason) String() string {
+	return proto.EnumName(HasToValidateReason_name, int32(x))
+}
+
+func (HasToValidateReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{0}
+}
+
 type QueryConsumerGenesisRequest struct {
 	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
@@ -630,6 +674,16 @@ type QueryThrottleStateResponse struct {
 	// next time the slash meter could potentially be replenished, iff it's not
 	// full
 	NextReplenishCandidate time.Time `protobuf:"bytes,3,opt,name=next_replenish_candidate,json=nextReplenishCandidate,proto3,stdtime" json:"next_replenish_candidate"`
+	// the fraction of total voting power that is replenished to the slash meter
+	// every replenish period
+	SlashMeterReplenishFraction string `protobuf:"bytes,4,opt,name=slash_meter_replenish_fraction,json=slashMeterReplenishFraction,proto3" json:"slash_meter_replenish_fraction,omitempty"`
+	// the time period between replenishments of the slash meter
+	SlashMeterReplenishPeriod time.Duration `protobuf:"bytes,5,opt,name=slash_meter_replenish_period,json=slashMeterReplenishPeriod,proto3,stdduration" json:"slash_meter_replenish_period"`
+	// number of downtime slash packets that consumed the slash meter
+	DowntimeSlashPackets uint64 `protobuf:"varint,6,opt,name=downtime_slash_packets,json=downtimeSlashPackets,proto3" json:"downtime_slash_packets,omitempty"`
+	// number of double-sign slash packets that were recorded;
+	// these packets bypass the slash meter, i.e., they are not throttled
+	DoubleSignSlashPackets uint64 `protobuf:"varint,7,opt,name=double_sign_slash_packets,json=doubleSignSlashPackets,proto3" json:"double_sign_slash_packets,omitempty"`
 }
 
 func (m *QueryThrottleStateResponse) Reset()         { *m = QueryThrottleStateResponse{} }
@@ -686,6 +740,34 @@ func (m *QueryThrottleStateResponse) GetNextReplenishCandidate() time.Time {
 	return time.Time{}
 }
 
+func (m *QueryThrottleStateResponse) GetSlashMeterReplenishFraction() string {
+	if m != nil {
+		return m.SlashMeterReplenishFraction
+	}
+	return ""
+}
+
+func (m *QueryThrottleStateResponse) GetSlashMeterReplenishPeriod() time.Duration {
+	if m != nil {
+		return m.SlashMeterReplenishPeriod
+	}
+	return 0
+}
+
+func (m *QueryThrottleStateResponse) GetDowntimeSlashPackets() uint64 {
+	if m != nil {
+		return m.DowntimeSlashPackets
+	}
+	return 0
+}
+
+func (m *QueryThrottleStateResponse) GetDoubleSignSlashPackets() uint64 {
+	if m != nil {
+		return m.DoubleSignSlashPackets
+	}
+	return 0
+}
+
 type QueryRegisteredConsumerRewardDenomsRequest struct {
 }
 
@@ -1942,2944 +2024,21502 @@ func (m *QueryConsumerGenesisTimeResponse) GetGenesisTime() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*QueryConsumerGenesisRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisRequest")
-	proto.RegisterType((*QueryConsumerGenesisResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisResponse")
-	proto.RegisterType((*QueryConsumerChainsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsRequest")
-	proto.RegisterType((*QueryConsumerChainsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsResponse")
-	proto.RegisterType((*Chain)(nil), "interchain_security.ccv.provider.v1.Chain")
-	proto.RegisterType((*QueryValidatorConsumerAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrRequest")
-	proto.RegisterType((*QueryValidatorConsumerAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrResponse")
-	proto.RegisterType((*QueryValidatorProviderAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrRequest")
-	proto.RegisterType((*QueryValidatorProviderAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrResponse")
-	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateRequest")
-	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateResponse")
-	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsRequest")
-	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsResponse")
-	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerRequest)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerRequest")
-	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerResponse)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerResponse")
-	proto.RegisterType((*PairValConAddrProviderAndConsumer)(nil), "interchain_security.ccv.provider.v1.PairValConAddrProviderAndConsumer")
-	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.provider.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.provider.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryConsumerChainOptedInValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsRequest")
-	proto.RegisterType((*QueryConsumerChainOptedInValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsResponse")
-	proto.RegisterType((*QueryConsumerValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsRequest")
-	proto.RegisterType((*QueryConsumerValidatorsValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsValidator")
-	proto.RegisterType((*QueryConsumerValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsResponse")
-	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateRequest")
-	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateResponse")
-	proto.RegisterType((*QueryValidatorConsumerCommissionRateRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateRequest")
-	proto.RegisterType((*QueryValidatorConsumerCommissionRateResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateResponse")
-	proto.RegisterType((*QueryBlocksUntilNextEpochRequest)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochRequest")
-	proto.RegisterType((*QueryBlocksUntilNextEpochResponse)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochResponse")
-	proto.RegisterType((*QueryConsumerIdFromClientIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdRequest")
-	proto.RegisterType((*QueryConsumerIdFromClientIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdResponse")
-	proto.RegisterType((*QueryConsumerChainRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainRequest")
-	proto.RegisterType((*QueryConsumerChainResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainResponse")
-	proto.RegisterType((*QueryConsumerGenesisTimeRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeRequest")
-	proto.RegisterType((*QueryConsumerGenesisTimeResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeResponse")
+type QueryVSCMaturationScheduleRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/query.proto", fileDescriptor_422512d7b7586cd7)
+func (m *QueryVSCMaturationScheduleRequest) Reset()         { *m = QueryVSCMaturationScheduleRequest{} }
+func (m *QueryVSCMaturationScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVSCMaturationScheduleRequest) ProtoMessage()    {}
+func (*QueryVSCMaturationScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{35}
 }
-
-var fileDescriptor_422512d7b7586cd7 = []byte{
-	// 2574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x52, 0x3f, 0x4c, 0x0d, 0x2d, 0x39, 0x1e, 0xcb, 0x16, 0x4d, 0x39, 0xa2, 0xbc, 0x8e,
-	0xbf, 0x5f, 0x45, 0x8e, 0x49, 0x49, 0x45, 0xea, 0xd8, 0x89, 0x7f, 0x88, 0xb2, 0x24, 0x13, 0x8e,
-	0x6d, 0x65, 0xa5, 0x38, 0x80, 0x53, 0x77, 0x3b, 0xda, 0x1d, 0x53, 0x53, 0x91, 0xbb, 0xeb, 0x9d,
-	0x11, 0x6d, 0xd6, 0xf0, 0xa5, 0xbd, 0xe4, 0xd0, 0x02, 0x09, 0x8a, 0x9e, 0x9b, 0x73, 0x0f, 0x45,
-	0x51, 0x04, 0xfd, 0x1b, 0x72, 0xab, 0x9b, 0x5e, 0x8a, 0x14, 0x75, 0x0b, 0xbb, 0x05, 0x7a, 0xe9,
-	0xa1, 0x69, 0xd1, 0x73, 0x31, 0xb3, 0xb3, 0x4b, 0xee, 0x7a, 0x29, 0xee, 0x8a, 0xea, 0x4d, 0x3b,
-	0xf3, 0xde, 0xe7, 0xfd, 0x98, 0x37, 0x6f, 0xde, 0x7b, 0x14, 0x28, 0x13, 0x8b, 0x61, 0xd7, 0xd8,
-	0x46, 0xc4, 0xd2, 0x29, 0x36, 0x76, 0x5d, 0xc2, 0x5a, 0x65, 0xc3, 0x68, 0x96, 0x1d, 0xd7, 0x6e,
-	0x12, 0x13, 0xbb, 0xe5, 0xe6, 0x42, 0xf9, 0xe1, 0x2e, 0x76, 0x5b, 0x25, 0xc7, 0xb5, 0x99, 0x0d,
-	0xcf, 0xc4, 0x30, 0x94, 0x0c, 0xa3, 0x59, 0xf2, 0x19, 0x4a, 0xcd, 0x85, 0xc2, 0xa9, 0x9a, 0x6d,
-	0xd7, 0xea, 0xb8, 0x8c, 0x1c, 0x52, 0x46, 0x96, 0x65, 0x33, 0xc4, 0x88, 0x6d, 0x51, 0x0f, 0xa2,
-	0x30, 0x51, 0xb3, 0x6b, 0xb6, 0xf8, 0xb3, 0xcc, 0xff, 0x92, 0xab, 0x45, 0xc9, 0x23, 0xbe, 0xb6,
-	0x76, 0x1f, 0x94, 0x19, 0x69, 0x60, 0xca, 0x50, 0xc3, 0x91, 0x04, 0x8b, 0x49, 0x54, 0x0d, 0xb4,
-	0xf0, 0x78, 0xe6, 0xbb, 0xf1, 0x34, 0x17, 0xca, 0x74, 0x1b, 0xb9, 0xd8, 0xd4, 0x0d, 0xdb, 0xa2,
-	0xbb, 0x8d, 0x80, 0xe3, 0xec, 0x1e, 0x1c, 0x8f, 0x88, 0x8b, 0x25, 0xd9, 0x29, 0x86, 0x2d, 0x13,
-	0xbb, 0x0d, 0x62, 0xb1, 0xb2, 0xe1, 0xb6, 0x1c, 0x66, 0x97, 0x77, 0x70, 0xcb, 0xb7, 0xf0, 0xa4,
-	0x61, 0xd3, 0x86, 0x4d, 0x75, 0xcf, 0x48, 0xef, 0x43, 0x6e, 0xbd, 0xe1, 0x7d, 0x95, 0x29, 0x43,
-	0x3b, 0xc4, 0xaa, 0x95, 0x9b, 0x0b, 0x5b, 0x98, 0xa1, 0x05, 0xff, 0x5b, 0x52, 0xcd, 0x49, 0xaa,
-	0x2d, 0x44, 0xb1, 0xe7, 0xfe, 0x80, 0xd0, 0x41, 0x35, 0x62, 0x09, 0x7f, 0x7a, 0xb4, 0xea, 0x15,
-	0x30, 0xf5, 0x01, 0xa7, 0x58, 0x96, 0x86, 0xac, 0x61, 0x0b, 0x53, 0x42, 0x35, 0xfc, 0x70, 0x17,
-	0x53, 0x06, 0x8b, 0x20, 0xe7, 0x9b, 0xa8, 0x13, 0x33, 0xaf, 0xcc, 0x28, 0xb3, 0xa3, 0x1a, 0xf0,
-	0x97, 0xaa, 0xa6, 0xfa, 0x04, 0x9c, 0x8a, 0xe7, 0xa7, 0x8e, 0x6d, 0x51, 0x0c, 0x3f, 0x06, 0x63,
-	0x35, 0x6f, 0x49, 0xa7, 0x0c, 0x31, 0x2c, 0x20, 0x72, 0x8b, 0xf3, 0xa5, 0x6e, 0x91, 0xd0, 0x5c,
-	0x28, 0x45, 0xb0, 0x36, 0x38, 0x5f, 0x65, 0xe8, 0xcb, 0xe7, 0xc5, 0x01, 0xed, 0x70, 0xad, 0x63,
-	0x4d, 0xfd, 0xa5, 0x02, 0x0a, 0x21, 0xe9, 0xcb, 0x1c, 0x2f, 0x50, 0xfe, 0x06, 0x18, 0x76, 0xb6,
-	0x11, 0xf5, 0x64, 0x8e, 0x2f, 0x2e, 0x96, 0x12, 0x44, 0x5f, 0x20, 0x7c, 0x9d, 0x73, 0x6a, 0x1e,
-	0x00, 0x5c, 0x05, 0xa0, 0xed, 0xb9, 0x7c, 0x46, 0x98, 0xf0, 0x7f, 0x25, 0x79, 0x34, 0xdc, 0xcd,
-	0x25, 0x2f, 0xca, 0xa5, 0x9b, 0x4b, 0xeb, 0xa8, 0x86, 0xa5, 0x16, 0x5a, 0x07, 0xa7, 0xfa, 0x0b,
-	0x25, 0xe2, 0x6e, 0x5f, 0x61, 0xe9, 0xad, 0x0a, 0x18, 0x11, 0xea, 0xd1, 0xbc, 0x32, 0x33, 0x38,
-	0x9b, 0x5b, 0x9c, 0x4b, 0xa6, 0x32, 0xdf, 0xd6, 0x24, 0x27, 0x5c, 0x8b, 0xd1, 0xf5, 0xff, 0x7b,
-	0xea, 0xea, 0x29, 0x10, 0x52, 0xf6, 0x47, 0x23, 0x60, 0x58, 0x40, 0xc3, 0x93, 0x20, 0xeb, 0xa9,
-	0x10, 0x84, 0xc0, 0x21, 0xf1, 0x5d, 0x35, 0xe1, 0x14, 0x18, 0x35, 0xea, 0x04, 0x5b, 0x8c, 0xef,
-	0x65, 0xc4, 0x5e, 0xd6, 0x5b, 0xa8, 0x9a, 0xf0, 0x18, 0x18, 0x66, 0xb6, 0xa3, 0xdf, 0xce, 0x0f,
-	0xce, 0x28, 0xb3, 0x63, 0xda, 0x10, 0xb3, 0x9d, 0xdb, 0x70, 0x0e, 0xc0, 0x06, 0xb1, 0x74, 0xc7,
-	0x7e, 0xc4, 0x63, 0xca, 0xd2, 0x3d, 0x8a, 0xa1, 0x19, 0x65, 0x76, 0x50, 0x1b, 0x6f, 0x10, 0x6b,
-	0x9d, 0x6f, 0x54, 0xad, 0x4d, 0x4e, 0x3b, 0x0f, 0x26, 0x9a, 0xa8, 0x4e, 0x4c, 0xc4, 0x6c, 0x97,
-	0x4a, 0x16, 0x03, 0x39, 0xf9, 0x61, 0x81, 0x07, 0xdb, 0x7b, 0x82, 0x69, 0x19, 0x39, 0x70, 0x0e,
-	0x1c, 0x0d, 0x56, 0x75, 0x8a, 0x99, 0x20, 0x1f, 0x11, 0xe4, 0x47, 0x82, 0x8d, 0x0d, 0xcc, 0x38,
-	0xed, 0x29, 0x30, 0x8a, 0xea, 0x75, 0xfb, 0x51, 0x9d, 0x50, 0x96, 0x3f, 0x34, 0x33, 0x38, 0x3b,
-	0xaa, 0xb5, 0x17, 0x60, 0x01, 0x64, 0x4d, 0x6c, 0xb5, 0xc4, 0x66, 0x56, 0x6c, 0x06, 0xdf, 0x70,
-	0xc2, 0x8f, 0xac, 0x51, 0x61, 0xb1, 0x8c, 0x92, 0x8f, 0x40, 0xb6, 0x81, 0x19, 0x32, 0x11, 0x43,
-	0x79, 0x20, 0xfc, 0xfe, 0x76, 0xaa, 0x90, 0xbb, 0x25, 0x99, 0x65, 0xac, 0x07, 0x60, 0xdc, 0xc9,
-	0xdc, 0x65, 0xfc, 0x96, 0xe3, 0x7c, 0x6e, 0x46, 0x99, 0x1d, 0xd2, 0xb2, 0x0d, 0x62, 0x6d, 0xf0,
-	0x6f, 0x58, 0x02, 0xc7, 0x84, 0xd2, 0x3a, 0xb1, 0x90, 0xc1, 0x48, 0x13, 0xeb, 0x4d, 0x54, 0xa7,
-	0xf9, 0xc3, 0x33, 0xca, 0x6c, 0x56, 0x3b, 0x2a, 0xb6, 0xaa, 0x72, 0xe7, 0x2e, 0xaa, 0xd3, 0xe8,
-	0x95, 0x1e, 0x8b, 0x5e, 0x69, 0xf8, 0x18, 0x9c, 0x0c, 0xbc, 0x80, 0x4d, 0xdd, 0xc5, 0x8f, 0x90,
-	0x6b, 0xea, 0x26, 0xb6, 0xec, 0x06, 0xcd, 0x8f, 0x0b, 0xbb, 0xde, 0x4b, 0x64, 0xd7, 0x52, 0x1b,
-	0x45, 0x13, 0x20, 0xd7, 0x05, 0x86, 0x36, 0x89, 0xe2, 0x37, 0xa0, 0x0a, 0x0e, 0x3b, 0x2e, 0xb1,
-	0x39, 0x98, 0x70, 0xfb, 0x11, 0xe1, 0xf6, 0xd0, 0x1a, 0xb4, 0xc0, 0x71, 0x62, 0x3d, 0x70, 0xb9,
-	0x41, 0xb6, 0xa5, 0x3b, 0xc8, 0x45, 0x0d, 0xcc, 0xb0, 0x4b, 0xf3, 0xaf, 0x09, 0xcd, 0x2e, 0x26,
-	0xd2, 0xac, 0x1a, 0x20, 0xac, 0x07, 0x00, 0xda, 0x04, 0x89, 0x59, 0x55, 0x7f, 0xa2, 0x80, 0xd3,
-	0xe2, 0xca, 0xde, 0xf5, 0xa3, 0xc7, 0x3f, 0xae, 0x25, 0xd3, 0x74, 0xfd, 0x54, 0x73, 0x19, 0xbc,
-	0xe6, 0xe3, 0xeb, 0xc8, 0x34, 0x5d, 0x4c, 0xa9, 0x77, 0x53, 0x2a, 0xf0, 0x9b, 0xe7, 0xc5, 0xf1,
-	0x16, 0x6a, 0xd4, 0x2f, 0xa9, 0x72, 0x43, 0xd5, 0x8e, 0xf8, 0xb4, 0x4b, 0xde, 0x4a, 0xf4, 0x4c,
-	0x32, 0xd1, 0x33, 0xb9, 0x94, 0xfd, 0xe4, 0xf3, 0xe2, 0xc0, 0xdf, 0x3f, 0x2f, 0x0e, 0xa8, 0x77,
-	0x80, 0xba, 0x97, 0x3a, 0x32, 0x91, 0xbc, 0x09, 0x5e, 0x0b, 0x00, 0x43, 0xfa, 0x68, 0x47, 0x8c,
-	0x0e, 0x7a, 0xae, 0xcd, 0xab, 0x06, 0xae, 0x77, 0x68, 0xd7, 0x61, 0x60, 0x3c, 0x60, 0xbc, 0x81,
-	0x11, 0x21, 0x7d, 0x19, 0x18, 0x56, 0xa7, 0x6d, 0x60, 0xbc, 0xc3, 0x5f, 0x71, 0xae, 0x3a, 0x05,
-	0x4e, 0x0a, 0xc0, 0xcd, 0x6d, 0xd7, 0x66, 0xac, 0x8e, 0xc5, 0xdb, 0x21, 0xed, 0x52, 0x7f, 0xe7,
-	0x3f, 0x21, 0x91, 0x5d, 0x29, 0xa6, 0x08, 0x72, 0xb4, 0x8e, 0xe8, 0xb6, 0x2e, 0xa2, 0x41, 0x48,
-	0x18, 0xd4, 0x80, 0x58, 0xba, 0xc5, 0x57, 0xe0, 0x22, 0x38, 0xde, 0x41, 0xa0, 0x8b, 0xc8, 0x46,
-	0x96, 0x81, 0x85, 0x89, 0x83, 0xda, 0xb1, 0x36, 0xe9, 0x92, 0xbf, 0x05, 0xbf, 0x0b, 0xf2, 0x16,
-	0x7e, 0xcc, 0x74, 0x17, 0x3b, 0x75, 0x6c, 0x11, 0xba, 0xad, 0x1b, 0xc8, 0x32, 0xb9, 0xb1, 0x58,
-	0x64, 0xca, 0xdc, 0x62, 0xa1, 0xe4, 0xd5, 0x33, 0x25, 0xbf, 0x9e, 0x29, 0x6d, 0xfa, 0xf5, 0x4c,
-	0x25, 0xcb, 0x93, 0xc3, 0xa7, 0x7f, 0x2e, 0x2a, 0xda, 0x09, 0x8e, 0xa2, 0xf9, 0x20, 0xcb, 0x3e,
-	0x86, 0xfa, 0x16, 0x98, 0x13, 0x26, 0x69, 0xb8, 0xc6, 0xef, 0x98, 0x8b, 0x4d, 0x3f, 0x46, 0x42,
-	0xd7, 0x50, 0x7a, 0x60, 0x05, 0x9c, 0x4b, 0x44, 0x2d, 0x3d, 0x72, 0x02, 0x8c, 0xc8, 0x54, 0xa0,
-	0x88, 0xdb, 0x29, 0xbf, 0xd4, 0xf7, 0xc1, 0x9b, 0x02, 0x66, 0xa9, 0x5e, 0x5f, 0x47, 0xc4, 0xa5,
-	0x77, 0x51, 0x9d, 0xe3, 0xf0, 0x43, 0xa8, 0xb4, 0xda, 0x88, 0x09, 0xcb, 0x8a, 0x9f, 0x2b, 0xd2,
-	0x86, 0x1e, 0x70, 0x52, 0xa9, 0x87, 0xe0, 0xa8, 0x83, 0x88, 0xcb, 0x33, 0x1f, 0x2f, 0xc9, 0x44,
-	0x44, 0xc8, 0x27, 0x74, 0x35, 0x51, 0x42, 0xe0, 0x32, 0x3c, 0x11, 0x5c, 0x42, 0x10, 0x71, 0x56,
-	0xdb, 0x17, 0xe3, 0x4e, 0x88, 0x44, 0xfd, 0xb7, 0x02, 0x4e, 0xf7, 0xe4, 0x82, 0xab, 0x5d, 0xf3,
-	0xc2, 0xd4, 0x37, 0xcf, 0x8b, 0x93, 0xde, 0xb5, 0x89, 0x52, 0xc4, 0x24, 0x88, 0xd5, 0x98, 0xeb,
-	0x97, 0x89, 0xe2, 0x44, 0x29, 0x62, 0xee, 0xe1, 0x55, 0x70, 0x38, 0xa0, 0xda, 0xc1, 0x2d, 0x19,
-	0x6e, 0xa7, 0x4a, 0xed, 0x82, 0xb4, 0xe4, 0x15, 0xa4, 0xa5, 0xf5, 0xdd, 0xad, 0x3a, 0x31, 0x6e,
-	0xe2, 0x96, 0x16, 0x1c, 0xd5, 0x4d, 0xdc, 0x52, 0x27, 0x00, 0x14, 0xe7, 0x22, 0x32, 0x64, 0x10,
-	0x43, 0xdf, 0x03, 0xc7, 0x42, 0xab, 0xf2, 0x58, 0xaa, 0x60, 0x44, 0x24, 0x68, 0x2a, 0xab, 0xbe,
-	0x73, 0x09, 0xcf, 0x82, 0xb3, 0xc8, 0x47, 0x50, 0x02, 0xa8, 0xb7, 0x64, 0x3c, 0x84, 0x0a, 0xa7,
-	0x3b, 0x0e, 0xc3, 0x66, 0xd5, 0x0a, 0x32, 0x45, 0xf2, 0xb2, 0xf5, 0xa1, 0x0c, 0xfa, 0x5e, 0x70,
-	0x41, 0x5d, 0xf6, 0x7a, 0x67, 0x1d, 0x12, 0x39, 0x2f, 0xec, 0xdf, 0x85, 0xa9, 0x8e, 0x82, 0x24,
-	0x7c, 0x80, 0x98, 0xaa, 0x4b, 0x60, 0x3a, 0x24, 0x72, 0x1f, 0x5a, 0x7f, 0x76, 0x08, 0xcc, 0x74,
-	0xc1, 0x08, 0xfe, 0xea, 0xf7, 0x29, 0x8a, 0x46, 0x48, 0x26, 0x65, 0x84, 0xc0, 0x3c, 0x18, 0x16,
-	0x85, 0x9a, 0x88, 0xad, 0xc1, 0x4a, 0x26, 0xaf, 0x68, 0xde, 0x02, 0xbc, 0x08, 0x86, 0x5c, 0x9e,
-	0xe3, 0x86, 0x84, 0x36, 0x67, 0xf9, 0xf9, 0x7e, 0xfd, 0xbc, 0x38, 0xe5, 0x95, 0xa6, 0xd4, 0xdc,
-	0x29, 0x11, 0xbb, 0xdc, 0x40, 0x6c, 0xbb, 0xf4, 0x3e, 0xae, 0x21, 0xa3, 0x75, 0x1d, 0x1b, 0x79,
-	0x45, 0x13, 0x2c, 0xf0, 0x2c, 0x18, 0x0f, 0xb4, 0xf2, 0xd0, 0x87, 0x45, 0x7e, 0x1d, 0xf3, 0x57,
-	0x45, 0x01, 0x08, 0xef, 0x83, 0x7c, 0x40, 0x66, 0xd8, 0x8d, 0x06, 0xa1, 0x94, 0x57, 0x09, 0x42,
-	0xea, 0x88, 0x90, 0x7a, 0x26, 0x81, 0x54, 0xed, 0x84, 0x0f, 0xb2, 0x1c, 0x60, 0x68, 0x5c, 0x8b,
-	0xfb, 0x20, 0x1f, 0xb8, 0x36, 0x0a, 0x7f, 0x28, 0x05, 0xbc, 0x0f, 0x12, 0x81, 0xbf, 0x09, 0x72,
-	0x26, 0xa6, 0x86, 0x4b, 0x1c, 0x51, 0xba, 0x67, 0x85, 0xe7, 0xcf, 0xf8, 0xa5, 0xbb, 0xdf, 0xe3,
-	0xf9, 0x75, 0xfb, 0xf5, 0x36, 0xa9, 0xbc, 0x2b, 0x9d, 0xdc, 0xf0, 0x3e, 0x38, 0x19, 0xe8, 0x6a,
-	0x3b, 0xd8, 0x15, 0x05, 0xb1, 0x1f, 0x0f, 0xa2, 0x6c, 0xad, 0x9c, 0xfe, 0xea, 0x8b, 0xf3, 0xaf,
-	0x4b, 0xf4, 0x20, 0x7e, 0x64, 0x1c, 0x6c, 0x30, 0x97, 0x58, 0x35, 0x6d, 0xd2, 0xc7, 0xb8, 0x23,
-	0x21, 0xfc, 0x30, 0x39, 0x01, 0x46, 0xbe, 0x8f, 0x48, 0x1d, 0x9b, 0xa2, 0xd2, 0xcd, 0x6a, 0xf2,
-	0x0b, 0x5e, 0x02, 0x23, 0xbc, 0xcf, 0xdb, 0xa5, 0xa2, 0x4e, 0x1d, 0x5f, 0x54, 0xbb, 0xa9, 0x5f,
-	0xb1, 0x2d, 0x73, 0x43, 0x50, 0x6a, 0x92, 0x03, 0x6e, 0x82, 0x20, 0x1a, 0x75, 0x66, 0xef, 0x60,
-	0xcb, 0xab, 0x62, 0x47, 0x2b, 0xe7, 0xa4, 0x57, 0x8f, 0xbf, 0xea, 0xd5, 0xaa, 0xc5, 0xbe, 0xfa,
-	0xe2, 0x3c, 0x90, 0x42, 0xaa, 0x16, 0xd3, 0xc6, 0x7d, 0x8c, 0x4d, 0x01, 0xc1, 0x43, 0x27, 0x40,
-	0xf5, 0x42, 0x67, 0xcc, 0x0b, 0x1d, 0x7f, 0xd5, 0x0b, 0x9d, 0x6f, 0x83, 0x49, 0x79, 0x7b, 0x31,
-	0xd5, 0x8d, 0x5d, 0xd7, 0xe5, 0x3d, 0x0d, 0x76, 0x6c, 0x63, 0x5b, 0xd4, 0xbc, 0x59, 0xed, 0x78,
-	0xb0, 0xbd, 0xec, 0xed, 0xae, 0xf0, 0x4d, 0xf5, 0x13, 0x05, 0x14, 0xbb, 0xde, 0x6b, 0x99, 0x3e,
-	0x30, 0x00, 0xed, 0xcc, 0x20, 0xdf, 0xa5, 0x95, 0x44, 0xb9, 0xb0, 0xd7, 0x6d, 0xd7, 0x3a, 0x80,
-	0xd5, 0x87, 0x60, 0x3e, 0xa6, 0xb9, 0x0c, 0x68, 0x6f, 0x20, 0xba, 0x69, 0xcb, 0x2f, 0x7c, 0x30,
-	0x85, 0xab, 0x7a, 0x17, 0x2c, 0xa4, 0x10, 0x29, 0xdd, 0x71, 0xba, 0x23, 0xc5, 0x10, 0xd3, 0x4f,
-	0x9e, 0xb9, 0x76, 0xa2, 0x13, 0x45, 0xe9, 0xb9, 0xf8, 0x32, 0x37, 0x7c, 0x67, 0x92, 0xa6, 0xce,
-	0x58, 0x3b, 0x33, 0xc9, 0xed, 0xac, 0x81, 0xb7, 0x92, 0xa9, 0x23, 0x4d, 0xbc, 0x20, 0x53, 0x9d,
-	0x92, 0x3c, 0x2b, 0x08, 0x06, 0x55, 0x95, 0x19, 0xbe, 0x52, 0xb7, 0x8d, 0x1d, 0xfa, 0xa1, 0xc5,
-	0x48, 0xfd, 0x36, 0x7e, 0xec, 0xc5, 0x9a, 0xff, 0xda, 0xde, 0x93, 0x05, 0x7b, 0x3c, 0x8d, 0xd4,
-	0xe0, 0x6d, 0x30, 0xb9, 0x25, 0xf6, 0xf5, 0x5d, 0x4e, 0xa0, 0x8b, 0x8a, 0xd3, 0x8b, 0x67, 0x45,
-	0x74, 0x90, 0x13, 0x5b, 0x31, 0xec, 0xea, 0x92, 0xac, 0xbe, 0x97, 0x03, 0xd7, 0xad, 0xba, 0x76,
-	0x63, 0x59, 0x76, 0xf4, 0xbe, 0xbb, 0x43, 0x5d, 0xbf, 0x12, 0xee, 0xfa, 0xd5, 0x55, 0x70, 0x66,
-	0x4f, 0x88, 0x76, 0x69, 0xbd, 0xf7, 0x6b, 0xf7, 0x9e, 0xac, 0xdb, 0x43, 0xb1, 0x95, 0xf8, 0xad,
-	0x7c, 0x36, 0x14, 0x37, 0x1b, 0x4a, 0x2c, 0x3d, 0x34, 0xf3, 0xc8, 0x84, 0x67, 0x1e, 0x67, 0xc0,
-	0x98, 0xfd, 0xc8, 0xea, 0x08, 0xa4, 0x41, 0xb1, 0x7f, 0x58, 0x2c, 0xfa, 0x09, 0x32, 0x18, 0x11,
-	0x0c, 0x75, 0x1b, 0x11, 0x0c, 0x1f, 0xe4, 0x88, 0xe0, 0x01, 0xc8, 0x11, 0x8b, 0x30, 0x5d, 0xd6,
-	0x5b, 0x23, 0x02, 0x7b, 0x25, 0x15, 0x76, 0xd5, 0x22, 0x8c, 0xa0, 0x3a, 0xf9, 0x01, 0x8a, 0x34,
-	0xc6, 0x80, 0x23, 0x7b, 0x55, 0x19, 0x6c, 0x80, 0x09, 0x6f, 0x0c, 0x43, 0xb7, 0x91, 0x43, 0xac,
-	0x9a, 0x2f, 0xf0, 0x90, 0x10, 0xf8, 0x6e, 0xb2, 0x02, 0x8f, 0x03, 0x6c, 0x78, 0xfc, 0x1d, 0x62,
-	0xa0, 0x13, 0x5d, 0xa7, 0xdd, 0xbb, 0xfd, 0xec, 0xff, 0xa4, 0xdb, 0x0f, 0x07, 0xf6, 0x68, 0x24,
-	0xb0, 0x2b, 0x91, 0x4c, 0x2f, 0xe7, 0x93, 0xbc, 0x35, 0x4b, 0x1c, 0x96, 0x3b, 0x91, 0x0a, 0x2e,
-	0x84, 0x21, 0x63, 0x73, 0x0d, 0xf8, 0x63, 0x4e, 0x9d, 0x91, 0x86, 0x3f, 0x32, 0x4d, 0xd6, 0x13,
-	0xe6, 0x6a, 0x6d, 0xc0, 0xc5, 0xaf, 0x8b, 0x60, 0x58, 0x48, 0x83, 0x7f, 0x53, 0xc0, 0x44, 0x9c,
-	0x5c, 0x78, 0x2d, 0xfd, 0x33, 0x14, 0x1e, 0x11, 0x17, 0x96, 0xfa, 0x40, 0xf0, 0x0c, 0x56, 0x6f,
-	0xfc, 0xf0, 0xf7, 0x7f, 0xfd, 0x69, 0xa6, 0x02, 0xaf, 0xf5, 0xfe, 0x41, 0x21, 0xf0, 0xae, 0xb4,
-	0xb3, 0xfc, 0xa4, 0xc3, 0xdf, 0x4f, 0xe1, 0x1f, 0x15, 0xd9, 0x89, 0x84, 0x1f, 0x24, 0x78, 0x35,
-	0xbd, 0x92, 0xa1, 0x59, 0x72, 0xe1, 0xda, 0xfe, 0x01, 0xa4, 0x91, 0x4b, 0xc2, 0xc8, 0x77, 0xe1,
-	0xc5, 0x14, 0x46, 0x7a, 0x23, 0xdd, 0xf2, 0x13, 0x91, 0x3c, 0x9e, 0xc2, 0xcf, 0x32, 0x32, 0xa7,
-	0xc5, 0x0e, 0x7f, 0xe0, 0x6a, 0x72, 0x1d, 0xf7, 0x1a, 0x66, 0x15, 0xd6, 0xfa, 0xc6, 0x91, 0x26,
-	0x6f, 0x09, 0x93, 0xbf, 0x03, 0xef, 0x25, 0xf8, 0xa1, 0x28, 0x18, 0xda, 0x86, 0xba, 0xd8, 0xf0,
-	0xf1, 0x96, 0x9f, 0x44, 0xdf, 0xf0, 0x38, 0x9f, 0x74, 0xb6, 0x5e, 0xfb, 0xf2, 0x49, 0xcc, 0xfc,
-	0x6b, 0x5f, 0x3e, 0x89, 0x1b, 0x5c, 0xed, 0xcf, 0x27, 0x21, 0xb3, 0xa3, 0x3e, 0x89, 0xb6, 0xfd,
-	0x4f, 0xe1, 0x6f, 0x15, 0xd9, 0xa5, 0x87, 0x86, 0x5a, 0xf0, 0x4a, 0x72, 0x1b, 0xe2, 0x66, 0x65,
-	0x85, 0xab, 0xfb, 0xe6, 0x97, 0xb6, 0xbf, 0x23, 0x6c, 0x5f, 0x84, 0xf3, 0xbd, 0x6d, 0x67, 0x12,
-	0xc0, 0xfb, 0xd5, 0x08, 0xfe, 0x2c, 0x23, 0x8b, 0x8a, 0xbd, 0xa7, 0x54, 0xf0, 0x4e, 0x72, 0x15,
-	0x13, 0x4d, 0xc7, 0x0a, 0xeb, 0x07, 0x07, 0x28, 0x9d, 0x70, 0x53, 0x38, 0x61, 0x05, 0x2e, 0xf7,
-	0x76, 0x82, 0x1b, 0x20, 0xb6, 0x6f, 0x45, 0x68, 0x1c, 0x0f, 0x7f, 0x9c, 0x91, 0xf5, 0xda, 0x9e,
-	0x73, 0x32, 0x78, 0x3b, 0xb9, 0x15, 0x49, 0xe6, 0x77, 0x85, 0x3b, 0x07, 0x86, 0x27, 0x9d, 0xb2,
-	0x22, 0x9c, 0x72, 0x15, 0x5e, 0xee, 0xed, 0x14, 0x19, 0xe5, 0xba, 0xc3, 0x51, 0x23, 0xe9, 0xff,
-	0xd7, 0x0a, 0xc8, 0x75, 0x0c, 0xa2, 0xe0, 0x85, 0xe4, 0x7a, 0x86, 0x06, 0x5a, 0x85, 0x77, 0xd2,
-	0x33, 0x4a, 0x4b, 0xe6, 0x85, 0x25, 0x73, 0x70, 0xb6, 0xb7, 0x25, 0x5e, 0xe9, 0xd4, 0x8e, 0xed,
-	0xbd, 0x87, 0x51, 0x69, 0x62, 0x3b, 0xd1, 0x94, 0x2c, 0x4d, 0x6c, 0x27, 0x9b, 0x93, 0xa5, 0x89,
-	0x6d, 0x9b, 0x83, 0xe8, 0xc4, 0xd2, 0xdb, 0x0d, 0x6c, 0xe4, 0x30, 0x7f, 0x93, 0x91, 0x23, 0xe5,
-	0x24, 0xcd, 0x25, 0xfc, 0x70, 0xbf, 0x0f, 0xf4, 0x9e, 0xfd, 0x71, 0xe1, 0xee, 0x41, 0xc3, 0x4a,
-	0x4f, 0xdd, 0x13, 0x9e, 0xda, 0x84, 0x5a, 0xea, 0x6a, 0x40, 0x77, 0xb0, 0xdb, 0x76, 0x5a, 0xdc,
-	0x93, 0xf8, 0xab, 0x0c, 0x78, 0x23, 0x49, 0xb7, 0x0a, 0xd7, 0xfb, 0x78, 0xe8, 0x63, 0xfb, 0xf0,
-	0xc2, 0x07, 0x07, 0x88, 0x28, 0x3d, 0x65, 0x08, 0x4f, 0xdd, 0x87, 0x1f, 0xa7, 0xf1, 0x54, 0x78,
-	0x38, 0xd7, 0xbb, 0x8a, 0xf8, 0xa7, 0x02, 0x26, 0xbb, 0xcc, 0x5a, 0xe0, 0x72, 0x3f, 0x93, 0x1a,
-	0xdf, 0x31, 0xd7, 0xfb, 0x03, 0x49, 0x7f, 0xbf, 0x02, 0x8b, 0xbb, 0xde, 0xaf, 0x7f, 0x28, 0xb2,
-	0xc1, 0x8e, 0x9b, 0x23, 0xc0, 0x14, 0xf3, 0xa9, 0x3d, 0x66, 0x15, 0x85, 0xd5, 0x7e, 0x61, 0xd2,
-	0x57, 0xcf, 0x5d, 0xc6, 0x1e, 0xf0, 0x5f, 0xd1, 0x7f, 0xbe, 0x08, 0x0f, 0x26, 0xe0, 0x5a, 0xfa,
-	0x23, 0x8a, 0x9d, 0x8e, 0x14, 0x6e, 0xf4, 0x0f, 0xd4, 0x47, 0xcf, 0x40, 0xcc, 0xf2, 0x93, 0xa0,
-	0x87, 0x7d, 0x0a, 0xff, 0xe4, 0xd7, 0x82, 0xa1, 0xf4, 0x94, 0xa6, 0x16, 0x8c, 0x9b, 0xbf, 0x14,
-	0xae, 0xee, 0x9b, 0x5f, 0x9a, 0xb6, 0x2a, 0x4c, 0xbb, 0x06, 0xaf, 0xa4, 0x4d, 0x80, 0x91, 0x28,
-	0xfe, 0x8f, 0x02, 0xf2, 0xdd, 0x3a, 0x6a, 0x78, 0x7d, 0xdf, 0xbd, 0x69, 0x47, 0x53, 0x5f, 0x58,
-	0xe9, 0x13, 0x45, 0x5a, 0x7c, 0x4b, 0x58, 0xbc, 0x06, 0x57, 0xd2, 0x77, 0xb9, 0x62, 0x0e, 0x10,
-	0x36, 0xbc, 0xf2, 0xd1, 0x97, 0x2f, 0xa6, 0x95, 0x67, 0x2f, 0xa6, 0x95, 0xbf, 0xbc, 0x98, 0x56,
-	0x3e, 0x7d, 0x39, 0x3d, 0xf0, 0xec, 0xe5, 0xf4, 0xc0, 0x1f, 0x5e, 0x4e, 0x0f, 0xdc, 0xbb, 0x5c,
-	0x23, 0x6c, 0x7b, 0x77, 0xab, 0x64, 0xd8, 0x0d, 0xf9, 0xef, 0x63, 0x1d, 0x12, 0xcf, 0x07, 0x12,
-	0x9b, 0x17, 0xca, 0x8f, 0x23, 0x45, 0x77, 0xcb, 0xc1, 0x74, 0x6b, 0x44, 0x0c, 0x18, 0xbe, 0xf5,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x0a, 0xe9, 0x01, 0xde, 0x27, 0x00, 0x00,
+func (m *QueryVSCMaturationScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error)
-	// ConsumerChains queries active consumer chains supported by the provider
-	// chain
-	QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error)
-	// QueryValidatorConsumerAddr queries the address
-	// assigned by a validator for a consumer chain.
-	QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error)
-	// QueryProviderAddr returns the provider chain validator
-	// given a consumer chain validator address
-	QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error)
-	// QueryThrottleState returns the main on-chain state relevant to currently
-	// throttled slash packets
-	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
-	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
-	// denoms that are registered
-	QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error)
-	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
-	// between provider and consumer chain
-	QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error)
-	// QueryParams returns all current values of provider parameters
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
-	// that opted-in to the given consumer chain
-	QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error)
-	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
-	// that a given validator must validate
-	QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
-	// QueryValidatorConsumerCommissionRate returns the commission rate a given
-	// validator charges on a given consumer chain
-	QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error)
-	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
-	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
-	// because a VSCPacket could be delayed to be delivered on the consumer chain.
-	QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error)
-	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
-	// starts and validator updates are sent to the consumer chains
-	QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error)
-	// QueryConsumerIdFromClientId returns the consumer id of the chain
-	// associated with the provided client id
-	QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error)
-	// QueryConsumerChain returns the consumer chain
-	// associated with the provided consumer id
-	QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error)
-	// QueryConsumerGenesisTime returns the genesis time
-	// of the consumer chain associated with the provided consumer id
-	QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error)
+func (m *QueryVSCMaturationScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVSCMaturationScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVSCMaturationScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVSCMaturationScheduleRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryVSCMaturationScheduleRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error) {
-	out := new(QueryConsumerGenesisResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVSCMaturationScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVSCMaturationScheduleRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error) {
-	out := new(QueryConsumerChainsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryVSCMaturationScheduleRequest proto.InternalMessageInfo
 
-func (c *queryClient) QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error) {
-	out := new(QueryValidatorConsumerAddrResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVSCMaturationScheduleRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error) {
-	out := new(QueryValidatorProviderAddrResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VSCMaturity struct {
+	// the id of the VSC packet
+	VscId uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	// the time when the VSC matures, i.e., the time the VSC packet
+	// was sent plus the unbonding period
+	MaturityTime time.Time `protobuf:"bytes,2,opt,name=maturity_time,json=maturityTime,proto3,stdtime" json:"maturity_time"`
 }
 
-func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
-	out := new(QueryThrottleStateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryThrottleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VSCMaturity) Reset()         { *m = VSCMaturity{} }
+func (m *VSCMaturity) String() string { return proto.CompactTextString(m) }
+func (*VSCMaturity) ProtoMessage()    {}
+func (*VSCMaturity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{36}
+}
+func (m *VSCMaturity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VSCMaturity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VSCMaturity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *VSCMaturity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VSCMaturity.Merge(m, src)
+}
+func (m *VSCMaturity) XXX_Size() int {
+	return m.Size()
+}
+func (m *VSCMaturity) XXX_DiscardUnknown() {
+	xxx_messageInfo_VSCMaturity.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
-	out := new(QueryRegisteredConsumerRewardDenomsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VSCMaturity proto.InternalMessageInfo
+
+func (m *VSCMaturity) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
-	out := new(QueryAllPairsValConsAddrByConsumerResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VSCMaturity) GetMaturityTime() time.Time {
+	if m != nil {
+		return m.MaturityTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryVSCMaturationScheduleResponse struct {
+	// the pending VSCs ordered by VSC id
+	Maturities []VSCMaturity `protobuf:"bytes,1,rep,name=maturities,proto3" json:"maturities"`
 }
 
-func (c *queryClient) QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error) {
-	out := new(QueryConsumerChainOptedInValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVSCMaturationScheduleResponse) Reset()         { *m = QueryVSCMaturationScheduleResponse{} }
+func (m *QueryVSCMaturationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVSCMaturationScheduleResponse) ProtoMessage()    {}
+func (*QueryVSCMaturationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{37}
+}
+func (m *QueryVSCMaturationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVSCMaturationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVSCMaturationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVSCMaturationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVSCMaturationScheduleResponse.Merge(m, src)
+}
+func (m *QueryVSCMaturationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVSCMaturationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVSCMaturationScheduleResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
-	out := new(QueryConsumerChainsValidatorHasToValidateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVSCMaturationScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryVSCMaturationScheduleResponse) GetMaturities() []VSCMaturity {
+	if m != nil {
+		return m.Maturities
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error) {
-	out := new(QueryValidatorConsumerCommissionRateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySimulateConsumerUpdateRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the proposed power-shaping parameters of the consumer chain
+	PowerShapingParams *PowerShapingParameters `protobuf:"bytes,2,opt,name=power_shaping_params,json=powerShapingParams,proto3" json:"power_shaping_params,omitempty"`
 }
 
-func (c *queryClient) QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error) {
-	out := new(QueryConsumerValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateConsumerUpdateRequest) Reset()         { *m = QuerySimulateConsumerUpdateRequest{} }
+func (m *QuerySimulateConsumerUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateConsumerUpdateRequest) ProtoMessage()    {}
+func (*QuerySimulateConsumerUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{38}
+}
+func (m *QuerySimulateConsumerUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateConsumerUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateConsumerUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateConsumerUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateConsumerUpdateRequest.Merge(m, src)
+}
+func (m *QuerySimulateConsumerUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateConsumerUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateConsumerUpdateRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error) {
-	out := new(QueryBlocksUntilNextEpochResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateConsumerUpdateRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateConsumerUpdateRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error) {
-	out := new(QueryConsumerIdFromClientIdResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateConsumerUpdateRequest) GetPowerShapingParams() *PowerShapingParameters {
+	if m != nil {
+		return m.PowerShapingParams
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error) {
-	out := new(QueryConsumerChainResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QuerySimulateConsumerUpdateResponse struct {
+	// the validator set of the consumer chain with the proposed power-shaping parameters
+	NextValidators []ConsensusValidator `protobuf:"bytes,1,rep,name=next_validators,json=nextValidators,proto3" json:"next_validators"`
+	// the validator updates with respect to the current validator set of the consumer chain
+	ValidatorUpdates []types2.ValidatorUpdate `protobuf:"bytes,2,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+}
+
+func (m *QuerySimulateConsumerUpdateResponse) Reset()         { *m = QuerySimulateConsumerUpdateResponse{} }
+func (m *QuerySimulateConsumerUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateConsumerUpdateResponse) ProtoMessage()    {}
+func (*QuerySimulateConsumerUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{39}
+}
+func (m *QuerySimulateConsumerUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateConsumerUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateConsumerUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateConsumerUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateConsumerUpdateResponse.Merge(m, src)
+}
+func (m *QuerySimulateConsumerUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateConsumerUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateConsumerUpdateResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error) {
-	out := new(QueryConsumerGenesisTimeResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateConsumerUpdateResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateConsumerUpdateResponse) GetNextValidators() []ConsensusValidator {
+	if m != nil {
+		return m.NextValidators
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryConsumerGenesis(context.Context, *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error)
-	// ConsumerChains queries active consumer chains supported by the provider
-	// chain
-	QueryConsumerChains(context.Context, *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error)
-	// QueryValidatorConsumerAddr queries the address
-	// assigned by a validator for a consumer chain.
-	QueryValidatorConsumerAddr(context.Context, *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error)
-	// QueryProviderAddr returns the provider chain validator
-	// given a consumer chain validator address
-	QueryValidatorProviderAddr(context.Context, *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error)
-	// QueryThrottleState returns the main on-chain state relevant to currently
-	// throttled slash packets
-	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
-	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
-	// denoms that are registered
-	QueryRegisteredConsumerRewardDenoms(context.Context, *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error)
-	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
-	// between provider and consumer chain
-	QueryAllPairsValConsAddrByConsumer(context.Context, *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error)
-	// QueryParams returns all current values of provider parameters
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
-	// that opted-in to the given consumer chain
-	QueryConsumerChainOptedInValidators(context.Context, *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error)
-	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
-	// that a given validator must validate
-	QueryConsumerChainsValidatorHasToValidate(context.Context, *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
-	// QueryValidatorConsumerCommissionRate returns the commission rate a given
-	// validator charges on a given consumer chain
-	QueryValidatorConsumerCommissionRate(context.Context, *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error)
-	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
-	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
-	// because a VSCPacket could be delayed to be delivered on the consumer chain.
-	QueryConsumerValidators(context.Context, *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error)
-	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
-	// starts and validator updates are sent to the consumer chains
-	QueryBlocksUntilNextEpoch(context.Context, *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error)
-	// QueryConsumerIdFromClientId returns the consumer id of the chain
-	// associated with the provided client id
-	QueryConsumerIdFromClientId(context.Context, *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error)
-	// QueryConsumerChain returns the consumer chain
-	// associated with the provided consumer id
-	QueryConsumerChain(context.Context, *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error)
-	// QueryConsumerGenesisTime returns the genesis time
-	// of the consumer chain associated with the provided consumer id
-	QueryConsumerGenesisTime(context.Context, *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error)
+func (m *QuerySimulateConsumerUpdateResponse) GetValidatorUpdates() []types2.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryConsumerValidatorSetHashRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (*UnimplementedQueryServer) QueryConsumerGenesis(ctx context.Context, req *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesis not implemented")
+func (m *QueryConsumerValidatorSetHashRequest) Reset()         { *m = QueryConsumerValidatorSetHashRequest{} }
+func (m *QueryConsumerValidatorSetHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerValidatorSetHashRequest) ProtoMessage()    {}
+func (*QueryConsumerValidatorSetHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{40}
 }
-func (*UnimplementedQueryServer) QueryConsumerChains(ctx context.Context, req *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChains not implemented")
+func (m *QueryConsumerValidatorSetHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryValidatorConsumerAddr(ctx context.Context, req *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerAddr not implemented")
+func (m *QueryConsumerValidatorSetHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorSetHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryValidatorProviderAddr(ctx context.Context, req *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorProviderAddr not implemented")
+func (m *QueryConsumerValidatorSetHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorSetHashRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+func (m *QueryConsumerValidatorSetHashRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryRegisteredConsumerRewardDenoms(ctx context.Context, req *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRegisteredConsumerRewardDenoms not implemented")
+func (m *QueryConsumerValidatorSetHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorSetHashRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryAllPairsValConsAddrByConsumer(ctx context.Context, req *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllPairsValConsAddrByConsumer not implemented")
+
+var xxx_messageInfo_QueryConsumerValidatorSetHashRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorSetHashRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+
+type QueryConsumerValidatorSetHashResponse struct {
+	// the hex-encoded CometBFT validator set hash of the consumer validators
+	ValsetHash string `protobuf:"bytes,1,opt,name=valset_hash,json=valsetHash,proto3" json:"valset_hash,omitempty"`
+	// the number of consumer validators
+	ValsetSize uint64 `protobuf:"varint,2,opt,name=valset_size,json=valsetSize,proto3" json:"valset_size,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryConsumerChainOptedInValidators(ctx context.Context, req *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainOptedInValidators not implemented")
+
+func (m *QueryConsumerValidatorSetHashResponse) Reset()         { *m = QueryConsumerValidatorSetHashResponse{} }
+func (m *QueryConsumerValidatorSetHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerValidatorSetHashResponse) ProtoMessage()    {}
+func (*QueryConsumerValidatorSetHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{41}
 }
-func (*UnimplementedQueryServer) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, req *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsValidatorHasToValidate not implemented")
+func (m *QueryConsumerValidatorSetHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryValidatorConsumerCommissionRate(ctx context.Context, req *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerCommissionRate not implemented")
+func (m *QueryConsumerValidatorSetHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorSetHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryConsumerValidators(ctx context.Context, req *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidators not implemented")
+func (m *QueryConsumerValidatorSetHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorSetHashResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryBlocksUntilNextEpoch(ctx context.Context, req *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocksUntilNextEpoch not implemented")
+func (m *QueryConsumerValidatorSetHashResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryConsumerIdFromClientId(ctx context.Context, req *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerIdFromClientId not implemented")
+func (m *QueryConsumerValidatorSetHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorSetHashResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryConsumerChain(ctx context.Context, req *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChain not implemented")
+
+var xxx_messageInfo_QueryConsumerValidatorSetHashResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorSetHashResponse) GetValsetHash() string {
+	if m != nil {
+		return m.ValsetHash
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) QueryConsumerGenesisTime(ctx context.Context, req *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesisTime not implemented")
+
+func (m *QueryConsumerValidatorSetHashResponse) GetValsetSize() uint64 {
+	if m != nil {
+		return m.ValsetSize
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryValsetUpdateIdToHeightRequest struct {
+	VscId uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
 }
 
-func _Query_QueryConsumerGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerGenesisRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerGenesis(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerGenesis(ctx, req.(*QueryConsumerGenesisRequest))
+func (m *QueryValsetUpdateIdToHeightRequest) Reset()         { *m = QueryValsetUpdateIdToHeightRequest{} }
+func (m *QueryValsetUpdateIdToHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetUpdateIdToHeightRequest) ProtoMessage()    {}
+func (*QueryValsetUpdateIdToHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{42}
+}
+func (m *QueryValsetUpdateIdToHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetUpdateIdToHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetUpdateIdToHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValsetUpdateIdToHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetUpdateIdToHeightRequest.Merge(m, src)
+}
+func (m *QueryValsetUpdateIdToHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValsetUpdateIdToHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetUpdateIdToHeightRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryConsumerChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChains(ctx, req.(*QueryConsumerChainsRequest))
+var xxx_messageInfo_QueryValsetUpdateIdToHeightRequest proto.InternalMessageInfo
+
+func (m *QueryValsetUpdateIdToHeightRequest) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryValidatorConsumerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorConsumerAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, req.(*QueryValidatorConsumerAddrRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryValsetUpdateIdToHeightResponse struct {
+	// the provider block height mapped to the valset update id
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func _Query_QueryValidatorProviderAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorProviderAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, req.(*QueryValidatorProviderAddrRequest))
+func (m *QueryValsetUpdateIdToHeightResponse) Reset()         { *m = QueryValsetUpdateIdToHeightResponse{} }
+func (m *QueryValsetUpdateIdToHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetUpdateIdToHeightResponse) ProtoMessage()    {}
+func (*QueryValsetUpdateIdToHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{43}
+}
+func (m *QueryValsetUpdateIdToHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetUpdateIdToHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetUpdateIdToHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValsetUpdateIdToHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetUpdateIdToHeightResponse.Merge(m, src)
+}
+func (m *QueryValsetUpdateIdToHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValsetUpdateIdToHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetUpdateIdToHeightResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryThrottleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryThrottleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryThrottleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+var xxx_messageInfo_QueryValsetUpdateIdToHeightResponse proto.InternalMessageInfo
+
+func (m *QueryValsetUpdateIdToHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryRegisteredConsumerRewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredConsumerRewardDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, req.(*QueryRegisteredConsumerRewardDenomsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRecentValsetUpdateIdsRequest struct {
 }
 
-func _Query_QueryAllPairsValConsAddrByConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPairsValConsAddrByConsumerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, req.(*QueryAllPairsValConsAddrByConsumerRequest))
+func (m *QueryRecentValsetUpdateIdsRequest) Reset()         { *m = QueryRecentValsetUpdateIdsRequest{} }
+func (m *QueryRecentValsetUpdateIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentValsetUpdateIdsRequest) ProtoMessage()    {}
+func (*QueryRecentValsetUpdateIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{44}
+}
+func (m *QueryRecentValsetUpdateIdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentValsetUpdateIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentValsetUpdateIdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRecentValsetUpdateIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentValsetUpdateIdsRequest.Merge(m, src)
+}
+func (m *QueryRecentValsetUpdateIdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentValsetUpdateIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentValsetUpdateIdsRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryRecentValsetUpdateIdsRequest proto.InternalMessageInfo
+
+type QueryRecentValsetUpdateIdsResponse struct {
+	// the most recent valset update ids and their block heights,
+	// in ascending order of valset update ids
+	ValsetUpdateIdToHeight []ValsetUpdateIdToHeight `protobuf:"bytes,1,rep,name=valset_update_id_to_height,json=valsetUpdateIdToHeight,proto3" json:"valset_update_id_to_height"`
 }
 
-func _Query_QueryConsumerChainOptedInValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainOptedInValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, req.(*QueryConsumerChainOptedInValidatorsRequest))
+func (m *QueryRecentValsetUpdateIdsResponse) Reset()         { *m = QueryRecentValsetUpdateIdsResponse{} }
+func (m *QueryRecentValsetUpdateIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentValsetUpdateIdsResponse) ProtoMessage()    {}
+func (*QueryRecentValsetUpdateIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{45}
+}
+func (m *QueryRecentValsetUpdateIdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentValsetUpdateIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentValsetUpdateIdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRecentValsetUpdateIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentValsetUpdateIdsResponse.Merge(m, src)
+}
+func (m *QueryRecentValsetUpdateIdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentValsetUpdateIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentValsetUpdateIdsResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryConsumerChainsValidatorHasToValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainsValidatorHasToValidateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, req.(*QueryConsumerChainsValidatorHasToValidateRequest))
+var xxx_messageInfo_QueryRecentValsetUpdateIdsResponse proto.InternalMessageInfo
+
+func (m *QueryRecentValsetUpdateIdsResponse) GetValsetUpdateIdToHeight() []ValsetUpdateIdToHeight {
+	if m != nil {
+		return m.ValsetUpdateIdToHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryValidatorConsumerCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorConsumerCommissionRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, req.(*QueryValidatorConsumerCommissionRateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryValidatorsUsingDefaultKeyRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func _Query_QueryConsumerValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerValidators(ctx, req.(*QueryConsumerValidatorsRequest))
+func (m *QueryValidatorsUsingDefaultKeyRequest) Reset()         { *m = QueryValidatorsUsingDefaultKeyRequest{} }
+func (m *QueryValidatorsUsingDefaultKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsUsingDefaultKeyRequest) ProtoMessage()    {}
+func (*QueryValidatorsUsingDefaultKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{46}
+}
+func (m *QueryValidatorsUsingDefaultKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsUsingDefaultKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsUsingDefaultKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorsUsingDefaultKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsUsingDefaultKeyRequest.Merge(m, src)
+}
+func (m *QueryValidatorsUsingDefaultKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsUsingDefaultKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsUsingDefaultKeyRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryBlocksUntilNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlocksUntilNextEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, req.(*QueryBlocksUntilNextEpochRequest))
+var xxx_messageInfo_QueryValidatorsUsingDefaultKeyRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsUsingDefaultKeyRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryConsumerIdFromClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerIdFromClientIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, req.(*QueryConsumerIdFromClientIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryValidatorsUsingDefaultKeyResponse struct {
+	// The consensus addresses of the validators on the provider chain
+	ValidatorsProviderAddresses []string `protobuf:"bytes,1,rep,name=validators_provider_addresses,json=validatorsProviderAddresses,proto3" json:"validators_provider_addresses,omitempty"`
 }
 
-func _Query_QueryConsumerChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChain(ctx, req.(*QueryConsumerChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryValidatorsUsingDefaultKeyResponse) Reset() {
+	*m = QueryValidatorsUsingDefaultKeyResponse{}
 }
-
-func _Query_QueryConsumerGenesisTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerGenesisTimeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, req.(*QueryConsumerGenesisTimeRequest))
+func (m *QueryValidatorsUsingDefaultKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsUsingDefaultKeyResponse) ProtoMessage()    {}
+func (*QueryValidatorsUsingDefaultKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{47}
+}
+func (m *QueryValidatorsUsingDefaultKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsUsingDefaultKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsUsingDefaultKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "interchain_security.ccv.provider.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryConsumerGenesis",
-			Handler:    _Query_QueryConsumerGenesis_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChains",
-			Handler:    _Query_QueryConsumerChains_Handler,
-		},
-		{
-			MethodName: "QueryValidatorConsumerAddr",
-			Handler:    _Query_QueryValidatorConsumerAddr_Handler,
-		},
-		{
-			MethodName: "QueryValidatorProviderAddr",
-			Handler:    _Query_QueryValidatorProviderAddr_Handler,
-		},
-		{
-			MethodName: "QueryThrottleState",
-			Handler:    _Query_QueryThrottleState_Handler,
-		},
-		{
-			MethodName: "QueryRegisteredConsumerRewardDenoms",
-			Handler:    _Query_QueryRegisteredConsumerRewardDenoms_Handler,
-		},
-		{
-			MethodName: "QueryAllPairsValConsAddrByConsumer",
-			Handler:    _Query_QueryAllPairsValConsAddrByConsumer_Handler,
-		},
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChainOptedInValidators",
-			Handler:    _Query_QueryConsumerChainOptedInValidators_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChainsValidatorHasToValidate",
-			Handler:    _Query_QueryConsumerChainsValidatorHasToValidate_Handler,
-		},
-		{
-			MethodName: "QueryValidatorConsumerCommissionRate",
-			Handler:    _Query_QueryValidatorConsumerCommissionRate_Handler,
-		},
-		{
-			MethodName: "QueryConsumerValidators",
-			Handler:    _Query_QueryConsumerValidators_Handler,
-		},
-		{
-			MethodName: "QueryBlocksUntilNextEpoch",
-			Handler:    _Query_QueryBlocksUntilNextEpoch_Handler,
-		},
-		{
-			MethodName: "QueryConsumerIdFromClientId",
-			Handler:    _Query_QueryConsumerIdFromClientId_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChain",
-			Handler:    _Query_QueryConsumerChain_Handler,
-		},
-		{
-			MethodName: "QueryConsumerGenesisTime",
-			Handler:    _Query_QueryConsumerGenesisTime_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchain_security/ccv/provider/v1/query.proto",
+func (m *QueryValidatorsUsingDefaultKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsUsingDefaultKeyResponse.Merge(m, src)
+}
+func (m *QueryValidatorsUsingDefaultKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsUsingDefaultKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsUsingDefaultKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorsUsingDefaultKeyResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsUsingDefaultKeyResponse) GetValidatorsProviderAddresses() []string {
+	if m != nil {
+		return m.ValidatorsProviderAddresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerRewardsAddressRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryConsumerGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerRewardsAddressRequest) Reset()         { *m = QueryConsumerRewardsAddressRequest{} }
+func (m *QueryConsumerRewardsAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRewardsAddressRequest) ProtoMessage()    {}
+func (*QueryConsumerRewardsAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{48}
+}
+func (m *QueryConsumerRewardsAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRewardsAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRewardsAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerRewardsAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRewardsAddressRequest.Merge(m, src)
+}
+func (m *QueryConsumerRewardsAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRewardsAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRewardsAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerRewardsAddressRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRewardsAddressRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerRewardsAddressResponse struct {
+	// the address of the module account that collects the consumer rewards,
+	// i.e., the provider fee pool address sent during the channel handshake
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
 }
 
-func (m *QueryConsumerGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GenesisState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerRewardsAddressResponse) Reset()         { *m = QueryConsumerRewardsAddressResponse{} }
+func (m *QueryConsumerRewardsAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRewardsAddressResponse) ProtoMessage()    {}
+func (*QueryConsumerRewardsAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{49}
+}
+func (m *QueryConsumerRewardsAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRewardsAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRewardsAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerRewardsAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRewardsAddressResponse.Merge(m, src)
+}
+func (m *QueryConsumerRewardsAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRewardsAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRewardsAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerRewardsAddressResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerRewardsAddressResponse) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerChainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTopNThresholdRequest struct {
+	// the N in (0, 100] for which the threshold is computed
+	TopN uint32 `protobuf:"varint,1,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
 }
 
-func (m *QueryConsumerChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTopNThresholdRequest) Reset()         { *m = QueryTopNThresholdRequest{} }
+func (m *QueryTopNThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopNThresholdRequest) ProtoMessage()    {}
+func (*QueryTopNThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{50}
+}
+func (m *QueryTopNThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopNThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopNThresholdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Phase != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryTopNThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopNThresholdRequest.Merge(m, src)
+}
+func (m *QueryTopNThresholdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopNThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopNThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopNThresholdRequest proto.InternalMessageInfo
+
+func (m *QueryTopNThresholdRequest) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryConsumerChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryTopNThresholdResponse struct {
+	// the minimum power needed for a validator to belong to the top N%
+	MinPower int64 `protobuf:"varint,1,opt,name=min_power,json=minPower,proto3" json:"min_power,omitempty"`
 }
 
-func (m *QueryConsumerChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTopNThresholdResponse) Reset()         { *m = QueryTopNThresholdResponse{} }
+func (m *QueryTopNThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopNThresholdResponse) ProtoMessage()    {}
+func (*QueryTopNThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{51}
 }
-
-func (m *QueryConsumerChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTopNThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopNThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopNThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopNThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopNThresholdResponse.Merge(m, src)
+}
+func (m *QueryTopNThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopNThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopNThresholdResponse.DiscardUnknown(m)
 }
 
-func (m *Chain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopNThresholdResponse proto.InternalMessageInfo
+
+func (m *QueryTopNThresholdResponse) GetMinPower() int64 {
+	if m != nil {
+		return m.MinPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerPhaseHistoryRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InfractionParameters != nil {
-		{
-			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryConsumerPhaseHistoryRequest) Reset()         { *m = QueryConsumerPhaseHistoryRequest{} }
+func (m *QueryConsumerPhaseHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerPhaseHistoryRequest) ProtoMessage()    {}
+func (*QueryConsumerPhaseHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{52}
+}
+func (m *QueryConsumerPhaseHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerPhaseHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerPhaseHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		return b[:n], nil
 	}
-	if len(m.Prioritylist) > 0 {
-		for iNdEx := len(m.Prioritylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Prioritylist[iNdEx])
-			copy(dAtA[i:], m.Prioritylist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Prioritylist[iNdEx])))
-			i--
-			dAtA[i] = 0x7a
-		}
+}
+func (m *QueryConsumerPhaseHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerPhaseHistoryRequest.Merge(m, src)
+}
+func (m *QueryConsumerPhaseHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerPhaseHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerPhaseHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerPhaseHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerPhaseHistoryRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	if m.AllowlistedRewardDenoms != nil {
-		{
-			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return ""
+}
+
+type QueryConsumerPhaseHistoryResponse struct {
+	// the phase transitions ordered from the oldest to the newest
+	Transitions []ConsumerPhaseTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions"`
+}
+
+func (m *QueryConsumerPhaseHistoryResponse) Reset()         { *m = QueryConsumerPhaseHistoryResponse{} }
+func (m *QueryConsumerPhaseHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerPhaseHistoryResponse) ProtoMessage()    {}
+func (*QueryConsumerPhaseHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{53}
+}
+func (m *QueryConsumerPhaseHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerPhaseHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerPhaseHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x72
+		return b[:n], nil
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x6a
+}
+func (m *QueryConsumerPhaseHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerPhaseHistoryResponse.Merge(m, src)
+}
+func (m *QueryConsumerPhaseHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerPhaseHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerPhaseHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerPhaseHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerPhaseHistoryResponse) GetTransitions() []ConsumerPhaseTransition {
+	if m != nil {
+		return m.Transitions
 	}
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+type QueryConsumerAddrsToPruneRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerAddrsToPruneRequest) Reset()         { *m = QueryConsumerAddrsToPruneRequest{} }
+func (m *QueryConsumerAddrsToPruneRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerAddrsToPruneRequest) ProtoMessage()    {}
+func (*QueryConsumerAddrsToPruneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{54}
+}
+func (m *QueryConsumerAddrsToPruneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerAddrsToPruneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerAddrsToPruneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x60
+		return b[:n], nil
 	}
-	if m.MinStake != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *QueryConsumerAddrsToPruneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerAddrsToPruneRequest.Merge(m, src)
+}
+func (m *QueryConsumerAddrsToPruneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerAddrsToPruneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerAddrsToPruneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerAddrsToPruneRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerAddrsToPruneRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type QueryConsumerAddrsToPruneResponse struct {
+	// the consumer addresses to prune ordered by their prune timestamps
+	ConsumerAddrsToPrune []ConsumerAddrsToPruneV2 `protobuf:"bytes,1,rep,name=consumer_addrs_to_prune,json=consumerAddrsToPrune,proto3" json:"consumer_addrs_to_prune"`
+}
+
+func (m *QueryConsumerAddrsToPruneResponse) Reset()         { *m = QueryConsumerAddrsToPruneResponse{} }
+func (m *QueryConsumerAddrsToPruneResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerAddrsToPruneResponse) ProtoMessage()    {}
+func (*QueryConsumerAddrsToPruneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{55}
+}
+func (m *QueryConsumerAddrsToPruneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerAddrsToPruneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerAddrsToPruneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0x4a
+		return b[:n], nil
 	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+}
+func (m *QueryConsumerAddrsToPruneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerAddrsToPruneResponse.Merge(m, src)
+}
+func (m *QueryConsumerAddrsToPruneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerAddrsToPruneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerAddrsToPruneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerAddrsToPruneResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerAddrsToPruneResponse) GetConsumerAddrsToPrune() []ConsumerAddrsToPruneV2 {
+	if m != nil {
+		return m.ConsumerAddrsToPrune
 	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+	return nil
+}
+
+type QueryRecentKeyAssignmentsRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the id of the VSC packet after which the key rotations became effective
+	SinceVscId uint64 `protobuf:"varint,2,opt,name=since_vsc_id,json=sinceVscId,proto3" json:"since_vsc_id,omitempty"`
+}
+
+func (m *QueryRecentKeyAssignmentsRequest) Reset()         { *m = QueryRecentKeyAssignmentsRequest{} }
+func (m *QueryRecentKeyAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentKeyAssignmentsRequest) ProtoMessage()    {}
+func (*QueryRecentKeyAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{56}
+}
+func (m *QueryRecentKeyAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentKeyAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentKeyAssignmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MinPowerInTop_N != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinPowerInTop_N))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Top_N != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryRecentKeyAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentKeyAssignmentsRequest.Merge(m, src)
+}
+func (m *QueryRecentKeyAssignmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentKeyAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentKeyAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecentKeyAssignmentsRequest proto.InternalMessageInfo
+
+func (m *QueryRecentKeyAssignmentsRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorConsumerAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRecentKeyAssignmentsRequest) GetSinceVscId() uint64 {
+	if m != nil {
+		return m.SinceVscId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorConsumerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRecentKeyAssignmentsResponse struct {
+	// the key rotations ordered by the time they took place
+	KeyRotations []KeyRotation `protobuf:"bytes,1,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations"`
 }
 
-func (m *QueryValidatorConsumerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRecentKeyAssignmentsResponse) Reset()         { *m = QueryRecentKeyAssignmentsResponse{} }
+func (m *QueryRecentKeyAssignmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentKeyAssignmentsResponse) ProtoMessage()    {}
+func (*QueryRecentKeyAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{57}
+}
+func (m *QueryRecentKeyAssignmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentKeyAssignmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentKeyAssignmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRecentKeyAssignmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentKeyAssignmentsResponse.Merge(m, src)
+}
+func (m *QueryRecentKeyAssignmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentKeyAssignmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentKeyAssignmentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecentKeyAssignmentsResponse proto.InternalMessageInfo
+
+func (m *QueryRecentKeyAssignmentsResponse) GetKeyRotations() []KeyRotation {
+	if m != nil {
+		return m.KeyRotations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorConsumerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// KeyRotation describes the replacement of the consensus address
+// used by a validator on a consumer chain
+type KeyRotation struct {
+	// the validator's consensus address on the provider
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the consensus address used on the consumer chain before the rotation
+	OldConsumerAddress string `protobuf:"bytes,2,opt,name=old_consumer_address,json=oldConsumerAddress,proto3" json:"old_consumer_address,omitempty"`
+	// the consensus address used on the consumer chain after the rotation
+	NewConsumerAddress string `protobuf:"bytes,3,opt,name=new_consumer_address,json=newConsumerAddress,proto3" json:"new_consumer_address,omitempty"`
 }
 
-func (m *QueryValidatorConsumerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *KeyRotation) Reset()         { *m = KeyRotation{} }
+func (m *KeyRotation) String() string { return proto.CompactTextString(m) }
+func (*KeyRotation) ProtoMessage()    {}
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{58}
 }
-
-func (m *QueryValidatorProviderAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryValidatorProviderAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotation.Merge(m, src)
+}
+func (m *KeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotation.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorProviderAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_KeyRotation proto.InternalMessageInfo
+
+func (m *KeyRotation) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *KeyRotation) GetOldConsumerAddress() string {
+	if m != nil {
+		return m.OldConsumerAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorProviderAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyRotation) GetNewConsumerAddress() string {
+	if m != nil {
+		return m.NewConsumerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorProviderAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryJailingReasonRequest struct {
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
 }
 
-func (m *QueryValidatorProviderAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryJailingReasonRequest) Reset()         { *m = QueryJailingReasonRequest{} }
+func (m *QueryJailingReasonRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJailingReasonRequest) ProtoMessage()    {}
+func (*QueryJailingReasonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{59}
+}
+func (m *QueryJailingReasonRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailingReasonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailingReasonRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryJailingReasonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailingReasonRequest.Merge(m, src)
+}
+func (m *QueryJailingReasonRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailingReasonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailingReasonRequest.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryJailingReasonRequest proto.InternalMessageInfo
+
+func (m *QueryJailingReasonRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryJailingReasonResponse struct {
+	// the consumer id of the consumer chain that caused the jailing,
+	// or "provider" if the validator was jailed on the provider chain
+	JailedBy string `protobuf:"bytes,1,opt,name=jailed_by,json=jailedBy,proto3" json:"jailed_by,omitempty"`
+	// the infraction reported by the consumer chain;
+	// unspecified if the validator was jailed on the provider chain
+	Infraction types1.Infraction `protobuf:"varint,2,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
 }
 
-func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryJailingReasonResponse) Reset()         { *m = QueryJailingReasonResponse{} }
+func (m *QueryJailingReasonResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJailingReasonResponse) ProtoMessage()    {}
+func (*QueryJailingReasonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{60}
 }
-
-func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryJailingReasonResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailingReasonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailingReasonResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryJailingReasonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailingReasonResponse.Merge(m, src)
+}
+func (m *QueryJailingReasonResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailingReasonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailingReasonResponse.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryJailingReasonResponse proto.InternalMessageInfo
+
+func (m *QueryJailingReasonResponse) GetJailedBy() string {
+	if m != nil {
+		return m.JailedBy
+	}
+	return ""
 }
 
-func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x1a
-	if m.SlashMeterAllowance != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SlashMeter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryJailingReasonResponse) GetInfraction() types1.Infraction {
+	if m != nil {
+		return m.Infraction
 	}
-	return len(dAtA) - i, nil
+	return types1.Infraction_INFRACTION_UNSPECIFIED
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryConsumerSlashPacketRateRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerSlashPacketRateRequest) Reset()         { *m = QueryConsumerSlashPacketRateRequest{} }
+func (m *QueryConsumerSlashPacketRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerSlashPacketRateRequest) ProtoMessage()    {}
+func (*QueryConsumerSlashPacketRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{61}
 }
-
-func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryConsumerSlashPacketRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerSlashPacketRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerSlashPacketRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerSlashPacketRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerSlashPacketRateRequest.Merge(m, src)
+}
+func (m *QueryConsumerSlashPacketRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerSlashPacketRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerSlashPacketRateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerSlashPacketRateRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerSlashPacketRateRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerSlashPacketRateResponse struct {
+	// the number of slash packets received from the consumer chain in the window
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// the number of most recent blocks, including the current block,
+	// over which the slash packets are counted
+	WindowBlocks uint64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryConsumerSlashPacketRateResponse) Reset()         { *m = QueryConsumerSlashPacketRateResponse{} }
+func (m *QueryConsumerSlashPacketRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerSlashPacketRateResponse) ProtoMessage()    {}
+func (*QueryConsumerSlashPacketRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{62}
+}
+func (m *QueryConsumerSlashPacketRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerSlashPacketRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerSlashPacketRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryConsumerSlashPacketRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerSlashPacketRateResponse.Merge(m, src)
 }
-
-func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerSlashPacketRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerSlashPacketRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerSlashPacketRateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryConsumerSlashPacketRateResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerSlashPacketRateResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerSlashPacketRateResponse) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryEffectiveConsumerKeyRequest struct {
+	// The id of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PairValConAddr) > 0 {
-		for iNdEx := len(m.PairValConAddr) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PairValConAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEffectiveConsumerKeyRequest) Reset()         { *m = QueryEffectiveConsumerKeyRequest{} }
+func (m *QueryEffectiveConsumerKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveConsumerKeyRequest) ProtoMessage()    {}
+func (*QueryEffectiveConsumerKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{63}
+}
+func (m *QueryEffectiveConsumerKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveConsumerKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveConsumerKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEffectiveConsumerKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveConsumerKeyRequest.Merge(m, src)
+}
+func (m *QueryEffectiveConsumerKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveConsumerKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveConsumerKeyRequest.DiscardUnknown(m)
 }
 
-func (m *PairValConAddrProviderAndConsumer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEffectiveConsumerKeyRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveConsumerKeyRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PairValConAddrProviderAndConsumer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEffectiveConsumerKeyRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
 
-func (m *PairValConAddrProviderAndConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryEffectiveConsumerKeyResponse struct {
+	// The key used by the validator on the consumer chain
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,1,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+	// True if the validator did not assign a consumer key and
+	// thus uses its provider consensus key on the consumer chain
+	IsDefault bool `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+}
+
+func (m *QueryEffectiveConsumerKeyResponse) Reset()         { *m = QueryEffectiveConsumerKeyResponse{} }
+func (m *QueryEffectiveConsumerKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveConsumerKeyResponse) ProtoMessage()    {}
+func (*QueryEffectiveConsumerKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{64}
+}
+func (m *QueryEffectiveConsumerKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveConsumerKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveConsumerKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryEffectiveConsumerKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveConsumerKeyResponse.Merge(m, src)
+}
+func (m *QueryEffectiveConsumerKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveConsumerKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveConsumerKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveConsumerKeyResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveConsumerKeyResponse) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEffectiveConsumerKeyResponse) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerLaunchFailureRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryConsumerLaunchFailureRequest) Reset()         { *m = QueryConsumerLaunchFailureRequest{} }
+func (m *QueryConsumerLaunchFailureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerLaunchFailureRequest) ProtoMessage()    {}
+func (*QueryConsumerLaunchFailureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{65}
+}
+func (m *QueryConsumerLaunchFailureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerLaunchFailureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerLaunchFailureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerLaunchFailureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerLaunchFailureRequest.Merge(m, src)
+}
+func (m *QueryConsumerLaunchFailureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerLaunchFailureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerLaunchFailureRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerLaunchFailureRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerLaunchFailureRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerLaunchFailureResponse struct {
+	// the number of failed attempts to launch the consumer chain
+	Attempts uint32 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// the error of the last failed attempt
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// the phase of the consumer chain, i.e., REGISTERED
+	// if the consumer chain is not scheduled to launch anymore
+	Phase ConsumerPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerLaunchFailureResponse) Reset()         { *m = QueryConsumerLaunchFailureResponse{} }
+func (m *QueryConsumerLaunchFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerLaunchFailureResponse) ProtoMessage()    {}
+func (*QueryConsumerLaunchFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{66}
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerLaunchFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerLaunchFailureResponse.Merge(m, src)
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerLaunchFailureResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerLaunchFailureResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerLaunchFailureResponse) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerLaunchFailureResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerLaunchFailureResponse) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
 	}
-	return len(dAtA) - i, nil
+	return CONSUMER_PHASE_UNSPECIFIED
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type QuerySlashPacketBySeqRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the IBC sequence number of the slash packet
+	IbcSeq uint64 `protobuf:"varint,2,opt,name=ibc_seq,json=ibcSeq,proto3" json:"ibc_seq,omitempty"`
+}
+
+func (m *QuerySlashPacketBySeqRequest) Reset()         { *m = QuerySlashPacketBySeqRequest{} }
+func (m *QuerySlashPacketBySeqRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashPacketBySeqRequest) ProtoMessage()    {}
+func (*QuerySlashPacketBySeqRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{67}
+}
+func (m *QuerySlashPacketBySeqRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashPacketBySeqRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashPacketBySeqRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySlashPacketBySeqRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashPacketBySeqRequest.Merge(m, src)
+}
+func (m *QuerySlashPacketBySeqRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashPacketBySeqRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashPacketBySeqRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySlashPacketBySeqRequest proto.InternalMessageInfo
+
+func (m *QuerySlashPacketBySeqRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorsProviderAddresses) > 0 {
-		for iNdEx := len(m.ValidatorsProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ValidatorsProviderAddresses[iNdEx])
-			copy(dAtA[i:], m.ValidatorsProviderAddresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorsProviderAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySlashPacketBySeqRequest) GetIbcSeq() uint64 {
+	if m != nil {
+		return m.IbcSeq
+	}
+	return 0
+}
+
+type QuerySlashPacketBySeqResponse struct {
+	// the processing status of the slash packet; unknown if the slash packet was not received,
+	// was invalid, or was received more than an unbonding period ago
+	Status SlashPacketStatus `protobuf:"varint,1,opt,name=status,proto3,enum=interchain_security.ccv.provider.v1.SlashPacketStatus" json:"status,omitempty"`
+	// the consensus address on the provider of the validator in the slash packet
+	ProviderAddress string `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the infraction reported in the slash packet
+	Infraction types1.Infraction `protobuf:"varint,3,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+	// true if the validator was jailed because of the slash packet
+	Jailed bool `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+}
+
+func (m *QuerySlashPacketBySeqResponse) Reset()         { *m = QuerySlashPacketBySeqResponse{} }
+func (m *QuerySlashPacketBySeqResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashPacketBySeqResponse) ProtoMessage()    {}
+func (*QuerySlashPacketBySeqResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{68}
+}
+func (m *QuerySlashPacketBySeqResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashPacketBySeqResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashPacketBySeqResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySlashPacketBySeqResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashPacketBySeqResponse.Merge(m, src)
+}
+func (m *QuerySlashPacketBySeqResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashPacketBySeqResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashPacketBySeqResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashPacketBySeqResponse proto.InternalMessageInfo
+
+func (m *QuerySlashPacketBySeqResponse) GetStatus() SlashPacketStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return SLASH_PACKET_STATUS_UNKNOWN
 }
 
-func (m *QueryConsumerValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashPacketBySeqResponse) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
 
-func (m *QueryConsumerValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySlashPacketBySeqResponse) GetInfraction() types1.Infraction {
+	if m != nil {
+		return m.Infraction
 	}
-	return len(dAtA) - i, nil
+	return types1.Infraction_INFRACTION_UNSPECIFIED
 }
 
-func (m *QueryConsumerValidatorsValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlashPacketBySeqResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryConsumerValidatorsValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumersWithThrottledSlashingRequest struct {
 }
 
-func (m *QueryConsumerValidatorsValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatesCurrentEpoch {
-		i--
-		if m.ValidatesCurrentEpoch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryConsumersWithThrottledSlashingRequest) Reset() {
+	*m = QueryConsumersWithThrottledSlashingRequest{}
+}
+func (m *QueryConsumersWithThrottledSlashingRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumersWithThrottledSlashingRequest) ProtoMessage() {}
+func (*QueryConsumersWithThrottledSlashingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{69}
+}
+func (m *QueryConsumersWithThrottledSlashingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersWithThrottledSlashingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersWithThrottledSlashingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x70
+		return b[:n], nil
 	}
-	if m.ProviderPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProviderPower))
-		i--
-		dAtA[i] = 0x68
+}
+func (m *QueryConsumersWithThrottledSlashingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersWithThrottledSlashingRequest.Merge(m, src)
+}
+func (m *QueryConsumersWithThrottledSlashingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersWithThrottledSlashingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersWithThrottledSlashingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersWithThrottledSlashingRequest proto.InternalMessageInfo
+
+type QueryConsumersWithThrottledSlashingResponse struct {
+	Consumers []ConsumerThrottledSlashPackets `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers"`
+}
+
+func (m *QueryConsumersWithThrottledSlashingResponse) Reset() {
+	*m = QueryConsumersWithThrottledSlashingResponse{}
+}
+func (m *QueryConsumersWithThrottledSlashingResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumersWithThrottledSlashingResponse) ProtoMessage() {}
+func (*QueryConsumersWithThrottledSlashingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{70}
+}
+func (m *QueryConsumersWithThrottledSlashingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersWithThrottledSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersWithThrottledSlashingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	{
-		size := m.ProviderTokens.Size()
-		i -= size
-		if _, err := m.ProviderTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.ProviderOperatorAddress) > 0 {
-		i -= len(m.ProviderOperatorAddress)
-		copy(dAtA[i:], m.ProviderOperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderOperatorAddress)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.ProviderCommissionRate.Size()
-		i -= size
-		if _, err := m.ProviderCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.ConsumerCommissionRate.Size()
-		i -= size
-		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.ConsumerPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerPower))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Power != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumersWithThrottledSlashingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersWithThrottledSlashingResponse.Merge(m, src)
+}
+func (m *QueryConsumersWithThrottledSlashingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersWithThrottledSlashingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersWithThrottledSlashingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumersWithThrottledSlashingResponse proto.InternalMessageInfo
+
+func (m *QueryConsumersWithThrottledSlashingResponse) GetConsumers() []ConsumerThrottledSlashPackets {
+	if m != nil {
+		return m.Consumers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ConsumerThrottledSlashPackets struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the number of slash packets of the consumer chain that are currently throttled,
+	// i.e., whose last attempt was bounced and that are thus still to be retried
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *QueryConsumerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ConsumerThrottledSlashPackets) Reset()         { *m = ConsumerThrottledSlashPackets{} }
+func (m *ConsumerThrottledSlashPackets) String() string { return proto.CompactTextString(m) }
+func (*ConsumerThrottledSlashPackets) ProtoMessage()    {}
+func (*ConsumerThrottledSlashPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{71}
+}
+func (m *ConsumerThrottledSlashPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerThrottledSlashPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerThrottledSlashPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerThrottledSlashPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerThrottledSlashPackets.Merge(m, src)
+}
+func (m *ConsumerThrottledSlashPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerThrottledSlashPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerThrottledSlashPackets.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerThrottledSlashPackets proto.InternalMessageInfo
+
+func (m *ConsumerThrottledSlashPackets) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerThrottledSlashPackets) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+type QueryValidatorTopNObligationsRequest struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+}
+
+func (m *QueryValidatorTopNObligationsRequest) Reset()         { *m = QueryValidatorTopNObligationsRequest{} }
+func (m *QueryValidatorTopNObligationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorTopNObligationsRequest) ProtoMessage()    {}
+func (*QueryValidatorTopNObligationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{72}
+}
+func (m *QueryValidatorTopNObligationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorTopNObligationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorTopNObligationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorTopNObligationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorTopNObligationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorTopNObligationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorTopNObligationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorTopNObligationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorTopNObligationsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorTopNObligationsRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValidatorTopNObligationsResponse struct {
+	// the current power of the validator on the provider chain
+	Power       int64            `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
+	Obligations []TopNObligation `protobuf:"bytes,2,rep,name=obligations,proto3" json:"obligations"`
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerIds) > 0 {
-		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ConsumerIds[iNdEx])
-			copy(dAtA[i:], m.ConsumerIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorTopNObligationsResponse) Reset()         { *m = QueryValidatorTopNObligationsResponse{} }
+func (m *QueryValidatorTopNObligationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorTopNObligationsResponse) ProtoMessage()    {}
+func (*QueryValidatorTopNObligationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{73}
+}
+func (m *QueryValidatorTopNObligationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorTopNObligationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorTopNObligationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorTopNObligationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorTopNObligationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorTopNObligationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorTopNObligationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorTopNObligationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorTopNObligationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorTopNObligationsResponse) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorTopNObligationsResponse) GetObligations() []TopNObligation {
+	if m != nil {
+		return m.Obligations
+	}
+	return nil
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0x12
+// TopNObligation is a Top N consumer chain that a validator is forced to validate
+type TopNObligation struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the N of the Top N consumer chain
+	Top_N uint32 `protobuf:"varint,2,opt,name=top_N,json=topN,proto3" json:"top_N,omitempty"`
+	// the minimum power a validator needs to be forced to validate the consumer chain
+	MinPowerInTop_N int64 `protobuf:"varint,3,opt,name=min_power_in_top_N,json=minPowerInTopN,proto3" json:"min_power_in_top_N,omitempty"`
+}
+
+func (m *TopNObligation) Reset()         { *m = TopNObligation{} }
+func (m *TopNObligation) String() string { return proto.CompactTextString(m) }
+func (*TopNObligation) ProtoMessage()    {}
+func (*TopNObligation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{74}
+}
+func (m *TopNObligation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopNObligation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopNObligation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TopNObligation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopNObligation.Merge(m, src)
+}
+func (m *TopNObligation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopNObligation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopNObligation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopNObligation proto.InternalMessageInfo
+
+func (m *TopNObligation) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TopNObligation) GetTop_N() uint32 {
+	if m != nil {
+		return m.Top_N
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TopNObligation) GetMinPowerInTop_N() int64 {
+	if m != nil {
+		return m.MinPowerInTop_N
+	}
+	return 0
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type QueryConsumerGenesisValsetHashRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerGenesisValsetHashRequest) Reset()         { *m = QueryConsumerGenesisValsetHashRequest{} }
+func (m *QueryConsumerGenesisValsetHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerGenesisValsetHashRequest) ProtoMessage()    {}
+func (*QueryConsumerGenesisValsetHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{75}
+}
+func (m *QueryConsumerGenesisValsetHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerGenesisValsetHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerGenesisValsetHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerGenesisValsetHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerGenesisValsetHashRequest.Merge(m, src)
+}
+func (m *QueryConsumerGenesisValsetHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerGenesisValsetHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerGenesisValsetHashRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerGenesisValsetHashRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerGenesisValsetHashRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerGenesisValsetHashResponse struct {
+	// the hex-encoded CometBFT validator set hash of the initial consumer validators
+	ValsetHash string `protobuf:"bytes,1,opt,name=valset_hash,json=valsetHash,proto3" json:"valset_hash,omitempty"`
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryConsumerGenesisValsetHashResponse) Reset() {
+	*m = QueryConsumerGenesisValsetHashResponse{}
+}
+func (m *QueryConsumerGenesisValsetHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerGenesisValsetHashResponse) ProtoMessage()    {}
+func (*QueryConsumerGenesisValsetHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{76}
+}
+func (m *QueryConsumerGenesisValsetHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerGenesisValsetHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerGenesisValsetHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerGenesisValsetHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerGenesisValsetHashResponse.Merge(m, src)
+}
+func (m *QueryConsumerGenesisValsetHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerGenesisValsetHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerGenesisValsetHashResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerGenesisValsetHashResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerGenesisValsetHashResponse) GetValsetHash() string {
+	if m != nil {
+		return m.ValsetHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerDistributionRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlocksUntilNextEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryConsumerDistributionRequest) Reset()         { *m = QueryConsumerDistributionRequest{} }
+func (m *QueryConsumerDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerDistributionRequest) ProtoMessage()    {}
+func (*QueryConsumerDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{77}
+}
+func (m *QueryConsumerDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerDistributionRequest.Merge(m, src)
+}
+func (m *QueryConsumerDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerDistributionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerDistributionRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerDistributionResponse struct {
+	// the distribution transmission channel id on the consumer chain
+	DistributionChannelId string `protobuf:"bytes,1,opt,name=distribution_channel_id,json=distributionChannelId,proto3" json:"distribution_channel_id,omitempty"`
+	// the counterparty channel id on the provider chain
+	ProviderChannelId string `protobuf:"bytes,2,opt,name=provider_channel_id,json=providerChannelId,proto3" json:"provider_channel_id,omitempty"`
+	// whether the distribution channel is OPEN
+	Open bool `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	// whether sending IBC transfers is enabled on the provider chain
+	SendEnabled bool `protobuf:"varint,4,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// whether receiving IBC transfers is enabled on the provider chain
+	ReceiveEnabled bool `protobuf:"varint,5,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerDistributionResponse) Reset()         { *m = QueryConsumerDistributionResponse{} }
+func (m *QueryConsumerDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerDistributionResponse) ProtoMessage()    {}
+func (*QueryConsumerDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{78}
+}
+func (m *QueryConsumerDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerDistributionResponse.Merge(m, src)
+}
+func (m *QueryConsumerDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerDistributionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerDistributionResponse) GetDistributionChannelId() string {
+	if m != nil {
+		return m.DistributionChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerDistributionResponse) GetProviderChannelId() string {
+	if m != nil {
+		return m.ProviderChannelId
+	}
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerDistributionResponse) GetOpen() bool {
+	if m != nil {
+		return m.Open
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryConsumerChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerDistributionResponse) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryConsumerChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerDistributionResponse) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
 }
 
-func (m *QueryConsumerChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+type QueryConsumerValidatorSetsRequest struct {
+	ConsumerIds []string `protobuf:"bytes,1,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
+}
+
+func (m *QueryConsumerValidatorSetsRequest) Reset()         { *m = QueryConsumerValidatorSetsRequest{} }
+func (m *QueryConsumerValidatorSetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerValidatorSetsRequest) ProtoMessage()    {}
+func (*QueryConsumerValidatorSetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{79}
+}
+func (m *QueryConsumerValidatorSetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerValidatorSetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorSetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerValidatorSetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorSetsRequest.Merge(m, src)
+}
+func (m *QueryConsumerValidatorSetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerValidatorSetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorSetsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerValidatorSetsRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorSetsRequest) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerValidatorSetsResponse struct {
+	// the validator sets in the order of the requested consumer ids
+	ValidatorSets []ConsumerValidatorSet `protobuf:"bytes,1,rep,name=validator_sets,json=validatorSets,proto3" json:"validator_sets"`
 }
 
-func (m *QueryConsumerChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.InfractionParameters != nil {
-		{
-			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.PowerShapingParams != nil {
-		{
-			size, err := m.PowerShapingParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.InitParams != nil {
-		{
-			size, err := m.InitParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerValidatorSetsResponse) Reset()         { *m = QueryConsumerValidatorSetsResponse{} }
+func (m *QueryConsumerValidatorSetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerValidatorSetsResponse) ProtoMessage()    {}
+func (*QueryConsumerValidatorSetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{80}
+}
+func (m *QueryConsumerValidatorSetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerValidatorSetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorSetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerValidatorSetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorSetsResponse.Merge(m, src)
+}
+func (m *QueryConsumerValidatorSetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerValidatorSetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorSetsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisTimeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerValidatorSetsResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorSetsResponse) GetValidatorSets() []ConsumerValidatorSet {
+	if m != nil {
+		return m.ValidatorSets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerGenesisTimeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerValidatorSet is the validator set of a consumer chain
+type ConsumerValidatorSet struct {
+	ConsumerId string                              `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	Validators []*QueryConsumerValidatorsValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (m *QueryConsumerGenesisTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerValidatorSet) Reset()         { *m = ConsumerValidatorSet{} }
+func (m *ConsumerValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*ConsumerValidatorSet) ProtoMessage()    {}
+func (*ConsumerValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{81}
+}
+func (m *ConsumerValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerValidatorSet.Merge(m, src)
+}
+func (m *ConsumerValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerValidatorSet.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisTimeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerValidatorSet proto.InternalMessageInfo
+
+func (m *ConsumerValidatorSet) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerGenesisTimeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerValidatorSet) GetValidators() []*QueryConsumerValidatorsValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (m *QueryConsumerGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.GenesisTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime):])
-	if err16 != nil {
-		return 0, err16
-	}
-	i -= n16
-	i = encodeVarintQuery(dAtA, i, uint64(n16))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type QueryValidatorKeyConflictsRequest struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryValidatorKeyConflictsRequest) Reset()         { *m = QueryValidatorKeyConflictsRequest{} }
+func (m *QueryValidatorKeyConflictsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorKeyConflictsRequest) ProtoMessage()    {}
+func (*QueryValidatorKeyConflictsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{82}
 }
-func (m *QueryConsumerGenesisRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorKeyConflictsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorKeyConflictsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorKeyConflictsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorKeyConflictsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorKeyConflictsRequest.Merge(m, src)
+}
+func (m *QueryValidatorKeyConflictsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorKeyConflictsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorKeyConflictsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorKeyConflictsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorKeyConflictsRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	var l int
-	_ = l
-	l = m.GenesisState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryConsumerChainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Phase != 0 {
-		n += 1 + sovQuery(uint64(m.Phase))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryValidatorKeyConflictsResponse struct {
+	Conflicts []ConsumerKeyConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts"`
 }
 
-func (m *QueryConsumerChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryValidatorKeyConflictsResponse) Reset()         { *m = QueryValidatorKeyConflictsResponse{} }
+func (m *QueryValidatorKeyConflictsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorKeyConflictsResponse) ProtoMessage()    {}
+func (*QueryValidatorKeyConflictsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{83}
 }
-
-func (m *Chain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Top_N != 0 {
-		n += 1 + sovQuery(uint64(m.Top_N))
-	}
-	if m.MinPowerInTop_N != 0 {
-		n += 1 + sovQuery(uint64(m.MinPowerInTop_N))
-	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorsPowerCap))
-	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorSetCap))
-	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.MinStake != 0 {
-		n += 1 + sovQuery(uint64(m.MinStake))
-	}
-	if m.AllowInactiveVals {
-		n += 2
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AllowlistedRewardDenoms != nil {
-		l = m.AllowlistedRewardDenoms.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Prioritylist) > 0 {
-		for _, s := range m.Prioritylist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorKeyConflictsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorKeyConflictsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorKeyConflictsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.InfractionParameters != nil {
-		l = m.InfractionParameters.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryValidatorKeyConflictsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorKeyConflictsResponse.Merge(m, src)
+}
+func (m *QueryValidatorKeyConflictsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorKeyConflictsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorKeyConflictsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryValidatorKeyConflictsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorKeyConflictsResponse) GetConflicts() []ConsumerKeyConflict {
+	if m != nil {
+		return m.Conflicts
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorConsumerAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// ConsumerKeyConflict is a consumer key that a validator assigned on multiple consumer chains
+type ConsumerKeyConflict struct {
+	// The consensus address of the validator on the consumer chains
+	ConsumerAddress string `protobuf:"bytes,1,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// The consumer public key assigned on the consumer chains
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,2,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+	// The consumer chains on which the consumer key is assigned
+	ConsumerIds []string `protobuf:"bytes,3,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
 }
 
-func (m *QueryValidatorProviderAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ConsumerKeyConflict) Reset()         { *m = ConsumerKeyConflict{} }
+func (m *ConsumerKeyConflict) String() string { return proto.CompactTextString(m) }
+func (*ConsumerKeyConflict) ProtoMessage()    {}
+func (*ConsumerKeyConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{84}
+}
+func (m *ConsumerKeyConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerKeyConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerKeyConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConsumerKeyConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerKeyConflict.Merge(m, src)
+}
+func (m *ConsumerKeyConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerKeyConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerKeyConflict.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorProviderAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_ConsumerKeyConflict proto.InternalMessageInfo
+
+func (m *ConsumerKeyConflict) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryThrottleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerKeyConflict) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryThrottleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SlashMeter != 0 {
-		n += 1 + sovQuery(uint64(m.SlashMeter))
-	}
-	if m.SlashMeterAllowance != 0 {
-		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+func (m *ConsumerKeyConflict) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryConsumerRemovalETARequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConsumerRemovalETARequest) Reset()         { *m = QueryConsumerRemovalETARequest{} }
+func (m *QueryConsumerRemovalETARequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRemovalETARequest) ProtoMessage()    {}
+func (*QueryConsumerRemovalETARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{85}
+}
+func (m *QueryConsumerRemovalETARequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRemovalETARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRemovalETARequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConsumerRemovalETARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRemovalETARequest.Merge(m, src)
+}
+func (m *QueryConsumerRemovalETARequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRemovalETARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRemovalETARequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryConsumerRemovalETARequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRemovalETARequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PairValConAddr) > 0 {
-		for _, e := range m.PairValConAddr {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryConsumerRemovalETAResponse struct {
+	// whether the removal of the consumer chain is scheduled
+	RemovalScheduled bool `protobuf:"varint,1,opt,name=removal_scheduled,json=removalScheduled,proto3" json:"removal_scheduled,omitempty"`
+	// the time at which the consumer chain is scheduled to be removed
+	RemovalTime time.Time `protobuf:"bytes,2,opt,name=removal_time,json=removalTime,proto3,stdtime" json:"removal_time"`
+	// the time remaining until the removal, relative to the current block time;
+	// zero if the removal time has already been reached
+	TimeRemaining time.Duration `protobuf:"bytes,3,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining"`
+}
+
+func (m *QueryConsumerRemovalETAResponse) Reset()         { *m = QueryConsumerRemovalETAResponse{} }
+func (m *QueryConsumerRemovalETAResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRemovalETAResponse) ProtoMessage()    {}
+func (*QueryConsumerRemovalETAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{86}
+}
+func (m *QueryConsumerRemovalETAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRemovalETAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRemovalETAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConsumerRemovalETAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRemovalETAResponse.Merge(m, src)
+}
+func (m *QueryConsumerRemovalETAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRemovalETAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRemovalETAResponse.DiscardUnknown(m)
 }
 
-func (m *PairValConAddrProviderAndConsumer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryConsumerRemovalETAResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerRemovalETAResponse) GetRemovalScheduled() bool {
+	if m != nil {
+		return m.RemovalScheduled
 	}
-	return n
+	return false
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerRemovalETAResponse) GetRemovalTime() time.Time {
+	if m != nil {
+		return m.RemovalTime
 	}
-	var l int
-	_ = l
-	return n
+	return time.Time{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerRemovalETAResponse) GetTimeRemaining() time.Duration {
+	if m != nil {
+		return m.TimeRemaining
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryConsumerJailedPowerRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorsProviderAddresses) > 0 {
-		for _, s := range m.ValidatorsProviderAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConsumerJailedPowerRequest) Reset()         { *m = QueryConsumerJailedPowerRequest{} }
+func (m *QueryConsumerJailedPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerJailedPowerRequest) ProtoMessage()    {}
+func (*QueryConsumerJailedPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{87}
+}
+func (m *QueryConsumerJailedPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerJailedPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerJailedPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConsumerJailedPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerJailedPowerRequest.Merge(m, src)
+}
+func (m *QueryConsumerJailedPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerJailedPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerJailedPowerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryConsumerJailedPowerRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerJailedPowerRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerValidatorsValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovQuery(uint64(m.Power))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ConsumerPower != 0 {
-		n += 1 + sovQuery(uint64(m.ConsumerPower))
-	}
-	l = m.ConsumerCommissionRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ProviderCommissionRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProviderOperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Jailed {
-		n += 2
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	l = m.ProviderTokens.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ProviderPower != 0 {
-		n += 1 + sovQuery(uint64(m.ProviderPower))
-	}
-	if m.ValidatesCurrentEpoch {
-		n += 2
-	}
-	return n
+type QueryConsumerJailedPowerResponse struct {
+	// the cumulative power of the validators jailed because of the
+	// slash packets received from the consumer chain since it launched
+	JailedPower int64 `protobuf:"varint,1,opt,name=jailed_power,json=jailedPower,proto3" json:"jailed_power,omitempty"`
 }
 
-func (m *QueryConsumerValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConsumerJailedPowerResponse) Reset()         { *m = QueryConsumerJailedPowerResponse{} }
+func (m *QueryConsumerJailedPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerJailedPowerResponse) ProtoMessage()    {}
+func (*QueryConsumerJailedPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{88}
+}
+func (m *QueryConsumerJailedPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerJailedPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerJailedPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConsumerJailedPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerJailedPowerResponse.Merge(m, src)
+}
+func (m *QueryConsumerJailedPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerJailedPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerJailedPowerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryConsumerJailedPowerResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerJailedPowerResponse) GetJailedPower() int64 {
+	if m != nil {
+		return m.JailedPower
 	}
-	return n
+	return 0
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ConsumerIds) > 0 {
-		for _, s := range m.ConsumerIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+type QueryValidatorDenylistedConsumersRequest struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+}
+
+func (m *QueryValidatorDenylistedConsumersRequest) Reset() {
+	*m = QueryValidatorDenylistedConsumersRequest{}
+}
+func (m *QueryValidatorDenylistedConsumersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorDenylistedConsumersRequest) ProtoMessage()    {}
+func (*QueryValidatorDenylistedConsumersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{89}
+}
+func (m *QueryValidatorDenylistedConsumersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDenylistedConsumersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDenylistedConsumersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorDenylistedConsumersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDenylistedConsumersRequest.Merge(m, src)
+}
+func (m *QueryValidatorDenylistedConsumersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDenylistedConsumersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDenylistedConsumersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryValidatorDenylistedConsumersRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorDenylistedConsumersRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryValidatorDenylistedConsumersResponse struct {
+	// the ids of the active consumer chains on which the validator is denylisted
+	// and hence cannot validate, even if it opted in or belongs to the Top N validators
+	ConsumerIds []string `protobuf:"bytes,1,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorDenylistedConsumersResponse) Reset() {
+	*m = QueryValidatorDenylistedConsumersResponse{}
+}
+func (m *QueryValidatorDenylistedConsumersResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorDenylistedConsumersResponse) ProtoMessage() {}
+func (*QueryValidatorDenylistedConsumersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{90}
+}
+func (m *QueryValidatorDenylistedConsumersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDenylistedConsumersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDenylistedConsumersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryValidatorDenylistedConsumersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDenylistedConsumersResponse.Merge(m, src)
+}
+func (m *QueryValidatorDenylistedConsumersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDenylistedConsumersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDenylistedConsumersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorDenylistedConsumersResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorDenylistedConsumersResponse) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
 	}
-	var l int
-	_ = l
-	if m.BlocksUntilNextEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+	return nil
+}
+
+type QueryValidatorHasToValidateRequest struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+	// The id of the consumer chain
+	ConsumerId string `protobuf:"bytes,2,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryValidatorHasToValidateRequest) Reset()         { *m = QueryValidatorHasToValidateRequest{} }
+func (m *QueryValidatorHasToValidateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorHasToValidateRequest) ProtoMessage()    {}
+func (*QueryValidatorHasToValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{91}
+}
+func (m *QueryValidatorHasToValidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorHasToValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorHasToValidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorHasToValidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorHasToValidateRequest.Merge(m, src)
+}
+func (m *QueryValidatorHasToValidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorHasToValidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorHasToValidateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorHasToValidateRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorHasToValidateRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryValidatorHasToValidateRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryValidatorHasToValidateResponse struct {
+	// whether the validator has to validate the consumer chain
+	HasToValidate bool `protobuf:"varint,1,opt,name=has_to_validate,json=hasToValidate,proto3" json:"has_to_validate,omitempty"`
+	// the reason why the validator has to validate the consumer chain;
+	// unspecified if the validator does not have to validate it
+	Reason HasToValidateReason `protobuf:"varint,2,opt,name=reason,proto3,enum=interchain_security.ccv.provider.v1.HasToValidateReason" json:"reason,omitempty"`
+}
+
+func (m *QueryValidatorHasToValidateResponse) Reset()         { *m = QueryValidatorHasToValidateResponse{} }
+func (m *QueryValidatorHasToValidateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorHasToValidateResponse) ProtoMessage()    {}
+func (*QueryValidatorHasToValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{92}
+}
+func (m *QueryValidatorHasToValidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorHasToValidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorHasToValidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryValidatorHasToValidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorHasToValidateResponse.Merge(m, src)
+}
+func (m *QueryValidatorHasToValidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorHasToValidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorHasToValidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorHasToValidateResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorHasToValidateResponse) GetHasToValidate() bool {
+	if m != nil {
+		return m.HasToValidate
 	}
-	return n
+	return false
 }
 
-func (m *QueryConsumerChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorHasToValidateResponse) GetReason() HasToValidateReason {
+	if m != nil {
+		return m.Reason
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return HAS_TO_VALIDATE_REASON_UNSPECIFIED
+}
+
+type QueryConsumerCCVTimeoutRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerCCVTimeoutRequest) Reset()         { *m = QueryConsumerCCVTimeoutRequest{} }
+func (m *QueryConsumerCCVTimeoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerCCVTimeoutRequest) ProtoMessage()    {}
+func (*QueryConsumerCCVTimeoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{93}
+}
+func (m *QueryConsumerCCVTimeoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerCCVTimeoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerCCVTimeoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConsumerCCVTimeoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerCCVTimeoutRequest.Merge(m, src)
+}
+func (m *QueryConsumerCCVTimeoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerCCVTimeoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerCCVTimeoutRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryConsumerCCVTimeoutRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerCCVTimeoutRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryConsumerCCVTimeoutResponse struct {
+	// the effective timeout period of the CCV packets sent to the consumer chain
+	CcvTimeoutPeriod time.Duration `protobuf:"bytes,1,opt,name=ccv_timeout_period,json=ccvTimeoutPeriod,proto3,stdduration" json:"ccv_timeout_period"`
+	// whether the effective timeout period is a consumer-specific override
+	// of the `ccv_timeout_period` provider param
+	Overridden bool `protobuf:"varint,2,opt,name=overridden,proto3" json:"overridden,omitempty"`
+}
+
+func (m *QueryConsumerCCVTimeoutResponse) Reset()         { *m = QueryConsumerCCVTimeoutResponse{} }
+func (m *QueryConsumerCCVTimeoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerCCVTimeoutResponse) ProtoMessage()    {}
+func (*QueryConsumerCCVTimeoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{94}
+}
+func (m *QueryConsumerCCVTimeoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerCCVTimeoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerCCVTimeoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryConsumerCCVTimeoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerCCVTimeoutResponse.Merge(m, src)
+}
+func (m *QueryConsumerCCVTimeoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerCCVTimeoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerCCVTimeoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerCCVTimeoutResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerCCVTimeoutResponse) GetCcvTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.CcvTimeoutPeriod
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryConsumerCCVTimeoutResponse) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
 	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+type QueryKeyAssignmentStatsRequest struct {
+}
+
+func (m *QueryKeyAssignmentStatsRequest) Reset()         { *m = QueryKeyAssignmentStatsRequest{} }
+func (m *QueryKeyAssignmentStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyAssignmentStatsRequest) ProtoMessage()    {}
+func (*QueryKeyAssignmentStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{95}
+}
+func (m *QueryKeyAssignmentStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyAssignmentStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyAssignmentStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.InitParams != nil {
-		l = m.InitParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryKeyAssignmentStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyAssignmentStatsRequest.Merge(m, src)
+}
+func (m *QueryKeyAssignmentStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyAssignmentStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyAssignmentStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKeyAssignmentStatsRequest proto.InternalMessageInfo
+
+type QueryKeyAssignmentStatsResponse struct {
+	// the total number of consumer keys assigned by validators
+	TotalAssignedKeys uint64 `protobuf:"varint,1,opt,name=total_assigned_keys,json=totalAssignedKeys,proto3" json:"total_assigned_keys,omitempty"`
+	// the total number of consumer addresses pending pruning
+	TotalAddrsToPrune uint64 `protobuf:"varint,2,opt,name=total_addrs_to_prune,json=totalAddrsToPrune,proto3" json:"total_addrs_to_prune,omitempty"`
+	// the number of assigned consumer keys and of consumer addresses
+	// pending pruning for every consumer chain
+	ConsumerStats []ConsumerKeyAssignmentStats `protobuf:"bytes,3,rep,name=consumer_stats,json=consumerStats,proto3" json:"consumer_stats"`
+}
+
+func (m *QueryKeyAssignmentStatsResponse) Reset()         { *m = QueryKeyAssignmentStatsResponse{} }
+func (m *QueryKeyAssignmentStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyAssignmentStatsResponse) ProtoMessage()    {}
+func (*QueryKeyAssignmentStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{96}
+}
+func (m *QueryKeyAssignmentStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyAssignmentStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyAssignmentStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PowerShapingParams != nil {
-		l = m.PowerShapingParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryKeyAssignmentStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyAssignmentStatsResponse.Merge(m, src)
+}
+func (m *QueryKeyAssignmentStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyAssignmentStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyAssignmentStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKeyAssignmentStatsResponse proto.InternalMessageInfo
+
+func (m *QueryKeyAssignmentStatsResponse) GetTotalAssignedKeys() uint64 {
+	if m != nil {
+		return m.TotalAssignedKeys
 	}
-	if m.InfractionParameters != nil {
-		l = m.InfractionParameters.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryKeyAssignmentStatsResponse) GetTotalAddrsToPrune() uint64 {
+	if m != nil {
+		return m.TotalAddrsToPrune
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryKeyAssignmentStatsResponse) GetConsumerStats() []ConsumerKeyAssignmentStats {
+	if m != nil {
+		return m.ConsumerStats
+	}
+	return nil
+}
+
+type ConsumerKeyAssignmentStats struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the number of consumer keys assigned by validators on the consumer chain
+	AssignedKeys uint64 `protobuf:"varint,2,opt,name=assigned_keys,json=assignedKeys,proto3" json:"assigned_keys,omitempty"`
+	// the number of consumer addresses of the consumer chain pending pruning
+	AddrsToPrune uint64 `protobuf:"varint,3,opt,name=addrs_to_prune,json=addrsToPrune,proto3" json:"addrs_to_prune,omitempty"`
+}
+
+func (m *ConsumerKeyAssignmentStats) Reset()         { *m = ConsumerKeyAssignmentStats{} }
+func (m *ConsumerKeyAssignmentStats) String() string { return proto.CompactTextString(m) }
+func (*ConsumerKeyAssignmentStats) ProtoMessage()    {}
+func (*ConsumerKeyAssignmentStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{97}
+}
+func (m *ConsumerKeyAssignmentStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerKeyAssignmentStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerKeyAssignmentStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerKeyAssignmentStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerKeyAssignmentStats.Merge(m, src)
+}
+func (m *ConsumerKeyAssignmentStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerKeyAssignmentStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerKeyAssignmentStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerKeyAssignmentStats proto.InternalMessageInfo
+
+func (m *ConsumerKeyAssignmentStats) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerKeyAssignmentStats) GetAssignedKeys() uint64 {
+	if m != nil {
+		return m.AssignedKeys
+	}
+	return 0
+}
+
+func (m *ConsumerKeyAssignmentStats) GetAddrsToPrune() uint64 {
+	if m != nil {
+		return m.AddrsToPrune
+	}
+	return 0
+}
+
+type QuerySlashMeterHistoryRequest struct {
+}
+
+func (m *QuerySlashMeterHistoryRequest) Reset()         { *m = QuerySlashMeterHistoryRequest{} }
+func (m *QuerySlashMeterHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashMeterHistoryRequest) ProtoMessage()    {}
+func (*QuerySlashMeterHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{98}
+}
+func (m *QuerySlashMeterHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashMeterHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashMeterHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashMeterHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashMeterHistoryRequest.Merge(m, src)
+}
+func (m *QuerySlashMeterHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashMeterHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashMeterHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashMeterHistoryRequest proto.InternalMessageInfo
+
+type QuerySlashMeterHistoryResponse struct {
+	// the recorded slash meter values, in ascending order of block height
+	Entries []SlashMeterHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QuerySlashMeterHistoryResponse) Reset()         { *m = QuerySlashMeterHistoryResponse{} }
+func (m *QuerySlashMeterHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashMeterHistoryResponse) ProtoMessage()    {}
+func (*QuerySlashMeterHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{99}
+}
+func (m *QuerySlashMeterHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashMeterHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashMeterHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashMeterHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashMeterHistoryResponse.Merge(m, src)
+}
+func (m *QuerySlashMeterHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashMeterHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashMeterHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashMeterHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySlashMeterHistoryResponse) GetEntries() []SlashMeterHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type SlashMeterHistoryEntry struct {
+	// the provider block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the value of the slash meter at the end of the block
+	SlashMeter int64 `protobuf:"varint,2,opt,name=slash_meter,json=slashMeter,proto3" json:"slash_meter,omitempty"`
+}
+
+func (m *SlashMeterHistoryEntry) Reset()         { *m = SlashMeterHistoryEntry{} }
+func (m *SlashMeterHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*SlashMeterHistoryEntry) ProtoMessage()    {}
+func (*SlashMeterHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{100}
+}
+func (m *SlashMeterHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashMeterHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashMeterHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashMeterHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashMeterHistoryEntry.Merge(m, src)
+}
+func (m *SlashMeterHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashMeterHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashMeterHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashMeterHistoryEntry proto.InternalMessageInfo
+
+func (m *SlashMeterHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashMeterHistoryEntry) GetSlashMeter() int64 {
+	if m != nil {
+		return m.SlashMeter
+	}
+	return 0
+}
+
+type QueryValidatorAllConsumerKeysRequest struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+}
+
+func (m *QueryValidatorAllConsumerKeysRequest) Reset()         { *m = QueryValidatorAllConsumerKeysRequest{} }
+func (m *QueryValidatorAllConsumerKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAllConsumerKeysRequest) ProtoMessage()    {}
+func (*QueryValidatorAllConsumerKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{101}
+}
+func (m *QueryValidatorAllConsumerKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAllConsumerKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAllConsumerKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAllConsumerKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAllConsumerKeysRequest.Merge(m, src)
+}
+func (m *QueryValidatorAllConsumerKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAllConsumerKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAllConsumerKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAllConsumerKeysRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorAllConsumerKeysRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
+}
+
+type QueryValidatorAllConsumerKeysResponse struct {
+	// the consumer keys assigned by the validator, ordered by consumer id
+	ConsumerKeys []AssignedConsumerKey `protobuf:"bytes,1,rep,name=consumer_keys,json=consumerKeys,proto3" json:"consumer_keys"`
+}
+
+func (m *QueryValidatorAllConsumerKeysResponse) Reset()         { *m = QueryValidatorAllConsumerKeysResponse{} }
+func (m *QueryValidatorAllConsumerKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAllConsumerKeysResponse) ProtoMessage()    {}
+func (*QueryValidatorAllConsumerKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{102}
+}
+func (m *QueryValidatorAllConsumerKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAllConsumerKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAllConsumerKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAllConsumerKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAllConsumerKeysResponse.Merge(m, src)
+}
+func (m *QueryValidatorAllConsumerKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAllConsumerKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAllConsumerKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAllConsumerKeysResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorAllConsumerKeysResponse) GetConsumerKeys() []AssignedConsumerKey {
+	if m != nil {
+		return m.ConsumerKeys
+	}
+	return nil
+}
+
+// AssignedConsumerKey is a consumer key assigned by a validator on a consumer chain
+type AssignedConsumerKey struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The consensus address of the validator on the consumer chain
+	ConsumerAddress string `protobuf:"bytes,2,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// The consumer public key assigned on the consumer chain
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,3,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+}
+
+func (m *AssignedConsumerKey) Reset()         { *m = AssignedConsumerKey{} }
+func (m *AssignedConsumerKey) String() string { return proto.CompactTextString(m) }
+func (*AssignedConsumerKey) ProtoMessage()    {}
+func (*AssignedConsumerKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{103}
+}
+func (m *AssignedConsumerKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignedConsumerKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignedConsumerKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignedConsumerKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignedConsumerKey.Merge(m, src)
+}
+func (m *AssignedConsumerKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignedConsumerKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignedConsumerKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignedConsumerKey proto.InternalMessageInfo
+
+func (m *AssignedConsumerKey) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *AssignedConsumerKey) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
+	}
+	return ""
+}
+
+func (m *AssignedConsumerKey) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
+	}
+	return nil
+}
+
+type QueryMaxProviderConsensusValidatorsRequest struct {
+}
+
+func (m *QueryMaxProviderConsensusValidatorsRequest) Reset() {
+	*m = QueryMaxProviderConsensusValidatorsRequest{}
+}
+func (m *QueryMaxProviderConsensusValidatorsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMaxProviderConsensusValidatorsRequest) ProtoMessage() {}
+func (*QueryMaxProviderConsensusValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{104}
+}
+func (m *QueryMaxProviderConsensusValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxProviderConsensusValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxProviderConsensusValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaxProviderConsensusValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxProviderConsensusValidatorsRequest.Merge(m, src)
+}
+func (m *QueryMaxProviderConsensusValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxProviderConsensusValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxProviderConsensusValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaxProviderConsensusValidatorsRequest proto.InternalMessageInfo
+
+type QueryMaxProviderConsensusValidatorsResponse struct {
+	// the value of the MaxProviderConsensusValidators param
+	MaxProviderConsensusValidators int64 `protobuf:"varint,1,opt,name=max_provider_consensus_validators,json=maxProviderConsensusValidators,proto3" json:"max_provider_consensus_validators,omitempty"`
+	// the number of validators in the last validator set sent to the
+	// consensus engine of the provider chain
+	ActiveValidators int64 `protobuf:"varint,2,opt,name=active_validators,json=activeValidators,proto3" json:"active_validators,omitempty"`
+}
+
+func (m *QueryMaxProviderConsensusValidatorsResponse) Reset() {
+	*m = QueryMaxProviderConsensusValidatorsResponse{}
+}
+func (m *QueryMaxProviderConsensusValidatorsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMaxProviderConsensusValidatorsResponse) ProtoMessage() {}
+func (*QueryMaxProviderConsensusValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{105}
+}
+func (m *QueryMaxProviderConsensusValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxProviderConsensusValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxProviderConsensusValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaxProviderConsensusValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxProviderConsensusValidatorsResponse.Merge(m, src)
+}
+func (m *QueryMaxProviderConsensusValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxProviderConsensusValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxProviderConsensusValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaxProviderConsensusValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryMaxProviderConsensusValidatorsResponse) GetMaxProviderConsensusValidators() int64 {
+	if m != nil {
+		return m.MaxProviderConsensusValidators
+	}
+	return 0
+}
+
+func (m *QueryMaxProviderConsensusValidatorsResponse) GetActiveValidators() int64 {
+	if m != nil {
+		return m.ActiveValidators
+	}
+	return 0
+}
+
+type QueryConsumersByOwnerRequest struct {
+	// the owner address of the consumer chains
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *QueryConsumersByOwnerRequest) Reset()         { *m = QueryConsumersByOwnerRequest{} }
+func (m *QueryConsumersByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersByOwnerRequest) ProtoMessage()    {}
+func (*QueryConsumersByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{106}
+}
+func (m *QueryConsumersByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersByOwnerRequest.Merge(m, src)
+}
+func (m *QueryConsumersByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryConsumersByOwnerRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+type QueryConsumersByOwnerResponse struct {
+	// the consumer chains owned by the address, ordered by consumer id
+	Consumers []OwnedConsumer `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers"`
+}
+
+func (m *QueryConsumersByOwnerResponse) Reset()         { *m = QueryConsumersByOwnerResponse{} }
+func (m *QueryConsumersByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersByOwnerResponse) ProtoMessage()    {}
+func (*QueryConsumersByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{107}
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersByOwnerResponse.Merge(m, src)
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryConsumersByOwnerResponse) GetConsumers() []OwnedConsumer {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
+// OwnedConsumer is a consumer chain owned by a given address
+type OwnedConsumer struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the phase of the consumer chain
+	Phase ConsumerPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+}
+
+func (m *OwnedConsumer) Reset()         { *m = OwnedConsumer{} }
+func (m *OwnedConsumer) String() string { return proto.CompactTextString(m) }
+func (*OwnedConsumer) ProtoMessage()    {}
+func (*OwnedConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{108}
+}
+func (m *OwnedConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnedConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnedConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnedConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnedConsumer.Merge(m, src)
+}
+func (m *OwnedConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnedConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnedConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnedConsumer proto.InternalMessageInfo
+
+func (m *OwnedConsumer) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *OwnedConsumer) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return CONSUMER_PHASE_UNSPECIFIED
+}
+
+type QueryConsumerChainIdAvailableRequest struct {
+	// the chain id to check
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryConsumerChainIdAvailableRequest) Reset()         { *m = QueryConsumerChainIdAvailableRequest{} }
+func (m *QueryConsumerChainIdAvailableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainIdAvailableRequest) ProtoMessage()    {}
+func (*QueryConsumerChainIdAvailableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{109}
+}
+func (m *QueryConsumerChainIdAvailableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainIdAvailableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainIdAvailableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerChainIdAvailableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainIdAvailableRequest.Merge(m, src)
+}
+func (m *QueryConsumerChainIdAvailableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainIdAvailableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainIdAvailableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerChainIdAvailableRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerChainIdAvailableRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type QueryConsumerChainIdAvailableResponse struct {
+	// whether the chain id is not used by any registered, initialized, or
+	// launched consumer chain
+	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	// the consumer id of the (first) consumer chain using the chain id, if any
+	ConflictingConsumerId string `protobuf:"bytes,2,opt,name=conflicting_consumer_id,json=conflictingConsumerId,proto3" json:"conflicting_consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerChainIdAvailableResponse) Reset()         { *m = QueryConsumerChainIdAvailableResponse{} }
+func (m *QueryConsumerChainIdAvailableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainIdAvailableResponse) ProtoMessage()    {}
+func (*QueryConsumerChainIdAvailableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{110}
+}
+func (m *QueryConsumerChainIdAvailableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainIdAvailableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainIdAvailableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerChainIdAvailableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainIdAvailableResponse.Merge(m, src)
+}
+func (m *QueryConsumerChainIdAvailableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainIdAvailableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainIdAvailableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerChainIdAvailableResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerChainIdAvailableResponse) GetAvailable() bool {
+	if m != nil {
+		return m.Available
+	}
+	return false
+}
+
+func (m *QueryConsumerChainIdAvailableResponse) GetConflictingConsumerId() string {
+	if m != nil {
+		return m.ConflictingConsumerId
+	}
+	return ""
+}
+
+type QueryNextConsumerIdRequest struct {
+}
+
+func (m *QueryNextConsumerIdRequest) Reset()         { *m = QueryNextConsumerIdRequest{} }
+func (m *QueryNextConsumerIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextConsumerIdRequest) ProtoMessage()    {}
+func (*QueryNextConsumerIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{111}
+}
+func (m *QueryNextConsumerIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextConsumerIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextConsumerIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextConsumerIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextConsumerIdRequest.Merge(m, src)
+}
+func (m *QueryNextConsumerIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextConsumerIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextConsumerIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextConsumerIdRequest proto.InternalMessageInfo
+
+type QueryNextConsumerIdResponse struct {
+	// the consumer id of the next created consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryNextConsumerIdResponse) Reset()         { *m = QueryNextConsumerIdResponse{} }
+func (m *QueryNextConsumerIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextConsumerIdResponse) ProtoMessage()    {}
+func (*QueryNextConsumerIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{112}
+}
+func (m *QueryNextConsumerIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextConsumerIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextConsumerIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextConsumerIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextConsumerIdResponse.Merge(m, src)
+}
+func (m *QueryNextConsumerIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextConsumerIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextConsumerIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextConsumerIdResponse proto.InternalMessageInfo
+
+func (m *QueryNextConsumerIdResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QueryConsumerVSCLatencyRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerVSCLatencyRequest) Reset()         { *m = QueryConsumerVSCLatencyRequest{} }
+func (m *QueryConsumerVSCLatencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerVSCLatencyRequest) ProtoMessage()    {}
+func (*QueryConsumerVSCLatencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{113}
+}
+func (m *QueryConsumerVSCLatencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerVSCLatencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerVSCLatencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerVSCLatencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerVSCLatencyRequest.Merge(m, src)
+}
+func (m *QueryConsumerVSCLatencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerVSCLatencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerVSCLatencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerVSCLatencyRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerVSCLatencyRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QueryConsumerVSCLatencyResponse struct {
+	// the number of acknowledged VSC packets over which the latency is computed
+	NumVscPackets uint64 `protobuf:"varint,1,opt,name=num_vsc_packets,json=numVscPackets,proto3" json:"num_vsc_packets,omitempty"`
+	// the average time between sending a VSC packet and receiving
+	// its acknowledgement
+	AverageLatency time.Duration `protobuf:"bytes,2,opt,name=average_latency,json=averageLatency,proto3,stdduration" json:"average_latency"`
+	// the maximum time between sending a VSC packet and receiving
+	// its acknowledgement
+	MaxLatency time.Duration `protobuf:"bytes,3,opt,name=max_latency,json=maxLatency,proto3,stdduration" json:"max_latency"`
+}
+
+func (m *QueryConsumerVSCLatencyResponse) Reset()         { *m = QueryConsumerVSCLatencyResponse{} }
+func (m *QueryConsumerVSCLatencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerVSCLatencyResponse) ProtoMessage()    {}
+func (*QueryConsumerVSCLatencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{114}
+}
+func (m *QueryConsumerVSCLatencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerVSCLatencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerVSCLatencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerVSCLatencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerVSCLatencyResponse.Merge(m, src)
+}
+func (m *QueryConsumerVSCLatencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerVSCLatencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerVSCLatencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerVSCLatencyResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerVSCLatencyResponse) GetNumVscPackets() uint64 {
+	if m != nil {
+		return m.NumVscPackets
+	}
+	return 0
+}
+
+func (m *QueryConsumerVSCLatencyResponse) GetAverageLatency() time.Duration {
+	if m != nil {
+		return m.AverageLatency
+	}
+	return 0
+}
+
+func (m *QueryConsumerVSCLatencyResponse) GetMaxLatency() time.Duration {
+	if m != nil {
+		return m.MaxLatency
+	}
+	return 0
+}
+
+type QueryConsumersByClientIdRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryConsumersByClientIdRequest) Reset()         { *m = QueryConsumersByClientIdRequest{} }
+func (m *QueryConsumersByClientIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersByClientIdRequest) ProtoMessage()    {}
+func (*QueryConsumersByClientIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{115}
+}
+func (m *QueryConsumersByClientIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersByClientIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersByClientIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersByClientIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersByClientIdRequest.Merge(m, src)
+}
+func (m *QueryConsumersByClientIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersByClientIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersByClientIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersByClientIdRequest proto.InternalMessageInfo
+
+func (m *QueryConsumersByClientIdRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type QueryConsumersByClientIdResponse struct {
+	// the ids of the consumer chains using the client, in ascending order
+	ConsumerIds []string `protobuf:"bytes,1,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
+}
+
+func (m *QueryConsumersByClientIdResponse) Reset()         { *m = QueryConsumersByClientIdResponse{} }
+func (m *QueryConsumersByClientIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersByClientIdResponse) ProtoMessage()    {}
+func (*QueryConsumersByClientIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{116}
+}
+func (m *QueryConsumersByClientIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersByClientIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersByClientIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumersByClientIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersByClientIdResponse.Merge(m, src)
+}
+func (m *QueryConsumersByClientIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersByClientIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersByClientIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumersByClientIdResponse proto.InternalMessageInfo
+
+func (m *QueryConsumersByClientIdResponse) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
+	}
+	return nil
+}
+
+type QueryPruningInvariantRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryPruningInvariantRequest) Reset()         { *m = QueryPruningInvariantRequest{} }
+func (m *QueryPruningInvariantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPruningInvariantRequest) ProtoMessage()    {}
+func (*QueryPruningInvariantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{117}
+}
+func (m *QueryPruningInvariantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPruningInvariantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPruningInvariantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPruningInvariantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPruningInvariantRequest.Merge(m, src)
+}
+func (m *QueryPruningInvariantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPruningInvariantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPruningInvariantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPruningInvariantRequest proto.InternalMessageInfo
+
+func (m *QueryPruningInvariantRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QueryPruningInvariantResponse struct {
+	// whether every consumer address is either currently assigned or scheduled
+	// for pruning
+	Holds bool `protobuf:"varint,1,opt,name=holds,proto3" json:"holds,omitempty"`
+	// the consumer addresses that are neither currently assigned nor scheduled
+	// for pruning
+	ViolatingConsumerAddrs []string `protobuf:"bytes,2,rep,name=violating_consumer_addrs,json=violatingConsumerAddrs,proto3" json:"violating_consumer_addrs,omitempty"`
+}
+
+func (m *QueryPruningInvariantResponse) Reset()         { *m = QueryPruningInvariantResponse{} }
+func (m *QueryPruningInvariantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPruningInvariantResponse) ProtoMessage()    {}
+func (*QueryPruningInvariantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{118}
+}
+func (m *QueryPruningInvariantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPruningInvariantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPruningInvariantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPruningInvariantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPruningInvariantResponse.Merge(m, src)
+}
+func (m *QueryPruningInvariantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPruningInvariantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPruningInvariantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPruningInvariantResponse proto.InternalMessageInfo
+
+func (m *QueryPruningInvariantResponse) GetHolds() bool {
+	if m != nil {
+		return m.Holds
+	}
+	return false
+}
+
+func (m *QueryPruningInvariantResponse) GetViolatingConsumerAddrs() []string {
+	if m != nil {
+		return m.ViolatingConsumerAddrs
+	}
+	return nil
+}
+
+type QueryValidatorsConsumerObligationsRequest struct {
+	// the consensus addresses of the validators on the provider chain
+	ProviderAddrs []string `protobuf:"bytes,1,rep,name=provider_addrs,json=providerAddrs,proto3" json:"provider_addrs,omitempty"`
+}
+
+func (m *QueryValidatorsConsumerObligationsRequest) Reset() {
+	*m = QueryValidatorsConsumerObligationsRequest{}
+}
+func (m *QueryValidatorsConsumerObligationsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorsConsumerObligationsRequest) ProtoMessage() {}
+func (*QueryValidatorsConsumerObligationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{119}
+}
+func (m *QueryValidatorsConsumerObligationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsConsumerObligationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsConsumerObligationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsConsumerObligationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsConsumerObligationsRequest.Merge(m, src)
+}
+func (m *QueryValidatorsConsumerObligationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsConsumerObligationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsConsumerObligationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsConsumerObligationsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsConsumerObligationsRequest) GetProviderAddrs() []string {
+	if m != nil {
+		return m.ProviderAddrs
+	}
+	return nil
+}
+
+type QueryValidatorsConsumerObligationsResponse struct {
+	// the obligations in the order of the requested provider addresses
+	Obligations []ValidatorConsumerObligations `protobuf:"bytes,1,rep,name=obligations,proto3" json:"obligations"`
+}
+
+func (m *QueryValidatorsConsumerObligationsResponse) Reset() {
+	*m = QueryValidatorsConsumerObligationsResponse{}
+}
+func (m *QueryValidatorsConsumerObligationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorsConsumerObligationsResponse) ProtoMessage() {}
+func (*QueryValidatorsConsumerObligationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{120}
+}
+func (m *QueryValidatorsConsumerObligationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsConsumerObligationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsConsumerObligationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsConsumerObligationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsConsumerObligationsResponse.Merge(m, src)
+}
+func (m *QueryValidatorsConsumerObligationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsConsumerObligationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsConsumerObligationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsConsumerObligationsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsConsumerObligationsResponse) GetObligations() []ValidatorConsumerObligations {
+	if m != nil {
+		return m.Obligations
+	}
+	return nil
+}
+
+// ValidatorConsumerObligations are the consumer chains a validator has to
+// validate and the consumer keys it assigned
+type ValidatorConsumerObligations struct {
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the consumer chains the validator has to validate now or in the next
+	// epoch if nothing changes
+	ConsumerIds []string `protobuf:"bytes,2,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
+	// the consumer keys assigned by the validator on all the consumer chains
+	ConsumerKeys []AssignedConsumerKey `protobuf:"bytes,3,rep,name=consumer_keys,json=consumerKeys,proto3" json:"consumer_keys"`
+}
+
+func (m *ValidatorConsumerObligations) Reset()         { *m = ValidatorConsumerObligations{} }
+func (m *ValidatorConsumerObligations) String() string { return proto.CompactTextString(m) }
+func (*ValidatorConsumerObligations) ProtoMessage()    {}
+func (*ValidatorConsumerObligations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{121}
+}
+func (m *ValidatorConsumerObligations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorConsumerObligations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorConsumerObligations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorConsumerObligations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorConsumerObligations.Merge(m, src)
+}
+func (m *ValidatorConsumerObligations) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorConsumerObligations) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorConsumerObligations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorConsumerObligations proto.InternalMessageInfo
+
+func (m *ValidatorConsumerObligations) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
+}
+
+func (m *ValidatorConsumerObligations) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
+	}
+	return nil
+}
+
+func (m *ValidatorConsumerObligations) GetConsumerKeys() []AssignedConsumerKey {
+	if m != nil {
+		return m.ConsumerKeys
+	}
+	return nil
+}
+
+type QueryAllDefaultKeyUsersRequest struct {
+}
+
+func (m *QueryAllDefaultKeyUsersRequest) Reset()         { *m = QueryAllDefaultKeyUsersRequest{} }
+func (m *QueryAllDefaultKeyUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDefaultKeyUsersRequest) ProtoMessage()    {}
+func (*QueryAllDefaultKeyUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{122}
+}
+func (m *QueryAllDefaultKeyUsersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDefaultKeyUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDefaultKeyUsersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDefaultKeyUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDefaultKeyUsersRequest.Merge(m, src)
+}
+func (m *QueryAllDefaultKeyUsersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDefaultKeyUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDefaultKeyUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDefaultKeyUsersRequest proto.InternalMessageInfo
+
+type QueryAllDefaultKeyUsersResponse struct {
+	// the default key users of every launched consumer chain, in ascending
+	// order of the consumer ids
+	Consumers []ConsumerDefaultKeyUsers `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers"`
+}
+
+func (m *QueryAllDefaultKeyUsersResponse) Reset()         { *m = QueryAllDefaultKeyUsersResponse{} }
+func (m *QueryAllDefaultKeyUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDefaultKeyUsersResponse) ProtoMessage()    {}
+func (*QueryAllDefaultKeyUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{123}
+}
+func (m *QueryAllDefaultKeyUsersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDefaultKeyUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDefaultKeyUsersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDefaultKeyUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDefaultKeyUsersResponse.Merge(m, src)
+}
+func (m *QueryAllDefaultKeyUsersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDefaultKeyUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDefaultKeyUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDefaultKeyUsersResponse proto.InternalMessageInfo
+
+func (m *QueryAllDefaultKeyUsersResponse) GetConsumers() []ConsumerDefaultKeyUsers {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
+// ConsumerDefaultKeyUsers are the validators in the validator set of a
+// consumer chain that use their provider key as consumer key
+type ConsumerDefaultKeyUsers struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the consensus addresses on the provider chain of the validators whose
+	// public key in the consumer validator set is their provider consensus key
+	ProviderAddresses []string `protobuf:"bytes,2,rep,name=provider_addresses,json=providerAddresses,proto3" json:"provider_addresses,omitempty"`
+}
+
+func (m *ConsumerDefaultKeyUsers) Reset()         { *m = ConsumerDefaultKeyUsers{} }
+func (m *ConsumerDefaultKeyUsers) String() string { return proto.CompactTextString(m) }
+func (*ConsumerDefaultKeyUsers) ProtoMessage()    {}
+func (*ConsumerDefaultKeyUsers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{124}
+}
+func (m *ConsumerDefaultKeyUsers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerDefaultKeyUsers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerDefaultKeyUsers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerDefaultKeyUsers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerDefaultKeyUsers.Merge(m, src)
+}
+func (m *ConsumerDefaultKeyUsers) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerDefaultKeyUsers) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerDefaultKeyUsers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerDefaultKeyUsers proto.InternalMessageInfo
+
+func (m *ConsumerDefaultKeyUsers) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerDefaultKeyUsers) GetProviderAddresses() []string {
+	if m != nil {
+		return m.ProviderAddresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.HasToValidateReason", HasToValidateReason_name, HasToValidateReason_value)
+	proto.RegisterType((*QueryConsumerGenesisRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisRequest")
+	proto.RegisterType((*QueryConsumerGenesisResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisResponse")
+	proto.RegisterType((*QueryConsumerChainsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsRequest")
+	proto.RegisterType((*QueryConsumerChainsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsResponse")
+	proto.RegisterType((*Chain)(nil), "interchain_security.ccv.provider.v1.Chain")
+	proto.RegisterType((*QueryValidatorConsumerAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrRequest")
+	proto.RegisterType((*QueryValidatorConsumerAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrResponse")
+	proto.RegisterType((*QueryValidatorProviderAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrRequest")
+	proto.RegisterType((*QueryValidatorProviderAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrResponse")
+	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateRequest")
+	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateResponse")
+	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsRequest")
+	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsResponse")
+	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerRequest)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerRequest")
+	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerResponse)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerResponse")
+	proto.RegisterType((*PairValConAddrProviderAndConsumer)(nil), "interchain_security.ccv.provider.v1.PairValConAddrProviderAndConsumer")
+	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.provider.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.provider.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryConsumerChainOptedInValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsRequest")
+	proto.RegisterType((*QueryConsumerChainOptedInValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsResponse")
+	proto.RegisterType((*QueryConsumerValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsRequest")
+	proto.RegisterType((*QueryConsumerValidatorsValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsValidator")
+	proto.RegisterType((*QueryConsumerValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsResponse")
+	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateRequest")
+	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateResponse")
+	proto.RegisterType((*QueryValidatorConsumerCommissionRateRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateRequest")
+	proto.RegisterType((*QueryValidatorConsumerCommissionRateResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateResponse")
+	proto.RegisterType((*QueryBlocksUntilNextEpochRequest)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochRequest")
+	proto.RegisterType((*QueryBlocksUntilNextEpochResponse)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochResponse")
+	proto.RegisterType((*QueryConsumerIdFromClientIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdRequest")
+	proto.RegisterType((*QueryConsumerIdFromClientIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdResponse")
+	proto.RegisterType((*QueryConsumerChainRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainRequest")
+	proto.RegisterType((*QueryConsumerChainResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainResponse")
+	proto.RegisterType((*QueryConsumerGenesisTimeRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeRequest")
+	proto.RegisterType((*QueryConsumerGenesisTimeResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeResponse")
+	proto.RegisterType((*QueryVSCMaturationScheduleRequest)(nil), "interchain_security.ccv.provider.v1.QueryVSCMaturationScheduleRequest")
+	proto.RegisterType((*VSCMaturity)(nil), "interchain_security.ccv.provider.v1.VSCMaturity")
+	proto.RegisterType((*QueryVSCMaturationScheduleResponse)(nil), "interchain_security.ccv.provider.v1.QueryVSCMaturationScheduleResponse")
+	proto.RegisterType((*QuerySimulateConsumerUpdateRequest)(nil), "interchain_security.ccv.provider.v1.QuerySimulateConsumerUpdateRequest")
+	proto.RegisterType((*QuerySimulateConsumerUpdateResponse)(nil), "interchain_security.ccv.provider.v1.QuerySimulateConsumerUpdateResponse")
+	proto.RegisterType((*QueryConsumerValidatorSetHashRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorSetHashRequest")
+	proto.RegisterType((*QueryConsumerValidatorSetHashResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorSetHashResponse")
+	proto.RegisterType((*QueryValsetUpdateIdToHeightRequest)(nil), "interchain_security.ccv.provider.v1.QueryValsetUpdateIdToHeightRequest")
+	proto.RegisterType((*QueryValsetUpdateIdToHeightResponse)(nil), "interchain_security.ccv.provider.v1.QueryValsetUpdateIdToHeightResponse")
+	proto.RegisterType((*QueryRecentValsetUpdateIdsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRecentValsetUpdateIdsRequest")
+	proto.RegisterType((*QueryRecentValsetUpdateIdsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRecentValsetUpdateIdsResponse")
+	proto.RegisterType((*QueryValidatorsUsingDefaultKeyRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorsUsingDefaultKeyRequest")
+	proto.RegisterType((*QueryValidatorsUsingDefaultKeyResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorsUsingDefaultKeyResponse")
+	proto.RegisterType((*QueryConsumerRewardsAddressRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRewardsAddressRequest")
+	proto.RegisterType((*QueryConsumerRewardsAddressResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRewardsAddressResponse")
+	proto.RegisterType((*QueryTopNThresholdRequest)(nil), "interchain_security.ccv.provider.v1.QueryTopNThresholdRequest")
+	proto.RegisterType((*QueryTopNThresholdResponse)(nil), "interchain_security.ccv.provider.v1.QueryTopNThresholdResponse")
+	proto.RegisterType((*QueryConsumerPhaseHistoryRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerPhaseHistoryRequest")
+	proto.RegisterType((*QueryConsumerPhaseHistoryResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerPhaseHistoryResponse")
+	proto.RegisterType((*QueryConsumerAddrsToPruneRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerAddrsToPruneRequest")
+	proto.RegisterType((*QueryConsumerAddrsToPruneResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerAddrsToPruneResponse")
+	proto.RegisterType((*QueryRecentKeyAssignmentsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRecentKeyAssignmentsRequest")
+	proto.RegisterType((*QueryRecentKeyAssignmentsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRecentKeyAssignmentsResponse")
+	proto.RegisterType((*KeyRotation)(nil), "interchain_security.ccv.provider.v1.KeyRotation")
+	proto.RegisterType((*QueryJailingReasonRequest)(nil), "interchain_security.ccv.provider.v1.QueryJailingReasonRequest")
+	proto.RegisterType((*QueryJailingReasonResponse)(nil), "interchain_security.ccv.provider.v1.QueryJailingReasonResponse")
+	proto.RegisterType((*QueryConsumerSlashPacketRateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerSlashPacketRateRequest")
+	proto.RegisterType((*QueryConsumerSlashPacketRateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerSlashPacketRateResponse")
+	proto.RegisterType((*QueryEffectiveConsumerKeyRequest)(nil), "interchain_security.ccv.provider.v1.QueryEffectiveConsumerKeyRequest")
+	proto.RegisterType((*QueryEffectiveConsumerKeyResponse)(nil), "interchain_security.ccv.provider.v1.QueryEffectiveConsumerKeyResponse")
+	proto.RegisterType((*QueryConsumerLaunchFailureRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerLaunchFailureRequest")
+	proto.RegisterType((*QueryConsumerLaunchFailureResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerLaunchFailureResponse")
+	proto.RegisterType((*QuerySlashPacketBySeqRequest)(nil), "interchain_security.ccv.provider.v1.QuerySlashPacketBySeqRequest")
+	proto.RegisterType((*QuerySlashPacketBySeqResponse)(nil), "interchain_security.ccv.provider.v1.QuerySlashPacketBySeqResponse")
+	proto.RegisterType((*QueryConsumersWithThrottledSlashingRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumersWithThrottledSlashingRequest")
+	proto.RegisterType((*QueryConsumersWithThrottledSlashingResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumersWithThrottledSlashingResponse")
+	proto.RegisterType((*ConsumerThrottledSlashPackets)(nil), "interchain_security.ccv.provider.v1.ConsumerThrottledSlashPackets")
+	proto.RegisterType((*QueryValidatorTopNObligationsRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorTopNObligationsRequest")
+	proto.RegisterType((*QueryValidatorTopNObligationsResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorTopNObligationsResponse")
+	proto.RegisterType((*TopNObligation)(nil), "interchain_security.ccv.provider.v1.TopNObligation")
+	proto.RegisterType((*QueryConsumerGenesisValsetHashRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisValsetHashRequest")
+	proto.RegisterType((*QueryConsumerGenesisValsetHashResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisValsetHashResponse")
+	proto.RegisterType((*QueryConsumerDistributionRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerDistributionRequest")
+	proto.RegisterType((*QueryConsumerDistributionResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerDistributionResponse")
+	proto.RegisterType((*QueryConsumerValidatorSetsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorSetsRequest")
+	proto.RegisterType((*QueryConsumerValidatorSetsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorSetsResponse")
+	proto.RegisterType((*ConsumerValidatorSet)(nil), "interchain_security.ccv.provider.v1.ConsumerValidatorSet")
+	proto.RegisterType((*QueryValidatorKeyConflictsRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorKeyConflictsRequest")
+	proto.RegisterType((*QueryValidatorKeyConflictsResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorKeyConflictsResponse")
+	proto.RegisterType((*ConsumerKeyConflict)(nil), "interchain_security.ccv.provider.v1.ConsumerKeyConflict")
+	proto.RegisterType((*QueryConsumerRemovalETARequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRemovalETARequest")
+	proto.RegisterType((*QueryConsumerRemovalETAResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRemovalETAResponse")
+	proto.RegisterType((*QueryConsumerJailedPowerRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerJailedPowerRequest")
+	proto.RegisterType((*QueryConsumerJailedPowerResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerJailedPowerResponse")
+	proto.RegisterType((*QueryValidatorDenylistedConsumersRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorDenylistedConsumersRequest")
+	proto.RegisterType((*QueryValidatorDenylistedConsumersResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorDenylistedConsumersResponse")
+	proto.RegisterType((*QueryValidatorHasToValidateRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorHasToValidateRequest")
+	proto.RegisterType((*QueryValidatorHasToValidateResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorHasToValidateResponse")
+	proto.RegisterType((*QueryConsumerCCVTimeoutRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerCCVTimeoutRequest")
+	proto.RegisterType((*QueryConsumerCCVTimeoutResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerCCVTimeoutResponse")
+	proto.RegisterType((*QueryKeyAssignmentStatsRequest)(nil), "interchain_security.ccv.provider.v1.QueryKeyAssignmentStatsRequest")
+	proto.RegisterType((*QueryKeyAssignmentStatsResponse)(nil), "interchain_security.ccv.provider.v1.QueryKeyAssignmentStatsResponse")
+	proto.RegisterType((*ConsumerKeyAssignmentStats)(nil), "interchain_security.ccv.provider.v1.ConsumerKeyAssignmentStats")
+	proto.RegisterType((*QuerySlashMeterHistoryRequest)(nil), "interchain_security.ccv.provider.v1.QuerySlashMeterHistoryRequest")
+	proto.RegisterType((*QuerySlashMeterHistoryResponse)(nil), "interchain_security.ccv.provider.v1.QuerySlashMeterHistoryResponse")
+	proto.RegisterType((*SlashMeterHistoryEntry)(nil), "interchain_security.ccv.provider.v1.SlashMeterHistoryEntry")
+	proto.RegisterType((*QueryValidatorAllConsumerKeysRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorAllConsumerKeysRequest")
+	proto.RegisterType((*QueryValidatorAllConsumerKeysResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorAllConsumerKeysResponse")
+	proto.RegisterType((*AssignedConsumerKey)(nil), "interchain_security.ccv.provider.v1.AssignedConsumerKey")
+	proto.RegisterType((*QueryMaxProviderConsensusValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryMaxProviderConsensusValidatorsRequest")
+	proto.RegisterType((*QueryMaxProviderConsensusValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryMaxProviderConsensusValidatorsResponse")
+	proto.RegisterType((*QueryConsumersByOwnerRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumersByOwnerRequest")
+	proto.RegisterType((*QueryConsumersByOwnerResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumersByOwnerResponse")
+	proto.RegisterType((*OwnedConsumer)(nil), "interchain_security.ccv.provider.v1.OwnedConsumer")
+	proto.RegisterType((*QueryConsumerChainIdAvailableRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainIdAvailableRequest")
+	proto.RegisterType((*QueryConsumerChainIdAvailableResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainIdAvailableResponse")
+	proto.RegisterType((*QueryNextConsumerIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryNextConsumerIdRequest")
+	proto.RegisterType((*QueryNextConsumerIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryNextConsumerIdResponse")
+	proto.RegisterType((*QueryConsumerVSCLatencyRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerVSCLatencyRequest")
+	proto.RegisterType((*QueryConsumerVSCLatencyResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerVSCLatencyResponse")
+	proto.RegisterType((*QueryConsumersByClientIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumersByClientIdRequest")
+	proto.RegisterType((*QueryConsumersByClientIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumersByClientIdResponse")
+	proto.RegisterType((*QueryPruningInvariantRequest)(nil), "interchain_security.ccv.provider.v1.QueryPruningInvariantRequest")
+	proto.RegisterType((*QueryPruningInvariantResponse)(nil), "interchain_security.ccv.provider.v1.QueryPruningInvariantResponse")
+	proto.RegisterType((*QueryValidatorsConsumerObligationsRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorsConsumerObligationsRequest")
+	proto.RegisterType((*QueryValidatorsConsumerObligationsResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorsConsumerObligationsResponse")
+	proto.RegisterType((*ValidatorConsumerObligations)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerObligations")
+	proto.RegisterType((*QueryAllDefaultKeyUsersRequest)(nil), "interchain_security.ccv.provider.v1.QueryAllDefaultKeyUsersRequest")
+	proto.RegisterType((*QueryAllDefaultKeyUsersResponse)(nil), "interchain_security.ccv.provider.v1.QueryAllDefaultKeyUsersResponse")
+	proto.RegisterType((*ConsumerDefaultKeyUsers)(nil), "interchain_security.ccv.provider.v1.ConsumerDefaultKeyUsers")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/query.proto", fileDescriptor_422512d7b7586cd7)
+}
+
+var fileDescriptor_422512d7b7586cd7 = []byte{
+	// 6233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5d, 0xeb, 0x6f, 0x1c, 0xd7,
+	0x75, 0xd7, 0x2c, 0x29, 0x8a, 0xba, 0x14, 0x29, 0xea, 0x8a, 0x92, 0x56, 0x23, 0x89, 0xa4, 0x86,
+	0x92, 0xa3, 0x47, 0xc4, 0x95, 0x98, 0xf8, 0x21, 0xbf, 0x64, 0x72, 0xf9, 0x5a, 0x89, 0x22, 0xe9,
+	0x21, 0x45, 0xa7, 0x76, 0x9c, 0xc9, 0x70, 0xe6, 0x6a, 0x77, 0xa2, 0xdd, 0x99, 0xd5, 0xcc, 0xec,
+	0x52, 0xb4, 0x4a, 0xa0, 0x70, 0x02, 0xd4, 0x01, 0x1c, 0xd4, 0x41, 0x9b, 0xb6, 0x28, 0xda, 0xc6,
+	0x68, 0xda, 0x2f, 0x05, 0x5a, 0x14, 0x85, 0xd1, 0xbf, 0x21, 0xdf, 0xea, 0xba, 0xfd, 0x10, 0xb4,
+	0x88, 0x9b, 0xd8, 0x29, 0xd0, 0xa2, 0x4d, 0x91, 0xba, 0x6d, 0x80, 0xb6, 0x40, 0x5a, 0xdc, 0xd7,
+	0xbc, 0x76, 0x76, 0x77, 0x66, 0x87, 0xea, 0x37, 0xed, 0x7d, 0xfc, 0xee, 0x3d, 0x67, 0xce, 0x3d,
+	0xf7, 0x9c, 0x73, 0xcf, 0xa1, 0x40, 0xc1, 0x30, 0x5d, 0x64, 0x6b, 0x15, 0xd5, 0x30, 0x15, 0x07,
+	0x69, 0x0d, 0xdb, 0x70, 0x77, 0x0b, 0x9a, 0xd6, 0x2c, 0xd4, 0x6d, 0xab, 0x69, 0xe8, 0xc8, 0x2e,
+	0x34, 0x6f, 0x14, 0x1e, 0x36, 0x90, 0xbd, 0x3b, 0x5d, 0xb7, 0x2d, 0xd7, 0x82, 0x53, 0x31, 0x13,
+	0xa6, 0x35, 0xad, 0x39, 0xcd, 0x27, 0x4c, 0x37, 0x6f, 0x88, 0x67, 0xcb, 0x96, 0x55, 0xae, 0xa2,
+	0x82, 0x5a, 0x37, 0x0a, 0xaa, 0x69, 0x5a, 0xae, 0xea, 0x1a, 0x96, 0xe9, 0x50, 0x08, 0x71, 0xac,
+	0x6c, 0x95, 0x2d, 0xf2, 0xcf, 0x02, 0xfe, 0x17, 0x6b, 0x9d, 0x60, 0x73, 0xc8, 0xaf, 0xed, 0xc6,
+	0xfd, 0x82, 0x6b, 0xd4, 0x90, 0xe3, 0xaa, 0xb5, 0x3a, 0x1b, 0x30, 0x1e, 0x1d, 0xa0, 0x37, 0x6c,
+	0x82, 0xcb, 0xfa, 0x67, 0x92, 0x90, 0xe2, 0xed, 0x92, 0xce, 0xb9, 0x91, 0x64, 0x4e, 0x19, 0x99,
+	0xc8, 0x31, 0xf8, 0xee, 0xaf, 0xb7, 0x9b, 0xd2, 0xbc, 0x51, 0x70, 0x2a, 0xaa, 0x8d, 0x74, 0x45,
+	0xb3, 0x4c, 0xa7, 0x51, 0xf3, 0x16, 0xb9, 0xd8, 0x61, 0xc6, 0x8e, 0x61, 0x23, 0x36, 0xec, 0xac,
+	0x8b, 0x4c, 0x1d, 0xd9, 0x35, 0xc3, 0x74, 0x0b, 0x9a, 0xbd, 0x5b, 0x77, 0xad, 0xc2, 0x03, 0xb4,
+	0xcb, 0x97, 0x3d, 0x13, 0xe8, 0x55, 0xb7, 0x35, 0xa3, 0xe0, 0xee, 0xd6, 0x11, 0xef, 0x3c, 0xad,
+	0x59, 0x4e, 0xcd, 0x72, 0x14, 0xca, 0x54, 0xfa, 0x83, 0x75, 0x5d, 0xa0, 0xbf, 0x0a, 0x8e, 0xab,
+	0x3e, 0x30, 0xcc, 0x72, 0xa1, 0x79, 0x63, 0x1b, 0xb9, 0xea, 0x0d, 0xfe, 0x9b, 0x8d, 0xba, 0xc2,
+	0x46, 0x6d, 0xab, 0x0e, 0xa2, 0x9f, 0xdb, 0x1b, 0x58, 0x57, 0xcb, 0x86, 0x19, 0xe0, 0xb3, 0xf4,
+	0x32, 0x38, 0xf3, 0x2a, 0x1e, 0x51, 0x64, 0x54, 0x2e, 0x51, 0xf6, 0xc8, 0xe8, 0x61, 0x03, 0x39,
+	0x2e, 0x9c, 0x00, 0x43, 0x9c, 0x7e, 0xc5, 0xd0, 0xf3, 0xc2, 0xa4, 0x70, 0xe9, 0xb0, 0x0c, 0x78,
+	0x53, 0x49, 0x97, 0x1e, 0x83, 0xb3, 0xf1, 0xf3, 0x9d, 0xba, 0x65, 0x3a, 0x08, 0xbe, 0x01, 0x86,
+	0x19, 0xc7, 0x15, 0xc7, 0x55, 0x5d, 0x44, 0x20, 0x86, 0x66, 0xae, 0x4f, 0xb7, 0x93, 0xbc, 0xe6,
+	0x8d, 0xe9, 0x08, 0xd6, 0x06, 0x9e, 0x37, 0xd7, 0xff, 0xfd, 0x8f, 0x27, 0x0e, 0xc8, 0x47, 0xca,
+	0x81, 0x36, 0xe9, 0x4f, 0x05, 0x20, 0x86, 0x56, 0x2f, 0x62, 0x3c, 0x6f, 0xf3, 0xcb, 0xe0, 0x60,
+	0xbd, 0xa2, 0x3a, 0x74, 0xcd, 0x91, 0x99, 0x99, 0xe9, 0x04, 0xd2, 0xee, 0x2d, 0xbe, 0x8e, 0x67,
+	0xca, 0x14, 0x00, 0x2e, 0x02, 0xe0, 0x73, 0x2e, 0x9f, 0x23, 0x24, 0x3c, 0x35, 0xcd, 0x3e, 0x0d,
+	0x66, 0xf3, 0x34, 0x3d, 0x55, 0x8c, 0xcd, 0xd3, 0xeb, 0x6a, 0x19, 0xb1, 0x5d, 0xc8, 0x81, 0x99,
+	0xd2, 0x1f, 0x0b, 0xe0, 0x4c, 0xec, 0x86, 0x19, 0xb7, 0xe6, 0xc0, 0x00, 0xd9, 0x9e, 0x93, 0x17,
+	0x26, 0xfb, 0x2e, 0x0d, 0xcd, 0x5c, 0x49, 0xb6, 0x65, 0xdc, 0x2d, 0xb3, 0x99, 0x70, 0x29, 0x66,
+	0xaf, 0x9f, 0xeb, 0xba, 0x57, 0xba, 0x81, 0xd0, 0x66, 0xbf, 0x3e, 0x00, 0x0e, 0x12, 0x68, 0x78,
+	0x1a, 0x0c, 0xd2, 0x2d, 0x78, 0x22, 0x70, 0x88, 0xfc, 0x2e, 0xe9, 0xf0, 0x0c, 0x38, 0xac, 0x55,
+	0x0d, 0x64, 0xba, 0xb8, 0x2f, 0x47, 0xfa, 0x06, 0x69, 0x43, 0x49, 0x87, 0xc7, 0xc1, 0x41, 0xd7,
+	0xaa, 0x2b, 0xab, 0xf9, 0xbe, 0x49, 0xe1, 0xd2, 0xb0, 0xdc, 0xef, 0x5a, 0xf5, 0x55, 0x78, 0x05,
+	0xc0, 0x9a, 0x61, 0x2a, 0x75, 0x6b, 0x07, 0xcb, 0x94, 0xa9, 0xd0, 0x11, 0xfd, 0x93, 0xc2, 0xa5,
+	0x3e, 0x79, 0xa4, 0x66, 0x98, 0xeb, 0xb8, 0xa3, 0x64, 0x6e, 0xe2, 0xb1, 0xd7, 0xc1, 0x58, 0x53,
+	0xad, 0x1a, 0xba, 0xea, 0x5a, 0xb6, 0xc3, 0xa6, 0x68, 0x6a, 0x3d, 0x7f, 0x90, 0xe0, 0x41, 0xbf,
+	0x8f, 0x4c, 0x2a, 0xaa, 0x75, 0x78, 0x05, 0x1c, 0xf3, 0x5a, 0x15, 0x07, 0xb9, 0x64, 0xf8, 0x00,
+	0x19, 0x7e, 0xd4, 0xeb, 0xd8, 0x40, 0x2e, 0x1e, 0x7b, 0x16, 0x1c, 0x56, 0xab, 0x55, 0x6b, 0xa7,
+	0x6a, 0x38, 0x6e, 0xfe, 0xd0, 0x64, 0xdf, 0xa5, 0xc3, 0xb2, 0xdf, 0x00, 0x45, 0x30, 0xa8, 0x23,
+	0x73, 0x97, 0x74, 0x0e, 0x92, 0x4e, 0xef, 0x37, 0x1c, 0xe3, 0x92, 0x75, 0x98, 0x50, 0x4c, 0x7f,
+	0xc0, 0xd7, 0xc0, 0x60, 0x0d, 0xb9, 0xaa, 0xae, 0xba, 0x6a, 0x1e, 0x10, 0xbe, 0x3f, 0x9d, 0x4a,
+	0xe4, 0xee, 0xb2, 0xc9, 0x4c, 0xd6, 0x3d, 0x30, 0xcc, 0x64, 0xcc, 0x32, 0x7c, 0xca, 0x51, 0x7e,
+	0x68, 0x52, 0xb8, 0xd4, 0x2f, 0x0f, 0xd6, 0x0c, 0x73, 0x03, 0xff, 0x86, 0xd3, 0xe0, 0x38, 0xd9,
+	0xb4, 0x62, 0x98, 0xaa, 0xe6, 0x1a, 0x4d, 0xa4, 0x34, 0xd5, 0xaa, 0x93, 0x3f, 0x32, 0x29, 0x5c,
+	0x1a, 0x94, 0x8f, 0x91, 0xae, 0x12, 0xeb, 0xd9, 0x52, 0xab, 0x4e, 0xf4, 0x48, 0x0f, 0x47, 0x8f,
+	0x34, 0x7c, 0x04, 0x4e, 0x7b, 0x5c, 0x40, 0xba, 0x62, 0xa3, 0x1d, 0xd5, 0xd6, 0x15, 0x1d, 0x99,
+	0x56, 0xcd, 0xc9, 0x8f, 0x10, 0xba, 0x5e, 0x4c, 0x44, 0xd7, 0xac, 0x8f, 0x22, 0x13, 0x90, 0x79,
+	0x82, 0x21, 0x9f, 0x52, 0xe3, 0x3b, 0xa0, 0x04, 0x8e, 0xd4, 0x6d, 0xc3, 0xc2, 0x60, 0x84, 0xed,
+	0x47, 0x09, 0xdb, 0x43, 0x6d, 0xd0, 0x04, 0x27, 0x0c, 0xf3, 0xbe, 0x8d, 0x09, 0xb2, 0x4c, 0xa5,
+	0xae, 0xda, 0x6a, 0x0d, 0xb9, 0xc8, 0x76, 0xf2, 0xa3, 0x64, 0x67, 0x37, 0x13, 0xed, 0xac, 0xe4,
+	0x21, 0xac, 0x7b, 0x00, 0xf2, 0x98, 0x11, 0xd3, 0x2a, 0x7d, 0x4b, 0x00, 0xe7, 0xc9, 0x91, 0xdd,
+	0xe2, 0xd2, 0xc3, 0x3f, 0xd7, 0xac, 0xae, 0xdb, 0x5c, 0xd5, 0xbc, 0x04, 0x46, 0x39, 0xbe, 0xa2,
+	0xea, 0xba, 0x8d, 0x1c, 0x87, 0x9e, 0x94, 0x39, 0xf8, 0xd9, 0xc7, 0x13, 0x23, 0xbb, 0x6a, 0xad,
+	0xfa, 0xbc, 0xc4, 0x3a, 0x24, 0xf9, 0x28, 0x1f, 0x3b, 0x4b, 0x5b, 0xa2, 0xdf, 0x24, 0x17, 0xfd,
+	0x26, 0xcf, 0x0f, 0xbe, 0xf3, 0xfe, 0xc4, 0x81, 0x7f, 0x7c, 0x7f, 0xe2, 0x80, 0xb4, 0x06, 0xa4,
+	0x4e, 0xdb, 0x61, 0x8a, 0xe4, 0x32, 0x18, 0xf5, 0x00, 0x43, 0xfb, 0x91, 0x8f, 0x6a, 0x81, 0xf1,
+	0xc8, 0x89, 0x23, 0x70, 0x3d, 0xb0, 0xbb, 0x00, 0x81, 0xf1, 0x80, 0xf1, 0x04, 0x46, 0x16, 0xc9,
+	0x44, 0x60, 0x78, 0x3b, 0x3e, 0x81, 0xf1, 0x0c, 0x6f, 0x61, 0xae, 0x74, 0x06, 0x9c, 0x26, 0x80,
+	0x9b, 0x15, 0xdb, 0x72, 0xdd, 0x2a, 0x22, 0x77, 0x07, 0xa3, 0x4b, 0xfa, 0x69, 0x1f, 0x10, 0xe3,
+	0x7a, 0xd9, 0x32, 0x13, 0x60, 0xc8, 0xa9, 0xaa, 0x4e, 0x45, 0x21, 0xd2, 0x40, 0x56, 0xe8, 0x93,
+	0x01, 0x69, 0xba, 0x8b, 0x5b, 0xe0, 0x0c, 0x38, 0x11, 0x18, 0xa0, 0x10, 0xc9, 0x56, 0x4d, 0x0d,
+	0x11, 0x12, 0xfb, 0xe4, 0xe3, 0xfe, 0xd0, 0x59, 0xde, 0x05, 0xbf, 0x02, 0xf2, 0x26, 0x7a, 0xe4,
+	0x2a, 0x36, 0xaa, 0x57, 0x91, 0x69, 0x38, 0x15, 0x45, 0x53, 0x4d, 0x1d, 0x13, 0x8b, 0x88, 0xa6,
+	0x1c, 0x9a, 0x11, 0xa7, 0xa9, 0x79, 0x34, 0xcd, 0xcd, 0xa3, 0xe9, 0x4d, 0x6e, 0x3f, 0xcd, 0x0d,
+	0x62, 0xe5, 0xf0, 0xde, 0xdf, 0x4f, 0x08, 0xf2, 0x49, 0x8c, 0x22, 0x73, 0x90, 0x22, 0xc7, 0x80,
+	0x45, 0x30, 0x1e, 0xdc, 0x93, 0xbf, 0x0c, 0x17, 0x6f, 0xa2, 0x6d, 0x0f, 0xcb, 0x67, 0xfc, 0xcd,
+	0x79, 0x28, 0x8b, 0x6c, 0x08, 0xd4, 0xc1, 0xd9, 0x78, 0x90, 0x3a, 0xb2, 0x0d, 0x4b, 0x27, 0x2a,
+	0x78, 0x68, 0xe6, 0x74, 0xcb, 0x46, 0xe7, 0x99, 0x1d, 0x47, 0xf7, 0xf9, 0xdb, 0x78, 0x9f, 0xa7,
+	0x63, 0xd6, 0x59, 0x27, 0x28, 0xf0, 0x8b, 0xe0, 0xa4, 0x6e, 0xed, 0x98, 0xd8, 0x3a, 0x54, 0xe8,
+	0x72, 0x75, 0x55, 0x7b, 0x80, 0x5c, 0x87, 0xe8, 0xec, 0x7e, 0x79, 0x8c, 0xf7, 0x6e, 0xe0, 0xce,
+	0x75, 0xda, 0x07, 0x6f, 0x82, 0xd3, 0xba, 0xd5, 0xd8, 0xae, 0x22, 0xc5, 0x31, 0xca, 0x66, 0x64,
+	0xe2, 0x21, 0x32, 0xf1, 0x24, 0x1d, 0xb0, 0x61, 0x94, 0xcd, 0xe0, 0x54, 0xe9, 0xf3, 0xe0, 0x0a,
+	0xf9, 0xdc, 0x32, 0x2a, 0x1b, 0x8e, 0x8b, 0x6c, 0xa4, 0xf3, 0xf3, 0x13, 0x52, 0x51, 0x4c, 0x3a,
+	0x16, 0xc0, 0xd5, 0x44, 0xa3, 0x99, 0xb4, 0x9c, 0x04, 0x03, 0x4c, 0x4d, 0x0a, 0x44, 0x73, 0xb1,
+	0x5f, 0xd2, 0x0a, 0xb8, 0x4c, 0x60, 0x66, 0xab, 0xd5, 0x75, 0xd5, 0xb0, 0x9d, 0x2d, 0xb5, 0x8a,
+	0x71, 0xb0, 0x80, 0xce, 0xed, 0xfa, 0x88, 0x09, 0x4d, 0xae, 0xef, 0x0a, 0xe0, 0x4a, 0x12, 0x38,
+	0xb6, 0xa9, 0x87, 0xe0, 0x58, 0x5d, 0x35, 0x6c, 0x7c, 0x2b, 0x60, 0x5b, 0x96, 0x9c, 0x16, 0x66,
+	0x5e, 0x2c, 0x26, 0x52, 0x96, 0x78, 0x0d, 0xba, 0x04, 0x5e, 0xc1, 0x3b, 0x8d, 0xa6, 0xcf, 0x8b,
+	0x91, 0x7a, 0x68, 0x88, 0xf4, 0x1f, 0x02, 0x38, 0xdf, 0x75, 0x16, 0x5c, 0x6c, 0xab, 0x33, 0xcf,
+	0x7c, 0xf6, 0xf1, 0xc4, 0x29, 0xaa, 0x52, 0xa2, 0x23, 0x62, 0x94, 0xe7, 0x62, 0x8c, 0x6a, 0xca,
+	0x45, 0x71, 0xa2, 0x23, 0x62, 0x74, 0xd4, 0x2d, 0x70, 0xc4, 0x1b, 0xf5, 0x00, 0xed, 0xb2, 0xa3,
+	0x78, 0x76, 0xda, 0xb7, 0xd5, 0xa7, 0xa9, 0x25, 0x3f, 0xbd, 0xde, 0xd8, 0xae, 0x1a, 0xda, 0x1d,
+	0xb4, 0x2b, 0x7b, 0x9f, 0xea, 0x0e, 0xda, 0x95, 0xc6, 0x00, 0x24, 0xdf, 0x85, 0xdc, 0x1e, 0x9e,
+	0x0c, 0x7d, 0x15, 0x1c, 0x0f, 0xb5, 0xb2, 0xcf, 0x52, 0x02, 0x03, 0xe4, 0xf2, 0x72, 0x98, 0x45,
+	0x7c, 0x35, 0xe1, 0xb7, 0xc0, 0x53, 0x98, 0x81, 0xc0, 0x00, 0xa4, 0xbb, 0x4c, 0x1e, 0x42, 0x46,
+	0xe5, 0x5a, 0xdd, 0x45, 0x7a, 0xc9, 0xf4, 0xb4, 0x68, 0x72, 0x93, 0xfe, 0x21, 0xb8, 0x9a, 0x08,
+	0xce, 0xb3, 0x59, 0xcf, 0x05, 0x6d, 0xb4, 0xc8, 0xf7, 0x42, 0xfc, 0x2c, 0x9c, 0x09, 0x18, 0x6b,
+	0xe1, 0x0f, 0x88, 0x1c, 0x69, 0x16, 0x8c, 0x87, 0x96, 0xec, 0x61, 0xd7, 0xdf, 0x3e, 0x04, 0x26,
+	0xdb, 0x60, 0x78, 0xff, 0xca, 0x7a, 0x4d, 0x47, 0x25, 0x24, 0x97, 0x52, 0x42, 0x60, 0x1e, 0x1c,
+	0x24, 0x46, 0x2c, 0x91, 0xad, 0xbe, 0xb9, 0x5c, 0x5e, 0x90, 0x69, 0x03, 0xbc, 0x09, 0xfa, 0x6d,
+	0xac, 0xff, 0x89, 0x66, 0x9e, 0xbb, 0x88, 0xbf, 0xef, 0xdf, 0x7e, 0x3c, 0x71, 0x86, 0x9a, 0xed,
+	0x8e, 0xfe, 0x60, 0xda, 0xb0, 0x0a, 0x35, 0xd5, 0xad, 0x4c, 0xaf, 0xa0, 0xb2, 0xaa, 0xed, 0xce,
+	0x23, 0x2d, 0x2f, 0xc8, 0x64, 0x0a, 0xbc, 0x08, 0x46, 0xbc, 0x5d, 0x51, 0xf4, 0x83, 0xe4, 0xee,
+	0x19, 0xe6, 0xad, 0xc4, 0x38, 0x86, 0x6f, 0x82, 0xbc, 0x37, 0x4c, 0xb3, 0x6a, 0x35, 0xc3, 0x71,
+	0xb0, 0x05, 0x45, 0x56, 0x1d, 0x20, 0xab, 0x4e, 0x25, 0x58, 0x55, 0x3e, 0xc9, 0x41, 0x8a, 0x1e,
+	0x86, 0x8c, 0x77, 0xf1, 0x26, 0xc8, 0x7b, 0xac, 0x8d, 0xc2, 0x1f, 0x4a, 0x01, 0xcf, 0x41, 0x22,
+	0xf0, 0x77, 0xc0, 0x90, 0x8e, 0x1c, 0xcd, 0x36, 0xea, 0xe4, 0x02, 0x1b, 0x24, 0x9c, 0x9f, 0xe2,
+	0x6e, 0x0d, 0xf7, 0x7f, 0xb9, 0x4f, 0x33, 0xef, 0x0f, 0x65, 0x67, 0x25, 0x38, 0x1b, 0xbe, 0x09,
+	0x4e, 0x7b, 0x7b, 0xb5, 0xea, 0xc8, 0x26, 0xce, 0x02, 0x97, 0x07, 0x62, 0xd2, 0xcf, 0x9d, 0xff,
+	0xe8, 0x83, 0x6b, 0xe7, 0x18, 0xba, 0x27, 0x3f, 0x4c, 0x0e, 0x36, 0x5c, 0xdb, 0x30, 0xcb, 0xf2,
+	0x29, 0x8e, 0xb1, 0xc6, 0x20, 0xb8, 0x98, 0x9c, 0x04, 0x03, 0x5f, 0x53, 0x8d, 0x2a, 0xd2, 0x89,
+	0x17, 0x30, 0x28, 0xb3, 0x5f, 0xf0, 0x79, 0x30, 0x80, 0x7d, 0xe0, 0x86, 0x43, 0x6c, 0xf8, 0x91,
+	0x19, 0xa9, 0xdd, 0xf6, 0xe7, 0x2c, 0x53, 0xdf, 0x20, 0x23, 0x65, 0x36, 0x03, 0x6e, 0x02, 0x4f,
+	0x1a, 0x15, 0xd7, 0x7a, 0x80, 0x4c, 0x6a, 0xe1, 0x1f, 0x9e, 0xbb, 0xca, 0xb8, 0x7a, 0xa2, 0x95,
+	0xab, 0x25, 0xd3, 0xfd, 0xe8, 0x83, 0x6b, 0x80, 0x2d, 0x52, 0x32, 0x5d, 0x79, 0x84, 0x63, 0x6c,
+	0x12, 0x08, 0x2c, 0x3a, 0x1e, 0x2a, 0x15, 0x9d, 0x61, 0x2a, 0x3a, 0xbc, 0x95, 0x8a, 0xce, 0x33,
+	0xe0, 0x14, 0x3b, 0xbd, 0xc8, 0x51, 0xb4, 0x86, 0x6d, 0x63, 0x7f, 0x0f, 0xd5, 0x2d, 0xad, 0x42,
+	0xfc, 0x81, 0x41, 0xf9, 0x84, 0xd7, 0x5d, 0xa4, 0xbd, 0x0b, 0xb8, 0x53, 0x7a, 0x47, 0x00, 0x13,
+	0x6d, 0xcf, 0x35, 0x53, 0x1f, 0x08, 0x00, 0x5f, 0x33, 0xb0, 0x7b, 0x69, 0x21, 0x91, 0x2e, 0xec,
+	0x76, 0xda, 0xe5, 0x00, 0xb0, 0xf4, 0x10, 0x5c, 0x8f, 0x71, 0xbc, 0xbd, 0xb1, 0xcb, 0xaa, 0xb3,
+	0x69, 0xb1, 0x5f, 0x68, 0x7f, 0x8c, 0x7a, 0x69, 0x0b, 0xdc, 0x48, 0xb1, 0x24, 0x63, 0xc7, 0xf9,
+	0x80, 0x8a, 0x31, 0x74, 0xae, 0x3c, 0x87, 0x7c, 0x45, 0x47, 0x0c, 0xf6, 0xab, 0xf1, 0x2e, 0x40,
+	0xf8, 0xcc, 0x24, 0x55, 0x9d, 0xb1, 0x74, 0xe6, 0x92, 0xd3, 0x59, 0x06, 0x9f, 0x4f, 0xb6, 0x1d,
+	0x46, 0xe2, 0xb3, 0x4c, 0xd5, 0x09, 0xc9, 0xb5, 0x02, 0x99, 0x20, 0x49, 0x4c, 0xc3, 0xcf, 0x55,
+	0x2d, 0xed, 0x81, 0x73, 0xcf, 0x74, 0x8d, 0xea, 0x2a, 0x7a, 0x44, 0x65, 0x8d, 0xdf, 0xb6, 0xaf,
+	0x83, 0xf3, 0x1d, 0xc6, 0xb0, 0x1d, 0x3c, 0x0d, 0x4e, 0x6d, 0x93, 0x7e, 0xa5, 0x81, 0x07, 0x28,
+	0xc4, 0x1a, 0xa7, 0xf2, 0x2c, 0x50, 0xb3, 0x73, 0x3b, 0x66, 0xba, 0x34, 0xcb, 0x3c, 0x93, 0xa2,
+	0xc7, 0xba, 0x45, 0xdb, 0xaa, 0x15, 0x59, 0xb4, 0x83, 0xb3, 0x3b, 0x14, 0x11, 0x11, 0xc2, 0x11,
+	0x11, 0x69, 0x11, 0x4c, 0x75, 0x84, 0xf0, 0xdd, 0x8e, 0xce, 0xb7, 0xdd, 0x8b, 0xe0, 0x74, 0x08,
+	0x87, 0x86, 0x80, 0x92, 0xde, 0x95, 0x1f, 0xf6, 0xc7, 0xc5, 0xcd, 0x12, 0xaf, 0x1e, 0x8a, 0x07,
+	0xe5, 0xc2, 0xf1, 0xa0, 0x29, 0x30, 0x6c, 0xed, 0x98, 0x01, 0x41, 0xea, 0x23, 0xfd, 0x47, 0x48,
+	0x23, 0x57, 0x90, 0x5e, 0xf8, 0xa4, 0xbf, 0x5d, 0xf8, 0xe4, 0xe0, 0x7e, 0x86, 0x4f, 0xee, 0x83,
+	0x21, 0xc3, 0x34, 0x5c, 0x85, 0xd9, 0x5b, 0x03, 0x93, 0x42, 0x62, 0x1d, 0xe3, 0x7d, 0x27, 0xd3,
+	0x70, 0x0d, 0xb5, 0x6a, 0xbc, 0xa5, 0x46, 0x82, 0x06, 0x00, 0x23, 0x93, 0xdf, 0x0e, 0xac, 0x81,
+	0x31, 0x1a, 0xa2, 0x72, 0x2a, 0x6a, 0xdd, 0x30, 0xcb, 0x7c, 0xc1, 0x43, 0x64, 0xc1, 0x17, 0x92,
+	0x19, 0x78, 0x18, 0x60, 0x83, 0xce, 0x0f, 0x2c, 0x03, 0xeb, 0xd1, 0x76, 0xa7, 0x7d, 0x24, 0x64,
+	0xf0, 0x89, 0x44, 0x42, 0xc2, 0x82, 0x7d, 0x38, 0x22, 0xd8, 0x73, 0x11, 0x4d, 0xcf, 0x62, 0xb7,
+	0xd8, 0x6d, 0x4d, 0x2c, 0x96, 0x0f, 0xc0, 0x64, 0x7b, 0x0c, 0x26, 0x9b, 0x4b, 0x80, 0x87, 0x80,
+	0x15, 0xec, 0x16, 0xe6, 0x85, 0x14, 0xfe, 0xf2, 0x50, 0xd9, 0x07, 0x94, 0xe6, 0x79, 0xd4, 0x63,
+	0xa3, 0x78, 0x57, 0x75, 0x99, 0xd3, 0xba, 0xa1, 0x55, 0x90, 0xde, 0xa8, 0x26, 0xdf, 0xb2, 0x05,
+	0x86, 0x38, 0x80, 0xe1, 0xee, 0xc2, 0x13, 0x60, 0xa0, 0xe9, 0x68, 0x7c, 0x68, 0xbf, 0x7c, 0xb0,
+	0xe9, 0x68, 0x25, 0x1d, 0x96, 0xc0, 0x70, 0x8d, 0x0d, 0xa1, 0xbb, 0xce, 0xa5, 0xd8, 0xf5, 0x11,
+	0x3e, 0x95, 0x6c, 0xfb, 0x97, 0x79, 0x74, 0x24, 0x7e, 0xdb, 0x8c, 0x4b, 0x5b, 0x00, 0xb0, 0x59,
+	0x06, 0xe2, 0x97, 0xea, 0xf5, 0x44, 0xf2, 0x10, 0xa0, 0x86, 0x9d, 0xa3, 0x00, 0x92, 0xf4, 0x81,
+	0xc0, 0x96, 0xdf, 0x30, 0x6a, 0x8d, 0xaa, 0xea, 0x22, 0xfe, 0xa9, 0xee, 0xd5, 0xf5, 0x34, 0x37,
+	0x4e, 0xbb, 0x93, 0x92, 0x7b, 0x22, 0x27, 0x45, 0xfa, 0x44, 0x00, 0x53, 0x1d, 0xb7, 0xcd, 0xd8,
+	0x76, 0x1f, 0x1c, 0x25, 0x57, 0x41, 0x8b, 0x41, 0xf2, 0x6c, 0x62, 0x65, 0x81, 0x4c, 0xa7, 0xe1,
+	0xdf, 0xf1, 0x8c, 0x85, 0x23, 0x18, 0xd5, 0x6b, 0x74, 0xe0, 0x46, 0x30, 0x48, 0xdd, 0x20, 0x7b,
+	0xc0, 0xb4, 0xe3, 0x95, 0x26, 0x83, 0xce, 0x04, 0x7e, 0x1a, 0xf2, 0xad, 0x4f, 0xba, 0x59, 0x06,
+	0x39, 0xda, 0x0c, 0x37, 0x3b, 0xd2, 0x12, 0xb8, 0x10, 0x6f, 0x11, 0x6d, 0x20, 0x77, 0x59, 0x75,
+	0x2a, 0x89, 0x65, 0xda, 0x00, 0x17, 0xbb, 0x00, 0xf9, 0xf7, 0x04, 0x0e, 0x35, 0x23, 0x57, 0xa9,
+	0xa8, 0x4e, 0x85, 0x23, 0xd1, 0x26, 0x3c, 0x30, 0x30, 0xc0, 0x31, 0xde, 0xa2, 0x52, 0xdf, 0xcf,
+	0x07, 0x6c, 0x18, 0x6f, 0x21, 0xe9, 0x05, 0x3f, 0xd6, 0xe7, 0x20, 0x97, 0x52, 0x52, 0xd2, 0x37,
+	0xad, 0x65, 0x64, 0x94, 0x2b, 0x2e, 0xdf, 0x71, 0xfc, 0xa9, 0x92, 0x5e, 0x02, 0x53, 0x1d, 0x27,
+	0xfb, 0x41, 0x99, 0x0a, 0x69, 0x61, 0xb3, 0xd9, 0x2f, 0x69, 0x8a, 0x29, 0x00, 0x19, 0x69, 0xc8,
+	0x74, 0xc3, 0x20, 0x9e, 0xf3, 0xfe, 0x3d, 0x2e, 0xf0, 0x6d, 0x46, 0xb1, 0x35, 0xf6, 0x80, 0xc8,
+	0x08, 0xa5, 0x5f, 0x53, 0x31, 0x74, 0xc5, 0xb5, 0x14, 0x6f, 0xdd, 0xbe, 0xc4, 0x52, 0x1d, 0x4f,
+	0x0c, 0xfb, 0xe8, 0x27, 0x9b, 0xb1, 0xbd, 0xd2, 0x32, 0xfb, 0x62, 0xbe, 0x88, 0xdd, 0x73, 0x0c,
+	0xb3, 0x3c, 0x8f, 0xee, 0xab, 0x8d, 0xaa, 0x8b, 0xbd, 0xd0, 0xa4, 0xdf, 0xbe, 0x0a, 0x9e, 0xea,
+	0x86, 0xb4, 0x8f, 0x6e, 0xff, 0x42, 0xc4, 0xa0, 0xa2, 0x41, 0x35, 0x87, 0x0d, 0x48, 0xbc, 0xe9,
+	0x55, 0x30, 0xd5, 0x11, 0x86, 0xed, 0xf8, 0x73, 0xe0, 0x28, 0x7d, 0xcb, 0x70, 0x22, 0x11, 0xe3,
+	0x11, 0x3b, 0x34, 0x41, 0xba, 0xce, 0x03, 0xc6, 0x56, 0x7d, 0x75, 0xb3, 0x62, 0x23, 0xa7, 0x62,
+	0x55, 0x3d, 0xf3, 0x8e, 0xbd, 0x69, 0x99, 0x79, 0xc1, 0x7f, 0xd3, 0x92, 0x6e, 0x02, 0x31, 0x6e,
+	0x06, 0x5b, 0x98, 0x3d, 0xdf, 0x50, 0x07, 0x8b, 0x86, 0x90, 0x07, 0xf9, 0x43, 0x97, 0x54, 0x8c,
+	0x5c, 0x7a, 0xe4, 0xdd, 0x71, 0xd9, 0x70, 0x5c, 0xcb, 0x4e, 0xfe, 0xd9, 0xbe, 0xc9, 0x63, 0xf8,
+	0xf1, 0x28, 0x6c, 0x1f, 0x3a, 0x18, 0x72, 0x6d, 0xd5, 0x74, 0x0c, 0xf2, 0x7e, 0xcf, 0xc4, 0xf2,
+	0xc5, 0xf4, 0xaf, 0xa2, 0x9b, 0x1e, 0x08, 0x77, 0xae, 0x03, 0xb0, 0x2d, 0x04, 0x61, 0xae, 0x3a,
+	0x9b, 0xd6, 0xba, 0xdd, 0x30, 0x93, 0xdf, 0xab, 0xbf, 0x17, 0x25, 0x28, 0x8c, 0xc2, 0x08, 0x7a,
+	0x04, 0x4e, 0x85, 0xe2, 0x7a, 0x0e, 0x3e, 0x74, 0x75, 0x3c, 0x24, 0xd5, 0x99, 0x8b, 0x5b, 0x63,
+	0x6b, 0x86, 0xd1, 0x36, 0xa6, 0xc5, 0xf4, 0x4a, 0x08, 0x4c, 0x06, 0xd4, 0xc2, 0x1d, 0xb4, 0x3b,
+	0xeb, 0xe0, 0x50, 0x74, 0x0d, 0x99, 0x6e, 0x62, 0xb9, 0x85, 0x93, 0xe0, 0x88, 0x63, 0x98, 0x1a,
+	0x52, 0x98, 0x76, 0x63, 0xfa, 0x91, 0xb4, 0x6d, 0x11, 0x15, 0xf7, 0x2b, 0x02, 0x38, 0xdf, 0x61,
+	0x1d, 0xff, 0x8d, 0xfd, 0x01, 0xda, 0x55, 0x6c, 0x9e, 0x99, 0x91, 0xea, 0xc2, 0xc7, 0x67, 0x9a,
+	0x4d, 0xe4, 0x6f, 0xec, 0x0f, 0xfc, 0x26, 0x47, 0xfa, 0x5d, 0x01, 0x0c, 0x05, 0xc6, 0xa4, 0x78,
+	0x78, 0xc1, 0xaf, 0xb7, 0x56, 0xd5, 0x4f, 0xa0, 0x08, 0xfb, 0x96, 0x32, 0xb4, 0xaa, 0x7a, 0x31,
+	0x12, 0x82, 0xbd, 0x0e, 0xc6, 0x4c, 0xb4, 0xd3, 0x3a, 0x83, 0x3a, 0x11, 0xd0, 0x44, 0x3b, 0x91,
+	0x19, 0x92, 0xc6, 0xce, 0xea, 0x6d, 0xd5, 0xa8, 0xe2, 0xa0, 0x0c, 0x52, 0x1d, 0xcb, 0x73, 0x84,
+	0x3a, 0x44, 0x98, 0x3f, 0xfa, 0xe0, 0xda, 0x29, 0x16, 0x18, 0xf1, 0xae, 0x6d, 0xae, 0x30, 0x5a,
+	0x3c, 0xdc, 0x3d, 0x20, 0xc6, 0x2d, 0xe2, 0x1f, 0x6f, 0x1a, 0xe0, 0x51, 0xb6, 0x77, 0xb9, 0xc3,
+	0x47, 0x1b, 0xe6, 0x76, 0xe1, 0x1c, 0x00, 0xbe, 0x31, 0x9d, 0xcf, 0x75, 0x8e, 0xfb, 0xf8, 0xc6,
+	0xb8, 0x1c, 0x98, 0xd5, 0xe2, 0x34, 0x06, 0x1e, 0x34, 0xd2, 0xf8, 0xf9, 0x92, 0x0a, 0x2e, 0x74,
+	0xc6, 0x61, 0x04, 0x8d, 0x81, 0x83, 0x9a, 0xd5, 0x30, 0xf9, 0x85, 0x49, 0x7f, 0x60, 0xcf, 0x6e,
+	0xc7, 0x30, 0x75, 0x6b, 0x47, 0xa1, 0xce, 0x31, 0x13, 0xd7, 0x23, 0xb4, 0x91, 0xfa, 0xdb, 0xd2,
+	0xdb, 0x02, 0x3b, 0x18, 0x0b, 0xf7, 0xef, 0x23, 0xf2, 0xe6, 0x5c, 0xf4, 0xc3, 0x9f, 0xff, 0x5f,
+	0x01, 0x89, 0xaf, 0xf3, 0x53, 0x13, 0xbf, 0x09, 0x46, 0x65, 0x34, 0x98, 0x2b, 0xa4, 0x0d, 0xe6,
+	0x9e, 0x03, 0xc0, 0x70, 0x14, 0x9d, 0x5e, 0x8d, 0x64, 0x7f, 0x83, 0xf2, 0x61, 0xc3, 0x61, 0x77,
+	0xa5, 0xe7, 0x60, 0xf0, 0xb5, 0x57, 0xd4, 0x86, 0xa9, 0x55, 0x16, 0x55, 0xa3, 0xda, 0xb0, 0x93,
+	0x7f, 0xb3, 0xf7, 0x05, 0x20, 0x75, 0x82, 0x61, 0xc4, 0x88, 0x60, 0x50, 0x75, 0x5d, 0x54, 0xab,
+	0xbb, 0x0e, 0xbb, 0x98, 0xbc, 0xdf, 0xf8, 0x73, 0x22, 0xdb, 0xb6, 0x6c, 0x76, 0xee, 0xe8, 0x0f,
+	0x3f, 0x39, 0xa6, 0x2f, 0x63, 0x72, 0x8c, 0xf4, 0x25, 0x96, 0x02, 0x14, 0x10, 0xa7, 0xb9, 0xdd,
+	0x0d, 0xf4, 0x30, 0xf1, 0xe7, 0x3e, 0x05, 0x0e, 0x19, 0xdb, 0x9a, 0xe2, 0xa0, 0x87, 0x4c, 0xa6,
+	0x06, 0x8c, 0x6d, 0x6d, 0x03, 0x3d, 0x94, 0x7e, 0x2e, 0x80, 0x73, 0x6d, 0xa0, 0x19, 0xdd, 0xab,
+	0x5e, 0x48, 0x95, 0xe6, 0xf8, 0x3c, 0x93, 0x88, 0x8c, 0x00, 0x5c, 0x24, 0xcc, 0x7a, 0xb9, 0x9d,
+	0xe4, 0xb5, 0x6a, 0xb7, 0xf0, 0xc9, 0xee, 0xeb, 0xe5, 0x64, 0x07, 0x22, 0xc5, 0xfd, 0xc1, 0x48,
+	0xb1, 0xf7, 0x4a, 0xc9, 0xf9, 0xed, 0xbc, 0x66, 0xb8, 0x15, 0xfe, 0x42, 0xad, 0x93, 0xed, 0x13,
+	0x3d, 0x44, 0x8d, 0xd4, 0xef, 0x08, 0xe0, 0x6a, 0xa2, 0xe1, 0x9e, 0x9b, 0x73, 0x98, 0x73, 0x9f,
+	0xdf, 0x15, 0x73, 0xa9, 0x3e, 0x7f, 0x18, 0x9a, 0x32, 0x92, 0x3f, 0x4a, 0xf9, 0xd0, 0xd2, 0x16,
+	0x38, 0xd7, 0x71, 0x46, 0x77, 0xc9, 0xf0, 0x34, 0x51, 0x8e, 0xc8, 0x34, 0xfd, 0x21, 0x21, 0x70,
+	0x21, 0x6c, 0xa4, 0x62, 0xb3, 0x6b, 0x6d, 0xbb, 0x6a, 0x94, 0xe9, 0x9d, 0xb5, 0x4f, 0xf1, 0xdb,
+	0xdf, 0x11, 0xc0, 0xc5, 0x2e, 0xeb, 0xf8, 0x0a, 0x33, 0x68, 0xdc, 0xd1, 0x1f, 0xf0, 0x0d, 0x30,
+	0x64, 0xf9, 0x83, 0x99, 0x7f, 0xf7, 0x85, 0x44, 0x8c, 0x0e, 0x2f, 0xc4, 0xad, 0xac, 0x00, 0x9a,
+	0x64, 0x83, 0x91, 0xf0, 0xa0, 0xee, 0xcc, 0xf4, 0xb2, 0xb1, 0x72, 0x5d, 0xb3, 0xb1, 0xfa, 0xe2,
+	0xb2, 0xb1, 0x3c, 0x37, 0x23, 0x12, 0x9f, 0xd9, 0xf2, 0x1c, 0xbe, 0xc4, 0x5a, 0xad, 0x04, 0x9e,
+	0xea, 0x86, 0x94, 0xd0, 0xc7, 0x6c, 0x31, 0x37, 0xe7, 0x0d, 0xc7, 0xb5, 0x8d, 0xed, 0x06, 0x39,
+	0x6b, 0x49, 0xf7, 0xf3, 0xcf, 0x51, 0x73, 0x33, 0x8c, 0xc2, 0xf6, 0xf2, 0x0c, 0x38, 0xa5, 0x07,
+	0xda, 0x15, 0xad, 0xa2, 0x9a, 0x26, 0xaa, 0xfa, 0x90, 0x27, 0x82, 0xdd, 0x45, 0xda, 0x5b, 0xd2,
+	0x71, 0x86, 0x96, 0xff, 0x34, 0xe6, 0xcf, 0xa1, 0x7a, 0xe5, 0x18, 0xef, 0xf2, 0xc7, 0x43, 0xd0,
+	0x6f, 0xd5, 0x11, 0xd5, 0x29, 0x83, 0x32, 0xf9, 0x37, 0x7e, 0x17, 0x70, 0x90, 0xa9, 0x2b, 0xc8,
+	0x54, 0xb7, 0x7d, 0x7d, 0x31, 0x84, 0xdb, 0x16, 0x68, 0x13, 0xf5, 0x6f, 0x34, 0x64, 0x34, 0x91,
+	0x37, 0xea, 0x20, 0x19, 0x35, 0xc2, 0x9a, 0xd9, 0x40, 0x69, 0x31, 0x42, 0x6c, 0xd0, 0xc1, 0xf7,
+	0x0e, 0x4f, 0x82, 0x87, 0x88, 0x77, 0xa3, 0x77, 0x53, 0x04, 0xc8, 0x53, 0x37, 0x23, 0xa1, 0x94,
+	0x3c, 0xae, 0x73, 0x6e, 0xa6, 0xd2, 0x39, 0x41, 0x6c, 0x76, 0x20, 0x86, 0x83, 0x09, 0x7d, 0x8e,
+	0xf4, 0xfb, 0x02, 0x18, 0x8b, 0x1b, 0xdd, 0xfd, 0x64, 0x84, 0xdf, 0xa0, 0x72, 0x4f, 0xea, 0x0d,
+	0x6a, 0x3b, 0x9a, 0x68, 0x75, 0x07, 0xe1, 0xb9, 0xf7, 0xab, 0x86, 0xe6, 0xee, 0x97, 0xd2, 0x7a,
+	0x5b, 0x00, 0x52, 0xa7, 0x45, 0xd8, 0x37, 0xf9, 0x32, 0xb9, 0x02, 0x68, 0x23, 0xfb, 0x1c, 0xcf,
+	0xa5, 0xfa, 0x1c, 0x01, 0xd4, 0x80, 0xe2, 0xa7, 0x80, 0xd2, 0x1f, 0x0a, 0xe0, 0x78, 0xcc, 0xc0,
+	0x14, 0x59, 0x69, 0xd9, 0x9f, 0xda, 0xa3, 0xf2, 0xdb, 0xd7, 0x2a, 0xbf, 0xd1, 0xac, 0x03, 0x19,
+	0xd5, 0xac, 0xa6, 0x5a, 0x5d, 0xd8, 0x9c, 0x4d, 0xac, 0x38, 0x3e, 0x8d, 0xbe, 0x70, 0x06, 0x31,
+	0x18, 0xaf, 0xaf, 0x82, 0x63, 0x36, 0x6d, 0x55, 0x1c, 0x16, 0xa8, 0xa5, 0x50, 0x83, 0xf2, 0x28,
+	0xeb, 0xe0, 0x01, 0x5c, 0x1d, 0xc7, 0xb7, 0xf9, 0xe0, 0xd4, 0x91, 0xe2, 0x21, 0x36, 0x13, 0xf7,
+	0xc1, 0xdb, 0x60, 0x04, 0x03, 0x28, 0x36, 0xaa, 0xa9, 0x86, 0x69, 0x98, 0xe5, 0x7c, 0x5f, 0xf2,
+	0x8c, 0xad, 0x61, 0x97, 0xc4, 0xdc, 0xd9, 0xcc, 0x96, 0xe0, 0xfe, 0x6d, 0x62, 0xa5, 0x90, 0xab,
+	0x21, 0x31, 0xa7, 0x16, 0xc0, 0x64, 0x7b, 0x0c, 0xff, 0xf1, 0x93, 0x79, 0x52, 0xc1, 0xeb, 0x74,
+	0xe8, 0x6b, 0xfe, 0x50, 0xc9, 0x00, 0x97, 0xc2, 0xe2, 0x3d, 0xcf, 0x72, 0x72, 0xfd, 0xd4, 0xac,
+	0xfd, 0x3a, 0x4a, 0xab, 0xe0, 0x72, 0x82, 0xa5, 0x92, 0xbf, 0xdb, 0x7e, 0xa3, 0xe5, 0x68, 0x3e,
+	0x81, 0x57, 0xe7, 0xae, 0x99, 0x96, 0x38, 0x7d, 0x6c, 0xaa, 0xe3, 0x36, 0x18, 0x45, 0x4f, 0x81,
+	0xa3, 0x15, 0x95, 0x44, 0x54, 0xf8, 0xe3, 0x3e, 0x13, 0xda, 0xe1, 0x4a, 0x70, 0x3c, 0x5c, 0x07,
+	0x03, 0x36, 0x71, 0x88, 0x99, 0x77, 0x9b, 0x4c, 0x8f, 0x44, 0xd6, 0x24, 0x0e, 0x35, 0xc3, 0x69,
+	0x39, 0x97, 0xc5, 0xe2, 0x16, 0x16, 0x69, 0xab, 0xe1, 0x26, 0x96, 0xb6, 0xdf, 0x88, 0x9e, 0xcb,
+	0x20, 0x06, 0x23, 0xf0, 0x55, 0x00, 0x35, 0xad, 0x49, 0x8e, 0x99, 0xd5, 0x70, 0x79, 0x5e, 0xa3,
+	0x90, 0xfc, 0x94, 0x8c, 0x6a, 0x5a, 0x93, 0x81, 0xb2, 0x74, 0xc6, 0x71, 0x00, 0xac, 0x26, 0xb2,
+	0x6d, 0x43, 0xd7, 0x91, 0xc9, 0x5c, 0xc2, 0x40, 0x8b, 0x34, 0xc9, 0x28, 0x0b, 0x05, 0x72, 0xb0,
+	0x0b, 0xe2, 0x05, 0x9c, 0x7f, 0xc6, 0x37, 0x1e, 0x37, 0x84, 0x6d, 0x7c, 0x1a, 0x1c, 0x77, 0x2d,
+	0x57, 0xad, 0x2a, 0x2a, 0x19, 0x80, 0x74, 0xac, 0x21, 0x1d, 0xe6, 0xad, 0x1f, 0x23, 0x5d, 0xb3,
+	0xac, 0xe7, 0x0e, 0xda, 0x75, 0x60, 0x01, 0x8c, 0xb1, 0xf1, 0xe1, 0x18, 0x59, 0x2e, 0x38, 0x21,
+	0x10, 0xdd, 0x82, 0xd5, 0x40, 0x46, 0x11, 0x76, 0x8c, 0xa8, 0xf6, 0x1c, 0x9a, 0xb9, 0x95, 0xf6,
+	0x8a, 0x88, 0x50, 0xc0, 0xef, 0x6d, 0x0e, 0x4e, 0x1a, 0x71, 0x96, 0x88, 0xd8, 0x7e, 0x4e, 0xf7,
+	0xdb, 0x7b, 0x0a, 0x0c, 0x87, 0x19, 0xc1, 0x02, 0x13, 0x6a, 0x90, 0x07, 0x17, 0xc0, 0x48, 0x84,
+	0xfa, 0x3e, 0x36, 0x2a, 0x18, 0xd6, 0x9b, 0x08, 0xfa, 0x9b, 0x24, 0x61, 0x35, 0x1c, 0x89, 0x95,
+	0xf6, 0xc0, 0x78, 0xbb, 0x01, 0x5e, 0x30, 0xee, 0x10, 0x32, 0x5d, 0xdb, 0x7f, 0x77, 0x7b, 0x21,
+	0xb9, 0x4b, 0x1a, 0x04, 0x5c, 0x30, 0x5d, 0x9b, 0x3f, 0xc1, 0x71, 0x44, 0xe9, 0x55, 0x70, 0x32,
+	0x7e, 0x60, 0xe4, 0x95, 0xa3, 0x8f, 0xbf, 0x72, 0x44, 0x13, 0x98, 0x73, 0xd1, 0x04, 0xe6, 0x56,
+	0x67, 0x6a, 0xb6, 0x5a, 0x0d, 0x7c, 0x8d, 0xfd, 0x52, 0xa6, 0xef, 0xb6, 0x38, 0x53, 0x2d, 0xeb,
+	0x30, 0x06, 0x6a, 0x60, 0x38, 0x78, 0xf3, 0xa7, 0x33, 0x4f, 0xb8, 0xdc, 0x07, 0x90, 0x79, 0x54,
+	0x33, 0x60, 0x1c, 0x38, 0xd2, 0x1f, 0x08, 0xe0, 0x78, 0xcc, 0xd8, 0xee, 0xc2, 0x76, 0xb9, 0x5d,
+	0xb2, 0xe9, 0x13, 0xc8, 0x27, 0xe5, 0x51, 0x80, 0xbb, 0xea, 0xa3, 0x75, 0x2f, 0x2b, 0x2e, 0xfa,
+	0xc4, 0xe8, 0x69, 0x8e, 0x3f, 0xe2, 0x51, 0x80, 0x6e, 0xc3, 0xbd, 0x04, 0xd4, 0xf3, 0x35, 0xf5,
+	0x91, 0x12, 0x48, 0xda, 0x63, 0x63, 0xc3, 0xcf, 0x9f, 0x58, 0x5e, 0xc6, 0x6b, 0x1d, 0x21, 0xb1,
+	0x85, 0xe3, 0x97, 0x9e, 0xf8, 0x66, 0x34, 0x9e, 0x3a, 0xea, 0x55, 0x9e, 0xb0, 0x76, 0xa9, 0x18,
+	0xa9, 0x18, 0x73, 0xe6, 0x76, 0xd7, 0x70, 0x72, 0x08, 0x17, 0xb4, 0x96, 0x0c, 0x12, 0xa1, 0x35,
+	0x83, 0x44, 0xda, 0x01, 0xe7, 0xda, 0x80, 0x78, 0x2f, 0xe0, 0x2d, 0x31, 0x8e, 0x64, 0x21, 0xae,
+	0xb5, 0x9d, 0x80, 0x48, 0xb4, 0xc6, 0x34, 0xde, 0x02, 0xc3, 0xa1, 0x11, 0xdd, 0x25, 0xc6, 0x0b,
+	0xb4, 0xe5, 0xb2, 0x06, 0xda, 0x66, 0xc1, 0x85, 0xd6, 0xac, 0x9d, 0x92, 0x3e, 0xdb, 0x54, 0x8d,
+	0x2a, 0xf6, 0xec, 0x38, 0x07, 0xdb, 0x97, 0x6b, 0x49, 0x7b, 0xe0, 0x62, 0x17, 0x08, 0xc6, 0x3f,
+	0x5c, 0x1b, 0xc5, 0x1b, 0xd9, 0xbd, 0xef, 0x37, 0x60, 0x4f, 0x98, 0x5b, 0xfb, 0xf8, 0xf5, 0xbe,
+	0xd5, 0xe0, 0x38, 0x11, 0xe8, 0xf6, 0x73, 0x9d, 0xa4, 0xb3, 0x2c, 0x90, 0x8e, 0x73, 0xaa, 0xfc,
+	0x66, 0x2e, 0xc1, 0xbc, 0x16, 0x31, 0xda, 0x9b, 0x34, 0x29, 0xaa, 0x25, 0x8b, 0x78, 0xa3, 0xb8,
+	0xa2, 0xba, 0xc8, 0xd4, 0x92, 0x3f, 0xa4, 0xfd, 0xb0, 0x25, 0x63, 0x31, 0x80, 0xe1, 0x1b, 0x46,
+	0x66, 0xa3, 0x46, 0x1e, 0x6d, 0x78, 0xcd, 0x01, 0xbd, 0x7a, 0x87, 0xcd, 0x46, 0x6d, 0xcb, 0xd1,
+	0x78, 0x74, 0x6b, 0x05, 0x1c, 0x55, 0x9b, 0xc8, 0x56, 0xcb, 0x48, 0xa9, 0x52, 0x88, 0x7c, 0x2e,
+	0xb9, 0x71, 0x31, 0xc2, 0xe6, 0xb2, 0xd5, 0xe1, 0x3c, 0x18, 0xc2, 0xc7, 0x95, 0x23, 0xa5, 0x30,
+	0xe6, 0x41, 0x4d, 0x7d, 0xc4, 0x50, 0xa4, 0x97, 0x23, 0xe4, 0x39, 0x73, 0xbb, 0xa9, 0xf2, 0xd7,
+	0xa2, 0x56, 0x7c, 0x68, 0x7e, 0x72, 0x53, 0xf8, 0x16, 0xd3, 0x01, 0xf8, 0xd6, 0x35, 0xcc, 0x72,
+	0xc9, 0x6c, 0xaa, 0xb6, 0xa1, 0x9a, 0x6e, 0x8a, 0xbc, 0x9b, 0x73, 0x6d, 0x00, 0xfc, 0x90, 0x1c,
+	0x7e, 0x83, 0x75, 0x98, 0xec, 0xd2, 0x1f, 0xf0, 0x39, 0x90, 0x6f, 0x1a, 0x56, 0x55, 0x0d, 0x4b,
+	0x2d, 0x31, 0x01, 0x88, 0xdb, 0x7f, 0x58, 0x3e, 0xe9, 0xf5, 0x87, 0x5e, 0x05, 0x25, 0x39, 0xea,
+	0x0c, 0x38, 0xbc, 0x3f, 0x26, 0xf0, 0x18, 0x4c, 0xab, 0xa5, 0xe0, 0x94, 0x07, 0xc3, 0xc1, 0x5b,
+	0xd1, 0x91, 0x7e, 0x8b, 0x17, 0x72, 0x74, 0x01, 0x65, 0x24, 0x19, 0xe1, 0x78, 0x22, 0x55, 0x6a,
+	0xb3, 0x49, 0xb3, 0x0a, 0xc2, 0xa9, 0x99, 0x01, 0xfc, 0xb8, 0xe8, 0xe2, 0x5f, 0x09, 0xe0, 0x6c,
+	0xa7, 0x39, 0x69, 0x5e, 0x01, 0xa3, 0xe2, 0x90, 0x6b, 0x11, 0x87, 0xd6, 0x2b, 0xbf, 0xef, 0x09,
+	0x5c, 0xf9, 0xdc, 0xf6, 0x9e, 0xad, 0x56, 0xfd, 0x74, 0x86, 0x7b, 0x8e, 0xef, 0x2f, 0xe2, 0x77,
+	0xa3, 0x89, 0xb6, 0x43, 0xd8, 0x47, 0xf8, 0x6a, 0xeb, 0xbd, 0x92, 0xee, 0x05, 0x3d, 0x02, 0xdc,
+	0x7a, 0xc3, 0x18, 0xe0, 0x54, 0x9b, 0xb1, 0xdd, 0xef, 0x9a, 0x6b, 0x00, 0xc6, 0x64, 0x62, 0x50,
+	0x8e, 0x1f, 0xab, 0x47, 0xf3, 0x2f, 0xae, 0xfc, 0x58, 0x00, 0xc7, 0x63, 0x1c, 0x31, 0xf8, 0x14,
+	0x90, 0x96, 0x67, 0x37, 0x94, 0xcd, 0x35, 0x65, 0x6b, 0x76, 0xa5, 0x34, 0x3f, 0xbb, 0xb9, 0xa0,
+	0xc8, 0x0b, 0xb3, 0x1b, 0x6b, 0xab, 0xca, 0xbd, 0xd5, 0x8d, 0xf5, 0x85, 0x62, 0x69, 0xb1, 0xb4,
+	0x30, 0x3f, 0x7a, 0x00, 0x4e, 0x82, 0xb3, 0x6d, 0xc6, 0x6d, 0xae, 0xad, 0x2b, 0xab, 0xa3, 0x02,
+	0x9c, 0x02, 0x13, 0x6d, 0x46, 0xac, 0xad, 0x6f, 0x2e, 0xcc, 0x2b, 0xa5, 0xd5, 0xd1, 0x5c, 0x87,
+	0xe5, 0x66, 0x57, 0x56, 0xd6, 0x5e, 0x5b, 0x29, 0x6d, 0x6c, 0x2e, 0xcc, 0x8f, 0xf6, 0xc1, 0x6b,
+	0xe0, 0x72, 0x9b, 0x71, 0xc5, 0xb5, 0xd5, 0x8d, 0x7b, 0x77, 0x17, 0x64, 0xde, 0xb1, 0x26, 0x8f,
+	0xf6, 0x8b, 0xfd, 0xef, 0x7c, 0x6f, 0xfc, 0xc0, 0xcc, 0x9f, 0xd4, 0xc0, 0x41, 0xf2, 0x51, 0xe1,
+	0x3f, 0x08, 0x60, 0x2c, 0x2e, 0xea, 0x0c, 0x5f, 0x49, 0x1f, 0xea, 0x0b, 0x97, 0xc9, 0x8b, 0xb3,
+	0x19, 0x10, 0xa8, 0x60, 0x49, 0xcb, 0x6f, 0xff, 0xf5, 0x4f, 0x7e, 0x3d, 0x37, 0x07, 0x5f, 0xe9,
+	0xfe, 0x47, 0x1c, 0x3c, 0x19, 0x60, 0xf9, 0x8c, 0x85, 0xc7, 0x01, 0xa9, 0xd8, 0x83, 0x7f, 0x27,
+	0x80, 0xe3, 0xa1, 0xa5, 0x68, 0xe2, 0x39, 0xbc, 0x95, 0x7e, 0x93, 0xa1, 0x7a, 0x7a, 0xf1, 0x95,
+	0xde, 0x01, 0x18, 0x91, 0xb3, 0x84, 0xc8, 0x17, 0xe0, 0xcd, 0x14, 0x44, 0x92, 0x41, 0x4e, 0xe1,
+	0x31, 0xb1, 0x81, 0xf6, 0xe0, 0xb7, 0x73, 0xcc, 0x86, 0x88, 0x2d, 0x80, 0x85, 0x8b, 0xc9, 0xf7,
+	0xd8, 0xa9, 0xa0, 0x57, 0x5c, 0xca, 0x8c, 0xc3, 0x48, 0xde, 0x26, 0x24, 0x7f, 0x19, 0xbe, 0xde,
+	0x9d, 0x64, 0x3f, 0x4a, 0x1e, 0xba, 0x9a, 0xc2, 0x9f, 0xb7, 0xf0, 0x38, 0x7a, 0xc2, 0xe3, 0x78,
+	0x12, 0xcc, 0xb5, 0xea, 0x89, 0x27, 0x31, 0x35, 0xc0, 0xe2, 0x52, 0x66, 0x9c, 0x2c, 0x3c, 0x09,
+	0x91, 0x1d, 0xe5, 0x49, 0xd4, 0x27, 0xdb, 0x83, 0x7f, 0x29, 0xb0, 0x6a, 0xbc, 0x50, 0x61, 0x2f,
+	0x7c, 0x39, 0x39, 0x0d, 0x71, 0xf5, 0xc2, 0xe2, 0xad, 0x9e, 0xe7, 0x33, 0xda, 0x9f, 0x23, 0xb4,
+	0xcf, 0xc0, 0xeb, 0xdd, 0x69, 0x77, 0x19, 0x00, 0xfd, 0xcb, 0x19, 0xf0, 0x3b, 0x39, 0x30, 0x95,
+	0xa0, 0x1a, 0x15, 0xae, 0x25, 0xdf, 0x62, 0xa2, 0x2a, 0x58, 0x71, 0x7d, 0xff, 0x00, 0x19, 0x13,
+	0xee, 0x10, 0x26, 0x2c, 0xc0, 0x62, 0x77, 0x26, 0xd8, 0x1e, 0xa2, 0x7f, 0x2a, 0x42, 0x7f, 0x92,
+	0x00, 0xbe, 0x9b, 0x03, 0x52, 0xf7, 0x7a, 0x58, 0xb8, 0x9a, 0x9c, 0x8a, 0x24, 0x75, 0xba, 0xe2,
+	0xda, 0xbe, 0xe1, 0x31, 0xa6, 0x2c, 0x10, 0xa6, 0xdc, 0x82, 0x2f, 0x75, 0x67, 0x0a, 0x93, 0x72,
+	0xa5, 0x8e, 0x51, 0x23, 0xea, 0xff, 0xcf, 0x05, 0x30, 0x14, 0x28, 0x38, 0x85, 0xcf, 0x26, 0xdf,
+	0x67, 0xa8, 0x70, 0x55, 0x7c, 0x2e, 0xfd, 0x44, 0x46, 0xc9, 0x75, 0x42, 0xc9, 0x15, 0x78, 0xa9,
+	0x3b, 0x25, 0x34, 0xf1, 0xdb, 0x97, 0xed, 0xce, 0x45, 0xa7, 0x69, 0x64, 0x3b, 0x51, 0x35, 0xac,
+	0xb8, 0xbe, 0x7f, 0x80, 0xe9, 0x65, 0xdb, 0xc2, 0x20, 0xf8, 0xd5, 0xdd, 0x0f, 0x9b, 0x44, 0x3e,
+	0xe6, 0x5f, 0xe4, 0xc0, 0xe5, 0xd6, 0xc5, 0xdb, 0x14, 0x91, 0xc1, 0x7b, 0xbd, 0x5e, 0xd0, 0x1d,
+	0x5f, 0x24, 0xc4, 0xad, 0xfd, 0x86, 0x65, 0x9c, 0x7a, 0x9d, 0x70, 0x6a, 0x13, 0xca, 0xa9, 0xad,
+	0x01, 0x1c, 0xac, 0xf7, 0x99, 0x16, 0x77, 0x25, 0xfe, 0x59, 0x2e, 0x1a, 0xd7, 0x8c, 0xaf, 0x4a,
+	0x83, 0xeb, 0x19, 0x2e, 0xfa, 0xd8, 0x7a, 0x3b, 0xf1, 0xd5, 0x7d, 0x44, 0x64, 0x9c, 0xd2, 0x08,
+	0xa7, 0xde, 0x84, 0x6f, 0xa4, 0xe1, 0x54, 0xb8, 0x08, 0xb7, 0xbb, 0x15, 0xf1, 0x6f, 0x02, 0x38,
+	0xd5, 0xe6, 0x3d, 0x1b, 0x16, 0xb3, 0xbc, 0x86, 0x73, 0xc6, 0xcc, 0x67, 0x03, 0x49, 0x7f, 0xbe,
+	0x3c, 0x8a, 0xdb, 0x9e, 0xaf, 0x9f, 0x0a, 0x2c, 0x7f, 0x34, 0xae, 0x5e, 0x10, 0xa6, 0xc8, 0x01,
+	0xe8, 0x50, 0x93, 0x28, 0x2e, 0x66, 0x85, 0x49, 0x6f, 0x3d, 0xb7, 0x29, 0x6f, 0x84, 0xff, 0x1e,
+	0xfd, 0x03, 0x54, 0xe1, 0x02, 0x44, 0xb8, 0x94, 0xfe, 0x13, 0xc5, 0x56, 0x41, 0x8a, 0xcb, 0xd9,
+	0x81, 0x32, 0xf8, 0x0c, 0x86, 0x5e, 0x78, 0xec, 0x05, 0xb1, 0xf6, 0xe0, 0x0f, 0xb9, 0x2d, 0x18,
+	0x52, 0x4f, 0x69, 0x6c, 0xc1, 0xb8, 0x3a, 0x4b, 0xf1, 0x56, 0xcf, 0xf3, 0x19, 0x69, 0x8b, 0x84,
+	0xb4, 0x57, 0xe0, 0xcb, 0x69, 0x15, 0x60, 0x44, 0x8a, 0x7f, 0x2e, 0x80, 0x7c, 0xbb, 0xca, 0x39,
+	0x38, 0xdf, 0xb3, 0x6f, 0x1a, 0x28, 0xde, 0x13, 0x17, 0x32, 0xa2, 0x30, 0x8a, 0xef, 0x12, 0x8a,
+	0x97, 0xe0, 0x42, 0x7a, 0x2f, 0x97, 0x3c, 0xd4, 0x46, 0x08, 0xff, 0x05, 0xff, 0x03, 0x70, 0xb1,
+	0xe5, 0x70, 0xa9, 0x1c, 0x9f, 0x0e, 0x65, 0x80, 0xe2, 0x52, 0x66, 0x1c, 0x46, 0xfe, 0x1a, 0x21,
+	0xbf, 0x04, 0x97, 0xba, 0x93, 0x8f, 0xc3, 0xcb, 0x35, 0x0f, 0xc9, 0xcb, 0x1c, 0x89, 0x30, 0xe0,
+	0xed, 0x1c, 0x38, 0xd3, 0xa1, 0xb2, 0x2d, 0xcd, 0x79, 0xee, 0x58, 0xd2, 0x27, 0x2e, 0x67, 0x07,
+	0x62, 0x3c, 0x58, 0x27, 0x3c, 0xb8, 0x0d, 0x97, 0xbb, 0xf3, 0xc0, 0x61, 0x48, 0xbe, 0xe5, 0x4f,
+	0xcb, 0xab, 0x22, 0x4c, 0xf8, 0x46, 0x0e, 0x9c, 0x8b, 0xbf, 0x35, 0x58, 0xc5, 0x1a, 0x2c, 0x65,
+	0xb8, 0x79, 0xc2, 0xe5, 0x73, 0xe2, 0xed, 0xfd, 0x80, 0x62, 0xac, 0x58, 0x21, 0xac, 0x58, 0x84,
+	0xf3, 0xe9, 0xae, 0x32, 0x9e, 0x0d, 0x19, 0x61, 0xc3, 0xff, 0x72, 0xdd, 0x1e, 0x5f, 0x43, 0x06,
+	0xd3, 0xb9, 0xef, 0xed, 0xeb, 0xf1, 0xc4, 0xe5, 0xec, 0x40, 0xe9, 0xd5, 0x41, 0xfb, 0xfa, 0xba,
+	0xc2, 0x63, 0x5a, 0x3f, 0x43, 0x2c, 0x18, 0xb1, 0x7d, 0xb5, 0x5e, 0x1a, 0x75, 0xd0, 0xa9, 0x28,
+	0x50, 0x5c, 0xca, 0x8c, 0xc3, 0xc8, 0x9f, 0x23, 0xe4, 0xbf, 0x08, 0x9f, 0x4f, 0xe2, 0x06, 0x63,
+	0x20, 0x25, 0xca, 0x05, 0x07, 0xfe, 0x5a, 0x8e, 0xc5, 0xb5, 0xdb, 0x96, 0xec, 0xc1, 0xdb, 0x3d,
+	0x18, 0xa4, 0x6d, 0x2a, 0x08, 0xc5, 0x3b, 0xfb, 0x82, 0xc5, 0xe8, 0xdf, 0x24, 0xf4, 0xaf, 0xc2,
+	0x95, 0x14, 0x71, 0x20, 0x47, 0x69, 0x60, 0x34, 0x5e, 0x77, 0x81, 0xdf, 0x0b, 0xda, 0xe9, 0xc4,
+	0xf8, 0x7a, 0xc0, 0x5e, 0x6c, 0x9c, 0xd8, 0xc2, 0x44, 0x71, 0x39, 0x3b, 0x50, 0x7a, 0x9d, 0x18,
+	0x09, 0x82, 0x78, 0xb5, 0x8c, 0x11, 0x26, 0xfc, 0xc0, 0x0b, 0x7f, 0x05, 0x4b, 0x12, 0x53, 0x85,
+	0xbf, 0x62, 0xaa, 0x1f, 0xc5, 0x5b, 0x3d, 0xcf, 0x4f, 0x6f, 0xcd, 0x91, 0x32, 0x4b, 0xc5, 0xe5,
+	0x10, 0x85, 0xc7, 0xa4, 0x61, 0x0f, 0xfe, 0xb7, 0x10, 0xf9, 0xe3, 0x17, 0xc1, 0x62, 0x47, 0xd8,
+	0x83, 0xa1, 0x12, 0x53, 0x72, 0x29, 0x2e, 0x66, 0x85, 0x61, 0xf4, 0xae, 0x12, 0x7a, 0x97, 0xe1,
+	0x62, 0x8a, 0x2f, 0x4b, 0x22, 0xdd, 0x4a, 0x85, 0x22, 0x45, 0xbe, 0xeb, 0xff, 0x44, 0x89, 0x0f,
+	0x25, 0x6e, 0xf5, 0x40, 0x7c, 0x4c, 0x79, 0xa6, 0xb8, 0x98, 0x15, 0x26, 0xbd, 0xb9, 0xd3, 0xa6,
+	0x8e, 0x33, 0x42, 0xfd, 0x37, 0x73, 0xe0, 0x74, 0x40, 0xaf, 0x86, 0xeb, 0x21, 0xd3, 0x50, 0xdf,
+	0xa1, 0x6e, 0x53, 0x5c, 0xcc, 0x0a, 0xc3, 0xa8, 0x7f, 0x93, 0x50, 0xff, 0x1a, 0xbc, 0x97, 0x58,
+	0xbb, 0xe3, 0x2a, 0x4e, 0xd5, 0x47, 0x8a, 0xba, 0xec, 0xc1, 0x62, 0xd1, 0x3d, 0xf8, 0x09, 0x3f,
+	0xe1, 0xa1, 0xaa, 0xc4, 0x34, 0x27, 0x3c, 0xae, 0x66, 0x52, 0xbc, 0xd5, 0xf3, 0xfc, 0xf4, 0xfe,
+	0xf9, 0xd7, 0x28, 0x80, 0x42, 0xf3, 0x3e, 0xe3, 0x62, 0x12, 0xbf, 0x9a, 0x8b, 0x64, 0x0b, 0x45,
+	0x6a, 0x16, 0x61, 0x0f, 0x3a, 0x38, 0xbe, 0x7c, 0x52, 0x2c, 0xed, 0x03, 0x12, 0x63, 0x81, 0x4c,
+	0x58, 0xb0, 0x02, 0x6f, 0xa7, 0x90, 0xfb, 0xe0, 0x1f, 0xb1, 0x8c, 0x09, 0xd8, 0xc0, 0x6f, 0x71,
+	0xd1, 0x8f, 0x2b, 0x6a, 0x4c, 0x23, 0xfa, 0x1d, 0x2a, 0x33, 0xc5, 0xc5, 0xac, 0x30, 0x8c, 0x01,
+	0x2a, 0x61, 0xc0, 0x1b, 0xf0, 0x97, 0xba, 0x33, 0x00, 0x71, 0x1c, 0x25, 0x98, 0x02, 0xd0, 0x3d,
+	0x5a, 0xf5, 0x8b, 0xe8, 0xdf, 0xfe, 0x0e, 0x15, 0x46, 0xc2, 0x1e, 0x54, 0x58, 0x5c, 0x81, 0xa6,
+	0xb8, 0x94, 0x19, 0x27, 0x83, 0x2e, 0xac, 0x12, 0x24, 0xe5, 0x3e, 0x85, 0x8a, 0x08, 0xc4, 0xbf,
+	0x0a, 0xe0, 0x44, 0x6c, 0x71, 0x24, 0x4c, 0xf1, 0x1a, 0xdd, 0xa6, 0x66, 0x53, 0x9c, 0xcb, 0x02,
+	0x91, 0xfe, 0xea, 0x0b, 0x0a, 0x7f, 0xf4, 0xd3, 0xb3, 0xd2, 0xd0, 0xbd, 0xd6, 0x37, 0x82, 0xf8,
+	0x32, 0xc7, 0x5e, 0xde, 0x08, 0x3a, 0xd6, 0x57, 0x8a, 0xeb, 0xfb, 0x07, 0xd8, 0x7b, 0x0c, 0xd3,
+	0x51, 0x76, 0x0c, 0xb7, 0xa2, 0xf0, 0x37, 0x41, 0x5d, 0x71, 0x38, 0xbd, 0xef, 0x71, 0xf7, 0xb7,
+	0x5d, 0x9d, 0x62, 0x1a, 0xf7, 0xb7, 0x4b, 0x4d, 0xa5, 0x78, 0x7b, 0x3f, 0xa0, 0x18, 0x17, 0xbe,
+	0x44, 0xb8, 0x20, 0xc3, 0xf5, 0x34, 0xcf, 0xc0, 0xd4, 0x2a, 0x0c, 0x24, 0x2b, 0xc5, 0x29, 0x07,
+	0xcf, 0x29, 0x6a, 0x5b, 0x60, 0x08, 0x6f, 0xf7, 0x1c, 0xd0, 0x6a, 0xa9, 0x77, 0x14, 0xef, 0xec,
+	0x0b, 0x56, 0x7a, 0xa7, 0xa8, 0x25, 0x44, 0xd6, 0x3e, 0x38, 0xf0, 0x5f, 0x51, 0xbb, 0x31, 0x58,
+	0xe1, 0xd8, 0x8b, 0xdd, 0x18, 0x53, 0x67, 0x29, 0x2e, 0x66, 0x85, 0xc9, 0x10, 0x25, 0x0c, 0x96,
+	0x5e, 0x46, 0x68, 0xff, 0x59, 0xf4, 0xaa, 0x08, 0xd5, 0x29, 0xf6, 0x72, 0x55, 0xc4, 0x55, 0x4c,
+	0x8a, 0x4b, 0x99, 0x71, 0x32, 0x44, 0xbc, 0xc3, 0x15, 0x96, 0xf0, 0xeb, 0x2d, 0x19, 0x21, 0xc1,
+	0x32, 0xc0, 0x9e, 0x32, 0x42, 0x62, 0x8a, 0x15, 0xc5, 0xa5, 0xcc, 0x38, 0x19, 0x22, 0x01, 0xc4,
+	0x5c, 0xf6, 0x8a, 0x0e, 0xe3, 0xd4, 0xc0, 0x67, 0xd1, 0x17, 0x2d, 0xbf, 0x3a, 0xaf, 0x97, 0x17,
+	0xad, 0x96, 0xfa, 0x40, 0x71, 0x3e, 0x1b, 0x48, 0x86, 0x30, 0x20, 0x2f, 0x12, 0x44, 0xae, 0xda,
+	0xed, 0x31, 0x20, 0x50, 0x69, 0xd7, 0xcb, 0x63, 0x40, 0x6b, 0xb1, 0x9f, 0xb8, 0x90, 0x11, 0x25,
+	0xc3, 0x31, 0x0f, 0xd6, 0x07, 0x46, 0x08, 0xff, 0x6e, 0x0e, 0x9c, 0xef, 0x5a, 0xb0, 0x07, 0xef,
+	0xf6, 0x20, 0xb2, 0xed, 0x6b, 0x0c, 0xc5, 0xd5, 0xfd, 0x82, 0x63, 0x3c, 0x79, 0x83, 0xf0, 0xe4,
+	0x1e, 0xdc, 0x48, 0x73, 0x10, 0x74, 0x0f, 0xd0, 0x33, 0xa2, 0x63, 0xcf, 0xc3, 0x6f, 0xe6, 0xfc,
+	0x08, 0x71, 0x5c, 0xfe, 0x40, 0x2f, 0xc7, 0x39, 0x36, 0x63, 0x60, 0x39, 0x3b, 0x10, 0xe3, 0x87,
+	0x4e, 0xf8, 0xf1, 0x15, 0xf8, 0xe5, 0x34, 0xfc, 0x88, 0xd4, 0x2d, 0x76, 0x77, 0x26, 0x5a, 0x14,
+	0x85, 0x5f, 0x2e, 0xd8, 0x8b, 0xa2, 0x68, 0x29, 0x58, 0x14, 0xe7, 0xb3, 0x81, 0x64, 0x50, 0x14,
+	0x81, 0x12, 0xc7, 0xc8, 0x79, 0xf9, 0x09, 0x27, 0x3a, 0xa6, 0xe8, 0x2e, 0x05, 0xd1, 0x6d, 0x6b,
+	0x19, 0xc5, 0xf9, 0x6c, 0x20, 0x8c, 0xe8, 0x97, 0x09, 0xd1, 0xcf, 0xc1, 0x67, 0xba, 0x13, 0x1d,
+	0x8e, 0x9f, 0xd0, 0xd2, 0x45, 0xf8, 0x23, 0x01, 0x9c, 0x8c, 0xaf, 0xd9, 0x83, 0x69, 0xbd, 0x9c,
+	0x98, 0x8a, 0x40, 0xb1, 0x98, 0x09, 0x83, 0xd1, 0xf8, 0x12, 0xa1, 0xf1, 0x59, 0xf8, 0x74, 0x52,
+	0x57, 0x89, 0xfe, 0xa7, 0x1c, 0x2c, 0x44, 0x18, 0xe3, 0x01, 0x44, 0x8a, 0xeb, 0x7a, 0xf2, 0x00,
+	0xe2, 0x0b, 0x01, 0xc5, 0xdb, 0xfb, 0x01, 0x95, 0xc5, 0x03, 0x50, 0xab, 0xd5, 0x50, 0xac, 0x20,
+	0x56, 0xd5, 0x79, 0xce, 0x62, 0xe7, 0x6a, 0xb8, 0x34, 0xce, 0x62, 0xa2, 0x32, 0x3c, 0x71, 0x7d,
+	0xff, 0x00, 0xd3, 0x3b, 0x8b, 0x5d, 0x0b, 0xfa, 0xe0, 0xbf, 0xf0, 0xa8, 0x41, 0xb4, 0x72, 0x0e,
+	0xf6, 0x90, 0xc3, 0x1e, 0x29, 0xdd, 0x13, 0xe7, 0xb2, 0x40, 0xf4, 0xae, 0xe3, 0x1c, 0x65, 0x7b,
+	0x57, 0x21, 0xe5, 0x81, 0x85, 0xc7, 0xa1, 0xd2, 0xc1, 0x3d, 0xf8, 0x4e, 0xf4, 0x69, 0x38, 0x5a,
+	0xf0, 0xd6, 0xcb, 0xd3, 0x70, 0x9b, 0xba, 0x3b, 0xf1, 0xf6, 0x7e, 0x40, 0x65, 0x78, 0x11, 0xe2,
+	0x45, 0x7f, 0x8a, 0x57, 0xa8, 0x57, 0x78, 0xcc, 0xdb, 0xf6, 0xe0, 0xdf, 0xf0, 0xb2, 0x80, 0x70,
+	0x79, 0x5d, 0x9a, 0xb2, 0x80, 0xd8, 0xb2, 0x3d, 0xf1, 0x95, 0xde, 0x01, 0x18, 0xb1, 0xcf, 0x13,
+	0x62, 0xbf, 0x08, 0x67, 0xba, 0x13, 0x4b, 0x72, 0x99, 0x02, 0xd7, 0x58, 0xeb, 0xd5, 0xed, 0x57,
+	0xec, 0xf5, 0x94, 0xb5, 0x16, 0xad, 0x19, 0x14, 0xe7, 0xb3, 0x81, 0x64, 0x79, 0xea, 0x77, 0x34,
+	0x5e, 0xef, 0x17, 0xb9, 0xba, 0xff, 0x33, 0x6a, 0xe3, 0x07, 0xea, 0xf0, 0x7a, 0xb1, 0xf1, 0x5b,
+	0xcb, 0x00, 0xc5, 0x85, 0x8c, 0x28, 0x19, 0x8f, 0xb3, 0x97, 0xbd, 0x15, 0x4a, 0xe4, 0xfa, 0x27,
+	0xae, 0xbd, 0xa2, 0x75, 0x7f, 0x69, 0xb4, 0x57, 0x9b, 0xa2, 0x43, 0x71, 0x2e, 0x0b, 0x04, 0x23,
+	0xb7, 0x44, 0xc8, 0x2d, 0xc2, 0xd9, 0xee, 0xe4, 0xd6, 0x29, 0x86, 0x62, 0x70, 0x90, 0xc8, 0x37,
+	0x7e, 0x2f, 0x07, 0xa4, 0xee, 0xd5, 0x81, 0xb0, 0x17, 0x07, 0xa4, 0x43, 0xed, 0xa2, 0xb8, 0xb6,
+	0x6f, 0x78, 0xe9, 0x59, 0x12, 0x78, 0xe4, 0xf7, 0x58, 0x11, 0x88, 0xf4, 0xc1, 0x1f, 0xf3, 0xb3,
+	0xde, 0x5a, 0xa0, 0x97, 0xe6, 0xac, 0xb7, 0xad, 0x00, 0x14, 0xe7, 0xb3, 0x81, 0x30, 0x8a, 0x5f,
+	0x20, 0x14, 0x3f, 0x0d, 0xbf, 0xd0, 0x9d, 0xe2, 0x40, 0x16, 0x83, 0xd2, 0x20, 0xf5, 0x80, 0xaf,
+	0x7d, 0xff, 0x93, 0x71, 0xe1, 0xc3, 0x4f, 0xc6, 0x85, 0x1f, 0x7d, 0x32, 0x2e, 0xbc, 0xf7, 0xe9,
+	0xf8, 0x81, 0x0f, 0x3f, 0x1d, 0x3f, 0xf0, 0x83, 0x4f, 0xc7, 0x0f, 0xbc, 0xfe, 0x52, 0xd9, 0x70,
+	0x2b, 0x8d, 0xed, 0x69, 0xcd, 0xaa, 0xb1, 0xff, 0x15, 0x36, 0x80, 0x7f, 0xcd, 0xc3, 0x6f, 0x3e,
+	0x5b, 0x78, 0x14, 0x5e, 0x84, 0xfc, 0xe7, 0xb2, 0xdb, 0x03, 0xa4, 0x46, 0xf8, 0x0b, 0xff, 0x37,
+	0x00, 0x10, 0x50, 0x7d, 0x50, 0x25, 0x78, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// that is either initialized or launched; for initialized consumer chains, the
+	// genesis state is computed on demand based on the current validator set
+	QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error)
+	// ConsumerChains queries active consumer chains supported by the provider
+	// chain
+	QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error)
+	// QueryValidatorConsumerAddr queries the address
+	// assigned by a validator for a consumer chain.
+	QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error)
+	// QueryProviderAddr returns the provider chain validator
+	// given a consumer chain validator address
+	QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error)
+	// QueryThrottleState returns the main on-chain state relevant to currently
+	// throttled slash packets
+	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
+	// denoms that are registered
+	QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error)
+	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
+	// between provider and consumer chain
+	QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error)
+	// QueryParams returns all current values of provider parameters
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
+	// that opted-in to the given consumer chain
+	QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error)
+	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
+	// that a given validator must validate
+	QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
+	// QueryValidatorConsumerCommissionRate returns the commission rate a given
+	// validator charges on a given consumer chain
+	QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error)
+	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
+	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
+	// because a VSCPacket could be delayed to be delivered on the consumer chain.
+	QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error)
+	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
+	// starts and validator updates are sent to the consumer chains
+	QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error)
+	// QueryConsumerIdFromClientId returns the consumer id of the chain
+	// associated with the provided client id
+	QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error)
+	// QueryConsumerChain returns the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error)
+	// QueryConsumerGenesisTime returns the genesis time
+	// of the consumer chain associated with the provided consumer id
+	QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error)
+	// QueryVSCMaturationSchedule returns the maturity times of all the VSC packets
+	// sent to the consumer chain associated with the provided consumer id
+	// that have not yet matured
+	QueryVSCMaturationSchedule(ctx context.Context, in *QueryVSCMaturationScheduleRequest, opts ...grpc.CallOption) (*QueryVSCMaturationScheduleResponse, error)
+	// QuerySimulateConsumerUpdate returns the validator set the consumer chain
+	// associated with the provided consumer id would have if its power-shaping
+	// parameters were updated to the provided ones, without updating them
+	QuerySimulateConsumerUpdate(ctx context.Context, in *QuerySimulateConsumerUpdateRequest, opts ...grpc.CallOption) (*QuerySimulateConsumerUpdateResponse, error)
+	// QueryConsumerValidatorSetHash returns the hash of the validator set the provider
+	// expects the consumer chain associated with the provided consumer id to use,
+	// computed as the CometBFT validator set hash of the consumer validators
+	QueryConsumerValidatorSetHash(ctx context.Context, in *QueryConsumerValidatorSetHashRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorSetHashResponse, error)
+	// QueryValsetUpdateIdToHeight returns the provider block height
+	// mapped to the provided valset update id
+	QueryValsetUpdateIdToHeight(ctx context.Context, in *QueryValsetUpdateIdToHeightRequest, opts ...grpc.CallOption) (*QueryValsetUpdateIdToHeightResponse, error)
+	// QueryRecentValsetUpdateIds returns the most recent valset update ids
+	// together with the provider block heights they are mapped to
+	QueryRecentValsetUpdateIds(ctx context.Context, in *QueryRecentValsetUpdateIdsRequest, opts ...grpc.CallOption) (*QueryRecentValsetUpdateIdsResponse, error)
+	// QueryValidatorsUsingDefaultKey returns the consensus addresses of the
+	// validators in the validator set of the given consumer chain that have not
+	// assigned a consumer key, i.e., that use their provider key on the consumer chain
+	QueryValidatorsUsingDefaultKey(ctx context.Context, in *QueryValidatorsUsingDefaultKeyRequest, opts ...grpc.CallOption) (*QueryValidatorsUsingDefaultKeyResponse, error)
+	// QueryConsumerRewardsAddress returns the address of the module account
+	// that collects the rewards sent by the given consumer chain
+	QueryConsumerRewardsAddress(ctx context.Context, in *QueryConsumerRewardsAddressRequest, opts ...grpc.CallOption) (*QueryConsumerRewardsAddressResponse, error)
+	// QueryTopNThreshold returns the minimum power needed for a validator
+	// to belong to the top N% of the current active validators
+	QueryTopNThreshold(ctx context.Context, in *QueryTopNThresholdRequest, opts ...grpc.CallOption) (*QueryTopNThresholdResponse, error)
+	// QueryConsumerPhaseHistory returns the most recent phase transitions
+	// of the given consumer chain
+	QueryConsumerPhaseHistory(ctx context.Context, in *QueryConsumerPhaseHistoryRequest, opts ...grpc.CallOption) (*QueryConsumerPhaseHistoryResponse, error)
+	// QueryConsumerAddrsToPrune returns the consumer addresses of the given
+	// consumer chain that are scheduled for pruning
+	QueryConsumerAddrsToPrune(ctx context.Context, in *QueryConsumerAddrsToPruneRequest, opts ...grpc.CallOption) (*QueryConsumerAddrsToPruneResponse, error)
+	// QueryRecentKeyAssignments returns the consumer key rotations of the
+	// validators on the given consumer chain that became effective after
+	// the given VSC packet was sent
+	QueryRecentKeyAssignments(ctx context.Context, in *QueryRecentKeyAssignmentsRequest, opts ...grpc.CallOption) (*QueryRecentKeyAssignmentsResponse, error)
+	// QueryJailingReason returns whether the given jailed validator was jailed
+	// because of a slash packet sent by a consumer chain or on the provider chain
+	QueryJailingReason(ctx context.Context, in *QueryJailingReasonRequest, opts ...grpc.CallOption) (*QueryJailingReasonResponse, error)
+	// QueryConsumerSlashPacketRate returns the number of slash packets
+	// received from a consumer chain in the most recent blocks
+	QueryConsumerSlashPacketRate(ctx context.Context, in *QueryConsumerSlashPacketRateRequest, opts ...grpc.CallOption) (*QueryConsumerSlashPacketRateResponse, error)
+	// QueryEffectiveConsumerKey returns the key a validator uses on a consumer chain,
+	// i.e., the assigned consumer key if any, and the provider consensus key otherwise
+	QueryEffectiveConsumerKey(ctx context.Context, in *QueryEffectiveConsumerKeyRequest, opts ...grpc.CallOption) (*QueryEffectiveConsumerKeyResponse, error)
+	// QueryConsumerLaunchFailure returns the number of failed attempts
+	// to launch a consumer chain and the error of the last attempt
+	QueryConsumerLaunchFailure(ctx context.Context, in *QueryConsumerLaunchFailureRequest, opts ...grpc.CallOption) (*QueryConsumerLaunchFailureResponse, error)
+	// QuerySlashPacketBySeq returns how the slash packet received from a consumer chain
+	// with the given IBC sequence number was processed
+	QuerySlashPacketBySeq(ctx context.Context, in *QuerySlashPacketBySeqRequest, opts ...grpc.CallOption) (*QuerySlashPacketBySeqResponse, error)
+	// QueryConsumersWithThrottledSlashing returns the consumer chains that currently
+	// have slash packets throttled, together with the number of throttled slash packets
+	QueryConsumersWithThrottledSlashing(ctx context.Context, in *QueryConsumersWithThrottledSlashingRequest, opts ...grpc.CallOption) (*QueryConsumersWithThrottledSlashingResponse, error)
+	// QueryValidatorTopNObligations returns the launched Top N consumer chains
+	// that the given validator is forced to validate, i.e., the chains for which
+	// the validator's power is at or above the Top N threshold
+	QueryValidatorTopNObligations(ctx context.Context, in *QueryValidatorTopNObligationsRequest, opts ...grpc.CallOption) (*QueryValidatorTopNObligationsResponse, error)
+	// QueryConsumerGenesisValsetHash returns the hash of the initial validator set
+	// of the consumer chain associated with the provided consumer id,
+	// computed when the consumer chain launched
+	QueryConsumerGenesisValsetHash(ctx context.Context, in *QueryConsumerGenesisValsetHashRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisValsetHashResponse, error)
+	// QueryConsumerDistribution returns the distribution transmission channel
+	// of the consumer chain associated with the provided consumer id,
+	// whether it is open, and whether IBC transfers are enabled
+	QueryConsumerDistribution(ctx context.Context, in *QueryConsumerDistributionRequest, opts ...grpc.CallOption) (*QueryConsumerDistributionResponse, error)
+	// QueryConsumerValidatorSets returns the validator sets of multiple
+	// consumer chains associated with the provided consumer ids
+	QueryConsumerValidatorSets(ctx context.Context, in *QueryConsumerValidatorSetsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorSetsResponse, error)
+	// QueryValidatorKeyConflicts returns the consumer keys that the given validator
+	// assigned on more than one consumer chain
+	QueryValidatorKeyConflicts(ctx context.Context, in *QueryValidatorKeyConflictsRequest, opts ...grpc.CallOption) (*QueryValidatorKeyConflictsResponse, error)
+	// QueryConsumerRemovalETA returns the time at which the consumer chain
+	// associated with the provided consumer id is scheduled to be removed
+	// and the time remaining until then
+	QueryConsumerRemovalETA(ctx context.Context, in *QueryConsumerRemovalETARequest, opts ...grpc.CallOption) (*QueryConsumerRemovalETAResponse, error)
+	// QueryConsumerJailedPower returns the cumulative power of the validators
+	// jailed because of the slash packets received from the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerJailedPower(ctx context.Context, in *QueryConsumerJailedPowerRequest, opts ...grpc.CallOption) (*QueryConsumerJailedPowerResponse, error)
+	// QueryValidatorDenylistedConsumers returns the ids of the consumer chains
+	// on which the given validator is denylisted
+	QueryValidatorDenylistedConsumers(ctx context.Context, in *QueryValidatorDenylistedConsumersRequest, opts ...grpc.CallOption) (*QueryValidatorDenylistedConsumersResponse, error)
+	// QueryValidatorHasToValidate returns whether the given validator has to
+	// validate the consumer chain associated with the provided consumer id,
+	// along with the reason why
+	QueryValidatorHasToValidate(ctx context.Context, in *QueryValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryValidatorHasToValidateResponse, error)
+	// QueryConsumerCCVTimeout returns the effective timeout period of the CCV packets
+	// sent by the provider to the consumer chain associated with the provided consumer id
+	QueryConsumerCCVTimeout(ctx context.Context, in *QueryConsumerCCVTimeoutRequest, opts ...grpc.CallOption) (*QueryConsumerCCVTimeoutResponse, error)
+	// QueryKeyAssignmentStats returns the number of assigned consumer keys and
+	// of consumer addresses pending pruning, in total and for every consumer chain
+	QueryKeyAssignmentStats(ctx context.Context, in *QueryKeyAssignmentStatsRequest, opts ...grpc.CallOption) (*QueryKeyAssignmentStatsResponse, error)
+	// QuerySlashMeterHistory returns the values of the slash meter at the end
+	// of the most recent blocks
+	QuerySlashMeterHistory(ctx context.Context, in *QuerySlashMeterHistoryRequest, opts ...grpc.CallOption) (*QuerySlashMeterHistoryResponse, error)
+	// QueryValidatorAllConsumerKeys returns the consumer keys assigned by
+	// a validator on all the consumer chains
+	QueryValidatorAllConsumerKeys(ctx context.Context, in *QueryValidatorAllConsumerKeysRequest, opts ...grpc.CallOption) (*QueryValidatorAllConsumerKeysResponse, error)
+	// QueryMaxProviderConsensusValidators returns the maximum number of
+	// validators sent to the consensus engine of the provider chain and the
+	// number of validators currently in the provider consensus validator set
+	QueryMaxProviderConsensusValidators(ctx context.Context, in *QueryMaxProviderConsensusValidatorsRequest, opts ...grpc.CallOption) (*QueryMaxProviderConsensusValidatorsResponse, error)
+	// QueryConsumersByOwner returns the consumer chains owned by the given
+	// address, together with their phases
+	QueryConsumersByOwner(ctx context.Context, in *QueryConsumersByOwnerRequest, opts ...grpc.CallOption) (*QueryConsumersByOwnerResponse, error)
+	// QueryConsumerChainIdAvailable returns whether a chain id is not used
+	// by any registered, initialized, or launched consumer chain
+	QueryConsumerChainIdAvailable(ctx context.Context, in *QueryConsumerChainIdAvailableRequest, opts ...grpc.CallOption) (*QueryConsumerChainIdAvailableResponse, error)
+	// QueryNextConsumerId returns the consumer id that is assigned to the
+	// next created consumer chain
+	QueryNextConsumerId(ctx context.Context, in *QueryNextConsumerIdRequest, opts ...grpc.CallOption) (*QueryNextConsumerIdResponse, error)
+	// QueryConsumerVSCLatency returns the average and maximum round-trip latency
+	// of the most recently acknowledged VSC packets of a given consumer chain
+	QueryConsumerVSCLatency(ctx context.Context, in *QueryConsumerVSCLatencyRequest, opts ...grpc.CallOption) (*QueryConsumerVSCLatencyResponse, error)
+	// QueryConsumersByClientId returns the ids of all the consumer chains
+	// whose IBC client is the given client
+	QueryConsumersByClientId(ctx context.Context, in *QueryConsumersByClientIdRequest, opts ...grpc.CallOption) (*QueryConsumersByClientIdResponse, error)
+	// QueryPruningInvariant checks, on the current state, that every consumer
+	// address of a given consumer chain is either currently assigned or
+	// scheduled for pruning, and returns the consumer addresses violating it
+	QueryPruningInvariant(ctx context.Context, in *QueryPruningInvariantRequest, opts ...grpc.CallOption) (*QueryPruningInvariantResponse, error)
+	// QueryValidatorsConsumerObligations returns, for each of the given
+	// validators, the consumer chains it has to validate and the consumer keys
+	// it assigned
+	QueryValidatorsConsumerObligations(ctx context.Context, in *QueryValidatorsConsumerObligationsRequest, opts ...grpc.CallOption) (*QueryValidatorsConsumerObligationsResponse, error)
+	// QueryAllDefaultKeyUsers returns, for every launched consumer chain, the
+	// validators in the consumer validator set that use their provider key on
+	// the consumer chain
+	QueryAllDefaultKeyUsers(ctx context.Context, in *QueryAllDefaultKeyUsersRequest, opts ...grpc.CallOption) (*QueryAllDefaultKeyUsersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error) {
+	out := new(QueryConsumerGenesisResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error) {
+	out := new(QueryConsumerChainsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error) {
+	out := new(QueryValidatorConsumerAddrResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error) {
+	out := new(QueryValidatorProviderAddrResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
+	out := new(QueryThrottleStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryThrottleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
+	out := new(QueryRegisteredConsumerRewardDenomsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
+	out := new(QueryAllPairsValConsAddrByConsumerResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error) {
+	out := new(QueryConsumerChainOptedInValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
+	out := new(QueryConsumerChainsValidatorHasToValidateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error) {
+	out := new(QueryValidatorConsumerCommissionRateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error) {
+	out := new(QueryConsumerValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error) {
+	out := new(QueryBlocksUntilNextEpochResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error) {
+	out := new(QueryConsumerIdFromClientIdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error) {
+	out := new(QueryConsumerChainResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error) {
+	out := new(QueryConsumerGenesisTimeResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVSCMaturationSchedule(ctx context.Context, in *QueryVSCMaturationScheduleRequest, opts ...grpc.CallOption) (*QueryVSCMaturationScheduleResponse, error) {
+	out := new(QueryVSCMaturationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryVSCMaturationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySimulateConsumerUpdate(ctx context.Context, in *QuerySimulateConsumerUpdateRequest, opts ...grpc.CallOption) (*QuerySimulateConsumerUpdateResponse, error) {
+	out := new(QuerySimulateConsumerUpdateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QuerySimulateConsumerUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerValidatorSetHash(ctx context.Context, in *QueryConsumerValidatorSetHashRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorSetHashResponse, error) {
+	out := new(QueryConsumerValidatorSetHashResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorSetHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValsetUpdateIdToHeight(ctx context.Context, in *QueryValsetUpdateIdToHeightRequest, opts ...grpc.CallOption) (*QueryValsetUpdateIdToHeightResponse, error) {
+	out := new(QueryValsetUpdateIdToHeightResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValsetUpdateIdToHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRecentValsetUpdateIds(ctx context.Context, in *QueryRecentValsetUpdateIdsRequest, opts ...grpc.CallOption) (*QueryRecentValsetUpdateIdsResponse, error) {
+	out := new(QueryRecentValsetUpdateIdsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRecentValsetUpdateIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorsUsingDefaultKey(ctx context.Context, in *QueryValidatorsUsingDefaultKeyRequest, opts ...grpc.CallOption) (*QueryValidatorsUsingDefaultKeyResponse, error) {
+	out := new(QueryValidatorsUsingDefaultKeyResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorsUsingDefaultKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerRewardsAddress(ctx context.Context, in *QueryConsumerRewardsAddressRequest, opts ...grpc.CallOption) (*QueryConsumerRewardsAddressResponse, error) {
+	out := new(QueryConsumerRewardsAddressResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerRewardsAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTopNThreshold(ctx context.Context, in *QueryTopNThresholdRequest, opts ...grpc.CallOption) (*QueryTopNThresholdResponse, error) {
+	out := new(QueryTopNThresholdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryTopNThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerPhaseHistory(ctx context.Context, in *QueryConsumerPhaseHistoryRequest, opts ...grpc.CallOption) (*QueryConsumerPhaseHistoryResponse, error) {
+	out := new(QueryConsumerPhaseHistoryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerPhaseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerAddrsToPrune(ctx context.Context, in *QueryConsumerAddrsToPruneRequest, opts ...grpc.CallOption) (*QueryConsumerAddrsToPruneResponse, error) {
+	out := new(QueryConsumerAddrsToPruneResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerAddrsToPrune", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRecentKeyAssignments(ctx context.Context, in *QueryRecentKeyAssignmentsRequest, opts ...grpc.CallOption) (*QueryRecentKeyAssignmentsResponse, error) {
+	out := new(QueryRecentKeyAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRecentKeyAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryJailingReason(ctx context.Context, in *QueryJailingReasonRequest, opts ...grpc.CallOption) (*QueryJailingReasonResponse, error) {
+	out := new(QueryJailingReasonResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryJailingReason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerSlashPacketRate(ctx context.Context, in *QueryConsumerSlashPacketRateRequest, opts ...grpc.CallOption) (*QueryConsumerSlashPacketRateResponse, error) {
+	out := new(QueryConsumerSlashPacketRateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerSlashPacketRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryEffectiveConsumerKey(ctx context.Context, in *QueryEffectiveConsumerKeyRequest, opts ...grpc.CallOption) (*QueryEffectiveConsumerKeyResponse, error) {
+	out := new(QueryEffectiveConsumerKeyResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryEffectiveConsumerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerLaunchFailure(ctx context.Context, in *QueryConsumerLaunchFailureRequest, opts ...grpc.CallOption) (*QueryConsumerLaunchFailureResponse, error) {
+	out := new(QueryConsumerLaunchFailureResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerLaunchFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySlashPacketBySeq(ctx context.Context, in *QuerySlashPacketBySeqRequest, opts ...grpc.CallOption) (*QuerySlashPacketBySeqResponse, error) {
+	out := new(QuerySlashPacketBySeqResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QuerySlashPacketBySeq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumersWithThrottledSlashing(ctx context.Context, in *QueryConsumersWithThrottledSlashingRequest, opts ...grpc.CallOption) (*QueryConsumersWithThrottledSlashingResponse, error) {
+	out := new(QueryConsumersWithThrottledSlashingResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumersWithThrottledSlashing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorTopNObligations(ctx context.Context, in *QueryValidatorTopNObligationsRequest, opts ...grpc.CallOption) (*QueryValidatorTopNObligationsResponse, error) {
+	out := new(QueryValidatorTopNObligationsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorTopNObligations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerGenesisValsetHash(ctx context.Context, in *QueryConsumerGenesisValsetHashRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisValsetHashResponse, error) {
+	out := new(QueryConsumerGenesisValsetHashResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisValsetHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerDistribution(ctx context.Context, in *QueryConsumerDistributionRequest, opts ...grpc.CallOption) (*QueryConsumerDistributionResponse, error) {
+	out := new(QueryConsumerDistributionResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerValidatorSets(ctx context.Context, in *QueryConsumerValidatorSetsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorSetsResponse, error) {
+	out := new(QueryConsumerValidatorSetsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorKeyConflicts(ctx context.Context, in *QueryValidatorKeyConflictsRequest, opts ...grpc.CallOption) (*QueryValidatorKeyConflictsResponse, error) {
+	out := new(QueryValidatorKeyConflictsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorKeyConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerRemovalETA(ctx context.Context, in *QueryConsumerRemovalETARequest, opts ...grpc.CallOption) (*QueryConsumerRemovalETAResponse, error) {
+	out := new(QueryConsumerRemovalETAResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerRemovalETA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerJailedPower(ctx context.Context, in *QueryConsumerJailedPowerRequest, opts ...grpc.CallOption) (*QueryConsumerJailedPowerResponse, error) {
+	out := new(QueryConsumerJailedPowerResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerJailedPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorDenylistedConsumers(ctx context.Context, in *QueryValidatorDenylistedConsumersRequest, opts ...grpc.CallOption) (*QueryValidatorDenylistedConsumersResponse, error) {
+	out := new(QueryValidatorDenylistedConsumersResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorDenylistedConsumers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorHasToValidate(ctx context.Context, in *QueryValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryValidatorHasToValidateResponse, error) {
+	out := new(QueryValidatorHasToValidateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorHasToValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerCCVTimeout(ctx context.Context, in *QueryConsumerCCVTimeoutRequest, opts ...grpc.CallOption) (*QueryConsumerCCVTimeoutResponse, error) {
+	out := new(QueryConsumerCCVTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerCCVTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryKeyAssignmentStats(ctx context.Context, in *QueryKeyAssignmentStatsRequest, opts ...grpc.CallOption) (*QueryKeyAssignmentStatsResponse, error) {
+	out := new(QueryKeyAssignmentStatsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryKeyAssignmentStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySlashMeterHistory(ctx context.Context, in *QuerySlashMeterHistoryRequest, opts ...grpc.CallOption) (*QuerySlashMeterHistoryResponse, error) {
+	out := new(QuerySlashMeterHistoryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QuerySlashMeterHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorAllConsumerKeys(ctx context.Context, in *QueryValidatorAllConsumerKeysRequest, opts ...grpc.CallOption) (*QueryValidatorAllConsumerKeysResponse, error) {
+	out := new(QueryValidatorAllConsumerKeysResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorAllConsumerKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryMaxProviderConsensusValidators(ctx context.Context, in *QueryMaxProviderConsensusValidatorsRequest, opts ...grpc.CallOption) (*QueryMaxProviderConsensusValidatorsResponse, error) {
+	out := new(QueryMaxProviderConsensusValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryMaxProviderConsensusValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumersByOwner(ctx context.Context, in *QueryConsumersByOwnerRequest, opts ...grpc.CallOption) (*QueryConsumersByOwnerResponse, error) {
+	out := new(QueryConsumersByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumersByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainIdAvailable(ctx context.Context, in *QueryConsumerChainIdAvailableRequest, opts ...grpc.CallOption) (*QueryConsumerChainIdAvailableResponse, error) {
+	out := new(QueryConsumerChainIdAvailableResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainIdAvailable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryNextConsumerId(ctx context.Context, in *QueryNextConsumerIdRequest, opts ...grpc.CallOption) (*QueryNextConsumerIdResponse, error) {
+	out := new(QueryNextConsumerIdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryNextConsumerId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerVSCLatency(ctx context.Context, in *QueryConsumerVSCLatencyRequest, opts ...grpc.CallOption) (*QueryConsumerVSCLatencyResponse, error) {
+	out := new(QueryConsumerVSCLatencyResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerVSCLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumersByClientId(ctx context.Context, in *QueryConsumersByClientIdRequest, opts ...grpc.CallOption) (*QueryConsumersByClientIdResponse, error) {
+	out := new(QueryConsumersByClientIdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumersByClientId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPruningInvariant(ctx context.Context, in *QueryPruningInvariantRequest, opts ...grpc.CallOption) (*QueryPruningInvariantResponse, error) {
+	out := new(QueryPruningInvariantResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryPruningInvariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorsConsumerObligations(ctx context.Context, in *QueryValidatorsConsumerObligationsRequest, opts ...grpc.CallOption) (*QueryValidatorsConsumerObligationsResponse, error) {
+	out := new(QueryValidatorsConsumerObligationsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorsConsumerObligations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAllDefaultKeyUsers(ctx context.Context, in *QueryAllDefaultKeyUsersRequest, opts ...grpc.CallOption) (*QueryAllDefaultKeyUsersResponse, error) {
+	out := new(QueryAllDefaultKeyUsersResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryAllDefaultKeyUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// that is either initialized or launched; for initialized consumer chains, the
+	// genesis state is computed on demand based on the current validator set
+	QueryConsumerGenesis(context.Context, *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error)
+	// ConsumerChains queries active consumer chains supported by the provider
+	// chain
+	QueryConsumerChains(context.Context, *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error)
+	// QueryValidatorConsumerAddr queries the address
+	// assigned by a validator for a consumer chain.
+	QueryValidatorConsumerAddr(context.Context, *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error)
+	// QueryProviderAddr returns the provider chain validator
+	// given a consumer chain validator address
+	QueryValidatorProviderAddr(context.Context, *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error)
+	// QueryThrottleState returns the main on-chain state relevant to currently
+	// throttled slash packets
+	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
+	// denoms that are registered
+	QueryRegisteredConsumerRewardDenoms(context.Context, *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error)
+	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
+	// between provider and consumer chain
+	QueryAllPairsValConsAddrByConsumer(context.Context, *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error)
+	// QueryParams returns all current values of provider parameters
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
+	// that opted-in to the given consumer chain
+	QueryConsumerChainOptedInValidators(context.Context, *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error)
+	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
+	// that a given validator must validate
+	QueryConsumerChainsValidatorHasToValidate(context.Context, *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
+	// QueryValidatorConsumerCommissionRate returns the commission rate a given
+	// validator charges on a given consumer chain
+	QueryValidatorConsumerCommissionRate(context.Context, *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error)
+	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
+	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
+	// because a VSCPacket could be delayed to be delivered on the consumer chain.
+	QueryConsumerValidators(context.Context, *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error)
+	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
+	// starts and validator updates are sent to the consumer chains
+	QueryBlocksUntilNextEpoch(context.Context, *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error)
+	// QueryConsumerIdFromClientId returns the consumer id of the chain
+	// associated with the provided client id
+	QueryConsumerIdFromClientId(context.Context, *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error)
+	// QueryConsumerChain returns the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerChain(context.Context, *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error)
+	// QueryConsumerGenesisTime returns the genesis time
+	// of the consumer chain associated with the provided consumer id
+	QueryConsumerGenesisTime(context.Context, *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error)
+	// QueryVSCMaturationSchedule returns the maturity times of all the VSC packets
+	// sent to the consumer chain associated with the provided consumer id
+	// that have not yet matured
+	QueryVSCMaturationSchedule(context.Context, *QueryVSCMaturationScheduleRequest) (*QueryVSCMaturationScheduleResponse, error)
+	// QuerySimulateConsumerUpdate returns the validator set the consumer chain
+	// associated with the provided consumer id would have if its power-shaping
+	// parameters were updated to the provided ones, without updating them
+	QuerySimulateConsumerUpdate(context.Context, *QuerySimulateConsumerUpdateRequest) (*QuerySimulateConsumerUpdateResponse, error)
+	// QueryConsumerValidatorSetHash returns the hash of the validator set the provider
+	// expects the consumer chain associated with the provided consumer id to use,
+	// computed as the CometBFT validator set hash of the consumer validators
+	QueryConsumerValidatorSetHash(context.Context, *QueryConsumerValidatorSetHashRequest) (*QueryConsumerValidatorSetHashResponse, error)
+	// QueryValsetUpdateIdToHeight returns the provider block height
+	// mapped to the provided valset update id
+	QueryValsetUpdateIdToHeight(context.Context, *QueryValsetUpdateIdToHeightRequest) (*QueryValsetUpdateIdToHeightResponse, error)
+	// QueryRecentValsetUpdateIds returns the most recent valset update ids
+	// together with the provider block heights they are mapped to
+	QueryRecentValsetUpdateIds(context.Context, *QueryRecentValsetUpdateIdsRequest) (*QueryRecentValsetUpdateIdsResponse, error)
+	// QueryValidatorsUsingDefaultKey returns the consensus addresses of the
+	// validators in the validator set of the given consumer chain that have not
+	// assigned a consumer key, i.e., that use their provider key on the consumer chain
+	QueryValidatorsUsingDefaultKey(context.Context, *QueryValidatorsUsingDefaultKeyRequest) (*QueryValidatorsUsingDefaultKeyResponse, error)
+	// QueryConsumerRewardsAddress returns the address of the module account
+	// that collects the rewards sent by the given consumer chain
+	QueryConsumerRewardsAddress(context.Context, *QueryConsumerRewardsAddressRequest) (*QueryConsumerRewardsAddressResponse, error)
+	// QueryTopNThreshold returns the minimum power needed for a validator
+	// to belong to the top N% of the current active validators
+	QueryTopNThreshold(context.Context, *QueryTopNThresholdRequest) (*QueryTopNThresholdResponse, error)
+	// QueryConsumerPhaseHistory returns the most recent phase transitions
+	// of the given consumer chain
+	QueryConsumerPhaseHistory(context.Context, *QueryConsumerPhaseHistoryRequest) (*QueryConsumerPhaseHistoryResponse, error)
+	// QueryConsumerAddrsToPrune returns the consumer addresses of the given
+	// consumer chain that are scheduled for pruning
+	QueryConsumerAddrsToPrune(context.Context, *QueryConsumerAddrsToPruneRequest) (*QueryConsumerAddrsToPruneResponse, error)
+	// QueryRecentKeyAssignments returns the consumer key rotations of the
+	// validators on the given consumer chain that became effective after
+	// the given VSC packet was sent
+	QueryRecentKeyAssignments(context.Context, *QueryRecentKeyAssignmentsRequest) (*QueryRecentKeyAssignmentsResponse, error)
+	// QueryJailingReason returns whether the given jailed validator was jailed
+	// because of a slash packet sent by a consumer chain or on the provider chain
+	QueryJailingReason(context.Context, *QueryJailingReasonRequest) (*QueryJailingReasonResponse, error)
+	// QueryConsumerSlashPacketRate returns the number of slash packets
+	// received from a consumer chain in the most recent blocks
+	QueryConsumerSlashPacketRate(context.Context, *QueryConsumerSlashPacketRateRequest) (*QueryConsumerSlashPacketRateResponse, error)
+	// QueryEffectiveConsumerKey returns the key a validator uses on a consumer chain,
+	// i.e., the assigned consumer key if any, and the provider consensus key otherwise
+	QueryEffectiveConsumerKey(context.Context, *QueryEffectiveConsumerKeyRequest) (*QueryEffectiveConsumerKeyResponse, error)
+	// QueryConsumerLaunchFailure returns the number of failed attempts
+	// to launch a consumer chain and the error of the last attempt
+	QueryConsumerLaunchFailure(context.Context, *QueryConsumerLaunchFailureRequest) (*QueryConsumerLaunchFailureResponse, error)
+	// QuerySlashPacketBySeq returns how the slash packet received from a consumer chain
+	// with the given IBC sequence number was processed
+	QuerySlashPacketBySeq(context.Context, *QuerySlashPacketBySeqRequest) (*QuerySlashPacketBySeqResponse, error)
+	// QueryConsumersWithThrottledSlashing returns the consumer chains that currently
+	// have slash packets throttled, together with the number of throttled slash packets
+	QueryConsumersWithThrottledSlashing(context.Context, *QueryConsumersWithThrottledSlashingRequest) (*QueryConsumersWithThrottledSlashingResponse, error)
+	// QueryValidatorTopNObligations returns the launched Top N consumer chains
+	// that the given validator is forced to validate, i.e., the chains for which
+	// the validator's power is at or above the Top N threshold
+	QueryValidatorTopNObligations(context.Context, *QueryValidatorTopNObligationsRequest) (*QueryValidatorTopNObligationsResponse, error)
+	// QueryConsumerGenesisValsetHash returns the hash of the initial validator set
+	// of the consumer chain associated with the provided consumer id,
+	// computed when the consumer chain launched
+	QueryConsumerGenesisValsetHash(context.Context, *QueryConsumerGenesisValsetHashRequest) (*QueryConsumerGenesisValsetHashResponse, error)
+	// QueryConsumerDistribution returns the distribution transmission channel
+	// of the consumer chain associated with the provided consumer id,
+	// whether it is open, and whether IBC transfers are enabled
+	QueryConsumerDistribution(context.Context, *QueryConsumerDistributionRequest) (*QueryConsumerDistributionResponse, error)
+	// QueryConsumerValidatorSets returns the validator sets of multiple
+	// consumer chains associated with the provided consumer ids
+	QueryConsumerValidatorSets(context.Context, *QueryConsumerValidatorSetsRequest) (*QueryConsumerValidatorSetsResponse, error)
+	// QueryValidatorKeyConflicts returns the consumer keys that the given validator
+	// assigned on more than one consumer chain
+	QueryValidatorKeyConflicts(context.Context, *QueryValidatorKeyConflictsRequest) (*QueryValidatorKeyConflictsResponse, error)
+	// QueryConsumerRemovalETA returns the time at which the consumer chain
+	// associated with the provided consumer id is scheduled to be removed
+	// and the time remaining until then
+	QueryConsumerRemovalETA(context.Context, *QueryConsumerRemovalETARequest) (*QueryConsumerRemovalETAResponse, error)
+	// QueryConsumerJailedPower returns the cumulative power of the validators
+	// jailed because of the slash packets received from the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerJailedPower(context.Context, *QueryConsumerJailedPowerRequest) (*QueryConsumerJailedPowerResponse, error)
+	// QueryValidatorDenylistedConsumers returns the ids of the consumer chains
+	// on which the given validator is denylisted
+	QueryValidatorDenylistedConsumers(context.Context, *QueryValidatorDenylistedConsumersRequest) (*QueryValidatorDenylistedConsumersResponse, error)
+	// QueryValidatorHasToValidate returns whether the given validator has to
+	// validate the consumer chain associated with the provided consumer id,
+	// along with the reason why
+	QueryValidatorHasToValidate(context.Context, *QueryValidatorHasToValidateRequest) (*QueryValidatorHasToValidateResponse, error)
+	// QueryConsumerCCVTimeout returns the effective timeout period of the CCV packets
+	// sent by the provider to the consumer chain associated with the provided consumer id
+	QueryConsumerCCVTimeout(context.Context, *QueryConsumerCCVTimeoutRequest) (*QueryConsumerCCVTimeoutResponse, error)
+	// QueryKeyAssignmentStats returns the number of assigned consumer keys and
+	// of consumer addresses pending pruning, in total and for every consumer chain
+	QueryKeyAssignmentStats(context.Context, *QueryKeyAssignmentStatsRequest) (*QueryKeyAssignmentStatsResponse, error)
+	// QuerySlashMeterHistory returns the values of the slash meter at the end
+	// of the most recent blocks
+	QuerySlashMeterHistory(context.Context, *QuerySlashMeterHistoryRequest) (*QuerySlashMeterHistoryResponse, error)
+	// QueryValidatorAllConsumerKeys returns the consumer keys assigned by
+	// a validator on all the consumer chains
+	QueryValidatorAllConsumerKeys(context.Context, *QueryValidatorAllConsumerKeysRequest) (*QueryValidatorAllConsumerKeysResponse, error)
+	// QueryMaxProviderConsensusValidators returns the maximum number of
+	// validators sent to the consensus engine of the provider chain and the
+	// number of validators currently in the provider consensus validator set
+	QueryMaxProviderConsensusValidators(context.Context, *QueryMaxProviderConsensusValidatorsRequest) (*QueryMaxProviderConsensusValidatorsResponse, error)
+	// QueryConsumersByOwner returns the consumer chains owned by the given
+	// address, together with their phases
+	QueryConsumersByOwner(context.Context, *QueryConsumersByOwnerRequest) (*QueryConsumersByOwnerResponse, error)
+	// QueryConsumerChainIdAvailable returns whether a chain id is not used
+	// by any registered, initialized, or launched consumer chain
+	QueryConsumerChainIdAvailable(context.Context, *QueryConsumerChainIdAvailableRequest) (*QueryConsumerChainIdAvailableResponse, error)
+	// QueryNextConsumerId returns the consumer id that is assigned to the
+	// next created consumer chain
+	QueryNextConsumerId(context.Context, *QueryNextConsumerIdRequest) (*QueryNextConsumerIdResponse, error)
+	// QueryConsumerVSCLatency returns the average and maximum round-trip latency
+	// of the most recently acknowledged VSC packets of a given consumer chain
+	QueryConsumerVSCLatency(context.Context, *QueryConsumerVSCLatencyRequest) (*QueryConsumerVSCLatencyResponse, error)
+	// QueryConsumersByClientId returns the ids of all the consumer chains
+	// whose IBC client is the given client
+	QueryConsumersByClientId(context.Context, *QueryConsumersByClientIdRequest) (*QueryConsumersByClientIdResponse, error)
+	// QueryPruningInvariant checks, on the current state, that every consumer
+	// address of a given consumer chain is either currently assigned or
+	// scheduled for pruning, and returns the consumer addresses violating it
+	QueryPruningInvariant(context.Context, *QueryPruningInvariantRequest) (*QueryPruningInvariantResponse, error)
+	// QueryValidatorsConsumerObligations returns, for each of the given
+	// validators, the consumer chains it has to validate and the consumer keys
+	// it assigned
+	QueryValidatorsConsumerObligations(context.Context, *QueryValidatorsConsumerObligationsRequest) (*QueryValidatorsConsumerObligationsResponse, error)
+	// QueryAllDefaultKeyUsers returns, for every launched consumer chain, the
+	// validators in the consumer validator set that use their provider key on
+	// the consumer chain
+	QueryAllDefaultKeyUsers(context.Context, *QueryAllDefaultKeyUsersRequest) (*QueryAllDefaultKeyUsersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryConsumerGenesis(ctx context.Context, req *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesis not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChains(ctx context.Context, req *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChains not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorConsumerAddr(ctx context.Context, req *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerAddr not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorProviderAddr(ctx context.Context, req *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorProviderAddr not implemented")
+}
+func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+}
+func (*UnimplementedQueryServer) QueryRegisteredConsumerRewardDenoms(ctx context.Context, req *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRegisteredConsumerRewardDenoms not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllPairsValConsAddrByConsumer(ctx context.Context, req *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllPairsValConsAddrByConsumer not implemented")
+}
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainOptedInValidators(ctx context.Context, req *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainOptedInValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, req *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsValidatorHasToValidate not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorConsumerCommissionRate(ctx context.Context, req *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerCommissionRate not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerValidators(ctx context.Context, req *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryBlocksUntilNextEpoch(ctx context.Context, req *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocksUntilNextEpoch not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerIdFromClientId(ctx context.Context, req *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerIdFromClientId not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChain(ctx context.Context, req *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChain not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerGenesisTime(ctx context.Context, req *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesisTime not implemented")
+}
+func (*UnimplementedQueryServer) QueryVSCMaturationSchedule(ctx context.Context, req *QueryVSCMaturationScheduleRequest) (*QueryVSCMaturationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVSCMaturationSchedule not implemented")
+}
+func (*UnimplementedQueryServer) QuerySimulateConsumerUpdate(ctx context.Context, req *QuerySimulateConsumerUpdateRequest) (*QuerySimulateConsumerUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySimulateConsumerUpdate not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerValidatorSetHash(ctx context.Context, req *QueryConsumerValidatorSetHashRequest) (*QueryConsumerValidatorSetHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidatorSetHash not implemented")
+}
+func (*UnimplementedQueryServer) QueryValsetUpdateIdToHeight(ctx context.Context, req *QueryValsetUpdateIdToHeightRequest) (*QueryValsetUpdateIdToHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValsetUpdateIdToHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueryRecentValsetUpdateIds(ctx context.Context, req *QueryRecentValsetUpdateIdsRequest) (*QueryRecentValsetUpdateIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRecentValsetUpdateIds not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorsUsingDefaultKey(ctx context.Context, req *QueryValidatorsUsingDefaultKeyRequest) (*QueryValidatorsUsingDefaultKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorsUsingDefaultKey not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerRewardsAddress(ctx context.Context, req *QueryConsumerRewardsAddressRequest) (*QueryConsumerRewardsAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerRewardsAddress not implemented")
+}
+func (*UnimplementedQueryServer) QueryTopNThreshold(ctx context.Context, req *QueryTopNThresholdRequest) (*QueryTopNThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTopNThreshold not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerPhaseHistory(ctx context.Context, req *QueryConsumerPhaseHistoryRequest) (*QueryConsumerPhaseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerPhaseHistory not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerAddrsToPrune(ctx context.Context, req *QueryConsumerAddrsToPruneRequest) (*QueryConsumerAddrsToPruneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerAddrsToPrune not implemented")
+}
+func (*UnimplementedQueryServer) QueryRecentKeyAssignments(ctx context.Context, req *QueryRecentKeyAssignmentsRequest) (*QueryRecentKeyAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRecentKeyAssignments not implemented")
+}
+func (*UnimplementedQueryServer) QueryJailingReason(ctx context.Context, req *QueryJailingReasonRequest) (*QueryJailingReasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryJailingReason not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerSlashPacketRate(ctx context.Context, req *QueryConsumerSlashPacketRateRequest) (*QueryConsumerSlashPacketRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerSlashPacketRate not implemented")
+}
+func (*UnimplementedQueryServer) QueryEffectiveConsumerKey(ctx context.Context, req *QueryEffectiveConsumerKeyRequest) (*QueryEffectiveConsumerKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEffectiveConsumerKey not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerLaunchFailure(ctx context.Context, req *QueryConsumerLaunchFailureRequest) (*QueryConsumerLaunchFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerLaunchFailure not implemented")
+}
+func (*UnimplementedQueryServer) QuerySlashPacketBySeq(ctx context.Context, req *QuerySlashPacketBySeqRequest) (*QuerySlashPacketBySeqResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySlashPacketBySeq not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumersWithThrottledSlashing(ctx context.Context, req *QueryConsumersWithThrottledSlashingRequest) (*QueryConsumersWithThrottledSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumersWithThrottledSlashing not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorTopNObligations(ctx context.Context, req *QueryValidatorTopNObligationsRequest) (*QueryValidatorTopNObligationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorTopNObligations not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerGenesisValsetHash(ctx context.Context, req *QueryConsumerGenesisValsetHashRequest) (*QueryConsumerGenesisValsetHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesisValsetHash not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerDistribution(ctx context.Context, req *QueryConsumerDistributionRequest) (*QueryConsumerDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerDistribution not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerValidatorSets(ctx context.Context, req *QueryConsumerValidatorSetsRequest) (*QueryConsumerValidatorSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidatorSets not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorKeyConflicts(ctx context.Context, req *QueryValidatorKeyConflictsRequest) (*QueryValidatorKeyConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorKeyConflicts not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerRemovalETA(ctx context.Context, req *QueryConsumerRemovalETARequest) (*QueryConsumerRemovalETAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerRemovalETA not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerJailedPower(ctx context.Context, req *QueryConsumerJailedPowerRequest) (*QueryConsumerJailedPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerJailedPower not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorDenylistedConsumers(ctx context.Context, req *QueryValidatorDenylistedConsumersRequest) (*QueryValidatorDenylistedConsumersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorDenylistedConsumers not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorHasToValidate(ctx context.Context, req *QueryValidatorHasToValidateRequest) (*QueryValidatorHasToValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorHasToValidate not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerCCVTimeout(ctx context.Context, req *QueryConsumerCCVTimeoutRequest) (*QueryConsumerCCVTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerCCVTimeout not implemented")
+}
+func (*UnimplementedQueryServer) QueryKeyAssignmentStats(ctx context.Context, req *QueryKeyAssignmentStatsRequest) (*QueryKeyAssignmentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryKeyAssignmentStats not implemented")
+}
+func (*UnimplementedQueryServer) QuerySlashMeterHistory(ctx context.Context, req *QuerySlashMeterHistoryRequest) (*QuerySlashMeterHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySlashMeterHistory not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorAllConsumerKeys(ctx context.Context, req *QueryValidatorAllConsumerKeysRequest) (*QueryValidatorAllConsumerKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorAllConsumerKeys not implemented")
+}
+func (*UnimplementedQueryServer) QueryMaxProviderConsensusValidators(ctx context.Context, req *QueryMaxProviderConsensusValidatorsRequest) (*QueryMaxProviderConsensusValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMaxProviderConsensusValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumersByOwner(ctx context.Context, req *QueryConsumersByOwnerRequest) (*QueryConsumersByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumersByOwner not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainIdAvailable(ctx context.Context, req *QueryConsumerChainIdAvailableRequest) (*QueryConsumerChainIdAvailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainIdAvailable not implemented")
+}
+func (*UnimplementedQueryServer) QueryNextConsumerId(ctx context.Context, req *QueryNextConsumerIdRequest) (*QueryNextConsumerIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNextConsumerId not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerVSCLatency(ctx context.Context, req *QueryConsumerVSCLatencyRequest) (*QueryConsumerVSCLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerVSCLatency not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumersByClientId(ctx context.Context, req *QueryConsumersByClientIdRequest) (*QueryConsumersByClientIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumersByClientId not implemented")
+}
+func (*UnimplementedQueryServer) QueryPruningInvariant(ctx context.Context, req *QueryPruningInvariantRequest) (*QueryPruningInvariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPruningInvariant not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorsConsumerObligations(ctx context.Context, req *QueryValidatorsConsumerObligationsRequest) (*QueryValidatorsConsumerObligationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorsConsumerObligations not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllDefaultKeyUsers(ctx context.Context, req *QueryAllDefaultKeyUsersRequest) (*QueryAllDefaultKeyUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllDefaultKeyUsers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryConsumerGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerGenesis(ctx, req.(*QueryConsumerGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChains(ctx, req.(*QueryConsumerChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorConsumerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsumerAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, req.(*QueryValidatorConsumerAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorProviderAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorProviderAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, req.(*QueryValidatorProviderAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRegisteredConsumerRewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredConsumerRewardDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, req.(*QueryRegisteredConsumerRewardDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAllPairsValConsAddrByConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPairsValConsAddrByConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, req.(*QueryAllPairsValConsAddrByConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainOptedInValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainOptedInValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, req.(*QueryConsumerChainOptedInValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainsValidatorHasToValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsValidatorHasToValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, req.(*QueryConsumerChainsValidatorHasToValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorConsumerCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsumerCommissionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, req.(*QueryValidatorConsumerCommissionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerValidators(ctx, req.(*QueryConsumerValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBlocksUntilNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocksUntilNextEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, req.(*QueryBlocksUntilNextEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerIdFromClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerIdFromClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, req.(*QueryConsumerIdFromClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChain(ctx, req.(*QueryConsumerChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerGenesisTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerGenesisTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, req.(*QueryConsumerGenesisTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVSCMaturationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVSCMaturationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVSCMaturationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryVSCMaturationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVSCMaturationSchedule(ctx, req.(*QueryVSCMaturationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySimulateConsumerUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateConsumerUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySimulateConsumerUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QuerySimulateConsumerUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySimulateConsumerUpdate(ctx, req.(*QuerySimulateConsumerUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerValidatorSetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerValidatorSetHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerValidatorSetHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorSetHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerValidatorSetHash(ctx, req.(*QueryConsumerValidatorSetHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValsetUpdateIdToHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetUpdateIdToHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValsetUpdateIdToHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValsetUpdateIdToHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValsetUpdateIdToHeight(ctx, req.(*QueryValsetUpdateIdToHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRecentValsetUpdateIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecentValsetUpdateIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRecentValsetUpdateIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRecentValsetUpdateIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRecentValsetUpdateIds(ctx, req.(*QueryRecentValsetUpdateIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorsUsingDefaultKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsUsingDefaultKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorsUsingDefaultKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorsUsingDefaultKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorsUsingDefaultKey(ctx, req.(*QueryValidatorsUsingDefaultKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerRewardsAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRewardsAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerRewardsAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerRewardsAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerRewardsAddress(ctx, req.(*QueryConsumerRewardsAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTopNThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopNThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTopNThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryTopNThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTopNThreshold(ctx, req.(*QueryTopNThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerPhaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerPhaseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerPhaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerPhaseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerPhaseHistory(ctx, req.(*QueryConsumerPhaseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerAddrsToPrune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerAddrsToPruneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerAddrsToPrune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerAddrsToPrune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerAddrsToPrune(ctx, req.(*QueryConsumerAddrsToPruneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRecentKeyAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecentKeyAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRecentKeyAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRecentKeyAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRecentKeyAssignments(ctx, req.(*QueryRecentKeyAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryJailingReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailingReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryJailingReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryJailingReason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryJailingReason(ctx, req.(*QueryJailingReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerSlashPacketRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerSlashPacketRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerSlashPacketRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerSlashPacketRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerSlashPacketRate(ctx, req.(*QueryConsumerSlashPacketRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryEffectiveConsumerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveConsumerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEffectiveConsumerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryEffectiveConsumerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEffectiveConsumerKey(ctx, req.(*QueryEffectiveConsumerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerLaunchFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerLaunchFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerLaunchFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerLaunchFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerLaunchFailure(ctx, req.(*QueryConsumerLaunchFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySlashPacketBySeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashPacketBySeqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySlashPacketBySeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QuerySlashPacketBySeq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySlashPacketBySeq(ctx, req.(*QuerySlashPacketBySeqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumersWithThrottledSlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumersWithThrottledSlashingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumersWithThrottledSlashing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumersWithThrottledSlashing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumersWithThrottledSlashing(ctx, req.(*QueryConsumersWithThrottledSlashingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorTopNObligations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorTopNObligationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorTopNObligations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorTopNObligations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorTopNObligations(ctx, req.(*QueryValidatorTopNObligationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerGenesisValsetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerGenesisValsetHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerGenesisValsetHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisValsetHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerGenesisValsetHash(ctx, req.(*QueryConsumerGenesisValsetHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerDistribution(ctx, req.(*QueryConsumerDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerValidatorSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerValidatorSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerValidatorSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerValidatorSets(ctx, req.(*QueryConsumerValidatorSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorKeyConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorKeyConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorKeyConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorKeyConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorKeyConflicts(ctx, req.(*QueryValidatorKeyConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerRemovalETA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRemovalETARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerRemovalETA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerRemovalETA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerRemovalETA(ctx, req.(*QueryConsumerRemovalETARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerJailedPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerJailedPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerJailedPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerJailedPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerJailedPower(ctx, req.(*QueryConsumerJailedPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorDenylistedConsumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDenylistedConsumersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorDenylistedConsumers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorDenylistedConsumers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorDenylistedConsumers(ctx, req.(*QueryValidatorDenylistedConsumersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorHasToValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorHasToValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorHasToValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorHasToValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorHasToValidate(ctx, req.(*QueryValidatorHasToValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerCCVTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerCCVTimeoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerCCVTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerCCVTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerCCVTimeout(ctx, req.(*QueryConsumerCCVTimeoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryKeyAssignmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyAssignmentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryKeyAssignmentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryKeyAssignmentStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryKeyAssignmentStats(ctx, req.(*QueryKeyAssignmentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySlashMeterHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashMeterHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySlashMeterHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QuerySlashMeterHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySlashMeterHistory(ctx, req.(*QuerySlashMeterHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorAllConsumerKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAllConsumerKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorAllConsumerKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorAllConsumerKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorAllConsumerKeys(ctx, req.(*QueryValidatorAllConsumerKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryMaxProviderConsensusValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxProviderConsensusValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMaxProviderConsensusValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryMaxProviderConsensusValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMaxProviderConsensusValidators(ctx, req.(*QueryMaxProviderConsensusValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumersByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumersByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumersByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumersByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumersByOwner(ctx, req.(*QueryConsumersByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainIdAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainIdAvailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainIdAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainIdAvailable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainIdAvailable(ctx, req.(*QueryConsumerChainIdAvailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryNextConsumerId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextConsumerIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNextConsumerId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryNextConsumerId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNextConsumerId(ctx, req.(*QueryNextConsumerIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerVSCLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerVSCLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerVSCLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerVSCLatency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerVSCLatency(ctx, req.(*QueryConsumerVSCLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumersByClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumersByClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumersByClientId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumersByClientId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumersByClientId(ctx, req.(*QueryConsumersByClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPruningInvariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPruningInvariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPruningInvariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryPruningInvariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPruningInvariant(ctx, req.(*QueryPruningInvariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorsConsumerObligations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsConsumerObligationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorsConsumerObligations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorsConsumerObligations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorsConsumerObligations(ctx, req.(*QueryValidatorsConsumerObligationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAllDefaultKeyUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDefaultKeyUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllDefaultKeyUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryAllDefaultKeyUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllDefaultKeyUsers(ctx, req.(*QueryAllDefaultKeyUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.provider.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryConsumerGenesis",
+			Handler:    _Query_QueryConsumerGenesis_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChains",
+			Handler:    _Query_QueryConsumerChains_Handler,
+		},
+		{
+			MethodName: "QueryValidatorConsumerAddr",
+			Handler:    _Query_QueryValidatorConsumerAddr_Handler,
+		},
+		{
+			MethodName: "QueryValidatorProviderAddr",
+			Handler:    _Query_QueryValidatorProviderAddr_Handler,
+		},
+		{
+			MethodName: "QueryThrottleState",
+			Handler:    _Query_QueryThrottleState_Handler,
+		},
+		{
+			MethodName: "QueryRegisteredConsumerRewardDenoms",
+			Handler:    _Query_QueryRegisteredConsumerRewardDenoms_Handler,
+		},
+		{
+			MethodName: "QueryAllPairsValConsAddrByConsumer",
+			Handler:    _Query_QueryAllPairsValConsAddrByConsumer_Handler,
+		},
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainOptedInValidators",
+			Handler:    _Query_QueryConsumerChainOptedInValidators_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainsValidatorHasToValidate",
+			Handler:    _Query_QueryConsumerChainsValidatorHasToValidate_Handler,
+		},
+		{
+			MethodName: "QueryValidatorConsumerCommissionRate",
+			Handler:    _Query_QueryValidatorConsumerCommissionRate_Handler,
+		},
+		{
+			MethodName: "QueryConsumerValidators",
+			Handler:    _Query_QueryConsumerValidators_Handler,
+		},
+		{
+			MethodName: "QueryBlocksUntilNextEpoch",
+			Handler:    _Query_QueryBlocksUntilNextEpoch_Handler,
+		},
+		{
+			MethodName: "QueryConsumerIdFromClientId",
+			Handler:    _Query_QueryConsumerIdFromClientId_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChain",
+			Handler:    _Query_QueryConsumerChain_Handler,
+		},
+		{
+			MethodName: "QueryConsumerGenesisTime",
+			Handler:    _Query_QueryConsumerGenesisTime_Handler,
+		},
+		{
+			MethodName: "QueryVSCMaturationSchedule",
+			Handler:    _Query_QueryVSCMaturationSchedule_Handler,
+		},
+		{
+			MethodName: "QuerySimulateConsumerUpdate",
+			Handler:    _Query_QuerySimulateConsumerUpdate_Handler,
+		},
+		{
+			MethodName: "QueryConsumerValidatorSetHash",
+			Handler:    _Query_QueryConsumerValidatorSetHash_Handler,
+		},
+		{
+			MethodName: "QueryValsetUpdateIdToHeight",
+			Handler:    _Query_QueryValsetUpdateIdToHeight_Handler,
+		},
+		{
+			MethodName: "QueryRecentValsetUpdateIds",
+			Handler:    _Query_QueryRecentValsetUpdateIds_Handler,
+		},
+		{
+			MethodName: "QueryValidatorsUsingDefaultKey",
+			Handler:    _Query_QueryValidatorsUsingDefaultKey_Handler,
+		},
+		{
+			MethodName: "QueryConsumerRewardsAddress",
+			Handler:    _Query_QueryConsumerRewardsAddress_Handler,
+		},
+		{
+			MethodName: "QueryTopNThreshold",
+			Handler:    _Query_QueryTopNThreshold_Handler,
+		},
+		{
+			MethodName: "QueryConsumerPhaseHistory",
+			Handler:    _Query_QueryConsumerPhaseHistory_Handler,
+		},
+		{
+			MethodName: "QueryConsumerAddrsToPrune",
+			Handler:    _Query_QueryConsumerAddrsToPrune_Handler,
+		},
+		{
+			MethodName: "QueryRecentKeyAssignments",
+			Handler:    _Query_QueryRecentKeyAssignments_Handler,
+		},
+		{
+			MethodName: "QueryJailingReason",
+			Handler:    _Query_QueryJailingReason_Handler,
+		},
+		{
+			MethodName: "QueryConsumerSlashPacketRate",
+			Handler:    _Query_QueryConsumerSlashPacketRate_Handler,
+		},
+		{
+			MethodName: "QueryEffectiveConsumerKey",
+			Handler:    _Query_QueryEffectiveConsumerKey_Handler,
+		},
+		{
+			MethodName: "QueryConsumerLaunchFailure",
+			Handler:    _Query_QueryConsumerLaunchFailure_Handler,
+		},
+		{
+			MethodName: "QuerySlashPacketBySeq",
+			Handler:    _Query_QuerySlashPacketBySeq_Handler,
+		},
+		{
+			MethodName: "QueryConsumersWithThrottledSlashing",
+			Handler:    _Query_QueryConsumersWithThrottledSlashing_Handler,
+		},
+		{
+			MethodName: "QueryValidatorTopNObligations",
+			Handler:    _Query_QueryValidatorTopNObligations_Handler,
+		},
+		{
+			MethodName: "QueryConsumerGenesisValsetHash",
+			Handler:    _Query_QueryConsumerGenesisValsetHash_Handler,
+		},
+		{
+			MethodName: "QueryConsumerDistribution",
+			Handler:    _Query_QueryConsumerDistribution_Handler,
+		},
+		{
+			MethodName: "QueryConsumerValidatorSets",
+			Handler:    _Query_QueryConsumerValidatorSets_Handler,
+		},
+		{
+			MethodName: "QueryValidatorKeyConflicts",
+			Handler:    _Query_QueryValidatorKeyConflicts_Handler,
+		},
+		{
+			MethodName: "QueryConsumerRemovalETA",
+			Handler:    _Query_QueryConsumerRemovalETA_Handler,
+		},
+		{
+			MethodName: "QueryConsumerJailedPower",
+			Handler:    _Query_QueryConsumerJailedPower_Handler,
+		},
+		{
+			MethodName: "QueryValidatorDenylistedConsumers",
+			Handler:    _Query_QueryValidatorDenylistedConsumers_Handler,
+		},
+		{
+			MethodName: "QueryValidatorHasToValidate",
+			Handler:    _Query_QueryValidatorHasToValidate_Handler,
+		},
+		{
+			MethodName: "QueryConsumerCCVTimeout",
+			Handler:    _Query_QueryConsumerCCVTimeout_Handler,
+		},
+		{
+			MethodName: "QueryKeyAssignmentStats",
+			Handler:    _Query_QueryKeyAssignmentStats_Handler,
+		},
+		{
+			MethodName: "QuerySlashMeterHistory",
+			Handler:    _Query_QuerySlashMeterHistory_Handler,
+		},
+		{
+			MethodName: "QueryValidatorAllConsumerKeys",
+			Handler:    _Query_QueryValidatorAllConsumerKeys_Handler,
+		},
+		{
+			MethodName: "QueryMaxProviderConsensusValidators",
+			Handler:    _Query_QueryMaxProviderConsensusValidators_Handler,
+		},
+		{
+			MethodName: "QueryConsumersByOwner",
+			Handler:    _Query_QueryConsumersByOwner_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainIdAvailable",
+			Handler:    _Query_QueryConsumerChainIdAvailable_Handler,
+		},
+		{
+			MethodName: "QueryNextConsumerId",
+			Handler:    _Query_QueryNextConsumerId_Handler,
+		},
+		{
+			MethodName: "QueryConsumerVSCLatency",
+			Handler:    _Query_QueryConsumerVSCLatency_Handler,
+		},
+		{
+			MethodName: "QueryConsumersByClientId",
+			Handler:    _Query_QueryConsumersByClientId_Handler,
+		},
+		{
+			MethodName: "QueryPruningInvariant",
+			Handler:    _Query_QueryPruningInvariant_Handler,
+		},
+		{
+			MethodName: "QueryValidatorsConsumerObligations",
+			Handler:    _Query_QueryValidatorsConsumerObligations_Handler,
+		},
+		{
+			MethodName: "QueryAllDefaultKeyUsers",
+			Handler:    _Query_QueryAllDefaultKeyUsers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchain_security/ccv/provider/v1/query.proto",
+}
+
+func (m *QueryConsumerGenesisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GenesisState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Chain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InfractionParameters != nil {
+		{
+			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Prioritylist) > 0 {
+		for iNdEx := len(m.Prioritylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prioritylist[iNdEx])
+			copy(dAtA[i:], m.Prioritylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Prioritylist[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		{
+			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinPowerInTop_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPowerInTop_N))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Top_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DoubleSignSlashPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DoubleSignSlashPackets))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DowntimeSlashPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DowntimeSlashPackets))
+		i--
+		dAtA[i] = 0x30
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.SlashMeterReplenishFraction) > 0 {
+		i -= len(m.SlashMeterReplenishFraction)
+		copy(dAtA[i:], m.SlashMeterReplenishFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.SlashMeterAllowance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlashMeter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PairValConAddr) > 0 {
+		for iNdEx := len(m.PairValConAddr) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairValConAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairValConAddrProviderAndConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairValConAddrProviderAndConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairValConAddrProviderAndConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for iNdEx := len(m.ValidatorsProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorsProviderAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorsProviderAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorsProviderAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatesCurrentEpoch {
+		i--
+		if m.ValidatesCurrentEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ProviderPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProviderPower))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ProviderTokens.Size()
+		i -= size
+		if _, err := m.ProviderTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ProviderOperatorAddress) > 0 {
+		i -= len(m.ProviderOperatorAddress)
+		copy(dAtA[i:], m.ProviderOperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderOperatorAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ProviderCommissionRate.Size()
+		i -= size
+		if _, err := m.ProviderCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ConsumerCommissionRate.Size()
+		i -= size
+		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ConsumerPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksUntilNextEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.InfractionParameters != nil {
+		{
+			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PowerShapingParams != nil {
+		{
+			size, err := m.PowerShapingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InitParams != nil {
+		{
+			size, err := m.InitParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.GenesisTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintQuery(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVSCMaturationScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVSCMaturationScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVSCMaturationScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VSCMaturity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VSCMaturity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VSCMaturity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MaturityTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaturityTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if m.VscId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVSCMaturationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVSCMaturationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVSCMaturationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Maturities) > 0 {
+		for iNdEx := len(m.Maturities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Maturities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateConsumerUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateConsumerUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateConsumerUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PowerShapingParams != nil {
+		{
+			size, err := m.PowerShapingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateConsumerUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateConsumerUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateConsumerUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NextValidators) > 0 {
+		for iNdEx := len(m.NextValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorSetHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorSetHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorSetHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorSetHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorSetHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorSetHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValsetSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValsetHash) > 0 {
+		i -= len(m.ValsetHash)
+		copy(dAtA[i:], m.ValsetHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValsetHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetUpdateIdToHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetUpdateIdToHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetUpdateIdToHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetUpdateIdToHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetUpdateIdToHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetUpdateIdToHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentValsetUpdateIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentValsetUpdateIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentValsetUpdateIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentValsetUpdateIdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentValsetUpdateIdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentValsetUpdateIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValsetUpdateIdToHeight) > 0 {
+		for iNdEx := len(m.ValsetUpdateIdToHeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetUpdateIdToHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsUsingDefaultKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsUsingDefaultKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsUsingDefaultKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsUsingDefaultKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsUsingDefaultKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsUsingDefaultKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for iNdEx := len(m.ValidatorsProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorsProviderAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorsProviderAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorsProviderAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRewardsAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRewardsAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRewardsAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRewardsAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRewardsAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRewardsAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopNThresholdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopNThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopNThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopN != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopNThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopNThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopNThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerPhaseHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerPhaseHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerPhaseHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerPhaseHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerPhaseHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerPhaseHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transitions) > 0 {
+		for iNdEx := len(m.Transitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerAddrsToPruneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerAddrsToPruneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerAddrsToPruneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerAddrsToPruneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerAddrsToPruneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerAddrsToPruneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerAddrsToPrune) > 0 {
+		for iNdEx := len(m.ConsumerAddrsToPrune) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerAddrsToPrune[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentKeyAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentKeyAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentKeyAssignmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceVscId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceVscId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentKeyAssignmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentKeyAssignmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentKeyAssignmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewConsumerAddress) > 0 {
+		i -= len(m.NewConsumerAddress)
+		copy(dAtA[i:], m.NewConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NewConsumerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldConsumerAddress) > 0 {
+		i -= len(m.OldConsumerAddress)
+		copy(dAtA[i:], m.OldConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OldConsumerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailingReasonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailingReasonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailingReasonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailingReasonResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailingReasonResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailingReasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JailedBy) > 0 {
+		i -= len(m.JailedBy)
+		copy(dAtA[i:], m.JailedBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.JailedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerSlashPacketRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerSlashPacketRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerSlashPacketRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerSlashPacketRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerSlashPacketRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerSlashPacketRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveConsumerKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveConsumerKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveConsumerKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveConsumerKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveConsumerKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveConsumerKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerLaunchFailureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerLaunchFailureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerLaunchFailureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerLaunchFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerLaunchFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerLaunchFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashPacketBySeqRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashPacketBySeqRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashPacketBySeqRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcSeq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IbcSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashPacketBySeqResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashPacketBySeqResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashPacketBySeqResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersWithThrottledSlashingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersWithThrottledSlashingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersWithThrottledSlashingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersWithThrottledSlashingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersWithThrottledSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersWithThrottledSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerThrottledSlashPackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerThrottledSlashPackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerThrottledSlashPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorTopNObligationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorTopNObligationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorTopNObligationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorTopNObligationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorTopNObligationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorTopNObligationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Obligations) > 0 {
+		for iNdEx := len(m.Obligations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Obligations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopNObligation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopNObligation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopNObligation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinPowerInTop_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPowerInTop_N))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Top_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisValsetHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisValsetHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisValsetHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisValsetHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisValsetHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisValsetHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValsetHash) > 0 {
+		i -= len(m.ValsetHash)
+		copy(dAtA[i:], m.ValsetHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValsetHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Open {
+		i--
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderChannelId) > 0 {
+		i -= len(m.ProviderChannelId)
+		copy(dAtA[i:], m.ProviderChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DistributionChannelId) > 0 {
+		i -= len(m.DistributionChannelId)
+		copy(dAtA[i:], m.DistributionChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorSetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorSetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorSetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorSetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorSetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorSets) > 0 {
+		for iNdEx := len(m.ValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorKeyConflictsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorKeyConflictsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorKeyConflictsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorKeyConflictsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorKeyConflictsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorKeyConflictsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerKeyConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerKeyConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerKeyConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRemovalETARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRemovalETARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRemovalETARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRemovalETAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRemovalETAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRemovalETAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeRemaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RemovalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RemovalTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintQuery(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x12
+	if m.RemovalScheduled {
+		i--
+		if m.RemovalScheduled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerJailedPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerJailedPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerJailedPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerJailedPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerJailedPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerJailedPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailedPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDenylistedConsumersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDenylistedConsumersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDenylistedConsumersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDenylistedConsumersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDenylistedConsumersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDenylistedConsumersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorHasToValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorHasToValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorHasToValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorHasToValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorHasToValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorHasToValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasToValidate {
+		i--
+		if m.HasToValidate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerCCVTimeoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerCCVTimeoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerCCVTimeoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerCCVTimeoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerCCVTimeoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerCCVTimeoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintQuery(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyAssignmentStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyAssignmentStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyAssignmentStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyAssignmentStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyAssignmentStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyAssignmentStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerStats) > 0 {
+		for iNdEx := len(m.ConsumerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalAddrsToPrune != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalAddrsToPrune))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalAssignedKeys != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalAssignedKeys))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerKeyAssignmentStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerKeyAssignmentStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerKeyAssignmentStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddrsToPrune != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddrsToPrune))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssignedKeys != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssignedKeys))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashMeterHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashMeterHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashMeterHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashMeterHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashMeterHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashMeterHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashMeterHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashMeterHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashMeterHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashMeter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAllConsumerKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAllConsumerKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAllConsumerKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAllConsumerKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAllConsumerKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAllConsumerKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerKeys) > 0 {
+		for iNdEx := len(m.ConsumerKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignedConsumerKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignedConsumerKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignedConsumerKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxProviderConsensusValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxProviderConsensusValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxProviderConsensusValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxProviderConsensusValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxProviderConsensusValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxProviderConsensusValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxProviderConsensusValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxProviderConsensusValidators))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnedConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnedConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnedConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainIdAvailableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainIdAvailableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainIdAvailableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainIdAvailableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainIdAvailableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainIdAvailableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConflictingConsumerId) > 0 {
+		i -= len(m.ConflictingConsumerId)
+		copy(dAtA[i:], m.ConflictingConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConflictingConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextConsumerIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextConsumerIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextConsumerIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextConsumerIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextConsumerIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextConsumerIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerVSCLatencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerVSCLatencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerVSCLatencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerVSCLatencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerVSCLatencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerVSCLatencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n26, err26 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxLatency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLatency):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintQuery(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x1a
+	n27, err27 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AverageLatency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageLatency):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintQuery(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x12
+	if m.NumVscPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumVscPackets))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersByClientIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersByClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersByClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersByClientIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersByClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersByClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPruningInvariantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPruningInvariantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPruningInvariantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPruningInvariantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPruningInvariantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPruningInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ViolatingConsumerAddrs) > 0 {
+		for iNdEx := len(m.ViolatingConsumerAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ViolatingConsumerAddrs[iNdEx])
+			copy(dAtA[i:], m.ViolatingConsumerAddrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ViolatingConsumerAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Holds {
+		i--
+		if m.Holds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsConsumerObligationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsConsumerObligationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsConsumerObligationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddrs) > 0 {
+		for iNdEx := len(m.ProviderAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProviderAddrs[iNdEx])
+			copy(dAtA[i:], m.ProviderAddrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsConsumerObligationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsConsumerObligationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsConsumerObligationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Obligations) > 0 {
+		for iNdEx := len(m.Obligations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Obligations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConsumerObligations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConsumerObligations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerObligations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerKeys) > 0 {
+		for iNdEx := len(m.ConsumerKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDefaultKeyUsersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDefaultKeyUsersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDefaultKeyUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDefaultKeyUsersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDefaultKeyUsersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDefaultKeyUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerDefaultKeyUsers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerDefaultKeyUsers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerDefaultKeyUsers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddresses) > 0 {
+		for iNdEx := len(m.ProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProviderAddresses[iNdEx])
+			copy(dAtA[i:], m.ProviderAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConsumerGenesisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GenesisState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Chain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovQuery(uint64(m.Top_N))
+	}
+	if m.MinPowerInTop_N != 0 {
+		n += 1 + sovQuery(uint64(m.MinPowerInTop_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MinStake != 0 {
+		n += 1 + sovQuery(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		l = m.AllowlistedRewardDenoms.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Prioritylist) > 0 {
+		for _, s := range m.Prioritylist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.InfractionParameters != nil {
+		l = m.InfractionParameters.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryThrottleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThrottleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashMeter != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeter))
+	}
+	if m.SlashMeterAllowance != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.SlashMeterReplenishFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DowntimeSlashPackets != 0 {
+		n += 1 + sovQuery(uint64(m.DowntimeSlashPackets))
+	}
+	if m.DoubleSignSlashPackets != 0 {
+		n += 1 + sovQuery(uint64(m.DoubleSignSlashPackets))
+	}
+	return n
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PairValConAddr) > 0 {
+		for _, e := range m.PairValConAddr {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairValConAddrProviderAndConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for _, s := range m.ValidatorsProviderAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ConsumerPower != 0 {
+		n += 1 + sovQuery(uint64(m.ConsumerPower))
+	}
+	l = m.ConsumerCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProviderCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProviderOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.ProviderTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProviderPower != 0 {
+		n += 1 + sovQuery(uint64(m.ProviderPower))
+	}
+	if m.ValidatesCurrentEpoch {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksUntilNextEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+	}
+	return n
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InitParams != nil {
+		l = m.InitParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PowerShapingParams != nil {
+		l = m.PowerShapingParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InfractionParameters != nil {
+		l = m.InfractionParameters.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVSCMaturationScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VSCMaturity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovQuery(uint64(m.VscId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaturityTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVSCMaturationScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Maturities) > 0 {
+		for _, e := range m.Maturities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateConsumerUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PowerShapingParams != nil {
+		l = m.PowerShapingParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateConsumerUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NextValidators) > 0 {
+		for _, e := range m.NextValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorSetHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorSetHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValsetHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValsetSize != 0 {
+		n += 1 + sovQuery(uint64(m.ValsetSize))
+	}
+	return n
+}
+
+func (m *QueryValsetUpdateIdToHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovQuery(uint64(m.VscId))
+	}
+	return n
+}
+
+func (m *QueryValsetUpdateIdToHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryRecentValsetUpdateIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRecentValsetUpdateIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValsetUpdateIdToHeight) > 0 {
+		for _, e := range m.ValsetUpdateIdToHeight {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorsUsingDefaultKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsUsingDefaultKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for _, s := range m.ValidatorsProviderAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerRewardsAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRewardsAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopNThresholdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopN != 0 {
+		n += 1 + sovQuery(uint64(m.TopN))
+	}
+	return n
+}
+
+func (m *QueryTopNThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinPower != 0 {
+		n += 1 + sovQuery(uint64(m.MinPower))
+	}
+	return n
+}
+
+func (m *QueryConsumerPhaseHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerPhaseHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transitions) > 0 {
+		for _, e := range m.Transitions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerAddrsToPruneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerAddrsToPruneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerAddrsToPrune) > 0 {
+		for _, e := range m.ConsumerAddrsToPrune {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecentKeyAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SinceVscId != 0 {
+		n += 1 + sovQuery(uint64(m.SinceVscId))
+	}
+	return n
+}
+
+func (m *QueryRecentKeyAssignmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OldConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NewConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailingReasonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailingReasonResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JailedBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovQuery(uint64(m.Infraction))
+	}
+	return n
+}
+
+func (m *QueryConsumerSlashPacketRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerSlashPacketRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *QueryEffectiveConsumerKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveConsumerKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsDefault {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsumerLaunchFailureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerLaunchFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		n += 1 + sovQuery(uint64(m.Attempts))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	return n
+}
+
+func (m *QuerySlashPacketBySeqRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IbcSeq != 0 {
+		n += 1 + sovQuery(uint64(m.IbcSeq))
+	}
+	return n
+}
+
+func (m *QuerySlashPacketBySeqResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovQuery(uint64(m.Infraction))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsumersWithThrottledSlashingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsumersWithThrottledSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerThrottledSlashPackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryValidatorTopNObligationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorTopNObligationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	if len(m.Obligations) > 0 {
+		for _, e := range m.Obligations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TopNObligation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovQuery(uint64(m.Top_N))
+	}
+	if m.MinPowerInTop_N != 0 {
+		n += 1 + sovQuery(uint64(m.MinPowerInTop_N))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisValsetHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisValsetHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValsetHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DistributionChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Open {
+		n += 2
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorSetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorSetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSets) > 0 {
+		for _, e := range m.ValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorKeyConflictsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorKeyConflictsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerKeyConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerRemovalETARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRemovalETAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemovalScheduled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RemovalTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeRemaining)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerJailedPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerJailedPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JailedPower != 0 {
+		n += 1 + sovQuery(uint64(m.JailedPower))
+	}
+	return n
+}
+
+func (m *QueryValidatorDenylistedConsumersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDenylistedConsumersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorHasToValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorHasToValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasToValidate {
+		n += 2
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *QueryConsumerCCVTimeoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerCCVTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Overridden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryKeyAssignmentStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryKeyAssignmentStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalAssignedKeys != 0 {
+		n += 1 + sovQuery(uint64(m.TotalAssignedKeys))
+	}
+	if m.TotalAddrsToPrune != 0 {
+		n += 1 + sovQuery(uint64(m.TotalAddrsToPrune))
+	}
+	if len(m.ConsumerStats) > 0 {
+		for _, e := range m.ConsumerStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerKeyAssignmentStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AssignedKeys != 0 {
+		n += 1 + sovQuery(uint64(m.AssignedKeys))
+	}
+	if m.AddrsToPrune != 0 {
+		n += 1 + sovQuery(uint64(m.AddrsToPrune))
+	}
+	return n
+}
+
+func (m *QuerySlashMeterHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashMeterHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashMeterHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.SlashMeter != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeter))
+	}
+	return n
+}
+
+func (m *QueryValidatorAllConsumerKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAllConsumerKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerKeys) > 0 {
+		for _, e := range m.ConsumerKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssignedConsumerKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaxProviderConsensusValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMaxProviderConsensusValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxProviderConsensusValidators != 0 {
+		n += 1 + sovQuery(uint64(m.MaxProviderConsensusValidators))
+	}
+	if m.ActiveValidators != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveValidators))
+	}
+	return n
+}
+
+func (m *QueryConsumersByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OwnedConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainIdAvailableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainIdAvailableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Available {
+		n += 2
+	}
+	l = len(m.ConflictingConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextConsumerIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextConsumerIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerVSCLatencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerVSCLatencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumVscPackets != 0 {
+		n += 1 + sovQuery(uint64(m.NumVscPackets))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageLatency)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLatency)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumersByClientIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersByClientIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPruningInvariantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPruningInvariantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Holds {
+		n += 2
+	}
+	if len(m.ViolatingConsumerAddrs) > 0 {
+		for _, s := range m.ViolatingConsumerAddrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorsConsumerObligationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderAddrs) > 0 {
+		for _, s := range m.ProviderAddrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorsConsumerObligationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Obligations) > 0 {
+		for _, e := range m.Obligations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorConsumerObligations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ConsumerKeys) > 0 {
+		for _, e := range m.ConsumerKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllDefaultKeyUsersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllDefaultKeyUsersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerDefaultKeyUsers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ProviderAddresses) > 0 {
+		for _, s := range m.ProviderAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerInTop_N", wireType)
+			}
+			m.MinPowerInTop_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPowerInTop_N |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowlistedRewardDenoms == nil {
+				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			}
+			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InfractionParameters == nil {
+				m.InfractionParameters = &InfractionParameters{}
+			}
+			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
+			}
+			m.SlashMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterAllowance", wireType)
+			}
+			m.SlashMeterAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeterAllowance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReplenishCandidate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextReplenishCandidate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSlashPackets", wireType)
+			}
+			m.DowntimeSlashPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeSlashPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignSlashPackets", wireType)
+			}
+			m.DoubleSignSlashPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoubleSignSlashPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairValConAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairValConAddr = append(m.PairValConAddr, &PairValConAddrProviderAndConsumer{})
+			if err := m.PairValConAddr[len(m.PairValConAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsProviderAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsProviderAddresses = append(m.ValidatorsProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
+			}
+			m.ConsumerPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
+			}
+			m.ProviderPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatesCurrentEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatesCurrentEpoch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &QueryConsumerValidatorsValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
+			}
+			m.BlocksUntilNextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUntilNextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitParams == nil {
+				m.InitParams = &ConsumerInitializationParameters{}
+			}
+			if err := m.InitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PowerShapingParams == nil {
+				m.PowerShapingParams = &PowerShapingParameters{}
+			}
+			if err := m.PowerShapingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InfractionParameters == nil {
+				m.InfractionParameters = &InfractionParameters{}
+			}
+			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVSCMaturationScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVSCMaturationScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVSCMaturationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VSCMaturity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VSCMaturity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VSCMaturity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MaturityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVSCMaturationScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVSCMaturationScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVSCMaturationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maturities = append(m.Maturities, VSCMaturity{})
+			if err := m.Maturities[len(m.Maturities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateConsumerUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateConsumerUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateConsumerUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PowerShapingParams == nil {
+				m.PowerShapingParams = &PowerShapingParameters{}
+			}
+			if err := m.PowerShapingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateConsumerUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateConsumerUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateConsumerUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidators = append(m.NextValidators, ConsensusValidator{})
+			if err := m.NextValidators[len(m.NextValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types2.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorSetHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorSetHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetSize", wireType)
+			}
+			m.ValsetSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetUpdateIdToHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetUpdateIdToHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetUpdateIdToHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetUpdateIdToHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetUpdateIdToHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetUpdateIdToHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentValsetUpdateIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentValsetUpdateIdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentValsetUpdateIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentValsetUpdateIdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentValsetUpdateIdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentValsetUpdateIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateIdToHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetUpdateIdToHeight = append(m.ValsetUpdateIdToHeight, ValsetUpdateIdToHeight{})
+			if err := m.ValsetUpdateIdToHeight[len(m.ValsetUpdateIdToHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsUsingDefaultKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsUsingDefaultKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsUsingDefaultKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsUsingDefaultKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsUsingDefaultKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsUsingDefaultKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsProviderAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsProviderAddresses = append(m.ValidatorsProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRewardsAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRewardsAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRewardsAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRewardsAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRewardsAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRewardsAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopNThresholdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopNThresholdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopNThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopNThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopNThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopNThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPower", wireType)
+			}
+			m.MinPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerPhaseHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerPhaseHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerPhaseHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerPhaseHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerPhaseHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerPhaseHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transitions = append(m.Transitions, ConsumerPhaseTransition{})
+			if err := m.Transitions[len(m.Transitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerAddrsToPruneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerAddrsToPruneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerAddrsToPruneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerAddrsToPruneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerAddrsToPruneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerAddrsToPruneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrsToPrune", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddrsToPrune = append(m.ConsumerAddrsToPrune, ConsumerAddrsToPruneV2{})
+			if err := m.ConsumerAddrsToPrune[len(m.ConsumerAddrsToPrune)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentKeyAssignmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentKeyAssignmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentKeyAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceVscId", wireType)
+			}
+			m.SinceVscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceVscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentKeyAssignmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentKeyAssignmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentKeyAssignmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotations = append(m.KeyRotations, KeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJailingReasonRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJailingReasonRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJailingReasonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJailingReasonResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJailingReasonResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJailingReasonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types1.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerSlashPacketRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerSlashPacketRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerSlashPacketRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerSlashPacketRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerSlashPacketRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerSlashPacketRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveConsumerKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveConsumerKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveConsumerKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveConsumerKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveConsumerKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveConsumerKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerLaunchFailureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerLaunchFailureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashPacketBySeqRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashPacketBySeqRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashPacketBySeqRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeq", wireType)
+			}
+			m.IbcSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashPacketBySeqResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashPacketBySeqResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashPacketBySeqResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashPacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types1.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumersWithThrottledSlashingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumersWithThrottledSlashingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumersWithThrottledSlashingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumersWithThrottledSlashingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumersWithThrottledSlashingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumersWithThrottledSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumers = append(m.Consumers, ConsumerThrottledSlashPackets{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerThrottledSlashPackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerThrottledSlashPackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerThrottledSlashPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorTopNObligationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorTopNObligationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorTopNObligationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryConsumerGenesisTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryConsumerGenesisTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorTopNObligationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorTopNObligationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorTopNObligationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obligations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Obligations = append(m.Obligations, TopNObligation{})
+			if err := m.Obligations[len(m.Obligations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
+func (m *TopNObligation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4902,10 +23542,10 @@ func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopNObligation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopNObligation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4940,6 +23580,44 @@ func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerInTop_N", wireType)
+			}
+			m.MinPowerInTop_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPowerInTop_N |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4961,7 +23639,7 @@ func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerGenesisValsetHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4984,17 +23662,17 @@ func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerGenesisValsetHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerGenesisValsetHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5004,24 +23682,23 @@ func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5044,7 +23721,7 @@ func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerGenesisValsetHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,17 +23744,17 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerGenesisValsetHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerGenesisValsetHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHash", wireType)
 			}
-			m.Phase = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5087,16 +23764,79 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5106,27 +23846,23 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5149,7 +23885,7 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5172,17 +23908,17 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5192,31 +23928,29 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, &Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DistributionChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5226,28 +23960,84 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.ProviderChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Open = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5269,7 +24059,7 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Chain) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerValidatorSetsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5292,15 +24082,15 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerValidatorSetsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerValidatorSetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5328,13 +24118,63 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorSetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5344,103 +24184,79 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
-			}
-			m.Top_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ValidatorSets = append(m.ValidatorSets, ConsumerValidatorSet{})
+			if err := m.ValidatorSets[len(m.ValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerInTop_N", wireType)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MinPowerInTop_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinPowerInTop_N |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5468,13 +24284,13 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5484,27 +24300,79 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			m.Validators = append(m.Validators, &QueryConsumerValidatorsValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorKeyConflictsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorKeyConflictsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorKeyConflictsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5532,11 +24400,61 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorKeyConflictsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorKeyConflictsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorKeyConflictsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5563,52 +24481,64 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conflicts = append(m.Conflicts, ConsumerKeyConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MinStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerKeyConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.AllowInactiveVals = bool(v != 0)
-		case 13:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerKeyConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerKeyConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5636,11 +24566,11 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5667,16 +24597,16 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowlistedRewardDenoms == nil {
-				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
 			}
-			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5704,13 +24634,63 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRemovalETARequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRemovalETARequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRemovalETARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5720,27 +24700,23 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InfractionParameters == nil {
-				m.InfractionParameters = &InfractionParameters{}
-			}
-			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5763,7 +24739,7 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerRemovalETAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5786,17 +24762,37 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerRemovalETAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerRemovalETAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalScheduled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemovalScheduled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5806,29 +24802,30 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RemovalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5838,23 +24835,24 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5877,7 +24875,7 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerJailedPowerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5900,15 +24898,15 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerJailedPowerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerJailedPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5936,7 +24934,7 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5959,7 +24957,7 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerJailedPowerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5982,49 +24980,17 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerJailedPowerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerJailedPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedPower", wireType)
 			}
-			var stringLen uint64
+			m.JailedPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6034,24 +25000,11 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.JailedPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6073,7 +25026,7 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDenylistedConsumersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6096,10 +25049,10 @@ func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDenylistedConsumersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDenylistedConsumersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6155,7 +25108,7 @@ func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDenylistedConsumersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6178,12 +25131,44 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDenylistedConsumersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDenylistedConsumersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6205,7 +25190,7 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorHasToValidateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6228,17 +25213,17 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorHasToValidateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorHasToValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
-			m.SlashMeter = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6248,35 +25233,29 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashMeter |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterAllowance", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.SlashMeterAllowance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashMeterAllowance |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextReplenishCandidate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6286,24 +25265,23 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextReplenishCandidate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6326,7 +25304,7 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorHasToValidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6349,12 +25327,51 @@ func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorHasToValidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorHasToValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasToValidate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasToValidate = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= HasToValidateReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6376,7 +25393,7 @@ func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerCCVTimeoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6399,15 +25416,15 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerCCVTimeoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerCCVTimeoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6435,7 +25452,7 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6458,7 +25475,7 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerCCVTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6481,17 +25498,17 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerCCVTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerCCVTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6501,24 +25518,45 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6540,7 +25578,7 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyAssignmentStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6563,15 +25601,103 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyAssignmentStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyAssignmentStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKeyAssignmentStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKeyAssignmentStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKeyAssignmentStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAssignedKeys", wireType)
+			}
+			m.TotalAssignedKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAssignedKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAddrsToPrune", wireType)
+			}
+			m.TotalAddrsToPrune = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAddrsToPrune |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairValConAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6598,8 +25724,8 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairValConAddr = append(m.PairValConAddr, &PairValConAddrProviderAndConsumer{})
-			if err := m.PairValConAddr[len(m.PairValConAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsumerStats = append(m.ConsumerStats, ConsumerKeyAssignmentStats{})
+			if err := m.ConsumerStats[len(m.ConsumerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6624,7 +25750,7 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
+func (m *ConsumerKeyAssignmentStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6647,15 +25773,15 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerKeyAssignmentStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerKeyAssignmentStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6683,13 +25809,13 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedKeys", wireType)
 			}
-			var stringLen uint64
+			m.AssignedKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6699,29 +25825,16 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssignedKeys |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddrsToPrune", wireType)
 			}
-			var msglen int
+			m.AddrsToPrune = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6731,28 +25844,11 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AddrsToPrune |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6774,7 +25870,7 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashMeterHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6797,10 +25893,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashMeterHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashMeterHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6824,7 +25920,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashMeterHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6847,15 +25943,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashMeterHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashMeterHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6882,7 +25978,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, SlashMeterHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6907,7 +26004,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *SlashMeterHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6930,17 +26027,36 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashMeterHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashMeterHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
 			}
-			var stringLen uint64
+			m.SlashMeter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6950,24 +26066,11 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashMeter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6989,7 +26092,7 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAllConsumerKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7012,15 +26115,15 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAllConsumerKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAllConsumerKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsProviderAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7048,7 +26151,7 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsProviderAddresses = append(m.ValidatorsProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7071,7 +26174,7 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAllConsumerKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7094,17 +26197,17 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAllConsumerKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAllConsumerKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7114,23 +26217,25 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerKeys = append(m.ConsumerKeys, AssignedConsumerKey{})
+			if err := m.ConsumerKeys[len(m.ConsumerKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7153,7 +26258,7 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
+func (m *AssignedConsumerKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7176,15 +26281,15 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignedConsumerKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignedConsumerKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7212,66 +26317,11 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7299,34 +26349,13 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
-			}
-			m.ConsumerPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConsumerPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7336,130 +26365,133 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCommissionRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxProviderConsensusValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOperatorAddress", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxProviderConsensusValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxProviderConsensusValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxProviderConsensusValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderOperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxProviderConsensusValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxProviderConsensusValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderConsensusValidators", wireType)
 			}
-			var v int
+			m.MaxProviderConsensusValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7469,17 +26501,16 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxProviderConsensusValidators |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidators", wireType)
 			}
-			m.Status = 0
+			m.ActiveValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7489,14 +26520,64 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types1.BondStatus(b&0x7F) << shift
+				m.ActiveValidators |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumersByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumersByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumersByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7524,49 +26605,8 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
-			}
-			m.ProviderPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProviderPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatesCurrentEpoch", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ValidatesCurrentEpoch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7588,7 +26628,7 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumersByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7611,15 +26651,15 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumersByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumersByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7646,8 +26686,8 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &QueryConsumerValidatorsValidator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Consumers = append(m.Consumers, OwnedConsumer{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7672,7 +26712,7 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte) error {
+func (m *OwnedConsumer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7695,15 +26735,15 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnedConsumer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnedConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7731,8 +26771,27 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7754,7 +26813,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerChainIdAvailableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7777,15 +26836,15 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerChainIdAvailableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerChainIdAvailableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7813,7 +26872,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7836,7 +26895,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerChainIdAvailableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7859,17 +26918,17 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerChainIdAvailableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerChainIdAvailableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7879,27 +26938,15 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Available = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7927,8 +26974,58 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ConflictingConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextConsumerIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextConsumerIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextConsumerIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7950,7 +27047,7 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextConsumerIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7973,15 +27070,15 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextConsumerIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextConsumerIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8009,9 +27106,7 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8034,7 +27129,7 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerVSCLatencyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8057,12 +27152,44 @@ func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerVSCLatencyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerVSCLatencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8084,7 +27211,7 @@ func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerVSCLatencyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8107,17 +27234,17 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerVSCLatencyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerVSCLatencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVscPackets", wireType)
 			}
-			m.BlocksUntilNextEpoch = 0
+			m.NumVscPackets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8127,11 +27254,77 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksUntilNextEpoch |= uint64(b&0x7F) << shift
+				m.NumVscPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AverageLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8153,7 +27346,7 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumersByClientIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8176,10 +27369,10 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumersByClientIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumersByClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8235,7 +27428,7 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumersByClientIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8258,15 +27451,15 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumersByClientIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumersByClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8294,7 +27487,7 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8317,7 +27510,7 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPruningInvariantRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8340,10 +27533,10 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPruningInvariantRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPruningInvariantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8399,7 +27592,7 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPruningInvariantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8422,17 +27615,17 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPruningInvariantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPruningInvariantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8442,27 +27635,15 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Holds = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ViolatingConsumerAddrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8490,11 +27671,61 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ViolatingConsumerAddrs = append(m.ViolatingConsumerAddrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsConsumerObligationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsConsumerObligationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsConsumerObligationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8522,43 +27753,61 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddrs = append(m.ProviderAddrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsConsumerObligationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsConsumerObligationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsConsumerObligationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Obligations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8585,15 +27834,66 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Obligations = append(m.Obligations, ValidatorConsumerObligations{})
+			if err := m.Obligations[len(m.Obligations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorConsumerObligations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorConsumerObligations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorConsumerObligations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8603,33 +27903,29 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitParams == nil {
-				m.InitParams = &ConsumerInitializationParameters{}
-			}
-			if err := m.InitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8639,31 +27935,27 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PowerShapingParams == nil {
-				m.PowerShapingParams = &PowerShapingParameters{}
-			}
-			if err := m.PowerShapingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8690,45 +27982,61 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InfractionParameters == nil {
-				m.InfractionParameters = &InfractionParameters{}
-			}
-			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsumerKeys = append(m.ConsumerKeys, AssignedConsumerKey{})
+			if err := m.ConsumerKeys[len(m.ConsumerKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDefaultKeyUsersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDefaultKeyUsersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDefaultKeyUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8750,7 +28058,7 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDefaultKeyUsersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8773,17 +28081,17 @@ func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDefaultKeyUsersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDefaultKeyUsersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8793,23 +28101,25 @@ func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.Consumers = append(m.Consumers, ConsumerDefaultKeyUsers{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8832,7 +28142,7 @@ func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerDefaultKeyUsers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8855,17 +28165,17 @@ func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerDefaultKeyUsers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerDefaultKeyUsers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8875,24 +28185,55 @@ func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ProviderAddresses = append(m.ProviderAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -97,6 +97,7 @@ type ChannelKeeper interface {
 	WriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error
 	ChanCloseInit(ctx sdk.Context, portID, channelID string) error
 	GetChannelConnection(ctx sdk.Context, portID, channelID string) (string, conntypes.ConnectionEnd, error)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
 }
 
 // ConnectionKeeper defines the expected IBC connection keeper
@@ -142,9 +143,10 @@ type AccountKeeper interface {
 }
 
 // IBCTransferKeeper defines the expected interface needed for distribution transfer
-// of tokens from the consumer to the provider chain
+// of tokens from the consumer to the provider chain and for querying the transfer params
 type IBCTransferKeeper interface {
 	Transfer(context.Context, *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+	GetParams(ctx sdk.Context) transfertypes.Params
 }
 
 // IBCCoreKeeper defines the expected interface needed for opening a
@@ -57,6 +57,16 @@ func ValidatePositiveInt64(i interface{}) error {
 	return nil
 }
 
+func ValidateNonNegativeInt64(i interface{}) error {
+	if err := ValidateInt64(i); err != nil {
+		return err
+	}
+	if i.(int64) < int64(0) {
+		return errors.New("int must be non-negative")
+	}
+	return nil
+}
+
 func ValidateString(i interface{}) error {
 	if _, ok := i.(string); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -18,6 +18,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 )
 
@@ -53,6 +54,11 @@ func AccumulateChanges(currentChanges, newChanges []abci.ValidatorUpdate) []abci
 // TMCryptoPublicKeyToConsAddr converts a TM public key to an SDK public key
 // and returns the associated consensus address
 func TMCryptoPublicKeyToConsAddr(k tmprotocrypto.PublicKey) (sdk.ConsAddress, error) {
+	// check the key size, as the SDK public key would panic when computing
+	// the address of a malformed key
+	if _, err := cryptoenc.PubKeyFromProto(k); err != nil {
+		return nil, err
+	}
 	sdkK, err := cryptocodec.FromCmtProtoPublicKey(k)
 	if err != nil {
 		return nil, err
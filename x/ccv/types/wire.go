@@ -65,6 +65,17 @@ func NewSlashPacketData(validator abci.Validator, valUpdateId uint64, infraction
 	}
 }
 
+// NewSlashConsumerPacketData creates a new ConsumerPacketData of type SlashPacket
+// that wraps the slash packet data created by NewSlashPacketData.
+func NewSlashConsumerPacketData(validator abci.Validator, valUpdateId uint64, infractionType stakingtypes.Infraction) ConsumerPacketData {
+	return NewConsumerPacketData(
+		SlashPacket,
+		&ConsumerPacketData_SlashPacketData{
+			SlashPacketData: NewSlashPacketData(validator, valUpdateId, infractionType),
+		},
+	)
+}
+
 // NewSlashPacketDataV1 creates a new SlashPacketDataV1 that uses ccv.InfractionTypes to maintain backward compatibility.
 func NewSlashPacketDataV1(validator abci.Validator, valUpdateId uint64, infractionType stakingtypes.Infraction) *SlashPacketDataV1 {
 	v1Type := InfractionEmpty
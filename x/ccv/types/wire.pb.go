@@ -368,6 +368,10 @@ func (*ConsumerPacketData) XXX_OneofWrappers() []interface{} {
 type HandshakeMetadata struct {
 	ProviderFeePoolAddr string `protobuf:"bytes,1,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
 	Version             string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// the maximum power (as a percentage of the total power) a validator can have
+	// on the consumer chain; zero means that power capping is not applied, i.e.,
+	// the consumer chain receives the staking power of the validators
+	ValidatorsPowerCap uint32 `protobuf:"varint,3,opt,name=validators_power_cap,json=validatorsPowerCap,proto3" json:"validators_power_cap,omitempty"`
 }
 
 func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
@@ -417,6 +421,13 @@ func (m *HandshakeMetadata) GetVersion() string {
 	return ""
 }
 
+func (m *HandshakeMetadata) GetValidatorsPowerCap() uint32 {
+	if m != nil {
+		return m.ValidatorsPowerCap
+	}
+	return 0
+}
+
 // ConsumerPacketData contains a consumer packet data and a type tag
 // that is compatible with ICS v1 and v2 over the wire. It is not used for internal storage.
 type ConsumerPacketDataV1 struct {
@@ -593,60 +604,61 @@ func init() {
 }
 
 var fileDescriptor_8fd0dc67df6b10ed = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x26, 0x65, 0x23, 0xad, 0x57, 0x85, 0x4c, 0x33, 0x6a, 0xc0, 0x32, 0xad, 0x42, 0x10, 0x2d,
-	0x20, 0xb8, 0x08, 0x59, 0xc9, 0x01, 0x0a, 0xb4, 0x97, 0xea, 0x87, 0xae, 0xd9, 0xc6, 0xb2, 0x40,
-	0x4a, 0x0a, 0xd2, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x42, 0x12, 0x97, 0xe0, 0xae, 0x98, 0xea, 0x0d,
-	0x0a, 0x9d, 0xfa, 0x02, 0x3a, 0x15, 0x3d, 0xe4, 0x31, 0x7a, 0xcb, 0x31, 0x40, 0x2f, 0xb9, 0x34,
-	0x28, 0xec, 0x37, 0xe8, 0x13, 0x14, 0xa4, 0x7e, 0x2d, 0xd1, 0x06, 0x02, 0x14, 0x68, 0x6e, 0xe4,
-	0xec, 0x7c, 0xdf, 0xce, 0x7c, 0xdf, 0x2c, 0x06, 0x7c, 0x81, 0x7d, 0x86, 0x42, 0xb7, 0x0f, 0xb1,
-	0xef, 0x50, 0xe4, 0x8e, 0x43, 0xcc, 0x26, 0xba, 0xeb, 0x46, 0x7a, 0x54, 0xd2, 0x5f, 0xe0, 0x10,
-	0x69, 0x41, 0x48, 0x18, 0x11, 0xe5, 0x94, 0x34, 0xcd, 0x75, 0x23, 0x2d, 0x2a, 0xc9, 0x9f, 0xbb,
-	0x84, 0x8e, 0x08, 0xd5, 0x29, 0x83, 0x03, 0xec, 0xf7, 0xf4, 0xa8, 0xd4, 0x45, 0x0c, 0x96, 0x96,
-	0xff, 0x73, 0x06, 0x39, 0xdf, 0x23, 0x3d, 0x92, 0x7c, 0xea, 0xf1, 0xd7, 0x22, 0xfa, 0x90, 0x21,
-	0xdf, 0x43, 0xe1, 0x08, 0xfb, 0x4c, 0x87, 0x5d, 0x17, 0xeb, 0x6c, 0x12, 0x20, 0x3a, 0x3f, 0x54,
-	0xdf, 0xf0, 0xe0, 0xd3, 0x0e, 0x1c, 0x62, 0x0f, 0x32, 0x12, 0xda, 0x88, 0xd5, 0xfa, 0xd0, 0xef,
-	0xa1, 0x26, 0x74, 0x07, 0x88, 0xd5, 0x21, 0x83, 0x22, 0x01, 0x47, 0xd1, 0xf2, 0xdc, 0x19, 0x07,
-	0x1e, 0x64, 0x88, 0x4a, 0xbc, 0xb2, 0x57, 0xcc, 0x96, 0x15, 0x6d, 0xcd, 0xac, 0xc5, 0xcc, 0xda,
-	0x8a, 0xa9, 0x9d, 0x24, 0x56, 0x95, 0x57, 0x6f, 0x1f, 0x71, 0xff, 0xbc, 0x7d, 0x24, 0x4d, 0xe0,
-	0x68, 0xf8, 0x8d, 0xba, 0x43, 0xa4, 0x5a, 0x42, 0x74, 0x13, 0x42, 0xc5, 0x22, 0x88, 0x63, 0x14,
-	0xb1, 0x45, 0x92, 0x83, 0x3d, 0x29, 0xa3, 0xf0, 0xc5, 0x7d, 0x2b, 0x37, 0x8f, 0xcf, 0x13, 0x4d,
-	0x4f, 0xfc, 0x0c, 0x00, 0x3a, 0x84, 0xb4, 0xef, 0x40, 0x77, 0x40, 0xa5, 0x3d, 0x65, 0xaf, 0x78,
-	0x60, 0x1d, 0x24, 0x91, 0x8a, 0x3b, 0xa0, 0xea, 0x77, 0x20, 0xdf, 0xb1, 0x6b, 0xe7, 0x90, 0x8d,
-	0x43, 0xe4, 0x6d, 0x74, 0x94, 0x76, 0x01, 0x9f, 0x76, 0x81, 0xfa, 0x27, 0x0f, 0x0e, 0xed, 0x98,
-	0x6f, 0x03, 0x6d, 0x81, 0x83, 0x55, 0xc9, 0x09, 0x2c, 0x5b, 0x96, 0x6f, 0xd7, 0xa1, 0x2a, 0x2d,
-	0x14, 0x10, 0xb6, 0x14, 0x50, 0xad, 0x35, 0xcd, 0x3b, 0xb4, 0x5c, 0x05, 0x00, 0xfb, 0x97, 0x21,
-	0x74, 0x19, 0x26, 0xbe, 0xb4, 0xa7, 0xf0, 0xc5, 0x5c, 0x59, 0xd5, 0xe6, 0xc3, 0xa1, 0x2d, 0x87,
-	0x61, 0x31, 0x1c, 0x9a, 0xb9, 0xca, 0xb4, 0x36, 0x50, 0xea, 0xef, 0x19, 0x20, 0xd6, 0x88, 0x4f,
-	0xc7, 0x23, 0x14, 0x6e, 0x34, 0x76, 0x0a, 0xf6, 0xe3, 0xc1, 0x48, 0x7a, 0xca, 0x95, 0xcb, 0xda,
-	0xed, 0xd3, 0xa8, 0xed, 0xa2, 0x5b, 0x93, 0x00, 0x59, 0x09, 0x5e, 0x7c, 0x06, 0x0e, 0xe9, 0x4d,
-	0xcd, 0x92, 0x5e, 0xb2, 0xe5, 0x2f, 0xef, 0xa2, 0xdc, 0x92, 0xf9, 0x8c, 0xb3, 0xb6, 0x59, 0xc4,
-	0x4b, 0x90, 0x8f, 0xa8, 0xbb, 0xe3, 0x67, 0xa2, 0x42, 0xb6, 0xfc, 0xd5, 0x5d, 0xec, 0x69, 0x73,
-	0x70, 0xc6, 0x59, 0xa9, 0x7c, 0xd5, 0x7b, 0x60, 0xdf, 0x83, 0x0c, 0xaa, 0x5d, 0x70, 0x74, 0x06,
-	0x7d, 0x8f, 0xf6, 0xe1, 0x00, 0x9d, 0x23, 0x06, 0xe3, 0xa0, 0x78, 0x02, 0x1e, 0x04, 0x21, 0x89,
-	0xb0, 0x87, 0x42, 0xe7, 0x12, 0x21, 0x27, 0x20, 0x64, 0xe8, 0x40, 0xcf, 0x9b, 0xcf, 0xc2, 0x81,
-	0x75, 0x7f, 0x79, 0x7a, 0x8a, 0x50, 0x93, 0x90, 0x61, 0xc5, 0xf3, 0x42, 0x51, 0x02, 0x1f, 0x44,
-	0x28, 0xa4, 0xb1, 0x65, 0x99, 0x24, 0x6b, 0xf9, 0xab, 0xbe, 0xcc, 0x80, 0xfc, 0xae, 0x9a, 0x9d,
-	0xd2, 0x7f, 0xe6, 0xc6, 0xf3, 0xdb, 0xdc, 0x78, 0xfc, 0x0e, 0x6e, 0x74, 0x4a, 0xef, 0x83, 0x1f,
-	0x7f, 0xf1, 0xe0, 0x68, 0xa7, 0xb0, 0xff, 0xf9, 0x3d, 0xfe, 0x90, 0xf2, 0x1e, 0x8f, 0xef, 0xea,
-	0x7c, 0xfd, 0x26, 0x13, 0x93, 0x36, 0xd0, 0xc7, 0x7f, 0xf0, 0xe0, 0x41, 0xba, 0x97, 0xe2, 0xb7,
-	0x40, 0xa9, 0x5d, 0x34, 0xec, 0xf6, 0xb9, 0x61, 0x39, 0xcd, 0x4a, 0xed, 0x47, 0xa3, 0xe5, 0xb4,
-	0x9e, 0x37, 0x0d, 0xa7, 0xdd, 0xb0, 0x9b, 0x46, 0xcd, 0x3c, 0x35, 0x8d, 0xba, 0xc0, 0xc9, 0x1f,
-	0x4f, 0x67, 0xca, 0x51, 0xdb, 0xa7, 0x01, 0x72, 0xf1, 0x25, 0x5e, 0x6a, 0x28, 0xea, 0x40, 0x4e,
-	0x05, 0xdb, 0x4f, 0x2b, 0xf6, 0x99, 0xc0, 0xcb, 0x87, 0xd3, 0x99, 0x92, 0xdd, 0x10, 0x56, 0x3c,
-	0x01, 0x9f, 0xa4, 0x02, 0x62, 0xd7, 0x84, 0x8c, 0x9c, 0x9f, 0xce, 0x14, 0xa1, 0xb3, 0xe5, 0x94,
-	0xbc, 0xff, 0xcb, 0x6f, 0x05, 0xee, 0xf8, 0x25, 0x0f, 0x72, 0x37, 0x5b, 0x14, 0x9f, 0x80, 0x87,
-	0x66, 0xe3, 0xd4, 0xaa, 0xd4, 0x5a, 0xe6, 0x45, 0x23, 0xad, 0xec, 0xfb, 0xd3, 0x99, 0x72, 0xb8,
-	0x06, 0x19, 0xa3, 0x80, 0x4d, 0x44, 0x7d, 0x17, 0x55, 0xbf, 0x68, 0x57, 0x9f, 0x1a, 0x8e, 0x6d,
-	0x7e, 0xdf, 0x10, 0x78, 0x39, 0x37, 0x9d, 0x29, 0xa0, 0x4e, 0xc6, 0xdd, 0x21, 0xb2, 0x71, 0xcf,
-	0x17, 0x8f, 0x81, 0xb4, 0x0b, 0x78, 0xd6, 0x68, 0x99, 0xe7, 0x86, 0x90, 0x91, 0x3f, 0x9a, 0xce,
-	0x94, 0x0f, 0xeb, 0xe4, 0x85, 0xcf, 0xf0, 0x08, 0xcd, 0x6b, 0xad, 0x36, 0x5e, 0x5d, 0x15, 0xf8,
-	0xd7, 0x57, 0x05, 0xfe, 0xef, 0xab, 0x02, 0xff, 0xeb, 0x75, 0x81, 0x7b, 0x7d, 0x5d, 0xe0, 0xde,
-	0x5c, 0x17, 0xb8, 0x9f, 0x9e, 0xf4, 0x30, 0xeb, 0x8f, 0xbb, 0x9a, 0x4b, 0x46, 0xfa, 0x62, 0xf1,
-	0xae, 0x2d, 0x7d, 0xbc, 0x5a, 0xe1, 0xd1, 0xd7, 0xfa, 0xcf, 0xc9, 0x1e, 0x4f, 0x16, 0x6a, 0xf7,
-	0x5e, 0xb2, 0x51, 0x4f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xac, 0x81, 0x69, 0x45, 0xef, 0x07,
-	0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xd3, 0x6a, 0xa1, 0x13, 0x48, 0xd3, 0xd9, 0xb0, 0x0a, 0x5e, 0xc8, 0x5a, 0x16, 0x48,
+	0x51, 0xd1, 0xda, 0x9b, 0x74, 0x25, 0x24, 0xb8, 0x90, 0x7f, 0xa5, 0x86, 0x6d, 0x1a, 0xd9, 0x49,
+	0x56, 0xcb, 0xc5, 0x9a, 0x8c, 0xa7, 0xc9, 0x28, 0x89, 0xc7, 0xf2, 0x4c, 0x5c, 0xf2, 0x0d, 0x50,
+	0x4e, 0x1c, 0xb8, 0xe6, 0x84, 0x38, 0xec, 0xc7, 0xe0, 0xb6, 0xc7, 0x95, 0xb8, 0xec, 0x85, 0x15,
+	0x6a, 0xbf, 0x01, 0x9f, 0x00, 0xd9, 0xf9, 0xdb, 0xc6, 0xad, 0xb4, 0x12, 0x12, 0xdc, 0xec, 0xf7,
+	0xde, 0xef, 0x37, 0xf3, 0xde, 0xef, 0x37, 0x7a, 0xe0, 0x73, 0xea, 0x0a, 0xe2, 0xe3, 0x3e, 0xa2,
+	0xae, 0xcd, 0x09, 0x1e, 0xfb, 0x54, 0x4c, 0x74, 0x8c, 0x03, 0x3d, 0x28, 0xea, 0x17, 0xd4, 0x27,
+	0x9a, 0xe7, 0x33, 0xc1, 0xa0, 0x1c, 0x53, 0xa6, 0x61, 0x1c, 0x68, 0x41, 0x51, 0xfe, 0x0c, 0x33,
+	0x3e, 0x62, 0x5c, 0xe7, 0x02, 0x0d, 0xa8, 0xdb, 0xd3, 0x83, 0x62, 0x97, 0x08, 0x54, 0x5c, 0xfe,
+	0xcf, 0x19, 0xe4, 0x6c, 0x8f, 0xf5, 0x58, 0xf4, 0xa9, 0x87, 0x5f, 0x8b, 0xe8, 0x43, 0x41, 0x5c,
+	0x87, 0xf8, 0x23, 0xea, 0x0a, 0x1d, 0x75, 0x31, 0xd5, 0xc5, 0xc4, 0x23, 0x7c, 0x9e, 0x54, 0xdf,
+	0x48, 0xe0, 0x93, 0x0e, 0x1a, 0x52, 0x07, 0x09, 0xe6, 0x5b, 0x44, 0x54, 0xfb, 0xc8, 0xed, 0x91,
+	0x26, 0xc2, 0x03, 0x22, 0x6a, 0x48, 0x20, 0xc8, 0xc0, 0x41, 0xb0, 0xcc, 0xdb, 0x63, 0xcf, 0x41,
+	0x82, 0xf0, 0x9c, 0xa4, 0xec, 0x14, 0x52, 0x25, 0x45, 0x5b, 0x33, 0x6b, 0x21, 0xb3, 0xb6, 0x62,
+	0x6a, 0x47, 0x85, 0x15, 0xe5, 0xd5, 0xdb, 0x47, 0x89, 0xbf, 0xdf, 0x3e, 0xca, 0x4d, 0xd0, 0x68,
+	0xf8, 0x95, 0xba, 0x45, 0xa4, 0x9a, 0x99, 0xe0, 0x3a, 0x84, 0xc3, 0x02, 0x08, 0x63, 0x9c, 0x88,
+	0x45, 0x91, 0x4d, 0x9d, 0x5c, 0x52, 0x91, 0x0a, 0xbb, 0x66, 0x7a, 0x1e, 0x9f, 0x17, 0x1a, 0x0e,
+	0xfc, 0x14, 0x00, 0x3e, 0x44, 0xbc, 0x6f, 0x23, 0x3c, 0xe0, 0xb9, 0x1d, 0x65, 0xa7, 0xb0, 0x67,
+	0xee, 0x45, 0x91, 0x32, 0x1e, 0x70, 0xf5, 0x1b, 0x90, 0xed, 0x58, 0xd5, 0x53, 0x24, 0xc6, 0x3e,
+	0x71, 0x36, 0x3a, 0x8a, 0x3b, 0x40, 0x8a, 0x3b, 0x40, 0xfd, 0x43, 0x02, 0xfb, 0x56, 0xc8, 0xb7,
+	0x81, 0x36, 0xc1, 0xde, 0xea, 0xca, 0x11, 0x2c, 0x55, 0x92, 0x6f, 0x9f, 0x43, 0x25, 0xb7, 0x98,
+	0x40, 0xe6, 0xc6, 0x04, 0x54, 0x73, 0x4d, 0xf3, 0x0e, 0x2d, 0x57, 0x00, 0xa0, 0xee, 0xb9, 0x8f,
+	0xb0, 0xa0, 0xcc, 0xcd, 0xed, 0x28, 0x52, 0x21, 0x5d, 0x52, 0xb5, 0xb9, 0x39, 0xb4, 0xa5, 0x19,
+	0x16, 0xe6, 0xd0, 0x8c, 0x55, 0xa5, 0xb9, 0x81, 0x52, 0x7f, 0x4b, 0x02, 0x58, 0x65, 0x2e, 0x1f,
+	0x8f, 0x88, 0xbf, 0xd1, 0xd8, 0x31, 0xd8, 0x0d, 0x8d, 0x11, 0xf5, 0x94, 0x2e, 0x95, 0xb4, 0xdb,
+	0xdd, 0xa8, 0x6d, 0xa3, 0x5b, 0x13, 0x8f, 0x98, 0x11, 0x1e, 0x3e, 0x07, 0xfb, 0xfc, 0xfa, 0xcc,
+	0xa2, 0x5e, 0x52, 0xa5, 0x2f, 0xee, 0xa2, 0xbc, 0x31, 0xe6, 0x93, 0x84, 0x79, 0x93, 0x05, 0x9e,
+	0x83, 0x6c, 0xc0, 0xf1, 0x96, 0x9e, 0xd1, 0x14, 0x52, 0xa5, 0x27, 0x77, 0xb1, 0xc7, 0xf9, 0xe0,
+	0x24, 0x61, 0xc6, 0xf2, 0x55, 0xee, 0x81, 0x5d, 0x07, 0x09, 0xa4, 0xfe, 0x22, 0x81, 0x83, 0x13,
+	0xe4, 0x3a, 0xbc, 0x8f, 0x06, 0xe4, 0x94, 0x08, 0x14, 0x46, 0xe1, 0x11, 0x78, 0xe0, 0xf9, 0x2c,
+	0xa0, 0x0e, 0xf1, 0xed, 0x73, 0x42, 0x6c, 0x8f, 0xb1, 0xa1, 0x8d, 0x1c, 0x67, 0x6e, 0x86, 0x3d,
+	0xf3, 0xfe, 0x32, 0x7b, 0x4c, 0x48, 0x93, 0xb1, 0x61, 0xd9, 0x71, 0x7c, 0x98, 0x03, 0xef, 0x05,
+	0xc4, 0xe7, 0xa1, 0x66, 0xc9, 0xa8, 0x6a, 0xf9, 0x0b, 0x9f, 0x80, 0xec, 0xca, 0x07, 0xdc, 0xf6,
+	0xd8, 0x05, 0xf1, 0x6d, 0x8c, 0xbc, 0xa8, 0xa9, 0x0f, 0x4d, 0xb8, 0xce, 0x35, 0xc3, 0x54, 0x15,
+	0x79, 0xea, 0xcb, 0x24, 0xc8, 0x6e, 0x0b, 0xd0, 0x29, 0xfe, 0x6b, 0x02, 0xbe, 0xb8, 0x4d, 0xc0,
+	0xc7, 0xef, 0x20, 0x60, 0xa7, 0xf8, 0x7f, 0x90, 0xf0, 0x4f, 0x09, 0x1c, 0x6c, 0x5d, 0xec, 0x3f,
+	0x7e, 0xc2, 0xdf, 0xc5, 0x3c, 0xe1, 0xc3, 0xbb, 0x3a, 0x5f, 0x3f, 0xe3, 0x48, 0xa4, 0x0d, 0xf4,
+	0xe1, 0xef, 0x12, 0x78, 0x10, 0xaf, 0x25, 0xfc, 0x1a, 0x28, 0xd5, 0xb3, 0x86, 0xd5, 0x3e, 0xad,
+	0x9b, 0x76, 0xb3, 0x5c, 0xfd, 0xbe, 0xde, 0xb2, 0x5b, 0x2f, 0x9a, 0x75, 0xbb, 0xdd, 0xb0, 0x9a,
+	0xf5, 0xaa, 0x71, 0x6c, 0xd4, 0x6b, 0x99, 0x84, 0xfc, 0xd1, 0x74, 0xa6, 0x1c, 0xb4, 0x5d, 0xee,
+	0x11, 0x4c, 0xcf, 0xe9, 0x72, 0x86, 0x50, 0x07, 0x72, 0x2c, 0xd8, 0x7a, 0x56, 0xb6, 0x4e, 0x32,
+	0x92, 0xbc, 0x3f, 0x9d, 0x29, 0xa9, 0x8d, 0xc1, 0xc2, 0x23, 0xf0, 0x71, 0x2c, 0x20, 0x54, 0x2d,
+	0x93, 0x94, 0xb3, 0xd3, 0x99, 0x92, 0xe9, 0xdc, 0x50, 0x4a, 0xde, 0xfd, 0xe9, 0xd7, 0x7c, 0xe2,
+	0xf0, 0xa5, 0x04, 0xd2, 0xd7, 0x5b, 0x84, 0x4f, 0xc1, 0x43, 0xa3, 0x71, 0x6c, 0x96, 0xab, 0x2d,
+	0xe3, 0xac, 0x11, 0x77, 0xed, 0xfb, 0xd3, 0x99, 0xb2, 0xbf, 0x06, 0xd5, 0x47, 0x9e, 0x98, 0x40,
+	0x7d, 0x1b, 0x55, 0x3b, 0x6b, 0x57, 0x9e, 0xd5, 0x6d, 0xcb, 0xf8, 0xb6, 0x91, 0x91, 0xe4, 0xf4,
+	0x74, 0xa6, 0x80, 0x1a, 0x1b, 0x77, 0x87, 0xc4, 0xa2, 0x3d, 0x17, 0x1e, 0x82, 0xdc, 0x36, 0xe0,
+	0x79, 0xa3, 0x65, 0x9c, 0xd6, 0x33, 0x49, 0xf9, 0x83, 0xe9, 0x4c, 0x79, 0xbf, 0xc6, 0x2e, 0x5c,
+	0x41, 0x47, 0x64, 0x7e, 0xd7, 0x4a, 0xe3, 0xd5, 0x65, 0x5e, 0x7a, 0x7d, 0x99, 0x97, 0xfe, 0xba,
+	0xcc, 0x4b, 0x3f, 0x5f, 0xe5, 0x13, 0xaf, 0xaf, 0xf2, 0x89, 0x37, 0x57, 0xf9, 0xc4, 0x0f, 0x4f,
+	0x7b, 0x54, 0xf4, 0xc7, 0x5d, 0x0d, 0xb3, 0x91, 0xbe, 0xd8, 0xd5, 0x6b, 0x49, 0x1f, 0xaf, 0xb6,
+	0x7e, 0xf0, 0xa5, 0xfe, 0x63, 0xb4, 0xfa, 0xa3, 0x1d, 0xdc, 0xbd, 0x17, 0x2d, 0xe1, 0xa3, 0x7f,
+	0x06, 0x00, 0x39, 0x87, 0x3a, 0x38, 0x22, 0x08, 0x00, 0x00,
 }
 
 func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
@@ -870,6 +882,11 @@ func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -1126,6 +1143,9 @@ func (m *HandshakeMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWire(uint64(l))
 	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovWire(uint64(m.ValidatorsPowerCap))
+	}
 	return n
 }
 
@@ -1748,6 +1768,25 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])